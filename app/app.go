@@ -207,6 +207,8 @@ import (
 
 	globalfeekeeper "github.com/neutron-org/neutron/v5/x/globalfee/keeper"
 	gmpmiddleware "github.com/neutron-org/neutron/v5/x/gmp"
+	gmpkeeper "github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 
 	// Block-sdk imports
 	blocksdkabci "github.com/skip-mev/block-sdk/v2/abci"
@@ -299,6 +301,8 @@ var (
 		dynamicfees.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		gamm.AppModuleBasic{},
+		mintburnmodule.AppModuleBasic{},
+		gmpmiddleware.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -437,6 +441,8 @@ type App struct {
 	// Adding the mintburn keeper
 	MintBurnKeeper mintburn.Keeper
 
+	GMPKeeper gmpkeeper.Keeper
+
 	GAMMKeeper gammkeeper.Keeper
 
 }
@@ -513,7 +519,7 @@ func New(
 		feeburnertypes.StoreKey, adminmoduletypes.StoreKey, ccvconsumertypes.StoreKey, tokenfactorytypes.StoreKey, pfmtypes.StoreKey,
 		crontypes.StoreKey, ibcratelimittypes.ModuleName, ibchookstypes.StoreKey, consensusparamtypes.StoreKey, crisistypes.StoreKey, dextypes.StoreKey, auctiontypes.StoreKey,
 		oracletypes.StoreKey, marketmaptypes.StoreKey, feemarkettypes.StoreKey, dynamicfeestypes.StoreKey, globalfeetypes.StoreKey,
-		mintburntypes.StoreKey, gammtypes.StoreKey,
+		mintburntypes.StoreKey, gammtypes.StoreKey, gmptypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, dextypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feetypes.MemStoreKey)
@@ -627,12 +633,16 @@ func New(
 	)
 
 	app.MintBurnKeeper = mintburn.NewKeeper(
-		mintburntypes.ModuleName,
+		appCodec,
 		keys[mintburntypes.StoreKey],
-		app.BankKeeper, 
+		app.BankKeeper,
+		// the transfer keeper is created later on
+		&app.TransferKeeper,
 		app.IBCKeeper.ChannelKeeper,
-		app.IBCKeeper.ConnectionKeeper, 
+		app.IBCKeeper.ConnectionKeeper,
 		app.IBCKeeper.ClientKeeper,
+		&app.InterchainQueriesKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
 	// Feekeeper needs to be initialized before middlewares injection
@@ -668,6 +678,18 @@ func New(
 	app.WireICS20PreWasmKeeper(appCodec)
 	app.PFMModule = packetforward.NewAppModule(app.PFMKeeper, app.GetSubspace(pfmtypes.ModuleName))
 
+	app.GMPKeeper = gmpkeeper.NewKeeper(
+		appCodec,
+		keys[gmptypes.StoreKey],
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.DexKeeper,
+		app.BankKeeper,
+		// the wasm keeper is created later, pure general messages call contracts through it
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper,
@@ -741,6 +763,8 @@ func New(
 		app.BankKeeper.WithMintCoinsRestriction(dextypes.NewDexDenomMintCoinsRestriction()),
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
+	// Modules reacting to dex trading activity must set their hooks right here: the dex module built below
+	// holds a copy of the keeper, so hooks set afterwards would never be called by its msg server
 
 	app.AuctionKeeper = auctionkeeper.NewKeeperWithRewardsAddressProvider(
 		appCodec,
@@ -845,6 +869,7 @@ func New(
 		&app.DexKeeper,
 		app.OracleKeeper,
 		app.MarketMapKeeper,
+		&app.GMPKeeper,
 	), wasmOpts...)
 
 	queryPlugins := wasmkeeper.WithQueryPlugins(
@@ -916,7 +941,9 @@ func New(
 
 	transferModule := transferSudo.NewAppModule(app.TransferKeeper)
 
-	wrappedTransfer := mintburnmodule.NewIBCMiddleware(app.TransferStack, app.MintBurnKeeper)
+	// gmp sits above wasm hooks for them to execute the payload of general messages
+	gmpTransfer := gmpmiddleware.NewIBCMiddleware(app.TransferStack, app.GMPKeeper)
+	wrappedTransfer := mintburnmodule.NewIBCMiddleware(gmpTransfer, app.MintBurnKeeper)
 
 	app.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
 
@@ -981,6 +1008,7 @@ func New(
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		mintburnmodule.NewAppModule(appCodec, app.MintBurnKeeper, app.Logger()),
 		gamm.NewAppModule(appCodec, app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		gmpmiddleware.NewAppModule(appCodec, app.GMPKeeper),
 
 	)
 
@@ -1028,7 +1056,7 @@ func New(
 		dextypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		gammtypes.ModuleName,
-
+		gmptypes.ModuleName,
 	)
 
 	app.mm.SetOrderEndBlockers(
@@ -1066,7 +1094,9 @@ func New(
 		feemarkettypes.ModuleName,
 		dextypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		mintburntypes.ModuleName,
 		gammtypes.ModuleName,
+		gmptypes.ModuleName,
 
 	)
 
@@ -1113,6 +1143,7 @@ func New(
 		consensusparamtypes.ModuleName,
 		mintburntypes.ModuleName,
 		gammtypes.ModuleName,
+		gmptypes.ModuleName,
 
 
 	)
@@ -1715,7 +1746,9 @@ func overrideWasmVariables() {
 // * SendPacket. Originates from the transferKeeper and goes up the stack:
 // transferKeeper.SendPacket -> ibc_rate_limit.SendPacket -> ibc_hooks.SendPacket -> channel.SendPacket
 // * RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-// channel.RecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> gmp.OnRecvPacket -> pfm.OnRecvPacket -> transfer.OnRecvPacket
+// channel.RecvPacket -> mintburn.OnRecvPacket -> gmp.OnRecvPacket -> ibc_hooks.OnRecvPacket -> ibc_rate_limit.OnRecvPacket -> pfm.OnRecvPacket -> transfer.OnRecvPacket
+//
+// mintburn and gmp wrap the TransferStack built here once their keepers are created.
 //
 // Note that the forward middleware is only integrated on the "receive" direction. It can be safely skipped when sending.
 // Note also that the forward middleware is called "router", but we are using the name "pfm" (packet forward middleware) for clarity
@@ -1790,7 +1823,6 @@ func (app *App) WireICS20PreWasmKeeper(
 		pfmkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
 	)
 
-	ibcStack = gmpmiddleware.NewIBCMiddleware(ibcStack)
 	// RateLimiting IBC Middleware
 	rateLimitingTransferModule := ibcratelimit.NewIBCModule(ibcStack, app.RateLimitingICS4Wrapper)
 
@@ -29,8 +29,10 @@ import (
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	feeburnertypes "github.com/neutron-org/neutron/v5/x/feeburner/types"
 	feerefundertypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 	interchainqueriestypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	interchaintxstypes "github.com/neutron-org/neutron/v5/x/interchaintxs/types"
+	mintburntypes "github.com/neutron-org/neutron/v5/x/mintburn/types"
 	tokenfactorytypes "github.com/neutron-org/neutron/v5/x/tokenfactory/types"
 )
 
@@ -96,7 +98,19 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
 		*dynamicfeestypes.MsgUpdateParams,
 		*ibctransfertypes.MsgUpdateParams,
 		*globalfeetypes.MsgUpdateParams,
-		*ibcratelimittypes.MsgUpdateParams:
+		*ibcratelimittypes.MsgUpdateParams,
+		*mintburntypes.MsgUpdateParams,
+		*mintburntypes.MsgRegisterBridgeRoute,
+		*mintburntypes.MsgUpdateBridgeRoute,
+		*mintburntypes.MsgRemoveBridgeRoute,
+		*mintburntypes.MsgSetChannelQuota,
+		*mintburntypes.MsgRemoveChannelQuota,
+		*mintburntypes.MsgPauseBridge,
+		*mintburntypes.MsgResumeBridge,
+		*mintburntypes.MsgMint,
+		*mintburntypes.MsgBurn,
+		*mintburntypes.MsgApproveChannel,
+		*gmptypes.MsgUpdateParams:
 		return true
 	}
 	return false
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	db2 "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func MintBurnKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return MintBurnKeeperWithDeps(t, nil)
+}
+
+func MintBurnKeeperWithDeps(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
+	return MintBurnKeeperWithIBCDeps(t, bankKeeper, nil, nil, nil, nil, nil)
+}
+
+func MintBurnKeeperWithEscrowDeps(
+	t testing.TB,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+) (keeper.Keeper, sdk.Context) {
+	return MintBurnKeeperWithIBCDeps(t, bankKeeper, transferKeeper, nil, nil, nil, nil)
+}
+
+func MintBurnKeeperWithIBCDeps(
+	t testing.TB,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
+	icqKeeper types.InterchainQueriesKeeper,
+) (keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := db2.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		bankKeeper,
+		transferKeeper,
+		channelKeeper,
+		connectionKeeper,
+		clientKeeper,
+		icqKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	return k, ctx
+}
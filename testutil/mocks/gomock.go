@@ -8,3 +8,4 @@ package mocks
 //go:generate mockgen -source=./../../x/transfer/types/expected_keepers.go -destination ./transfer/types/expected_keepers.go
 //go:generate mockgen -source=./../../x/feeburner/types/expected_keepers.go -destination ./feeburner/types/expected_keepers.go
 //go:generate mockgen -source=./../../x/cron/types/expected_keepers.go -destination ./cron/types/expected_keepers.go
+//go:generate mockgen -source=./../../x/mintburn/types/expected_keepers.go -destination ./mintburn/types/expected_keepers.go
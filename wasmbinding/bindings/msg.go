@@ -9,6 +9,7 @@ import (
 
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	feetypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	transferwrappertypes "github.com/neutron-org/neutron/v5/x/transfer/types"
 )
@@ -64,6 +65,10 @@ type NeutronMsg struct {
 
 	// dex module bindings
 	Dex *Dex `json:"dex,omitempty"`
+
+	// gmp module bindings
+	/// Contracts can send general messages to contracts of other chains through a gateway.
+	SendGeneralMessage *gmptypes.MsgSendGeneralMessage `json:"send_general_message,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -254,4 +259,6 @@ type MsgPlaceLimitOrder struct {
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
 	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
 	LimitSellPrice string `json:"limit_sell_price,omitempty"`
+	// post_only_slide is only valid for POST_ONLY order types.
+	PostOnlySlide bool `json:"post_only_slide,omitempty"`
 }
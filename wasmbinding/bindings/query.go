@@ -273,6 +273,20 @@ type DexQuery struct {
 	PoolMetadata *dextypes.QueryGetPoolMetadataRequest `json:"pool_metadata"`
 	// Queries a list of PoolMetadata items.
 	PoolMetadataAll *dextypes.QueryAllPoolMetadataRequest `json:"pool_metadata_all"`
+	// Queries the arithmetic time weighted average price of a pair
+	ArithmeticTwap *QueryTwapRequest `json:"arithmetic_twap"`
+	// Queries the geometric time weighted average price of a pair
+	GeometricTwap *QueryTwapRequest `json:"geometric_twap"`
+}
+
+// QueryTwapRequest is a copy of dextypes.QueryArithmeticTwapRequest and dextypes.QueryGeometricTwapRequest with altered
+// StartTime and EndTime fields, it's a preferable way to pass timestamp as unixtime to contracts
+type QueryTwapRequest struct {
+	PairID    string `json:"pair_id"`
+	BaseDenom string `json:"base_denom"`
+	StartTime uint64 `json:"start_time"`
+	// end_time defaults to the current block time if not set
+	EndTime *uint64 `json:"end_time,omitempty"`
 }
 
 // QueryEstimatePlaceLimitOrderRequest is a copy dextypes.QueryEstimatePlaceLimitOrderRequest with altered ExpirationTime field,
@@ -288,4 +302,6 @@ type QueryEstimatePlaceLimitOrderRequest struct {
 	// expirationTime is only valid iff orderType == GOOD_TIL_TIME.
 	ExpirationTime *uint64   `json:"expiration_time,omitempty"`
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
+	// post_only_slide is only valid for POST_ONLY order types.
+	PostOnlySlide bool `json:"post_only_slide,omitempty"`
 }
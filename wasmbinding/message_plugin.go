@@ -18,6 +18,8 @@ import (
 	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 	dexutils "github.com/neutron-org/neutron/v5/x/dex/utils"
+	gmpkeeper "github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 
 	"cosmossdk.io/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -64,6 +66,7 @@ func CustomMessageDecorator(
 	cronKeeper *cronkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
 	dexKeeper *dexkeeper.Keeper,
+	gmpKeeper *gmpkeeper.Keeper,
 ) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
@@ -81,6 +84,7 @@ func CustomMessageDecorator(
 			ContractmanagerMsgServer:   contractmanagerkeeper.NewMsgServerImpl(*contractmanagerKeeper),
 			ContractmanagerQueryServer: contractmanagerkeeper.NewQueryServerImpl(*contractmanagerKeeper),
 			DexMsgServer:               dexkeeper.NewMsgServerImpl(*dexKeeper),
+			GMPMsgServer:               gmpkeeper.NewMsgServerImpl(*gmpKeeper),
 		}
 	}
 }
@@ -100,6 +104,7 @@ type CustomMessenger struct {
 	ContractmanagerMsgServer   contractmanagertypes.MsgServer
 	ContractmanagerQueryServer contractmanagertypes.QueryServer
 	DexMsgServer               dextypes.MsgServer
+	GMPMsgServer               gmptypes.MsgServer
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -174,6 +179,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if contractMsg.ResubmitFailure != nil {
 		return m.resubmitFailure(ctx, contractAddr, contractMsg.ResubmitFailure)
 	}
+	if contractMsg.SendGeneralMessage != nil {
+		return m.sendGeneralMessage(ctx, contractAddr, contractMsg.SendGeneralMessage)
+	}
 	if contractMsg.Dex != nil {
 		data, messages, err := m.dispatchDexMsg(ctx, contractAddr, *(contractMsg.Dex))
 		return nil, data, messages, err
@@ -240,6 +248,7 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 			TickIndexInToOut: dex.PlaceLimitOrder.TickIndexInToOut,
 			AmountIn:         dex.PlaceLimitOrder.AmountIn,
 			MaxAmountOut:     dex.PlaceLimitOrder.MaxAmountOut,
+			PostOnlySlide:    dex.PlaceLimitOrder.PostOnlySlide,
 		}
 		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceLimitOrder.OrderType]
 		if !ok {
@@ -316,6 +325,42 @@ func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddre
 	return nil, [][]byte{data}, msgResponses, nil
 }
 
+func (m *CustomMessenger) sendGeneralMessage(ctx sdk.Context, contractAddr sdk.AccAddress, sendGeneralMessage *gmptypes.MsgSendGeneralMessage) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	sendGeneralMessage.Sender = contractAddr.String()
+
+	response, err := m.GMPMsgServer.SendGeneralMessage(ctx, sendGeneralMessage)
+	if err != nil {
+		ctx.Logger().Debug("GMPMsgServer.SendGeneralMessage: failed to send general message",
+			"from_address", contractAddr.String(),
+			"msg", sendGeneralMessage,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "failed to execute SendGeneralMessage")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal MsgSendGeneralMessageResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", response,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("sendGeneralMessage completed",
+		"from_address", contractAddr.String(),
+		"msg", sendGeneralMessage,
+	)
+
+	anyResp, err := types.NewAnyWithValue(response)
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "failed to convert {%T} to Any", response)
+	}
+	msgResponses := [][]*types.Any{{anyResp}}
+	return nil, [][]byte{data}, msgResponses, nil
+}
+
 func (m *CustomMessenger) updateInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, updateQuery *bindings.UpdateInterchainQuery) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	response, err := m.performUpdateInterchainQuery(ctx, contractAddr, updateQuery)
 	if err != nil {
@@ -153,6 +153,7 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 			TickIndexInToOut: query.EstimatePlaceLimitOrder.TickIndexInToOut,
 			AmountIn:         query.EstimatePlaceLimitOrder.AmountIn,
 			MaxAmountOut:     query.EstimatePlaceLimitOrder.MaxAmountOut,
+			PostOnlySlide:    query.EstimatePlaceLimitOrder.PostOnlySlide,
 		}
 		orderTypeInt, ok := dextypes.LimitOrderType_value[query.EstimatePlaceLimitOrder.OrderType]
 		if !ok {
@@ -169,6 +170,24 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 			q.ExpirationTime = &t
 		}
 		data, err = dexQuery(ctx, &q, qp.dexKeeper.EstimatePlaceLimitOrder)
+	case query.ArithmeticTwap != nil:
+		startTime, endTime := twapTimes(query.ArithmeticTwap)
+		q := dextypes.QueryArithmeticTwapRequest{
+			PairId:    query.ArithmeticTwap.PairID,
+			BaseDenom: query.ArithmeticTwap.BaseDenom,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+		data, err = dexQuery(ctx, &q, qp.dexKeeper.ArithmeticTwap)
+	case query.GeometricTwap != nil:
+		startTime, endTime := twapTimes(query.GeometricTwap)
+		q := dextypes.QueryGeometricTwapRequest{
+			PairId:    query.GeometricTwap.PairID,
+			BaseDenom: query.GeometricTwap.BaseDenom,
+			StartTime: startTime,
+			EndTime:   endTime,
+		}
+		data, err = dexQuery(ctx, &q, qp.dexKeeper.GeometricTwap)
 	case query.InactiveLimitOrderTranche != nil:
 		data, err = dexQuery(ctx, query.InactiveLimitOrderTranche, qp.dexKeeper.InactiveLimitOrderTranche)
 	case query.InactiveLimitOrderTrancheAll != nil:
@@ -260,6 +279,16 @@ func dexQuery[T, R any](ctx sdk.Context, query *T, queryHandler func(ctx context
 	return data, nil
 }
 
+// twapTimes converts the unixtime StartTime and EndTime of a QueryTwapRequest
+func twapTimes(query *bindings.QueryTwapRequest) (startTime time.Time, endTime *time.Time) {
+	startTime = time.Unix(int64(query.StartTime), 0)
+	if query.EndTime != nil {
+		t := time.Unix(int64(*query.EndTime), 0)
+		endTime = &t
+	}
+	return startTime, endTime
+}
+
 func processResponse(resp interface{}, err error) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to process request %T", resp)
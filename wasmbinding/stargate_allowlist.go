@@ -100,6 +100,18 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.dex.Query/SimulateWithdrawFilledLimitOrder":  &dextypes.QuerySimulateWithdrawFilledLimitOrderResponse{},
 		"/neutron.dex.Query/SimulateCancelLimitOrder":          &dextypes.QuerySimulateCancelLimitOrderResponse{},
 		"/neutron.dex.Query/SimulateMultiHopSwap":              &dextypes.QuerySimulateMultiHopSwapResponse{},
+		"/neutron.dex.Query/TriggerOrder":                      &dextypes.QueryGetTriggerOrderResponse{},
+		"/neutron.dex.Query/TriggerOrderAll":                   &dextypes.QueryAllTriggerOrderResponse{},
+		"/neutron.dex.Query/SimulatePlaceTriggerOrder":         &dextypes.QuerySimulatePlaceTriggerOrderResponse{},
+		"/neutron.dex.Query/SimulateCancelTriggerOrder":        &dextypes.QuerySimulateCancelTriggerOrderResponse{},
+		"/neutron.dex.Query/SimulateBatchLimitOrders":          &dextypes.QuerySimulateBatchLimitOrdersResponse{},
+		"/neutron.dex.Query/SimulateAmendLimitOrder":           &dextypes.QuerySimulateAmendLimitOrderResponse{},
+		"/neutron.dex.Query/ProtocolFeeAccrual":                &dextypes.QueryGetProtocolFeeAccrualResponse{},
+		"/neutron.dex.Query/ProtocolFeeAccrualAll":             &dextypes.QueryAllProtocolFeeAccrualResponse{},
+		"/neutron.dex.Query/ArithmeticTwap":                    &dextypes.QueryArithmeticTwapResponse{},
+		"/neutron.dex.Query/GeometricTwap":                     &dextypes.QueryGeometricTwapResponse{},
+		"/neutron.dex.Query/PairStats":                         &dextypes.QueryPairStatsResponse{},
+		"/neutron.dex.Query/Candles":                           &dextypes.QueryCandlesResponse{},
 
 		// oracle
 		"/slinky.oracle.v1.Query/GetAllCurrencyPairs": &oracletypes.GetAllCurrencyPairsResponse{},
@@ -41,6 +41,8 @@ import (
 	"github.com/neutron-org/neutron/v5/wasmbinding"
 	"github.com/neutron-org/neutron/v5/wasmbinding/bindings"
 	feetypes "github.com/neutron-org/neutron/v5/x/feerefunder/types"
+	gmpkeeper "github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 	icqkeeper "github.com/neutron-org/neutron/v5/x/interchainqueries/keeper"
 	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
 	ictxkeeper "github.com/neutron-org/neutron/v5/x/interchaintxs/keeper"
@@ -76,6 +78,7 @@ func (suite *CustomMessengerTestSuite) SetupTest() {
 	suite.messenger.AdminKeeper = &suite.neutron.AdminmoduleKeeper
 	suite.messenger.ContractmanagerMsgServer = contractmanagerkeeper.NewMsgServerImpl(suite.neutron.ContractManagerKeeper)
 	suite.messenger.ContractmanagerQueryServer = contractmanagerkeeper.NewQueryServerImpl(suite.neutron.ContractManagerKeeper)
+	suite.messenger.GMPMsgServer = gmpkeeper.NewMsgServerImpl(suite.neutron.GMPKeeper)
 	suite.contractOwner = keeper.RandomAccountAddress(suite.T())
 
 	suite.contractKeeper = keeper.NewDefaultPermissionKeeper(&suite.neutron.WasmKeeper)
@@ -557,6 +560,54 @@ func (suite *CustomMessengerTestSuite) TestSubmitTx() {
 	suite.Equal("channel-2", response.Channel)
 }
 
+func (suite *CustomMessengerTestSuite) TestSendGeneralMessage() {
+	suite.ConfigureTransferChannel()
+	suite.ctx = suite.ChainA.GetContext()
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(int64(10_000_000))))
+	err := suite.neutron.BankKeeper.SendCoins(suite.ctx, senderAddress, suite.contractAddress, coinsAmnt)
+	suite.NoError(err)
+
+	msg, err := json.Marshal(bindings.NeutronMsg{
+		SendGeneralMessage: &gmptypes.MsgSendGeneralMessage{
+			ChannelId:          suite.TransferPath.EndpointA.ChannelID,
+			DestinationChain:   "ethereum",
+			DestinationAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+			Payload:            []byte("payload"),
+			MessageType:        gmptypes.TypeGeneralMessage,
+			Token:              sdk.NewCoin(params.DefaultDenom, math.NewInt(1000)),
+			Fee:                &gmptypes.Fee{Amount: math.NewInt(1000), Recipient: "axelar1zl3rxpp70lmte2xr6c4lgske2fyuj3hupcsvcd"},
+			IbcFee: feetypes.Fee{
+				RecvFee:    sdk.NewCoins(),
+				AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+			},
+		},
+	})
+	suite.NoError(err)
+	cosmosMsg := types.CosmosMsg{Custom: msg}
+
+	// no gateway is configured for the channel
+	_, _, _, err = suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, cosmosMsg) //nolint:dogsled
+	suite.ErrorContains(err, "sender is not a gateway on the channel")
+
+	err = suite.neutron.GMPKeeper.SetParams(suite.ctx, gmptypes.NewParams([]gmptypes.Gateway{{
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+		Sender:    "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5",
+	}}, gmptypes.DefaultMessageRetention, gmptypes.DefaultMessagePruneLimit))
+	suite.Require().NoError(err)
+
+	_, data, _, err := suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, suite.Path.EndpointA.ChannelConfig.PortID, cosmosMsg)
+	suite.NoError(err)
+
+	var response gmptypes.MsgSendGeneralMessageResponse
+	err = json.Unmarshal(data[0], &response)
+	suite.NoError(err)
+	suite.Equal(uint64(1), response.SequenceId)
+	suite.Equal(suite.TransferPath.EndpointA.ChannelID, response.Channel)
+}
+
 func (suite *CustomMessengerTestSuite) TestSubmitTxTooMuchTxs() {
 	err := testutil.SetupICAPath(suite.Path, suite.contractAddress.String())
 	suite.Require().NoError(err)
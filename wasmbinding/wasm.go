@@ -9,6 +9,7 @@ import (
 	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
 	feeburnerkeeper "github.com/neutron-org/neutron/v5/x/feeburner/keeper"
 	feerefunderkeeper "github.com/neutron-org/neutron/v5/x/feerefunder/keeper"
+	gmpkeeper "github.com/neutron-org/neutron/v5/x/gmp/keeper"
 
 	adminmodulekeeper "github.com/cosmos/admin-module/v2/x/adminmodule/keeper"
 
@@ -36,6 +37,7 @@ func RegisterCustomPlugins(
 	dexKeeper *dexkeeper.Keeper,
 	oracleKeeper *oraclekeeper.Keeper,
 	markemapKeeper *marketmapkeeper.Keeper,
+	gmpKeeper *gmpkeeper.Keeper,
 ) []wasmkeeper.Option {
 	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeBurnerKeeper, feeRefunderKeeper, tfk, contractmanagerKeeper, dexKeeper, oracleKeeper, markemapKeeper)
 
@@ -43,7 +45,7 @@ func RegisterCustomPlugins(
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messagePluginOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, adminKeeper, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper),
+		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, adminKeeper, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper, gmpKeeper),
 	)
 
 	return []wasmkeeper.Option{
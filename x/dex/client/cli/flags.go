@@ -7,6 +7,8 @@ const (
 	FlagIncludePoolData = "include-pool-data"
 	FlagCalcWithdraw    = "calc-withdraw"
 	FlagPrice           = "price"
+	FlagPostOnlySlide   = "post-only-slide"
+	FlagTickIndex       = "tick-index-in-to-out"
 )
 
 func FlagSetMaxAmountOut() *flag.FlagSet {
@@ -21,6 +23,18 @@ func FlagSetPrice() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetTickIndex() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagTickIndex, 0, "Limit tick of the order, instead of a limit sell price")
+	return fs
+}
+
+func FlagSetPostOnlySlide() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagPostOnlySlide, false, "Move a POST_ONLY order to the best non-crossing tick instead of failing")
+	return fs
+}
+
 func FlagSetIncludePoolData() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Bool(FlagIncludePoolData, false, "Include pool data with response")
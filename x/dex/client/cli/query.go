@@ -40,6 +40,18 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdListPoolMetadata())
 	cmd.AddCommand(CmdShowPoolMetadata())
+
+	cmd.AddCommand(CmdListTriggerOrder())
+	cmd.AddCommand(CmdShowTriggerOrder())
+
+	cmd.AddCommand(CmdListProtocolFeeAccrual())
+	cmd.AddCommand(CmdShowProtocolFeeAccrual())
+
+	cmd.AddCommand(CmdArithmeticTwap())
+	cmd.AddCommand(CmdGeometricTwap())
+
+	cmd.AddCommand(CmdShowPairStats())
+	cmd.AddCommand(CmdListCandles())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdShowPairStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-pair-stats [pair-id]",
+		Short:   "shows the trading statistics of a pair over the last 24 hours",
+		Example: "show-pair-stats tokenA<>tokenB",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPairStatsRequest{
+				PairId: args[0],
+			}
+
+			res, err := queryClient.PairStats(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-candles [pair-id] [interval] ?[start-time] ?[end-time]",
+		Short:   "list the candles of a pair for an interval (ONE_MINUTE, ONE_HOUR or ONE_DAY)",
+		Example: `list-candles tokenA<>tokenB ONE_HOUR "01/02/2006 15:04:05" "01/03/2006 15:04:05"`,
+		Args:    cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			intervalInt, ok := types.CandleInterval_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid candle interval: %s", args[1])
+			}
+
+			params := &types.QueryCandlesRequest{
+				PairId:     args[0],
+				Interval:   types.CandleInterval(intervalInt),
+				Pagination: pageReq,
+			}
+
+			if len(args) >= 3 {
+				startTime, endTime, err := parseTwapTimes(args[2:])
+				if err != nil {
+					return err
+				}
+				params.StartTime = &startTime
+				params.EndTime = endTime
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Candles(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdListProtocolFeeAccrual() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-protocol-fee-accrual",
+		Short: "list the protocol fees accrued by all pairs",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllProtocolFeeAccrualRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ProtocolFeeAccrualAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowProtocolFeeAccrual() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-protocol-fee-accrual [pair-id]",
+		Short:   "shows the protocol fees accrued by a pair",
+		Example: "show-protocol-fee-accrual tokenA<>tokenB",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetProtocolFeeAccrualRequest{
+				PairId: args[0],
+			}
+
+			res, err := queryClient.ProtocolFeeAccrual(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdListTriggerOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-trigger-order [pair-id] [token-in]",
+		Short:   "list all pending TriggerOrders for a given pairID / tokenIn",
+		Example: "list-trigger-order tokenA<>tokenB tokenA",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllTriggerOrderRequest{
+				PairId:     args[0],
+				TokenIn:    args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TriggerOrderAll(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowTriggerOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-trigger-order [id]",
+		Short: "shows a TriggerOrder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetTriggerOrderRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.TriggerOrder(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
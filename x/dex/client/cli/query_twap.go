@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// parseTwapTimes parses the start time and optional end time arguments of the twap queries
+func parseTwapTimes(args []string) (startTime time.Time, endTime *time.Time, err error) {
+	const timeFormat = "01/02/2006 15:04:05"
+	startTime, err = time.Parse(timeFormat, args[0])
+	if err != nil {
+		return startTime, nil, sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+	}
+
+	if len(args) == 2 {
+		tm, err := time.Parse(timeFormat, args[1])
+		if err != nil {
+			return startTime, nil, sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+		}
+		endTime = &tm
+	}
+
+	return startTime, endTime, nil
+}
+
+func CmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "arithmetic-twap [pair-id] [base-denom] [start-time] ?[end-time]",
+		Short:   "queries the arithmetic time weighted average price of base-denom for a pair",
+		Example: `arithmetic-twap tokenA<>tokenB tokenA "01/02/2006 15:04:05" "01/03/2006 15:04:05"`,
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, endTime, err := parseTwapTimes(args[2:])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryArithmeticTwapRequest{
+				PairId:    args[0],
+				BaseDenom: args[1],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGeometricTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "geometric-twap [pair-id] [base-denom] [start-time] ?[end-time]",
+		Short:   "queries the geometric time weighted average price of base-denom for a pair",
+		Example: `geometric-twap tokenA<>tokenB tokenA "01/02/2006 15:04:05" "01/03/2006 15:04:05"`,
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, endTime, err := parseTwapTimes(args[2:])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGeometricTwapRequest{
+				PairId:    args[0],
+				BaseDenom: args[1],
+				StartTime: startTime,
+				EndTime:   endTime,
+			}
+
+			res, err := queryClient.GeometricTwap(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
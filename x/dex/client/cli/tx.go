@@ -29,6 +29,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdWithdrawFilledLimitOrder())
 	cmd.AddCommand(CmdCancelLimitOrder())
 	cmd.AddCommand(CmdMultiHopSwap())
+	cmd.AddCommand(CmdPlaceTriggerOrder())
+	cmd.AddCommand(CmdCancelTriggerOrder())
+	cmd.AddCommand(CmdBatchLimitOrders())
+	cmd.AddCommand(CmdAmendLimitOrder())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdAmendLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend-limit-order [tranche-key] [limit-sell-price or -] [amount-in or -]",
+		Short:   "Broadcast message AmendLimitOrder",
+		Long:    "Broadcast message AmendLimitOrder. Use - for the limit sell price or amount in to keep the current value. A limit tick can be set with --tick-index-in-to-out instead of a limit sell price.",
+		Example: "amend-limit-order TRANCHEKEY123 1.5 - --from alice\namend-limit-order TRANCHEKEY123 - - --tick-index-in-to-out 10 --from alice",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var limitSellPrice *math_utils.PrecDec
+			if args[1] != "-" {
+				price, err := math_utils.NewPrecDecFromStr(args[1])
+				if err != nil {
+					return err
+				}
+				limitSellPrice = &price
+			}
+
+			var tickIndexInToOut *int64
+			if cmd.Flags().Changed(FlagTickIndex) {
+				tickIndex, err := cmd.Flags().GetInt64(FlagTickIndex)
+				if err != nil {
+					return err
+				}
+				tickIndexInToOut = &tickIndex
+			}
+
+			var amountIn *math.Int
+			if args[2] != "-" {
+				amount, ok := math.NewIntFromString(args[2])
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+				}
+				amountIn = &amount
+			}
+
+			msg := types.NewMsgAmendLimitOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				limitSellPrice,
+				tickIndexInToOut,
+				amountIn,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetTickIndex())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdBatchLimitOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-limit-orders [batch-json-file]",
+		Short: "Broadcast message BatchLimitOrders",
+		Long: `Broadcast message BatchLimitOrders. The file must contain a JSON encoded MsgBatchLimitOrders, eg.
+{
+  "cancel_tranche_keys": ["TRANCHEKEY123"],
+  "place_orders": [
+    {"token_in": "tokenA", "token_out": "tokenB", "tick_index_in_to_out": "10", "amount_in": "1000", "order_type": "GOOD_TIL_CANCELLED"}
+  ]
+}
+The creator field is ignored and replaced with the --from address.`,
+		Example: "batch-limit-orders batch.json --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchLimitOrders{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return err
+			}
+			msg.Creator = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
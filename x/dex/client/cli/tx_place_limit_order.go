@@ -19,7 +19,7 @@ import (
 func CmdPlaceLimitOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		//nolint:lll
-		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price)",
+		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price) ?(--post-only-slide)",
 		Short:   "Broadcast message PlaceLimitOrder",
 		Example: "place-limit-order alice tokenA tokenB [-10] tokenA 50 GOOD_TIL_TIME '01/02/2006 15:04:05' --max-amount-out 20 --from alice",
 		Args:    cobra.RangeArgs(5, 7),
@@ -93,6 +93,11 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				priceDecP = &priceDec
 			}
 
+			postOnlySlide, err := cmd.Flags().GetBool(FlagPostOnlySlide)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -110,6 +115,7 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				maxAmountOutIntP,
 				priceDecP,
 			)
+			msg.PostOnlySlide = postOnlySlide
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -118,6 +124,7 @@ func CmdPlaceLimitOrder() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().AddFlagSet(FlagSetMaxAmountOut())
 	cmd.Flags().AddFlagSet(FlagSetPrice())
+	cmd.Flags().AddFlagSet(FlagSetPostOnlySlide())
 
 	return cmd
 }
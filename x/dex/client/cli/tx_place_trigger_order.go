@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func parseTickIndexArg(arg string) (int64, error) {
+	if strings.HasPrefix(arg, "[") && strings.HasSuffix(arg, "]") {
+		arg = strings.TrimPrefix(arg, "[")
+		arg = strings.TrimSuffix(arg, "]")
+	}
+	return strconv.ParseInt(arg, 10, 0)
+}
+
+func CmdPlaceTriggerOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "place-trigger-order [receiver] [token-in] [token-out] [trigger-type] [trigger-tick-index] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out)",
+		Short:   "Broadcast message PlaceTriggerOrder",
+		Example: "place-trigger-order alice tokenA tokenB STOP_LOSS [10] [20] 50 IMMEDIATE_OR_CANCEL --max-amount-out 20 --from alice",
+		Args:    cobra.RangeArgs(7, 9),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenIn := args[1]
+			argTokenOut := args[2]
+
+			triggerTypeInt, ok := types.TriggerType_value[args[3]]
+			if !ok {
+				return types.ErrInvalidTriggerType
+			}
+			triggerType := types.TriggerType(triggerTypeInt)
+
+			argTriggerTickIndexInt, err := parseTickIndexArg(args[4])
+			if err != nil {
+				return err
+			}
+
+			argTickIndexInt, err := parseTickIndexArg(args[5])
+			if err != nil {
+				return err
+			}
+
+			amountInInt, ok := math.NewIntFromString(args[6])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+			}
+
+			orderType := types.LimitOrderType_GOOD_TIL_CANCELLED
+			if len(args) >= 8 {
+				orderTypeInt, ok := types.LimitOrderType_value[args[7]]
+				if !ok {
+					return types.ErrInvalidOrderType
+				}
+				orderType = types.LimitOrderType(orderTypeInt)
+			}
+
+			var goodTil *time.Time
+			if len(args) == 9 {
+				const timeFormat = "01/02/2006 15:04:05"
+				tm, err := time.Parse(timeFormat, args[8])
+				if err != nil {
+					return sdkerrors.Wrapf(types.ErrInvalidTimeString, err.Error())
+				}
+				goodTil = &tm
+			}
+
+			maxAmountOutArg, err := cmd.Flags().GetString(FlagMaxAmountOut)
+			if err != nil {
+				return err
+			}
+
+			var maxAmountOutIntP *math.Int
+			if maxAmountOutArg != "" {
+				maxAmountOutInt, ok := math.NewIntFromString(maxAmountOutArg)
+				if !ok {
+					return sdkerrors.Wrapf(
+						types.ErrIntOverflowTx,
+						"Integer overflow for max-amount-out",
+					)
+				}
+				maxAmountOutIntP = &maxAmountOutInt
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceTriggerOrder(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenIn,
+				argTokenOut,
+				triggerType,
+				argTriggerTickIndexInt,
+				argTickIndexInt,
+				amountInInt,
+				orderType,
+				goodTil,
+				maxAmountOutIntP,
+				nil,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetMaxAmountOut())
+
+	return cmd
+}
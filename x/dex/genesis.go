@@ -44,6 +44,29 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set poolMetadata count
 	k.SetPoolCount(ctx, genState.PoolCount)
+
+	// Set all the triggerOrders
+	for _, elem := range genState.TriggerOrderList {
+		k.SetTriggerOrder(ctx, elem)
+	}
+
+	// Set triggerOrder count
+	k.SetTriggerOrderCount(ctx, genState.TriggerOrderCount)
+
+	// Set all the protocolFeeAccruals
+	for _, elem := range genState.ProtocolFeeAccrualList {
+		k.SetProtocolFeeAccrual(ctx, elem)
+	}
+
+	// Set all the twapRecords
+	for _, elem := range genState.TwapRecordList {
+		k.SetTwapRecord(ctx, elem)
+	}
+
+	// Set all the candles
+	for _, elem := range genState.CandleList {
+		k.SetCandle(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
@@ -60,6 +83,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.InactiveLimitOrderTrancheList = k.GetAllInactiveLimitOrderTranche(ctx)
 	genesis.PoolMetadataList = k.GetAllPoolMetadata(ctx)
 	genesis.PoolCount = k.GetPoolCount(ctx)
+	genesis.TriggerOrderList = k.GetAllTriggerOrder(ctx)
+	genesis.TriggerOrderCount = k.GetTriggerOrderCount(ctx)
+	genesis.ProtocolFeeAccrualList = k.GetAllProtocolFeeAccrual(ctx)
+	genesis.TwapRecordList = k.GetAllTwapRecord(ctx)
+	genesis.CandleList = k.GetAllCandle(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
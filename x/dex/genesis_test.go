@@ -5,10 +5,12 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/neutron-org/neutron/v5/testutil/common/nullify"
 	keepertest "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
@@ -148,6 +150,62 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		PoolCount: 2,
+		TriggerOrderList: []types.TriggerOrder{
+			{
+				Id:                      0,
+				Creator:                 "fakeAddr",
+				Receiver:                "fakeAddr",
+				TradePairId:             types.MustNewTradePairID("TokenA", "TokenB"),
+				TriggerType:             types.TriggerType_STOP_LOSS,
+				TriggerTickIndexInToOut: 10,
+				TickIndexInToOut:        20,
+				AmountIn:                math.NewInt(10),
+			},
+			{
+				Id:                      1,
+				Creator:                 "fakeAddr",
+				Receiver:                "fakeAddr",
+				TradePairId:             types.MustNewTradePairID("TokenB", "TokenA"),
+				TriggerType:             types.TriggerType_TAKE_PROFIT,
+				TriggerTickIndexInToOut: -10,
+				TickIndexInToOut:        -5,
+				AmountIn:                math.NewInt(10),
+			},
+		},
+		TriggerOrderCount: 2,
+		ProtocolFeeAccrualList: []types.ProtocolFeeAccrual{
+			{
+				PairId:    types.MustNewPairID("TokenA", "TokenB"),
+				TotalFees: sdk.NewCoins(sdk.NewInt64Coin("TokenA", 10), sdk.NewInt64Coin("TokenB", 5)),
+			},
+		},
+		TwapRecordList: []types.TwapRecord{
+			{
+				PairId:            types.MustNewPairID("TokenA", "TokenB"),
+				Height:            1,
+				Time:              time.Unix(100, 0).UTC(),
+				LastTickIndex:     math_utils.NewPrecDec(10),
+				LastPrice0:        types.MustCalcPrice(-10),
+				LastPrice1:        types.MustCalcPrice(10),
+				TickAccumulator:   math_utils.NewPrecDec(1000),
+				Price0Accumulator: math_utils.NewPrecDec(99),
+				Price1Accumulator: math_utils.NewPrecDec(101),
+			},
+		},
+		CandleList: []types.Candle{
+			{
+				PairId:     types.MustNewPairID("TokenA", "TokenB"),
+				Interval:   types.CandleInterval_ONE_HOUR,
+				StartTime:  time.Unix(3600, 0).UTC(),
+				Open:       math_utils.MustNewPrecDecFromStr("1.5"),
+				High:       math_utils.NewPrecDec(2),
+				Low:        math_utils.OnePrecDec(),
+				Close:      math_utils.MustNewPrecDecFromStr("1.25"),
+				Volume0:    math.NewInt(100),
+				Volume1:    math.NewInt(80),
+				TradeCount: 3,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -189,5 +247,10 @@ func TestGenesis(t *testing.T) {
 	)
 	require.ElementsMatch(t, genesisState.PoolMetadataList, got.PoolMetadataList)
 	require.Equal(t, genesisState.PoolCount, got.PoolCount)
+	require.ElementsMatch(t, genesisState.TriggerOrderList, got.TriggerOrderList)
+	require.Equal(t, genesisState.TriggerOrderCount, got.TriggerOrderCount)
+	require.ElementsMatch(t, genesisState.ProtocolFeeAccrualList, got.ProtocolFeeAccrualList)
+	require.ElementsMatch(t, genesisState.TwapRecordList, got.TwapRecordList)
+	require.ElementsMatch(t, genesisState.CandleList, got.CandleList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
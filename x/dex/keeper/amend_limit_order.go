@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// AmendedLimitOrder is the cancellation of a limit order and the placement of its replacement by
+// ExecuteAmendLimitOrder.
+type AmendedLimitOrder struct {
+	TakerTradePairID *types.TradePairID
+	OrderType        types.LimitOrderType
+	// Funds released by canceling the existing order
+	MakerCoinOut, TakerCoinOut sdk.Coin
+	// Placement of the amended order
+	TrancheKey       string
+	TickIndexInToOut int64
+	TotalIn          math.Int
+	SwapInCoin       sdk.Coin
+	SwapOutCoin      sdk.Coin
+	SharesIssued     math.Int
+	MinAvgSellPrice  math_utils.PrecDec
+}
+
+// AmendLimitOrderCore handles the logic for MsgAmendLimitOrder including bank operations and event emissions.
+// Only the net difference between the funds released by the existing order and the funds required by the
+// amended order is moved through the bank.
+func (k Keeper) AmendLimitOrderCore(
+	goCtx context.Context,
+	trancheKey string,
+	limitSellPrice *math_utils.PrecDec,
+	tickIndexInToOut *int64,
+	amountIn *math.Int,
+	callerAddr sdk.AccAddress,
+) (newTrancheKey string, coinsIn, coinsOut sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	amended, coinsIn, coinsOut, err := k.ExecuteAmendLimitOrder(ctx, trancheKey, limitSellPrice, tickIndexInToOut, amountIn, callerAddr)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if !coinsOut.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, coinsOut)
+		if err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	if !coinsIn.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, coinsIn)
+		if err != nil {
+			return "", nil, nil, err
+		}
+	}
+
+	// This will never panic since PairID has already been successfully constructed during tranche creation
+	pairID := amended.TakerTradePairID.MustPairID()
+	ctx.EventManager().EmitEvent(types.CancelLimitOrderEvent(
+		callerAddr,
+		pairID.Token0,
+		pairID.Token1,
+		amended.MakerCoinOut.Denom,
+		amended.TakerCoinOut.Denom,
+		amended.TakerCoinOut.Amount,
+		amended.MakerCoinOut.Amount,
+		trancheKey,
+	))
+	k.Hooks().AfterTrancheCancelled(ctx, callerAddr, trancheKey, amended.MakerCoinOut, amended.TakerCoinOut)
+	ctx.EventManager().EmitEvent(types.CreatePlaceLimitOrderEvent(
+		callerAddr,
+		callerAddr,
+		pairID.Token0,
+		pairID.Token1,
+		amended.TakerTradePairID.TakerDenom,
+		amended.TakerTradePairID.MakerDenom,
+		amended.TotalIn,
+		amended.TickIndexInToOut,
+		amended.OrderType.String(),
+		amended.MinAvgSellPrice,
+		amended.SharesIssued,
+		amended.TrancheKey,
+		amended.SwapInCoin.Amount,
+		amended.SwapOutCoin.Amount,
+	))
+	k.Hooks().AfterLimitOrderPlaced(
+		ctx,
+		callerAddr,
+		callerAddr,
+		amended.TakerTradePairID,
+		amended.TickIndexInToOut,
+		amended.OrderType,
+		amended.TrancheKey,
+		amended.TotalIn,
+		amended.SwapInCoin,
+		amended.SwapOutCoin,
+	)
+
+	return amended.TrancheKey, coinsIn, coinsOut, nil
+}
+
+// ExecuteAmendLimitOrder handles the core logic for AmendLimitOrder -- canceling the existing limit order, withdrawing
+// any filled proceeds and placing the unfilled remainder (or the new amountIn) at the new price. The amended order keeps
+// the order type and expiration of the original order, so expired GoodTil orders can't be amended.
+// IT DOES NOT PERFORM ANY BANKING OPERATIONS OR EMIT EVENTS
+func (k Keeper) ExecuteAmendLimitOrder(
+	ctx sdk.Context,
+	trancheKey string,
+	limitSellPrice *math_utils.PrecDec,
+	tickIndexInToOut *int64,
+	amountIn *math.Int,
+	callerAddr sdk.AccAddress,
+) (amended AmendedLimitOrder, coinsIn, coinsOut sdk.Coins, err error) {
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+	if !found {
+		return amended, nil, nil, sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+
+	orderType := trancheUser.OrderType
+	if !orderType.IsGTC() && !orderType.IsGoodTil() {
+		return amended, nil, nil, sdkerrors.Wrapf(types.ErrAmendInvalidOrderType, "%s", orderType.String())
+	}
+
+	tranche, _, found := k.FindLimitOrderTranche(
+		ctx,
+		&types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		},
+	)
+	if !found {
+		return amended, nil, nil, sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+	goodTil := tranche.ExpirationTime
+	// Expired orders are only purged in the next BeginBlock, they must not be placed again until then
+	if orderType.IsGoodTil() && goodTil != nil && !goodTil.After(ctx.BlockTime()) {
+		return amended, nil, nil, sdkerrors.Wrapf(types.ErrExpirationTimeInPast,
+			"Current BlockTime: %s; ExpirationTime: %s",
+			ctx.BlockTime().String(),
+			goodTil.String(),
+		)
+	}
+
+	// The tranche is stored from the maker's perspective, the new order is placed from the taker's perspective
+	takerTradePairID := trancheUser.TradePairId.Reversed()
+	newTickIndexInToOut := trancheUser.TickIndexTakerToMaker * -1
+	switch {
+	case tickIndexInToOut != nil:
+		newTickIndexInToOut = *tickIndexInToOut
+	case limitSellPrice != nil:
+		limitBuyPrice := math_utils.OnePrecDec().Quo(*limitSellPrice)
+		newTickIndexInToOut, err = types.CalcTickIndexFromPrice(limitBuyPrice)
+		if err != nil {
+			return amended, nil, nil, sdkerrors.Wrapf(err, "invalid LimitSellPrice %s", limitSellPrice.String())
+		}
+	}
+
+	makerCoinOut, takerCoinOut, err := k.ExecuteCancelLimitOrder(ctx, trancheKey, callerAddr)
+	if err != nil {
+		return amended, nil, nil, err
+	}
+
+	newAmountIn := makerCoinOut.Amount
+	if amountIn != nil {
+		newAmountIn = *amountIn
+	}
+	if !newAmountIn.IsPositive() {
+		return amended, nil, nil, sdkerrors.Wrapf(types.ErrZeroLimitOrder, "limit order %s has no unfilled amount", trancheKey)
+	}
+
+	newTrancheKey, placedTickIndex, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
+		ctx,
+		takerTradePairID,
+		newAmountIn,
+		newTickIndexInToOut,
+		orderType,
+		goodTil,
+		nil,
+		nil,
+		false,
+		callerAddr,
+	)
+	if err != nil {
+		return amended, nil, nil, err
+	}
+
+	amended = AmendedLimitOrder{
+		TakerTradePairID: takerTradePairID,
+		OrderType:        orderType,
+		MakerCoinOut:     makerCoinOut,
+		TakerCoinOut:     takerCoinOut,
+		TrancheKey:       newTrancheKey,
+		TickIndexInToOut: placedTickIndex,
+		TotalIn:          totalIn,
+		SwapInCoin:       swapInCoin,
+		SwapOutCoin:      swapOutCoin,
+		SharesIssued:     sharesIssued,
+		MinAvgSellPrice:  minAvgSellPrice,
+	}
+
+	grossIn := sdk.NewCoins(sdk.NewCoin(takerTradePairID.TakerDenom, totalIn))
+	grossOut := sdk.NewCoins(makerCoinOut, takerCoinOut, swapOutCoin)
+	coinsIn, coinsOut = NetCoins(grossIn, grossOut)
+
+	return amended, coinsIn, coinsOut, nil
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// BatchLimitOrdersCore handles the logic for MsgBatchLimitOrders including bank operations and event emissions.
+// Funds for all cancels and placements are netted per denom so that at most one transfer is made in each direction.
+func (k Keeper) BatchLimitOrdersCore(
+	goCtx context.Context,
+	msg *types.MsgBatchLimitOrders,
+	callerAddr sdk.AccAddress,
+) (trancheKeys []string, coinsIn, coinsOut sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trancheKeys, coinsIn, coinsOut, err = k.ExecuteBatchLimitOrders(ctx, msg, callerAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !coinsOut.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, coinsOut)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if !coinsIn.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, coinsIn)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	return trancheKeys, coinsIn, coinsOut, nil
+}
+
+// ExecuteBatchLimitOrders handles the core logic for MsgBatchLimitOrders -- executing all cancels followed by all
+// placements and netting the resulting balance changes for the caller. Events for the individual cancels and
+// placements are emitted as they are executed.
+// IT DOES NOT PERFORM ANY BANKING OPERATIONS
+func (k Keeper) ExecuteBatchLimitOrders(
+	ctx sdk.Context,
+	msg *types.MsgBatchLimitOrders,
+	callerAddr sdk.AccAddress,
+) (trancheKeys []string, coinsIn, coinsOut sdk.Coins, err error) {
+	// Gross amounts moving between the caller and the dex, these are netted once all orders have been executed
+	grossIn, grossOut := sdk.NewCoins(), sdk.NewCoins()
+
+	for _, trancheKey := range msg.CancelTrancheKeys {
+		makerCoinOut, takerCoinOut, err := k.ExecuteCancelLimitOrder(ctx, trancheKey, callerAddr)
+		if err != nil {
+			return nil, nil, nil, sdkerrors.Wrapf(err, "failed to cancel limit order %s", trancheKey)
+		}
+		grossOut = grossOut.Add(makerCoinOut, takerCoinOut)
+
+		makerDenom := makerCoinOut.Denom
+		takerDenom := takerCoinOut.Denom
+		// This will never panic since PairID has already been successfully constructed during tranche creation
+		pairID := types.MustNewPairID(makerDenom, takerDenom)
+		ctx.EventManager().EmitEvent(types.CancelLimitOrderEvent(
+			callerAddr,
+			pairID.Token0,
+			pairID.Token1,
+			makerDenom,
+			takerDenom,
+			takerCoinOut.Amount,
+			makerCoinOut.Amount,
+			trancheKey,
+		))
+		k.Hooks().AfterTrancheCancelled(ctx, callerAddr, trancheKey, makerCoinOut, takerCoinOut)
+	}
+
+	trancheKeys = make([]string, 0, len(msg.PlaceOrders))
+	for i, order := range msg.PlaceOrders {
+		placeMsg := order.ToMsgPlaceLimitOrder(msg.Creator)
+		if err := placeMsg.ValidateGoodTilExpiration(ctx.BlockTime()); err != nil {
+			return nil, nil, nil, sdkerrors.Wrapf(err, "invalid place_orders[%d]", i)
+		}
+
+		takerTradePairID, err := types.NewTradePairID(order.TokenIn, order.TokenOut)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		tickIndex := order.TickIndexInToOut
+		if order.LimitSellPrice != nil {
+			limitBuyPrice := math_utils.OnePrecDec().Quo(*order.LimitSellPrice)
+			tickIndex, err = types.CalcTickIndexFromPrice(limitBuyPrice)
+			if err != nil {
+				return nil, nil, nil, sdkerrors.Wrapf(err, "invalid LimitSellPrice %s", order.LimitSellPrice.String())
+			}
+		}
+
+		trancheKey, placedTickIndex, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
+			ctx,
+			takerTradePairID,
+			order.AmountIn,
+			tickIndex,
+			order.OrderType,
+			order.ExpirationTime,
+			order.MaxAmountOut,
+			order.MinAverageSellPrice,
+			order.PostOnlySlide,
+			callerAddr,
+		)
+		if err != nil {
+			return nil, nil, nil, sdkerrors.Wrapf(err, "failed to place place_orders[%d]", i)
+		}
+		trancheKeys = append(trancheKeys, trancheKey)
+		grossIn = grossIn.Add(sdk.NewCoin(order.TokenIn, totalIn))
+		grossOut = grossOut.Add(swapOutCoin)
+
+		// This will never panic because we've already successfully constructed a TradePairID above
+		pairID := takerTradePairID.MustPairID()
+		ctx.EventManager().EmitEvent(types.CreatePlaceLimitOrderEvent(
+			callerAddr,
+			callerAddr,
+			pairID.Token0,
+			pairID.Token1,
+			order.TokenIn,
+			order.TokenOut,
+			totalIn,
+			placedTickIndex,
+			order.OrderType.String(),
+			minAvgSellPrice,
+			sharesIssued,
+			trancheKey,
+			swapInCoin.Amount,
+			swapOutCoin.Amount,
+		))
+		k.Hooks().AfterLimitOrderPlaced(
+			ctx,
+			callerAddr,
+			callerAddr,
+			takerTradePairID,
+			placedTickIndex,
+			order.OrderType,
+			trancheKey,
+			totalIn,
+			swapInCoin,
+			swapOutCoin,
+		)
+	}
+
+	coinsIn, coinsOut = NetCoins(grossIn, grossOut)
+
+	return trancheKeys, coinsIn, coinsOut, nil
+}
+
+// NetCoins nets the coins owed by the caller (coinsIn) against the coins owed to the caller (coinsOut)
+// so that each denom is only ever transferred in a single direction.
+func NetCoins(coinsIn, coinsOut sdk.Coins) (netIn, netOut sdk.Coins) {
+	netIn, netOut = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range coinsIn.Add(coinsOut...) {
+		amountIn := coinsIn.AmountOf(coin.Denom)
+		amountOut := coinsOut.AmountOf(coin.Denom)
+		switch {
+		case amountIn.GT(amountOut):
+			netIn = netIn.Add(sdk.NewCoin(coin.Denom, amountIn.Sub(amountOut)))
+		case amountOut.GT(amountIn):
+			netOut = netOut.Add(sdk.NewCoin(coin.Denom, amountOut.Sub(amountIn)))
+		}
+	}
+
+	return netIn, netOut
+}
@@ -48,6 +48,7 @@ func (k Keeper) CancelLimitOrderCore(
 		makerCoinOut.Amount,
 		trancheKey,
 	))
+	k.Hooks().AfterTrancheCancelled(ctx, callerAddr, trancheKey, makerCoinOut, takerCoinOut)
 
 	return makerCoinOut, takerCoinOut, nil
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// CancelTriggerOrderCore handles the logic for MsgCancelTriggerOrder including bank operations and event emissions.
+func (k Keeper) CancelTriggerOrderCore(
+	goCtx context.Context,
+	id uint64,
+	callerAddr sdk.AccAddress,
+) (coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, coinOut, err := k.ExecuteCancelTriggerOrder(ctx, id, callerAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		callerAddr,
+		sdk.Coins{coinOut},
+	)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CancelTriggerOrderEvent(order))
+
+	return coinOut, nil
+}
+
+// ExecuteCancelTriggerOrder handles the core logic for CancelTriggerOrder -- removing the TriggerOrder
+// from the trigger index and returning the escrowed TokenIn.
+// IT DOES NOT PERFORM ANY BANKING OPERATIONS
+func (k Keeper) ExecuteCancelTriggerOrder(
+	ctx sdk.Context,
+	id uint64,
+	callerAddr sdk.AccAddress,
+) (order types.TriggerOrder, coinOut sdk.Coin, err error) {
+	order, found := k.GetTriggerOrder(ctx, id)
+	if !found || order.Creator != callerAddr.String() {
+		return order, sdk.Coin{}, sdkerrors.Wrapf(types.ErrTriggerOrderNotFound, "%d", id)
+	}
+
+	k.RemoveTriggerOrder(ctx, order)
+
+	coinOut = sdk.NewCoin(order.TradePairId.TakerDenom, order.AmountIn)
+
+	return order, coinOut, nil
+}
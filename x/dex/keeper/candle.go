@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetCandle set a specific candle in the store from its index
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(candle.PairId, candle.Interval, candle.StartTime), b)
+}
+
+// GetCandle returns a candle from its index
+func (k Keeper) GetCandle(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	interval types.CandleInterval,
+	startTime time.Time,
+) (val types.Candle, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+
+	b := store.Get(types.CandleKey(pairID, interval, startTime))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCandle returns all candles
+func (k Keeper) GetAllCandle(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) candleIntervalStore(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	interval types.CandleInterval,
+) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CandleKeyPrefix))
+	return prefix.NewStore(store, types.CandleIntervalPrefix(pairID, interval))
+}
+
+// RecordTrade adds a swap of amountIn for amountOut through tradePairID to the current candles of the pair
+func (k Keeper) RecordTrade(ctx sdk.Context, tradePairID *types.TradePairID, amountIn, amountOut sdk.Coin) {
+	if !amountIn.IsPositive() || !amountOut.IsPositive() {
+		return
+	}
+
+	// This will never panic since the swap has already been executed on the TradePairID
+	pairID := tradePairID.MustPairID()
+	amount0, amount1 := amountIn.Amount, amountOut.Amount
+	if tradePairID.IsMakerDenomToken0() {
+		amount0, amount1 = amountOut.Amount, amountIn.Amount
+	}
+	price := math_utils.NewPrecDecFromInt(amount0).Quo(math_utils.NewPrecDecFromInt(amount1))
+
+	for _, interval := range types.CandleIntervals {
+		startTime := interval.CandleStartTime(ctx.BlockTime())
+		candle, found := k.GetCandle(ctx, pairID, interval, startTime)
+		if found {
+			candle.AddTrade(price, amount0, amount1)
+		} else {
+			candle = types.NewCandle(pairID, interval, startTime, price, amount0, amount1)
+		}
+		k.SetCandle(ctx, candle)
+	}
+
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.CandleChangedPairKeyPrefix))
+	store.Set([]byte(pairID.CanonicalString()), k.cdc.MustMarshal(pairID))
+}
+
+var _ types.DexHooks = candleHooks{}
+
+// candleHooks records every swap of the dex in the candles of its pair, see Keeper.Hooks
+type candleHooks struct {
+	k Keeper
+}
+
+func (h candleHooks) AfterSwap(ctx sdk.Context, tradePairID *types.TradePairID, amountIn, amountOut sdk.Coin) {
+	h.k.RecordTrade(ctx, tradePairID, amountIn, amountOut)
+}
+
+func (h candleHooks) AfterDeposit(sdk.Context, sdk.AccAddress, sdk.AccAddress, *types.PairID, sdk.Coins, sdk.Coins) {
+}
+
+func (h candleHooks) AfterWithdraw(sdk.Context, sdk.AccAddress, sdk.AccAddress, *types.PairID, sdk.Coins, sdk.Coins) {
+}
+
+func (h candleHooks) AfterLimitOrderPlaced(
+	sdk.Context,
+	sdk.AccAddress,
+	sdk.AccAddress,
+	*types.TradePairID,
+	int64,
+	types.LimitOrderType,
+	string,
+	math.Int,
+	sdk.Coin,
+	sdk.Coin,
+) {
+}
+
+func (h candleHooks) AfterTrancheFilled(sdk.Context, *types.LimitOrderTranche, math.Int, math.Int) {}
+
+func (h candleHooks) AfterTrancheCancelled(sdk.Context, sdk.AccAddress, string, sdk.Coin, sdk.Coin) {}
+
+// PruneCandles removes the candles that started before the CandleRetentionPeriod for all pairs traded in the
+// current block. Since the pair has just been traded its current candles are always kept.
+func (k Keeper) PruneCandles(ctx sdk.Context) {
+	cutoffTime := ctx.BlockTime().Add(-k.GetParams(ctx).CandleRetentionPeriod)
+
+	tStore := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.CandleChangedPairKeyPrefix))
+	tIterator := storetypes.KVStorePrefixIterator(tStore, []byte{})
+	defer tIterator.Close()
+
+	for ; tIterator.Valid(); tIterator.Next() {
+		var pairID types.PairID
+		k.cdc.MustUnmarshal(tIterator.Value(), &pairID)
+
+		for _, interval := range types.CandleIntervals {
+			k.pruneCandlesBefore(ctx, &pairID, interval, cutoffTime)
+		}
+	}
+}
+
+func (k Keeper) pruneCandlesBefore(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	interval types.CandleInterval,
+	cutoffTime time.Time,
+) {
+	store := k.candleIntervalStore(ctx, pairID, interval)
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(cutoffTime))
+
+	var keysToDelete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// GetPairStats returns the trading statistics of a pair over the last 24 hours. The volume and trade count are the
+// totals of the current and previous 23 ONE_HOUR candles.
+func (k Keeper) GetPairStats(ctx sdk.Context, pairID *types.PairID) (stats types.PairStats, found bool) {
+	// The latest ONE_MINUTE candle always holds the latest trade since candles are only pruned when a pair is traded
+	minuteIterator := k.candleIntervalStore(ctx, pairID, types.CandleInterval_ONE_MINUTE).ReverseIterator(nil, nil)
+	defer minuteIterator.Close()
+	if !minuteIterator.Valid() {
+		return stats, false
+	}
+	var latestCandle types.Candle
+	k.cdc.MustUnmarshal(minuteIterator.Value(), &latestCandle)
+
+	stats = types.PairStats{
+		PairId:    pairID,
+		Volume0:   math.ZeroInt(),
+		Volume1:   math.ZeroInt(),
+		LastPrice: latestCandle.Close,
+	}
+
+	interval := types.CandleInterval_ONE_HOUR
+	windowStart := interval.CandleStartTime(ctx.BlockTime()).Add(interval.Duration() - types.PairStatsWindow)
+	hourIterator := k.candleIntervalStore(ctx, pairID, interval).Iterator(sdk.FormatTimeBytes(windowStart), nil)
+	defer hourIterator.Close()
+
+	for ; hourIterator.Valid(); hourIterator.Next() {
+		var candle types.Candle
+		k.cdc.MustUnmarshal(hourIterator.Value(), &candle)
+		stats.Volume0 = stats.Volume0.Add(candle.Volume0)
+		stats.Volume1 = stats.Volume1.Add(candle.Volume1)
+		stats.TradeCount += candle.TradeCount
+	}
+
+	return stats, true
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// candleStartTime is aligned to the start of an hour
+var candleStartTime = time.Unix(1_000_800, 0).UTC()
+
+// recordTrade records a trade of TokenA for TokenB in a new block at blockTime. The price of TokenB is amountA / amountB.
+func (s *DexTestSuite) recordTrade(blockTime time.Time, amountA, amountB int64, takerDenom string) {
+	s.beginTwapBlock(blockTime)
+	amounts := map[string]sdk.Coin{
+		"TokenA": sdk.NewInt64Coin("TokenA", amountA),
+		"TokenB": sdk.NewInt64Coin("TokenB", amountB),
+	}
+	makerDenom := "TokenB"
+	if takerDenom == "TokenB" {
+		makerDenom = "TokenA"
+	}
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, takerDenom)
+	s.App.DexKeeper.RecordTrade(s.Ctx, tradePairID, amounts[takerDenom], amounts[makerDenom])
+	s.App.DexKeeper.PruneCandles(s.Ctx)
+}
+
+func (s *DexTestSuite) getCandle(interval types.CandleInterval, startTime time.Time) types.Candle {
+	candle, found := s.App.DexKeeper.GetCandle(s.Ctx, defaultPairID, interval, startTime)
+	s.Require().True(found, "no %s candle at %s", interval, startTime)
+	return candle
+}
+
+func (s *DexTestSuite) assertCandle(
+	candle types.Candle,
+	open, high, low, closePrice string,
+	volume0, volume1 int64,
+	tradeCount uint64,
+) {
+	s.Assert().Equal(math_utils.MustNewPrecDecFromStr(open), candle.Open, "open")
+	s.Assert().Equal(math_utils.MustNewPrecDecFromStr(high), candle.High, "high")
+	s.Assert().Equal(math_utils.MustNewPrecDecFromStr(low), candle.Low, "low")
+	s.Assert().Equal(math_utils.MustNewPrecDecFromStr(closePrice), candle.Close, "close")
+	s.Assert().Equal(math.NewInt(volume0), candle.Volume0, "volume0")
+	s.Assert().Equal(math.NewInt(volume1), candle.Volume1, "volume1")
+	s.Assert().Equal(tradeCount, candle.TradeCount, "trade count")
+}
+
+func (s *DexTestSuite) TestCandleRecordsLimitOrderSwap() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+	s.beginTwapBlock(candleStartTime.Add(30 * time.Second))
+
+	// GIVEN alice has a resting limit order
+	s.aliceLimitSells("TokenB", 0, 10)
+
+	// WHEN bob places a limit order that swaps through alice's tranche
+	s.bobLimitSells("TokenA", 0, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN a candle is created for every interval
+	for _, interval := range types.CandleIntervals {
+		candle := s.getCandle(interval, interval.CandleStartTime(s.Ctx.BlockTime()))
+		s.assertCandle(candle, "1", "1", "1", "1", 5_000_000, 5_000_000, 1)
+	}
+}
+
+func (s *DexTestSuite) TestCandleRecordsMultiHopSwap() {
+	s.fundAliceBalances(100, 0)
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+	)
+	s.beginTwapBlock(candleStartTime)
+
+	// WHEN alice multihopswaps A<>B => B<>C
+	route := [][]string{{"TokenA", "TokenB", "TokenC"}}
+	s.aliceMultiHopSwaps(route, 10, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN a candle is created for both hops
+	candle := s.getCandle(types.CandleInterval_ONE_MINUTE, candleStartTime)
+	s.Assert().Equal(math.NewInt(10_000_000), candle.Volume0)
+	s.Assert().Equal(uint64(1), candle.TradeCount)
+
+	candle, found := s.App.DexKeeper.GetCandle(
+		s.Ctx, types.MustNewPairID("TokenB", "TokenC"), types.CandleInterval_ONE_MINUTE, candleStartTime,
+	)
+	s.Require().True(found)
+	s.Assert().Equal(uint64(1), candle.TradeCount)
+}
+
+func (s *DexTestSuite) TestCandleOHLCV() {
+	// WHEN TokenB is traded at prices 2, 3 and 1 in the first minute and at 4 in the second minute
+	s.recordTrade(candleStartTime, 20, 10, "TokenA")
+	s.recordTrade(candleStartTime.Add(10*time.Second), 30, 10, "TokenB")
+	s.recordTrade(candleStartTime.Add(20*time.Second), 10, 10, "TokenA")
+	s.recordTrade(candleStartTime.Add(time.Minute), 40, 10, "TokenA")
+
+	// THEN each ONE_MINUTE candle only holds the trades of its minute
+	firstMinute := s.getCandle(types.CandleInterval_ONE_MINUTE, candleStartTime)
+	s.assertCandle(firstMinute, "2", "3", "1", "1", 60, 30, 3)
+	secondMinute := s.getCandle(types.CandleInterval_ONE_MINUTE, candleStartTime.Add(time.Minute))
+	s.assertCandle(secondMinute, "4", "4", "4", "4", 40, 10, 1)
+
+	// AND the ONE_HOUR candle holds all trades
+	hour := s.getCandle(types.CandleInterval_ONE_HOUR, candleStartTime)
+	s.assertCandle(hour, "2", "4", "1", "4", 100, 40, 4)
+}
+
+func (s *DexTestSuite) TestPairStats() {
+	// GIVEN no trades THEN there are no stats
+	_, found := s.App.DexKeeper.GetPairStats(s.Ctx, defaultPairID)
+	s.Assert().False(found)
+
+	// WHEN TokenB is traded at the start, after 23 hours and after 25 hours
+	s.recordTrade(candleStartTime, 20, 10, "TokenA")
+	s.recordTrade(candleStartTime.Add(23*time.Hour+30*time.Minute), 30, 10, "TokenA")
+	s.recordTrade(candleStartTime.Add(25*time.Hour), 40, 20, "TokenA")
+
+	// THEN the stats only include the trades of the last 24 hourly candles
+	stats, found := s.App.DexKeeper.GetPairStats(s.Ctx, defaultPairID)
+	s.Require().True(found)
+	s.Assert().Equal(math.NewInt(70), stats.Volume0)
+	s.Assert().Equal(math.NewInt(30), stats.Volume1)
+	s.Assert().Equal(uint64(2), stats.TradeCount)
+
+	// AND the last price is the price of the latest trade
+	s.Assert().Equal(math_utils.NewPrecDec(2), stats.LastPrice)
+}
+
+func (s *DexTestSuite) TestCandlesArePruned() {
+	// GIVEN a retention period of 24 hours
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.CandleRetentionPeriod = 24 * time.Hour
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// AND trades of TokenA<>TokenB and TokenA<>TokenC at the start
+	s.recordTrade(candleStartTime, 20, 10, "TokenA")
+	otherPairID := types.MustNewPairID("TokenA", "TokenC")
+	s.App.DexKeeper.RecordTrade(
+		s.Ctx,
+		types.NewTradePairIDFromTaker(otherPairID, "TokenA"),
+		sdk.NewInt64Coin("TokenA", 10),
+		sdk.NewInt64Coin("TokenC", 10),
+	)
+	s.Assert().Len(s.App.DexKeeper.GetAllCandle(s.Ctx), 6)
+
+	// WHEN TokenA<>TokenB is traded again after 25 hours
+	tradeTime := candleStartTime.Add(25 * time.Hour)
+	s.recordTrade(tradeTime, 20, 10, "TokenA")
+
+	// THEN the candles of TokenA<>TokenB that started before the retention period are pruned
+	candles := s.App.DexKeeper.GetAllCandle(s.Ctx)
+	s.Require().Len(candles, 6)
+	for _, candle := range candles {
+		if candle.PairId.Equal(defaultPairID) {
+			s.Assert().Equal(candle.Interval.CandleStartTime(tradeTime), candle.StartTime.UTC())
+		} else {
+			// AND the candles of TokenA<>TokenC are kept until it is traded
+			s.Assert().Equal(candle.Interval.CandleStartTime(candleStartTime), candle.StartTime.UTC())
+		}
+	}
+}
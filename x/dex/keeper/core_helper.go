@@ -94,6 +94,18 @@ func (k Keeper) GetGoodTilPurgeAllowance(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).GoodTilPurgeAllowance
 }
 
+func (k Keeper) GetTriggerOrderExecutionAllowance(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).TriggerOrderExecutionAllowance
+}
+
+func (k Keeper) GetProtocolFeeShare(ctx sdk.Context) math.LegacyDec {
+	share := k.GetParams(ctx).ProtocolFeeShare
+	if share.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return share
+}
+
 func (k Keeper) IsBehindEnemyLines(ctx sdk.Context, tradePairID *types.TradePairID, tickIndex int64) bool {
 	oppositeTick, found := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID.Reversed())
 
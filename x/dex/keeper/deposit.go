@@ -57,6 +57,12 @@ func (k Keeper) DepositCore(
 		return nil, nil, nil, nil, err
 	}
 
+	amountsDeposited := sdk.NewCoins(
+		sdk.NewCoin(pairID.Token0, totalAmountReserve0),
+		sdk.NewCoin(pairID.Token1, totalAmountReserve1),
+	)
+	k.Hooks().AfterDeposit(ctx, callerAddr, receiverAddr, pairID, amountsDeposited, sharesIssued)
+
 	return amounts0Deposited, amounts1Deposited, sharesIssued, failedDeposits, nil
 }
 
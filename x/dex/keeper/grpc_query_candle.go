@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns the rolling 24 hour trading statistics of a pair
+func (k Keeper) PairStats(
+	goCtx context.Context,
+	req *types.QueryPairStatsRequest,
+) (*types.QueryPairStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, found := k.GetPairStats(ctx, pairID)
+	if !found {
+		return nil, types.ErrNoTradesForPair
+	}
+
+	return &types.QueryPairStatsResponse{PairStats: stats}, nil
+}
+
+// Returns the candles of a pair for an interval, optionally limited to candles starting in [start_time, end_time)
+func (k Keeper) Candles(
+	goCtx context.Context,
+	req *types.QueryCandlesRequest,
+) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Interval.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Candle keys within the interval store are their sortable start time
+	var startKey, endKey []byte
+	if req.StartTime != nil {
+		startKey = sdk.FormatTimeBytes(*req.StartTime)
+	}
+	if req.EndTime != nil {
+		endKey = sdk.FormatTimeBytes(*req.EndTime)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	candleStore := prefix.NewStore(store, types.KeyPrefix(types.CandleKeyPrefix))
+	intervalStore := prefix.NewStore(candleStore, types.CandleIntervalPrefix(pairID, req.Interval))
+
+	var candles []types.Candle
+	pageRes, err := query.FilteredPaginate(
+		intervalStore,
+		req.Pagination,
+		func(key, value []byte, accum bool) (hit bool, err error) {
+			if startKey != nil && bytes.Compare(key, startKey) < 0 {
+				return false, nil
+			}
+			if endKey != nil && bytes.Compare(key, endKey) >= 0 {
+				return false, nil
+			}
+
+			if accum {
+				var candle types.Candle
+				if err := k.cdc.Unmarshal(value, &candle); err != nil {
+					return false, err
+				}
+				candles = append(candles, candle)
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
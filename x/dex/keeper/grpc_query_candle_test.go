@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestQueryPairStats() {
+	s.recordTrade(candleStartTime, 20, 10, "TokenA")
+	s.recordTrade(candleStartTime.Add(time.Hour), 30, 10, "TokenA")
+
+	resp, err := s.App.DexKeeper.PairStats(s.Ctx, &types.QueryPairStatsRequest{PairId: "TokenA<>TokenB"})
+	s.Require().NoError(err)
+	s.Assert().Equal(types.PairStats{
+		PairId:     defaultPairID,
+		Volume0:    math.NewInt(50),
+		Volume1:    math.NewInt(20),
+		TradeCount: 2,
+		LastPrice:  math_utils.NewPrecDec(3),
+	}, resp.PairStats)
+
+	_, err = s.App.DexKeeper.PairStats(s.Ctx, &types.QueryPairStatsRequest{PairId: "TokenA<>TokenC"})
+	s.Assert().ErrorIs(err, types.ErrNoTradesForPair)
+
+	_, err = s.App.DexKeeper.PairStats(s.Ctx, &types.QueryPairStatsRequest{PairId: "TokenA"})
+	s.Assert().ErrorIs(err, types.ErrInvalidPairIDStr)
+}
+
+func (s *DexTestSuite) TestQueryCandles() {
+	for i := 0; i < 5; i++ {
+		s.recordTrade(candleStartTime.Add(time.Duration(i)*time.Minute), 10, 10, "TokenA")
+	}
+
+	candleStartTimes := func(candles []types.Candle) (list []time.Time) {
+		for _, candle := range candles {
+			list = append(list, candle.StartTime.UTC())
+		}
+		return list
+	}
+
+	// WHEN the candles are queried without a time range THEN all candles are returned in order
+	resp, err := s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:   "TokenA<>TokenB",
+		Interval: types.CandleInterval_ONE_MINUTE,
+	})
+	s.Require().NoError(err)
+	s.Assert().Len(resp.Candles, 5)
+	s.Assert().Equal(candleStartTime, resp.Candles[0].StartTime.UTC())
+
+	// WHEN a time range is given THEN only candles starting in [start_time, end_time) are returned
+	startTime := candleStartTime.Add(time.Minute)
+	endTime := candleStartTime.Add(3 * time.Minute)
+	resp, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:    "TokenA<>TokenB",
+		Interval:  types.CandleInterval_ONE_MINUTE,
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]time.Time{startTime, startTime.Add(time.Minute)}, candleStartTimes(resp.Candles))
+
+	// WHEN paginated THEN the candles are split over pages
+	resp, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:     "TokenA<>TokenB",
+		Interval:   types.CandleInterval_ONE_MINUTE,
+		StartTime:  &startTime,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal([]time.Time{startTime, startTime.Add(time.Minute)}, candleStartTimes(resp.Candles))
+	s.Assert().Equal(uint64(4), resp.Pagination.Total)
+
+	resp, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:     "TokenA<>TokenB",
+		Interval:   types.CandleInterval_ONE_MINUTE,
+		StartTime:  &startTime,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(
+		[]time.Time{startTime.Add(2 * time.Minute), startTime.Add(3 * time.Minute)},
+		candleStartTimes(resp.Candles),
+	)
+
+	// AND other intervals are queried separately
+	resp, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:   "TokenA<>TokenB",
+		Interval: types.CandleInterval_ONE_HOUR,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Candles, 1)
+	s.Assert().Equal(uint64(5), resp.Candles[0].TradeCount)
+}
+
+func (s *DexTestSuite) TestQueryCandlesInvalidRequest() {
+	_, err := s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:   "TokenA<>TokenB",
+		Interval: types.CandleInterval(3),
+	})
+	s.Assert().Error(err)
+
+	_, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{PairId: "TokenA"})
+	s.Assert().ErrorIs(err, types.ErrInvalidPairIDStr)
+
+	_, err = s.App.DexKeeper.Candles(s.Ctx, nil)
+	s.Assert().Error(err)
+}
@@ -24,6 +24,7 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		OrderType:        req.OrderType,
 		ExpirationTime:   req.ExpirationTime,
 		MaxAmountOut:     req.MaxAmountOut,
+		PostOnlySlide:    req.PostOnlySlide,
 	}
 	if err := msg.Validate(); err != nil {
 		return nil, err
@@ -44,7 +45,7 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		)
 	}
 
-	_, totalInCoin, swapInCoin, swapOutCoin, err := k.PlaceLimitOrderCore(
+	_, _, totalInCoin, swapInCoin, swapOutCoin, err := k.PlaceLimitOrderCore(
 		cacheCtx,
 		req.TokenIn,
 		req.TokenOut,
@@ -54,6 +55,7 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		req.ExpirationTime,
 		req.MaxAmountOut,
 		nil,
+		req.PostOnlySlide,
 		callerAddr,
 		receiverAddr,
 	)
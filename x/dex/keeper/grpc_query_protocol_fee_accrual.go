@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns the total protocol fees accrued by all pairs
+func (k Keeper) ProtocolFeeAccrualAll(
+	goCtx context.Context,
+	req *types.QueryAllProtocolFeeAccrualRequest,
+) (*types.QueryAllProtocolFeeAccrualResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var accruals []types.ProtocolFeeAccrual
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	accrualStore := prefix.NewStore(store, types.KeyPrefix(types.ProtocolFeeAccrualKeyPrefix))
+
+	pageRes, err := query.Paginate(accrualStore, req.Pagination, func(_, value []byte) error {
+		var accrual types.ProtocolFeeAccrual
+		if err := k.cdc.Unmarshal(value, &accrual); err != nil {
+			return err
+		}
+
+		accruals = append(accruals, accrual)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProtocolFeeAccrualResponse{ProtocolFeeAccrual: accruals, Pagination: pageRes}, nil
+}
+
+// Returns the total protocol fees accrued by a single pair
+func (k Keeper) ProtocolFeeAccrual(
+	goCtx context.Context,
+	req *types.QueryGetProtocolFeeAccrualRequest,
+) (*types.QueryGetProtocolFeeAccrualResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	accrual, found := k.GetProtocolFeeAccrual(ctx, pairID)
+	if !found {
+		return nil, status.Error(codes.NotFound, "ProtocolFeeAccrual not found for key")
+	}
+
+	return &types.QueryGetProtocolFeeAccrualResponse{ProtocolFeeAccrual: accrual}, nil
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestProtocolFeeAccrualQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := []types.ProtocolFeeAccrual{
+		{
+			PairId:    types.MustNewPairID("TokenA", "TokenB"),
+			TotalFees: sdk.NewCoins(sdk.NewInt64Coin("TokenA", 10), sdk.NewInt64Coin("TokenB", 20)),
+		},
+		{
+			PairId:    types.MustNewPairID("TokenA", "TokenC"),
+			TotalFees: sdk.NewCoins(sdk.NewInt64Coin("TokenC", 5)),
+		},
+	}
+	for _, item := range items {
+		keeper.SetProtocolFeeAccrual(ctx, item)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetProtocolFeeAccrualRequest
+		response *types.QueryGetProtocolFeeAccrualResponse
+		err      bool
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetProtocolFeeAccrualRequest{PairId: "TokenA<>TokenB"},
+			response: &types.QueryGetProtocolFeeAccrualResponse{ProtocolFeeAccrual: items[0]},
+		},
+		{
+			desc:     "Last",
+			request:  &types.QueryGetProtocolFeeAccrualRequest{PairId: "TokenA<>TokenC"},
+			response: &types.QueryGetProtocolFeeAccrualResponse{ProtocolFeeAccrual: items[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetProtocolFeeAccrualRequest{PairId: "TokenB<>TokenC"},
+			err:     true,
+		},
+		{
+			desc:    "InvalidPairID",
+			request: &types.QueryGetProtocolFeeAccrualRequest{PairId: "TokenA"},
+			err:     true,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ProtocolFeeAccrual(ctx, tc.request)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	t.Run("All", func(t *testing.T) {
+		response, err := keeper.ProtocolFeeAccrualAll(ctx, &types.QueryAllProtocolFeeAccrualRequest{})
+		require.NoError(t, err)
+		require.ElementsMatch(t,
+			nullify.Fill(items),
+			nullify.Fill(response.ProtocolFeeAccrual),
+		)
+	})
+}
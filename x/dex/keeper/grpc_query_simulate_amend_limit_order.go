@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SimulateAmendLimitOrder(
+	goCtx context.Context,
+	req *types.QuerySimulateAmendLimitOrderRequest,
+) (*types.QuerySimulateAmendLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := req.Msg
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	amended, coinsIn, coinsOut, err := k.ExecuteAmendLimitOrder(
+		cacheCtx,
+		msg.TrancheKey,
+		msg.LimitSellPrice,
+		msg.TickIndexInToOut,
+		msg.AmountIn,
+		callerAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateAmendLimitOrderResponse{
+		Resp: &types.MsgAmendLimitOrderResponse{
+			TrancheKey: amended.TrancheKey,
+			CoinsIn:    coinsIn,
+			CoinsOut:   coinsOut,
+		},
+	}, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestSimulateAmendLimitOrder() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 20)
+
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	s.bobLimitSells("TokenB", -10, 20, types.LimitOrderType_FILL_OR_KILL)
+
+	amountIn := math.NewInt(40_000_000)
+	req := &types.QuerySimulateAmendLimitOrderRequest{
+		Msg: &types.MsgAmendLimitOrder{
+			Creator:        s.alice.String(),
+			TrancheKey:     trancheKey,
+			LimitSellPrice: tickToLimitSellPrice("TokenA", -1),
+			AmountIn:       &amountIn,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateAmendLimitOrder(s.Ctx, req)
+	s.NoError(err)
+
+	s.NotEmpty(resp.Resp.TrancheKey)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", math.NewInt(10_000_000))), resp.Resp.CoinsIn)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenB", math.NewInt(20_000_000))), resp.Resp.CoinsOut)
+
+	// Nothing has changed
+	s.assertLimitLiquidityAtTick("TokenA", 0, 30)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 0)
+	s.assertDexBalances(30, 20)
+}
+
+func (s *DexTestSuite) TestSimulateAmendLimitOrderFails() {
+	s.fundAliceBalances(50, 0)
+
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	req := &types.QuerySimulateAmendLimitOrderRequest{
+		Msg: &types.MsgAmendLimitOrder{
+			Creator:        s.bob.String(),
+			TrancheKey:     trancheKey,
+			LimitSellPrice: tickToLimitSellPrice("TokenA", -1),
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateAmendLimitOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+	s.Nil(resp)
+}
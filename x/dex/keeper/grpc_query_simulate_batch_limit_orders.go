@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SimulateBatchLimitOrders(
+	goCtx context.Context,
+	req *types.QuerySimulateBatchLimitOrdersRequest,
+) (*types.QuerySimulateBatchLimitOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := req.Msg
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	trancheKeys, coinsIn, coinsOut, err := k.ExecuteBatchLimitOrders(cacheCtx, msg, callerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateBatchLimitOrdersResponse{
+		Resp: &types.MsgBatchLimitOrdersResponse{
+			TrancheKeys: trancheKeys,
+			CoinsIn:     coinsIn,
+			CoinsOut:    coinsOut,
+		},
+	}, nil
+}
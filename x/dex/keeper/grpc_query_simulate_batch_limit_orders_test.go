@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestSimulateBatchLimitOrders() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	trancheKey := s.aliceLimitSells("TokenA", -1, 5)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	req := &types.QuerySimulateBatchLimitOrdersRequest{
+		Msg: &types.MsgBatchLimitOrders{
+			Creator:           s.alice.String(),
+			CancelTrancheKeys: []string{trancheKey},
+			PlaceOrders: []types.BatchPlaceLimitOrder{
+				s.batchPlaceLimitOrder("TokenA", 0, 4, types.LimitOrderType_FILL_OR_KILL),
+				s.batchPlaceLimitOrder("TokenA", -2, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+			},
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateBatchLimitOrders(s.Ctx, req)
+	s.NoError(err)
+
+	s.Len(resp.Resp.TrancheKeys, 2)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", math.NewInt(9_000_000))), resp.Resp.CoinsIn)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenB", math.NewInt(4_000_000))), resp.Resp.CoinsOut)
+
+	// Nothing has changed
+	s.assertAliceBalances(5, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 5)
+	s.assertLimitLiquidityAtTick("TokenA", -2, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+}
+
+func (s *DexTestSuite) TestSimulateBatchLimitOrdersFails() {
+	s.fundAliceBalances(10, 0)
+
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	req := &types.QuerySimulateBatchLimitOrdersRequest{
+		Msg: &types.MsgBatchLimitOrders{
+			Creator:           s.bob.String(),
+			CancelTrancheKeys: []string{trancheKey},
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateBatchLimitOrders(s.Ctx, req)
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+	s.Nil(resp)
+}
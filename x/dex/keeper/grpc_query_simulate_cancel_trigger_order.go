@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SimulateCancelTriggerOrder(
+	goCtx context.Context,
+	req *types.QuerySimulateCancelTriggerOrderRequest,
+) (*types.QuerySimulateCancelTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := req.Msg
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	_, coinOut, err := k.ExecuteCancelTriggerOrder(
+		cacheCtx,
+		msg.Id,
+		callerAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateCancelTriggerOrderResponse{
+		Resp: &types.MsgCancelTriggerOrderResponse{
+			CoinOut: coinOut,
+		},
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestSimulateCancelTriggerOrder() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 20)
+
+	s.bobLimitSells("TokenB", 0, 20)
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, 10, 1000, 30, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	req := &types.QuerySimulateCancelTriggerOrderRequest{
+		Msg: &types.MsgCancelTriggerOrder{
+			Creator: s.alice.String(),
+			Id:      id,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateCancelTriggerOrder(s.Ctx, req)
+	s.NoError(err)
+
+	s.Equal(sdk.NewCoin("TokenA", math.NewInt(30_000_000)), resp.Resp.CoinOut)
+
+	// The order is still in place and its TokenA escrowed
+	s.assertTriggerOrderExists(id, true)
+	s.assertAliceBalances(20, 0)
+	s.assertDexBalances(30, 20)
+}
+
+func (s *DexTestSuite) TestSimulateCancelTriggerOrderFails() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 20)
+
+	s.bobLimitSells("TokenB", 0, 20)
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, 10, 1000, 30, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// Bob cannot cancel alice's order
+	req := &types.QuerySimulateCancelTriggerOrderRequest{
+		Msg: &types.MsgCancelTriggerOrder{
+			Creator: s.bob.String(),
+			Id:      id,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulateCancelTriggerOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrTriggerOrderNotFound)
+	s.Nil(resp)
+
+	// Nor can alice cancel an order that does not exist
+	req.Msg.Creator = s.alice.String()
+	req.Msg.Id = id + 1
+
+	resp, err = s.App.DexKeeper.SimulateCancelTriggerOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrTriggerOrderNotFound)
+	s.Nil(resp)
+
+	s.assertTriggerOrderExists(id, true)
+	s.assertDexBalances(30, 20)
+}
@@ -43,7 +43,7 @@ func (k Keeper) SimulatePlaceLimitOrder(
 			return nil, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
 		}
 	}
-	trancheKey, totalIn, takerCoinIn, takerCoinOut, _, _, err := k.ExecutePlaceLimitOrder(
+	trancheKey, placedTickIndex, totalIn, takerCoinIn, takerCoinOut, _, _, err := k.ExecutePlaceLimitOrder(
 		cacheCtx,
 		takerTradePairID,
 		msg.AmountIn,
@@ -52,6 +52,7 @@ func (k Keeper) SimulatePlaceLimitOrder(
 		msg.ExpirationTime,
 		msg.MaxAmountOut,
 		msg.MinAverageSellPrice,
+		msg.PostOnlySlide,
 		receiverAddr,
 	)
 	if err != nil {
@@ -61,10 +62,11 @@ func (k Keeper) SimulatePlaceLimitOrder(
 	coinIn := sdk.NewCoin(msg.TokenIn, totalIn)
 	return &types.QuerySimulatePlaceLimitOrderResponse{
 		Resp: &types.MsgPlaceLimitOrderResponse{
-			TrancheKey:   trancheKey,
-			CoinIn:       coinIn,
-			TakerCoinIn:  takerCoinIn,
-			TakerCoinOut: takerCoinOut,
+			TrancheKey:       trancheKey,
+			CoinIn:           coinIn,
+			TakerCoinIn:      takerCoinIn,
+			TakerCoinOut:     takerCoinOut,
+			TickIndexInToOut: placedTickIndex,
 		},
 	}, nil
 }
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SimulatePlaceTriggerOrder(
+	goCtx context.Context,
+	req *types.QuerySimulatePlaceTriggerOrderRequest,
+) (*types.QuerySimulatePlaceTriggerOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := req.Msg
+	msg.Creator = types.DummyAddress
+	msg.Receiver = types.DummyAddress
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := msg.ValidateGoodTilExpiration(ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := k.ExecutePlaceTriggerOrder(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulatePlaceTriggerOrderResponse{
+		Resp: &types.MsgPlaceTriggerOrderResponse{
+			Id:     order.Id,
+			CoinIn: sdk.NewCoin(msg.TokenIn, msg.AmountIn),
+		},
+	}, nil
+}
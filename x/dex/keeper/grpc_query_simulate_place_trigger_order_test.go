@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestSimulatePlaceTriggerOrder() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 20)
+
+	s.bobLimitSells("TokenB", 0, 20)
+	s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, 10, 1000, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	req := &types.QuerySimulatePlaceTriggerOrderRequest{
+		Msg: &types.MsgPlaceTriggerOrder{
+			TokenIn:                 "TokenA",
+			TokenOut:                "TokenB",
+			TriggerType:             types.TriggerType_TAKE_PROFIT,
+			TriggerTickIndexInToOut: -10,
+			TickIndexInToOut:        1000,
+			AmountIn:                math.NewInt(10_000_000),
+			OrderType:               types.LimitOrderType_FILL_OR_KILL,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulatePlaceTriggerOrder(s.Ctx, req)
+	s.NoError(err)
+
+	s.Equal(uint64(1), resp.Resp.Id)
+	s.Equal(sdk.NewCoin("TokenA", math.NewInt(10_000_000)), resp.Resp.CoinIn)
+
+	// The simulated order is neither stored nor escrowed
+	s.assertTriggerOrderExists(1, false)
+	s.Equal(uint64(1), s.App.DexKeeper.GetTriggerOrderCount(s.Ctx))
+	s.assertDexBalances(5, 20)
+}
+
+func (s *DexTestSuite) TestSimulatePlaceTriggerOrderFails() {
+	s.fundBobBalances(0, 20)
+
+	s.bobLimitSells("TokenB", 0, 20)
+
+	req := &types.QuerySimulatePlaceTriggerOrderRequest{
+		Msg: &types.MsgPlaceTriggerOrder{
+			TokenIn:                 "TokenA",
+			TokenOut:                "TokenB",
+			TriggerType:             types.TriggerType_STOP_LOSS,
+			TriggerTickIndexInToOut: int64(types.MaxTickExp) + 1,
+			TickIndexInToOut:        1000,
+			AmountIn:                math.NewInt(10_000_000),
+			OrderType:               types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulatePlaceTriggerOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrTickOutsideRange)
+	s.Nil(resp)
+
+	req.Msg.TriggerTickIndexInToOut = 10
+	req.Msg.OrderType = types.LimitOrderType_JUST_IN_TIME
+
+	resp, err = s.App.DexKeeper.SimulatePlaceTriggerOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrJITTriggerOrder)
+	s.Nil(resp)
+
+	s.assertTriggerOrderExists(0, false)
+	s.assertDexBalances(0, 20)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns all pending trigger orders for a given pairID/tokenIn combination
+func (k Keeper) TriggerOrderAll(
+	goCtx context.Context,
+	req *types.QueryAllTriggerOrderRequest,
+) (*types.QueryAllTriggerOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var triggerOrders []types.TriggerOrder
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+	tradePairID := types.NewTradePairIDFromTaker(pairID, req.TokenIn)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(
+		store,
+		append(types.KeyPrefix(types.TriggerOrderIndexKeyPrefix), types.TriggerOrderTradePairPrefix(tradePairID)...),
+	)
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(_, value []byte) error {
+		triggerOrder, found := k.GetTriggerOrder(ctx, sdk.BigEndianToUint64(value))
+		if !found {
+			return types.ErrTriggerOrderNotFound
+		}
+
+		triggerOrders = append(triggerOrders, triggerOrder)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTriggerOrderResponse{TriggerOrders: triggerOrders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TriggerOrder(
+	goCtx context.Context,
+	req *types.QueryGetTriggerOrderRequest,
+) (*types.QueryGetTriggerOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	triggerOrder, found := k.GetTriggerOrder(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "TriggerOrder not found for key")
+	}
+
+	return &types.QueryGetTriggerOrderResponse{TriggerOrder: triggerOrder}, nil
+}
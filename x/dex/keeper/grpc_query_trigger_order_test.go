@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/common/nullify"
+	keepertest "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestTriggerOrderQuery(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	items := []types.TriggerOrder{
+		{
+			Id:                      0,
+			Creator:                 "alice",
+			Receiver:                "alice",
+			TradePairId:             types.MustNewTradePairID("TokenA", "TokenB"),
+			TriggerType:             types.TriggerType_STOP_LOSS,
+			TriggerTickIndexInToOut: 10,
+			TickIndexInToOut:        20,
+			AmountIn:                math.NewInt(10),
+		},
+		{
+			Id:                      1,
+			Creator:                 "alice",
+			Receiver:                "alice",
+			TradePairId:             types.MustNewTradePairID("TokenA", "TokenB"),
+			TriggerType:             types.TriggerType_TAKE_PROFIT,
+			TriggerTickIndexInToOut: -10,
+			TickIndexInToOut:        -5,
+			AmountIn:                math.NewInt(10),
+		},
+		{
+			Id:                      2,
+			Creator:                 "bob",
+			Receiver:                "bob",
+			TradePairId:             types.MustNewTradePairID("TokenB", "TokenA"),
+			TriggerType:             types.TriggerType_STOP_LOSS,
+			TriggerTickIndexInToOut: 10,
+			TickIndexInToOut:        20,
+			AmountIn:                math.NewInt(10),
+		},
+	}
+	for _, item := range items {
+		keeper.SetTriggerOrder(ctx, item)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetTriggerOrderRequest
+		response *types.QueryGetTriggerOrderResponse
+		err      bool
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetTriggerOrderRequest{Id: 0},
+			response: &types.QueryGetTriggerOrderResponse{TriggerOrder: items[0]},
+		},
+		{
+			desc:     "Last",
+			request:  &types.QueryGetTriggerOrderRequest{Id: 2},
+			response: &types.QueryGetTriggerOrderResponse{TriggerOrder: items[2]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetTriggerOrderRequest{Id: 3},
+			err:     true,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TriggerOrder(ctx, tc.request)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+
+	t.Run("All", func(t *testing.T) {
+		response, err := keeper.TriggerOrderAll(ctx, &types.QueryAllTriggerOrderRequest{
+			PairId:  "TokenA<>TokenB",
+			TokenIn: "TokenA",
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t,
+			nullify.Fill(items[:2]),
+			nullify.Fill(response.TriggerOrders),
+		)
+	})
+}
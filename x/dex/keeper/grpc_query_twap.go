@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns the arithmetic TWAP of base_denom for a pair between start_time and end_time
+func (k Keeper) ArithmeticTwap(
+	goCtx context.Context,
+	req *types.QueryArithmeticTwapRequest,
+) (*types.QueryArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	twap, err := k.GetArithmeticTwap(ctx, pairID, req.BaseDenom, req.StartTime, twapEndTime(ctx, req.EndTime))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+// Returns the geometric TWAP of base_denom for a pair between start_time and end_time
+func (k Keeper) GeometricTwap(
+	goCtx context.Context,
+	req *types.QueryGeometricTwapRequest,
+) (*types.QueryGeometricTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	twap, err := k.GetGeometricTwap(ctx, pairID, req.BaseDenom, req.StartTime, twapEndTime(ctx, req.EndTime))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGeometricTwapResponse{GeometricTwap: twap}, nil
+}
+
+func twapEndTime(ctx sdk.Context, endTime *time.Time) time.Time {
+	if endTime == nil {
+		return ctx.BlockTime()
+	}
+	return *endTime
+}
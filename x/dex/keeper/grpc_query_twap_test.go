@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestQueryTwap() {
+	s.setupTwapHistory()
+	s.beginTwapBlock(twapStartTime.Add(30 * time.Second))
+
+	// WHEN the TWAPs are queried without an end time
+	arithmeticResp, err := s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		PairId:    "TokenA<>TokenB",
+		BaseDenom: "TokenB",
+		StartTime: twapStartTime,
+	})
+	s.Require().NoError(err)
+	geometricResp, err := s.App.DexKeeper.GeometricTwap(s.Ctx, &types.QueryGeometricTwapRequest{
+		PairId:    "TokenA<>TokenB",
+		BaseDenom: "TokenB",
+		StartTime: twapStartTime,
+	})
+	s.Require().NoError(err)
+
+	// THEN the current block time is used as end time
+	s.assertPriceApproxEqual("0.936654950751308103313782164", arithmeticResp.ArithmeticTwap)
+	s.assertPriceApproxEqual("0.935603654195874788739043808", geometricResp.GeometricTwap)
+
+	// WHEN an end time is given THEN it is used instead
+	endTime := twapStartTime.Add(10 * time.Second)
+	arithmeticResp, err = s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		PairId:    "TokenA<>TokenB",
+		BaseDenom: "TokenB",
+		StartTime: twapStartTime,
+		EndTime:   &endTime,
+	})
+	s.Require().NoError(err)
+	s.Assert().Equal(types.MustCalcPrice(1), arithmeticResp.ArithmeticTwap)
+}
+
+func (s *DexTestSuite) TestQueryTwapInvalidPairID() {
+	_, err := s.App.DexKeeper.ArithmeticTwap(s.Ctx, &types.QueryArithmeticTwapRequest{
+		PairId:    "TokenA",
+		BaseDenom: "TokenA",
+		StartTime: twapStartTime,
+	})
+	s.Assert().ErrorIs(err, types.ErrInvalidPairIDStr)
+
+	_, err = s.App.DexKeeper.GeometricTwap(s.Ctx, nil)
+	s.Assert().Error(err)
+}
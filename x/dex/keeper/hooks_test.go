@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var _ types.DexHooks = &recordingDexHooks{}
+
+// recordingDexHooks records every hook call as "<name>:<hook>" in a log shared between hooks
+type recordingDexHooks struct {
+	name string
+	log  *[]string
+}
+
+func (h *recordingDexHooks) record(hook string) {
+	*h.log = append(*h.log, fmt.Sprintf("%s:%s", h.name, hook))
+}
+
+func (h *recordingDexHooks) AfterSwap(_ sdk.Context, tradePairID *types.TradePairID, amountIn, amountOut sdk.Coin) {
+	h.record(fmt.Sprintf("AfterSwap(%s, %s, %s)", tradePairID.MustPairID().CanonicalString(), amountIn, amountOut))
+}
+
+func (h *recordingDexHooks) AfterDeposit(
+	_ sdk.Context,
+	_, _ sdk.AccAddress,
+	_ *types.PairID,
+	amountsDeposited sdk.Coins,
+	_ sdk.Coins,
+) {
+	h.record(fmt.Sprintf("AfterDeposit(%s)", amountsDeposited))
+}
+
+func (h *recordingDexHooks) AfterWithdraw(
+	_ sdk.Context,
+	_, _ sdk.AccAddress,
+	_ *types.PairID,
+	amountsWithdrawn sdk.Coins,
+	_ sdk.Coins,
+) {
+	h.record(fmt.Sprintf("AfterWithdraw(%s)", amountsWithdrawn))
+}
+
+func (h *recordingDexHooks) AfterLimitOrderPlaced(
+	_ sdk.Context,
+	creator, _ sdk.AccAddress,
+	_ *types.TradePairID,
+	_ int64,
+	_ types.LimitOrderType,
+	_ string,
+	amountIn math.Int,
+	swapIn, _ sdk.Coin,
+) {
+	h.record(fmt.Sprintf("AfterLimitOrderPlaced(%s, %s, %s)", creator, amountIn, swapIn.Amount))
+}
+
+func (h *recordingDexHooks) AfterTrancheFilled(
+	_ sdk.Context,
+	_ *types.LimitOrderTranche,
+	amountTakerIn, amountMakerOut math.Int,
+) {
+	h.record(fmt.Sprintf("AfterTrancheFilled(%s, %s)", amountTakerIn, amountMakerOut))
+}
+
+func (h *recordingDexHooks) AfterTrancheCancelled(
+	_ sdk.Context,
+	owner sdk.AccAddress,
+	_ string,
+	makerCoinOut, _ sdk.Coin,
+) {
+	h.record(fmt.Sprintf("AfterTrancheCancelled(%s, %s)", owner, makerCoinOut))
+}
+
+// newDexKeeper returns a keeper over the stores of the app dex keeper without any hooks set
+func (s *DexTestSuite) newDexKeeper() *dexkeeper.Keeper {
+	return dexkeeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetMemKey(types.MemStoreKey),
+		s.App.GetTKey(types.TStoreKey),
+		s.App.BankKeeper.WithMintCoinsRestriction(types.NewDexDenomMintCoinsRestriction()),
+		s.App.DexKeeper.GetAuthority(),
+	)
+}
+
+// setRecordingHooks registers recording hooks with the given names and returns the shared log of hook calls
+func (s *DexTestSuite) setRecordingHooks(names ...string) *[]string {
+	hookLog := &[]string{}
+	hooks := make([]types.DexHooks, len(names))
+	for i, name := range names {
+		hooks[i] = &recordingDexHooks{name: name, log: hookLog}
+	}
+
+	// A new keeper is built so that the hooks are only registered for the msgServer used by this test
+	keeper := s.newDexKeeper()
+	keeper.SetHooks(types.NewMultiDexHooks(hooks...))
+	s.msgServer = dexkeeper.NewMsgServerImpl(*keeper)
+
+	return hookLog
+}
+
+func (s *DexTestSuite) TestHooksLimitOrderCrossingTranche() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+	hookLog := s.setRecordingHooks("hooks")
+
+	// GIVEN alice has a resting limit order
+	s.aliceLimitSells("TokenB", 0, 10)
+
+	// WHEN bob places a limit order that swaps through alice's tranche
+	s.bobLimitSells("TokenA", 0, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the tranche fill is reported before the swap and the swap before the placement of bob's order
+	s.Equal([]string{
+		fmt.Sprintf("hooks:AfterLimitOrderPlaced(%s, 10000000, 0)", s.alice),
+		"hooks:AfterTrancheFilled(5000000, 5000000)",
+		"hooks:AfterSwap(TokenA<>TokenB, 5000000TokenA, 5000000TokenB)",
+		fmt.Sprintf("hooks:AfterLimitOrderPlaced(%s, 5000000, 5000000)", s.bob),
+	}, *hookLog)
+}
+
+func (s *DexTestSuite) TestHooksDepositAndWithdraw() {
+	s.fundAliceBalances(10, 10)
+	hookLog := s.setRecordingHooks("hooks")
+
+	// WHEN alice deposits and withdraws from a pool
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.aliceWithdraws(NewWithdrawal(20, 0, 1))
+
+	// THEN both hooks are called with the amounts moved
+	s.Equal([]string{
+		"hooks:AfterDeposit(10000000TokenA,10000000TokenB)",
+		"hooks:AfterWithdraw(10000000TokenA,10000000TokenB)",
+	}, *hookLog)
+}
+
+func (s *DexTestSuite) TestHooksCancelLimitOrder() {
+	s.fundAliceBalances(10, 0)
+	hookLog := s.setRecordingHooks("hooks")
+
+	// WHEN alice places and cancels a limit order
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN the cancellation is reported after the placement
+	s.Equal([]string{
+		fmt.Sprintf("hooks:AfterLimitOrderPlaced(%s, 10000000, 0)", s.alice),
+		fmt.Sprintf("hooks:AfterTrancheCancelled(%s, 10000000TokenA)", s.alice),
+	}, *hookLog)
+}
+
+func (s *DexTestSuite) TestHooksMultiHopSwap() {
+	s.fundAliceBalances(100, 0)
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, 0, 1),
+	)
+	hookLog := s.setRecordingHooks("hooks")
+
+	// WHEN alice multihopswaps A<>B => B<>C
+	route := [][]string{{"TokenA", "TokenB", "TokenC"}}
+	s.aliceMultiHopSwaps(route, 10, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN AfterSwap is called for each hop in order
+	s.Require().Len(*hookLog, 2)
+	s.Contains((*hookLog)[0], "hooks:AfterSwap(TokenA<>TokenB, ")
+	s.Contains((*hookLog)[1], "hooks:AfterSwap(TokenB<>TokenC, ")
+}
+
+func (s *DexTestSuite) TestMultiDexHooksOrdering() {
+	s.fundAliceBalances(10, 10)
+	hookLog := s.setRecordingHooks("first", "second")
+
+	// WHEN alice deposits and withdraws from a pool
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	s.aliceWithdraws(NewWithdrawal(20, 0, 1))
+
+	// THEN every hook is called on all hooks in the order they were registered before moving on to the next hook
+	s.Equal([]string{
+		"first:AfterDeposit(10000000TokenA,10000000TokenB)",
+		"second:AfterDeposit(10000000TokenA,10000000TokenB)",
+		"first:AfterWithdraw(10000000TokenA,10000000TokenB)",
+		"second:AfterWithdraw(10000000TokenA,10000000TokenB)",
+	}, *hookLog)
+}
+
+func (s *DexTestSuite) TestSetHooksTwicePanics() {
+	keeper := s.newDexKeeper()
+	keeper.SetHooks(types.NewMultiDexHooks())
+
+	s.Panics(func() { keeper.SetHooks(types.NewMultiDexHooks()) })
+}
+
+func (s *DexTestSuite) TestAppLeavesHooksUnset() {
+	// The app sets no hooks of its own, modules setting theirs on the app keeper must not panic
+	keeper := s.App.DexKeeper
+	s.NotPanics(func() { keeper.SetHooks(types.NewMultiDexHooks()) })
+}
+
+func (s *DexTestSuite) TestHooksRecordCandles() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(5, 0)
+	hookLog := s.setRecordingHooks("hooks")
+
+	// GIVEN alice has a resting limit order
+	s.aliceLimitSells("TokenB", 0, 10)
+
+	// WHEN bob swaps through it with hooks registered
+	s.bobLimitSells("TokenA", 0, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the swap is recorded in the candles of the pair as well as reported to the hooks
+	s.Contains(*hookLog, "hooks:AfterSwap(TokenA<>TokenB, 5000000TokenA, 5000000TokenB)")
+	stats, found := s.App.DexKeeper.GetPairStats(s.Ctx, &types.PairID{Token0: "TokenA", Token1: "TokenB"})
+	s.True(found)
+	s.Equal(uint64(1), stats.TradeCount)
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// tickToLimitSellPrice converts a normalized tick for selling tokenIn into the equivalent LimitSellPrice
+func tickToLimitSellPrice(tokenIn string, tick int) *math_utils.PrecDec {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	tickIndexInToOut := tradePairID.TickIndexTakerToMaker(int64(tick))
+	price := math_utils.OnePrecDec().Quo(types.MustCalcPrice(tickIndexInToOut))
+	return &price
+}
+
+func (s *DexTestSuite) aliceAmendsLimitOrder(
+	trancheKey string,
+	limitSellPrice *math_utils.PrecDec,
+	amountIn *int,
+) (*types.MsgAmendLimitOrderResponse, error) {
+	var amountInInt *sdkmath.Int
+	if amountIn != nil {
+		amount := sdkmath.NewInt(int64(*amountIn)).Mul(denomMultiple)
+		amountInInt = &amount
+	}
+
+	return s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:        s.alice.String(),
+		TrancheKey:     trancheKey,
+		LimitSellPrice: limitSellPrice,
+		AmountIn:       amountInInt,
+	})
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderMoveTick() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has a limit order for 10 tokenA at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she moves it to tick -1
+	resp, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), nil)
+	s.NoError(err)
+
+	// THEN the order is moved without any funds changing hands
+	s.NotEmpty(resp.TrancheKey)
+	s.True(resp.CoinsIn.IsZero())
+	s.True(resp.CoinsOut.IsZero())
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+
+	// AND the old tranche key is no longer valid
+	_, err = s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -2), nil)
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderMoveTickByTickIndex() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has a limit order for 10 tokenA at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she moves it to tick -1 by tick index
+	tickIndexInToOut := types.NewTradePairIDFromTaker(defaultPairID, "TokenA").TickIndexTakerToMaker(-1)
+	resp, err := s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:          s.alice.String(),
+		TrancheKey:       trancheKey,
+		TickIndexInToOut: &tickIndexInToOut,
+	})
+	s.NoError(err)
+
+	// THEN the order is moved
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertAliceBalances(0, 0)
+
+	// AND the cancellation and the placement are reported
+	cancelEvents := s.findActionEvents(types.CancelLimitOrderEventKey)
+	s.Require().Len(cancelEvents, 1)
+	s.Equal(trancheKey, cancelEvents[0][types.AttributeTrancheKey])
+	placeEvents := s.findActionEvents(types.PlaceLimitOrderEventKey)
+	s.Require().Len(placeEvents, 2)
+	s.Equal(resp.TrancheKey, placeEvents[1][types.AttributeTrancheKey])
+	s.Equal(strconv.FormatInt(tickIndexInToOut, 10), placeEvents[1][types.AttributeLimitTick])
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderIncreaseAmount() {
+	s.fundAliceBalances(20, 0)
+
+	// GIVEN alice has a limit order for 10 tokenA at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she increases it to 15 tokenA
+	amount := 15
+	resp, err := s.aliceAmendsLimitOrder(trancheKey, nil, &amount)
+	s.NoError(err)
+
+	// THEN only the difference is transferred
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(5_000_000))), resp.CoinsIn)
+	s.True(resp.CoinsOut.IsZero())
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 15)
+	s.assertAliceBalances(5, 0)
+	s.assertDexBalances(15, 0)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderDecreaseAmountAndMoveTick() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has a limit order for 10 tokenA at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she moves it to tick -1 and decreases it to 4 tokenA
+	amount := 4
+	resp, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), &amount)
+	s.NoError(err)
+
+	// THEN the difference is returned
+	s.True(resp.CoinsIn.IsZero())
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(6_000_000))), resp.CoinsOut)
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 4)
+	s.assertAliceBalances(6, 0)
+	s.assertDexBalances(4, 0)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderPartiallyFilled() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN alice has a limit order for 50 tokenA at tick 0 that is partially filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+	s.bobLimitSells("TokenB", -10, 20, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN she moves it to tick -1
+	resp, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), nil)
+	s.NoError(err)
+
+	// THEN the filled proceeds are withdrawn and the remainder is moved
+	s.True(resp.CoinsIn.IsZero())
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenB", sdkmath.NewInt(20_000_000))), resp.CoinsOut)
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 30)
+	s.assertAliceBalances(0, 20)
+	s.assertDexBalances(30, 0)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderGoodTilKeepsExpiration() {
+	s.fundAliceBalances(10, 0)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// GIVEN alice has a GoodTil limit order expiring tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 10, tomorrow)
+
+	// WHEN she moves it to tick -1
+	_, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), nil)
+	s.NoError(err)
+
+	// THEN the amended order still expires
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertNLimitOrderExpiration(1)
+
+	s.beginBlockWithTime(time.Now().AddDate(0, 0, 2))
+
+	s.assertLimitLiquidityAtTick("TokenA", -1, 0)
+	s.assertNLimitOrderExpiration(0)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderExpiredGoodTilFails() {
+	s.fundAliceBalances(10, 0)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// GIVEN alice has a GoodTil limit order expiring tomorrow
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 10, tomorrow)
+
+	// WHEN it expires but has not been purged yet
+	s.Ctx = s.Ctx.WithBlockTime(tomorrow)
+
+	// THEN it cannot be amended
+	_, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), nil)
+	s.ErrorIs(err, types.ErrExpirationTimeInPast)
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey)
+	s.True(found)
+	s.assertNLimitOrderExpiration(1)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderFails() {
+	s.fundAliceBalances(20, 0)
+
+	// JIT orders cannot be amended
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_JUST_IN_TIME)
+	_, err := s.aliceAmendsLimitOrder(trancheKey, tickToLimitSellPrice("TokenA", -1), nil)
+	s.ErrorIs(err, types.ErrAmendInvalidOrderType)
+
+	// Must change price or amount
+	trancheKey = s.aliceLimitSells("TokenA", 0, 10)
+	_, err = s.aliceAmendsLimitOrder(trancheKey, nil, nil)
+	s.ErrorIs(err, types.ErrEmptyAmendLimitOrder)
+
+	// Only one of price or tick can be set
+	tickIndexInToOut := int64(1)
+	_, err = s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:          s.alice.String(),
+		TrancheKey:       trancheKey,
+		LimitSellPrice:   tickToLimitSellPrice("TokenA", -1),
+		TickIndexInToOut: &tickIndexInToOut,
+	})
+	s.ErrorIs(err, types.ErrInvalidPriceAndTick)
+
+	// Amount must be positive
+	amount := 0
+	_, err = s.aliceAmendsLimitOrder(trancheKey, nil, &amount)
+	s.ErrorIs(err, types.ErrZeroLimitOrder)
+
+	// Only the owner can amend
+	_, err = s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:        s.bob.String(),
+		TrancheKey:     trancheKey,
+		LimitSellPrice: tickToLimitSellPrice("TokenA", -1),
+	})
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) batchPlaceLimitOrder(
+	tokenIn string,
+	tick, amountIn int,
+	orderType types.LimitOrderType,
+) types.BatchPlaceLimitOrder {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	return types.BatchPlaceLimitOrder{
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tradePairID.TickIndexTakerToMaker(int64(tick)),
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        orderType,
+	}
+}
+
+func (s *DexTestSuite) aliceBatchLimitOrders(
+	cancelTrancheKeys []string,
+	placeOrders ...types.BatchPlaceLimitOrder,
+) (*types.MsgBatchLimitOrdersResponse, error) {
+	return s.msgServer.BatchLimitOrders(s.Ctx, &types.MsgBatchLimitOrders{
+		Creator:           s.alice.String(),
+		CancelTrancheKeys: cancelTrancheKeys,
+		PlaceOrders:       placeOrders,
+	})
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersPlaceMultiple() {
+	s.fundAliceBalances(20, 0)
+
+	// WHEN alice places two limit orders in a single batch
+	resp, err := s.aliceBatchLimitOrders(nil,
+		s.batchPlaceLimitOrder("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+		s.batchPlaceLimitOrder("TokenA", -1, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+	)
+	s.NoError(err)
+
+	// THEN both orders are placed and funds are taken in a single transfer
+	s.Len(resp.TrancheKeys, 2)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(20_000_000))), resp.CoinsIn)
+	s.True(resp.CoinsOut.IsZero())
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(20, 0)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersCancelAndReplaceNetsFunds() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice has all her funds in a limit order at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.assertAliceBalances(0, 0)
+
+	// WHEN she cancels it and places the same amount at tick -1 in a single batch
+	resp, err := s.aliceBatchLimitOrders(
+		[]string{trancheKey},
+		s.batchPlaceLimitOrder("TokenA", -1, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+	)
+	s.NoError(err)
+
+	// THEN the order is moved and no funds change hands
+	s.Len(resp.TrancheKeys, 1)
+	s.True(resp.CoinsIn.IsZero())
+	s.True(resp.CoinsOut.IsZero())
+
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersSwapAndPlace() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN bob has a limit order for 10 tokenB at tick 0
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice swaps 5 tokenA and places a maker order with the remaining 5 tokenA
+	resp, err := s.aliceBatchLimitOrders(nil,
+		s.batchPlaceLimitOrder("TokenA", 0, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL),
+		s.batchPlaceLimitOrder("TokenA", -5, 5, types.LimitOrderType_GOOD_TIL_CANCELLED),
+	)
+	s.NoError(err)
+
+	// THEN funds in and out are netted per denom
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(10_000_000))), resp.CoinsIn)
+	s.Equal(sdk.NewCoins(sdk.NewCoin("TokenB", sdkmath.NewInt(5_000_000))), resp.CoinsOut)
+
+	s.assertAliceBalances(0, 5)
+	s.assertLimitLiquidityAtTick("TokenA", -5, 5)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 5)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersFailsOnInvalidPlacement() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice has a limit order at tick -1 and bob has a limit order at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", -1, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice cancels her order and places a POST_ONLY order that would cross
+	_, err := s.aliceBatchLimitOrders(
+		[]string{trancheKey},
+		s.batchPlaceLimitOrder("TokenA", 0, 10, types.LimitOrderType_POST_ONLY),
+	)
+
+	// THEN the whole batch fails and no funds are returned
+	s.ErrorIs(err, types.ErrPostOnlyWouldTake)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersInsufficientFundsFails() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice places orders for more than her balance
+	_, err := s.aliceBatchLimitOrders(nil,
+		s.batchPlaceLimitOrder("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+		s.batchPlaceLimitOrder("TokenA", -1, 10, types.LimitOrderType_GOOD_TIL_CANCELLED),
+	)
+
+	// THEN the batch fails
+	s.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *DexTestSuite) TestBatchLimitOrdersValidate() {
+	s.fundAliceBalances(10, 0)
+
+	_, err := s.aliceBatchLimitOrders(nil)
+	s.ErrorIs(err, types.ErrEmptyBatchLimitOrders)
+
+	_, err = s.aliceBatchLimitOrders([]string{"TRANCHEKEY1", "TRANCHEKEY1"})
+	s.ErrorIs(err, types.ErrDuplicateCancelTrancheKey)
+
+	_, err = s.aliceBatchLimitOrders(nil, s.batchPlaceLimitOrder("TokenA", 0, 0, types.LimitOrderType_GOOD_TIL_CANCELLED))
+	s.ErrorIs(err, types.ErrZeroLimitOrder)
+
+	tooMany := make([]string, types.MaxBatchLimitOrders+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("TRANCHEKEY%d", i)
+	}
+	_, err = s.aliceBatchLimitOrders(tooMany)
+	s.ErrorIs(err, types.ErrTooManyBatchLimitOrders)
+
+	_, err = s.aliceBatchLimitOrders([]string{"NOTATRANCHEKEY"})
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
@@ -775,6 +775,107 @@ func (s *DexTestSuite) TestPlaceLimitOrderJITTooManyFails() {
 	s.aliceLimitSells("TokenA", 0, 1, types.LimitOrderType_JUST_IN_TIME)
 }
 
+// Post Only Limit Orders //////////////////////////////////////////////////
+
+func (s *DexTestSuite) aliceLimitSellsPostOnlySlide(
+	selling string,
+	tick, amountIn int,
+) (*types.MsgPlaceLimitOrderResponse, error) {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, selling)
+	tickIndexTakerToMaker := tradePairID.TickIndexTakerToMaker(int64(tick))
+	return s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tickIndexTakerToMaker,
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_POST_ONLY,
+		PostOnlySlide:    true,
+	})
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyNoCross() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN bob has a limit order for 10 tokenB at tick 0
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice places a POST_ONLY order that does not cross
+	s.aliceLimitSells("TokenA", -1, 10, types.LimitOrderType_POST_ONLY)
+
+	// THEN it rests on the book and no swap occurs
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyCrossFails() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN bob has a limit order for 10 tokenB at tick 0
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// THEN alice's POST_ONLY order that would cross fails
+	s.assertAliceLimitSellFails(types.ErrPostOnlyWouldTake, "TokenA", 0, 10, types.LimitOrderType_POST_ONLY)
+	s.assertAliceLimitSellFails(types.ErrPostOnlyWouldTake, "TokenA", 5, 10, types.LimitOrderType_POST_ONLY)
+	s.assertAliceBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlySlides() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN bob has a limit order for 10 tokenB at tick 0
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// WHEN alice places a POST_ONLY order with slide that would cross
+	resp, err := s.aliceLimitSellsPostOnlySlide("TokenA", 5, 10)
+	s.NoError(err)
+
+	// THEN it is placed at the best non-crossing tick
+	s.assertLimitLiquidityAtTick("TokenA", -1, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+	s.assertAliceBalances(0, 0)
+
+	// AND the response and the event report the placed tick, not the requested one
+	s.Equal(int64(-1), resp.TickIndexInToOut)
+	placeEvents := s.findActionEvents(types.PlaceLimitOrderEventKey)
+	s.Require().Len(placeEvents, 2)
+	s.Equal(s.alice.String(), placeEvents[1][types.AttributeCreator])
+	s.Equal("-1", placeEvents[1][types.AttributeLimitTick])
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyGoodTilExpires() {
+	s.fundAliceBalances(10, 0)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// GIVEN Alice submits a POST_ONLY_GOOD_TIL_TIME order expiring tomorrow
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_POST_ONLY_GOOD_TIL_TIME,
+		ExpirationTime:   &tomorrow,
+	})
+	s.NoError(err)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertNLimitOrderExpiration(1)
+
+	// WHEN two days go by
+	s.beginBlockWithTime(time.Now().AddDate(0, 0, 2))
+
+	// THEN there is no liquidity available
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertNLimitOrderExpiration(0)
+}
+
 // GoodTilLimitOrders //////////////////////////////////////////////////
 
 func (s *DexTestSuite) TestPlaceLimitOrderGoodTilFills() {
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consumertypes "github.com/cosmos/interchain-security/v5/x/ccv/consumer/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setProtocolFeeParams(
+	share sdkmath.LegacyDec,
+	destination types.ProtocolFeeDestination,
+	contract string,
+) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.ProtocolFeeShare = share
+	params.ProtocolFeeDestination = destination
+	params.ProtocolFeeContract = contract
+	err := s.App.DexKeeper.SetParams(s.Ctx, params)
+	s.Require().NoError(err)
+}
+
+func (s *DexTestSuite) assertProtocolFeeAccrual(expected sdk.Coins) {
+	accrual, found := s.App.DexKeeper.GetProtocolFeeAccrual(s.Ctx, defaultPairID)
+	if expected.IsZero() {
+		s.Assert().False(found)
+		return
+	}
+	s.Require().True(found)
+	s.Assert().Equal(expected.String(), accrual.TotalFees.String())
+}
+
+// bobSwapsThroughPool swaps 10 TokenA for TokenB through a pool holding only TokenB with a fee of 100
+func (s *DexTestSuite) bobSwapsThroughPool() {
+	s.fundAliceBalances(0, 20)
+	s.fundBobBalances(10, 0)
+
+	s.aliceDeposits(NewDeposit(0, 20, 0, 100))
+	s.bobLimitSells("TokenA", 200, 10, types.LimitOrderType_FILL_OR_KILL)
+}
+
+func (s *DexTestSuite) TestSwapNoProtocolFee() {
+	// GIVEN a protocol fee share of 0
+
+	// WHEN bob swaps through a pool
+	s.bobSwapsThroughPool()
+
+	// THEN the pool receives all of bob's TokenA and no protocol fee is accrued
+	s.assertBobBalancesInt(sdkmath.ZeroInt(), sdkmath.NewInt(9_900_503))
+	s.assertLiquidityAtTickInt(sdkmath.NewInt(10_000_000), sdkmath.NewInt(10_099_497), 0, 100)
+	s.assertProtocolFeeAccrual(sdk.NewCoins())
+	s.Assert().True(s.App.DexKeeper.GetPendingProtocolFees(s.Ctx).IsZero())
+}
+
+func (s *DexTestSuite) TestSwapWithProtocolFee() {
+	// GIVEN a protocol fee share of 50%
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_TREASURY, "")
+
+	// WHEN bob swaps through a pool
+	s.bobSwapsThroughPool()
+
+	// THEN bob's swap is unaffected
+	s.assertBobBalancesInt(sdkmath.ZeroInt(), sdkmath.NewInt(9_900_503))
+
+	// AND half of the LP fee is removed from the pool and accrued as a protocol fee
+	// lpFee = 10_000_000 - 9_900_503 = 99_497; protocolFee = trunc(99_497 / 2) = 49_748
+	expectedFee := sdk.NewCoins(sdk.NewInt64Coin("TokenA", 49_748))
+	s.assertLiquidityAtTickInt(sdkmath.NewInt(9_950_252), sdkmath.NewInt(10_099_497), 0, 100)
+	s.assertProtocolFeeAccrual(expectedFee)
+	s.Assert().Equal(expectedFee, s.App.DexKeeper.GetPendingProtocolFees(s.Ctx))
+
+	// AND the dex still holds all of the funds until the fee is distributed
+	s.assertDexBalancesInt(sdkmath.NewInt(10_000_000), sdkmath.NewInt(10_099_497))
+}
+
+func (s *DexTestSuite) TestSwapWithProtocolFeeAccruesAcrossSwaps() {
+	// GIVEN a protocol fee share of 50%
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_TREASURY, "")
+
+	// WHEN bob and carol both swap through a pool
+	s.bobSwapsThroughPool()
+	s.fundCarolBalances(10, 0)
+	s.carolLimitSells("TokenA", 200, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN the accrual contains the fees from both swaps
+	accrual, found := s.App.DexKeeper.GetProtocolFeeAccrual(s.Ctx, defaultPairID)
+	s.Require().True(found)
+	s.Assert().True(accrual.TotalFees.AmountOf("TokenA").GT(sdkmath.NewInt(49_748)))
+	s.Assert().Equal(accrual.TotalFees, s.App.DexKeeper.GetPendingProtocolFees(s.Ctx))
+}
+
+func (s *DexTestSuite) TestSwapWithProtocolFeeDoesNotApplyToLimitOrders() {
+	// GIVEN a protocol fee share of 50%
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_TREASURY, "")
+	s.fundAliceBalances(0, 20)
+	s.fundBobBalances(10, 0)
+
+	// WHEN bob swaps through a limit order
+	s.aliceLimitSells("TokenB", -200, 20)
+	s.bobLimitSells("TokenA", 200, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN no protocol fee is accrued
+	s.assertProtocolFeeAccrual(sdk.NewCoins())
+}
+
+func (s *DexTestSuite) TestDistributeProtocolFeesToTreasury() {
+	// GIVEN a protocol fee has been accrued with the treasury as destination
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_TREASURY, "")
+	s.bobSwapsThroughPool()
+
+	// WHEN protocol fees are distributed
+	s.App.DexKeeper.DistributeProtocolFees(s.Ctx)
+
+	// THEN the fee is sent to the treasury
+	treasury := sdk.MustAccAddressFromBech32(s.App.DexKeeper.GetAuthority())
+	s.assertAccountBalanceWithDenomInt(treasury, "TokenA", sdkmath.NewInt(49_748))
+	s.assertDexBalancesInt(sdkmath.NewInt(9_950_252), sdkmath.NewInt(10_099_497))
+
+	// AND there are no more pending fees but the accrued total is kept
+	s.Assert().True(s.App.DexKeeper.GetPendingProtocolFees(s.Ctx).IsZero())
+	s.assertProtocolFeeAccrual(sdk.NewCoins(sdk.NewInt64Coin("TokenA", 49_748)))
+}
+
+func (s *DexTestSuite) TestDistributeProtocolFeesToFeeburner() {
+	// GIVEN a protocol fee has been accrued with the feeburner as destination
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_FEEBURNER, "")
+	s.bobSwapsThroughPool()
+
+	// WHEN protocol fees are distributed
+	s.App.DexKeeper.DistributeProtocolFees(s.Ctx)
+
+	// THEN the fee is sent to the account processed by x/feeburner
+	feeburnerAddr := authtypes.NewModuleAddress(consumertypes.ConsumerRedistributeName)
+	s.assertAccountBalanceWithDenomInt(feeburnerAddr, "TokenA", sdkmath.NewInt(49_748))
+	s.Assert().True(s.App.DexKeeper.GetPendingProtocolFees(s.Ctx).IsZero())
+}
+
+func (s *DexTestSuite) TestDistributeProtocolFeesToContract() {
+	// GIVEN a protocol fee has been accrued with a contract as destination
+	s.setProtocolFeeParams(sdkmath.LegacyNewDecWithPrec(5, 1), types.ProtocolFeeDestination_CONTRACT, s.carol.String())
+	s.bobSwapsThroughPool()
+
+	// WHEN protocol fees are distributed
+	s.App.DexKeeper.DistributeProtocolFees(s.Ctx)
+
+	// THEN the fee is sent to the contract
+	s.assertCarolBalancesInt(sdkmath.NewInt(49_748), sdkmath.ZeroInt())
+	s.Assert().True(s.App.DexKeeper.GetPendingProtocolFees(s.Ctx).IsZero())
+}
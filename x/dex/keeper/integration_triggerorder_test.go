@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) placeTriggerOrder(
+	account sdk.AccAddress,
+	triggerType types.TriggerType,
+	triggerTick, limitTick int64,
+	amountIn int,
+	orderType types.LimitOrderType,
+) (uint64, error) {
+	resp, err := s.msgServer.PlaceTriggerOrder(s.Ctx, &types.MsgPlaceTriggerOrder{
+		Creator:                 account.String(),
+		Receiver:                account.String(),
+		TokenIn:                 "TokenA",
+		TokenOut:                "TokenB",
+		TriggerType:             triggerType,
+		TriggerTickIndexInToOut: triggerTick,
+		TickIndexInToOut:        limitTick,
+		AmountIn:                sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:               orderType,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Id, nil
+}
+
+func (s *DexTestSuite) alicePlacesTriggerOrder(
+	triggerType types.TriggerType,
+	triggerTick, limitTick int64,
+	amountIn int,
+	orderType types.LimitOrderType,
+) uint64 {
+	id, err := s.placeTriggerOrder(s.alice, triggerType, triggerTick, limitTick, amountIn, orderType)
+	s.NoError(err)
+	return id
+}
+
+func (s *DexTestSuite) currTick0To1() int64 {
+	tick, found := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, defaultTradePairID0To1)
+	s.True(found)
+	return tick
+}
+
+func (s *DexTestSuite) assertTriggerOrderExists(id uint64, expected bool) {
+	_, found := s.App.DexKeeper.GetTriggerOrder(s.Ctx, id)
+	s.Equal(expected, found)
+}
+
+func (s *DexTestSuite) TestTriggerOrderStopLossExecutes() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN bob has liquidity at two ticks
+	trancheKey := s.bobLimitSells("TokenB", -10, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+	tickBefore := s.currTick0To1()
+
+	// WHEN alice places a stop loss just above the current price
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, tickBefore+1, tickBefore+1000, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN her TokenA is escrowed and the order is not triggered
+	s.assertAliceBalances(45, 0)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+	s.assertTriggerOrderExists(id, true)
+	s.assertAliceBalances(45, 0)
+
+	// WHEN the price moves past the trigger tick
+	s.bobCancelsLimitSell(trancheKey)
+	s.Greater(s.currTick0To1(), tickBefore)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN the order is executed as a taker order
+	s.assertTriggerOrderExists(id, false)
+	s.assertAliceBalances(45, 5)
+}
+
+func (s *DexTestSuite) TestTriggerOrderTakeProfitExecutes() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN bob has liquidity at a single tick
+	s.bobLimitSells("TokenB", 0, 10)
+	tickBefore := s.currTick0To1()
+
+	// WHEN alice places a take profit just below the current price
+	id := s.alicePlacesTriggerOrder(types.TriggerType_TAKE_PROFIT, tickBefore-1, tickBefore, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the order is not triggered
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+	s.assertTriggerOrderExists(id, true)
+
+	// WHEN better liquidity is added
+	s.bobLimitSells("TokenB", -10, 10)
+	s.Less(s.currTick0To1(), tickBefore)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN the order is executed at the better price
+	s.assertTriggerOrderExists(id, false)
+	s.assertAliceBalancesInt(sdkmath.NewInt(45_000_000), sdkmath.NewInt(5_005_002))
+}
+
+func (s *DexTestSuite) TestTriggerOrderMakerRemainder() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	s.bobLimitSells("TokenB", 0, 10)
+	tickBefore := s.currTick0To1()
+
+	// GIVEN a triggered GTC order that cannot be filled as a taker
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, tickBefore, tickBefore-1000, 5, types.LimitOrderType_GOOD_TIL_CANCELLED)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN it is placed as a maker order
+	s.assertTriggerOrderExists(id, false)
+	s.assertAliceBalances(45, 0)
+	s.assertDexBalances(5, 10)
+}
+
+func (s *DexTestSuite) TestTriggerOrderFailedExecutionRefunds() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	s.bobLimitSells("TokenB", 0, 10)
+	tickBefore := s.currTick0To1()
+
+	// GIVEN a triggered FoK order whose limit price cannot be met
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, tickBefore, tickBefore-1000, 5, types.LimitOrderType_FILL_OR_KILL)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN the order is removed and alice is refunded
+	s.assertTriggerOrderExists(id, false)
+	s.assertAliceBalances(50, 0)
+	s.assertDexBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestTriggerOrderHitsExecutionAllowance() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.TriggerOrderExecutionAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	s.bobLimitSells("TokenB", 0, 10)
+	tickBefore := s.currTick0To1()
+
+	// GIVEN a triggered order but no execution allowance
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, tickBefore, tickBefore+1000, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN the order is not executed
+	s.assertTriggerOrderExists(id, true)
+	s.assertAliceBalances(45, 0)
+}
+
+func (s *DexTestSuite) TestTriggerOrderCancel() {
+	s.fundAliceBalances(50, 0)
+
+	id := s.alicePlacesTriggerOrder(types.TriggerType_STOP_LOSS, 100, 200, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+	s.assertAliceBalances(40, 0)
+	s.assertDexBalances(10, 0)
+
+	// bob cannot cancel alice's order
+	_, err := s.msgServer.CancelTriggerOrder(s.Ctx, &types.MsgCancelTriggerOrder{Creator: s.bob.String(), Id: id})
+	s.ErrorIs(err, types.ErrTriggerOrderNotFound)
+
+	resp, err := s.msgServer.CancelTriggerOrder(s.Ctx, &types.MsgCancelTriggerOrder{Creator: s.alice.String(), Id: id})
+	s.NoError(err)
+	s.Equal(sdk.NewCoin("TokenA", sdkmath.NewInt(10).Mul(denomMultiple)), resp.CoinOut)
+
+	s.assertTriggerOrderExists(id, false)
+	s.assertAliceBalances(50, 0)
+	s.assertDexBalances(0, 0)
+
+	// Cannot cancel twice
+	_, err = s.msgServer.CancelTriggerOrder(s.Ctx, &types.MsgCancelTriggerOrder{Creator: s.alice.String(), Id: id})
+	s.ErrorIs(err, types.ErrTriggerOrderNotFound)
+}
+
+func (s *DexTestSuite) TestTriggerOrderPlaceJITFails() {
+	s.fundAliceBalances(50, 0)
+
+	_, err := s.placeTriggerOrder(s.alice, types.TriggerType_STOP_LOSS, 100, 200, 10, types.LimitOrderType_JUST_IN_TIME)
+	s.ErrorIs(err, types.ErrJITTriggerOrder)
+}
@@ -19,6 +19,7 @@ type (
 		tKey       storetypes.StoreKey
 		bankKeeper types.BankKeeper
 		authority  string
+		hooks      types.DexHooks
 	}
 )
 
@@ -47,3 +48,24 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
+
+// SetHooks sets the dex hooks.
+func (k *Keeper) SetHooks(dh types.DexHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set dex hooks twice")
+	}
+
+	k.hooks = dh
+
+	return k
+}
+
+// Hooks gets the hooks for dex. The dex records its own candles through them, before calling the hooks that
+// have been set.
+func (k Keeper) Hooks() types.DexHooks {
+	if k.hooks == nil {
+		return types.NewMultiDexHooks(candleHooks{k})
+	}
+
+	return types.NewMultiDexHooks(candleHooks{k}, k.hooks)
+}
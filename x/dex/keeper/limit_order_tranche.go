@@ -81,6 +81,8 @@ func (k Keeper) UpdateTranche(ctx sdk.Context, tranche *types.LimitOrderTranche)
 }
 
 func (k Keeper) SetLimitOrderTranche(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	k.TouchTwapRecord(ctx, tranche.Key.TradePairId.MustPairID())
+
 	// Wrap tranche back into TickLiquidity
 	tick := types.TickLiquidity{
 		Liquidity: &types.TickLiquidity_LimitOrderTranche{
@@ -131,6 +133,8 @@ func (k Keeper) GetLimitOrderTrancheByKey(
 }
 
 func (k Keeper) RemoveLimitOrderTranche(ctx sdk.Context, trancheKey *types.LimitOrderTrancheKey) {
+	k.TouchTwapRecord(ctx, trancheKey.TradePairId.MustPairID())
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickLiquidityKeyPrefix))
 	store.Delete(trancheKey.KeyMarshal())
 }
@@ -240,7 +244,7 @@ func (k Keeper) GetOrInitPlaceTranche(ctx sdk.Context,
 		}
 		placeTranche, err = NewLimitOrderTranche(limitOrderTrancheKey, &JITGoodTilTime)
 		ctx.EventManager().EmitEvents(types.GetEventsIncTotalOrders(tradePairID))
-	case types.LimitOrderType_GOOD_TIL_TIME:
+	case types.LimitOrderType_GOOD_TIL_TIME, types.LimitOrderType_POST_ONLY_GOOD_TIL_TIME:
 		limitOrderTrancheKey := &types.LimitOrderTrancheKey{
 			TradePairId:           tradePairID,
 			TickIndexTakerToMaker: tickIndexTakerToMaker,
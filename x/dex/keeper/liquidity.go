@@ -26,6 +26,7 @@ func (k Keeper) Swap(
 	remainingTakerDenom := maxAmountTakerDenom
 	totalMakerDenom := math.ZeroInt()
 	orderFilled = false
+	protocolFeeShare := k.GetProtocolFeeShare(ctx)
 
 	// verify that amount left is not zero and that there are additional valid ticks to check
 	liqIter := k.NewLiquidityIterator(ctx, tradePairID)
@@ -43,8 +44,19 @@ func (k Keeper) Swap(
 
 		inAmount, outAmount := liq.Swap(remainingTakerDenom, remainingMakerDenom)
 
+		// Capture the protocol's share of the LP fee. This only reduces the reserves credited to the pool,
+		// the amounts paid and received by the taker are unaffected.
+		if poolLiq, ok := liq.(*types.PoolLiquidity); ok && protocolFeeShare.IsPositive() {
+			protocolFee := poolLiq.Pool.TakeProtocolFee(tradePairID, inAmount, outAmount, protocolFeeShare)
+			k.AccrueProtocolFee(ctx, tradePairID, protocolFee)
+		}
+
 		k.SaveLiquidity(ctx, liq)
 
+		if tranche, ok := liq.(*types.LimitOrderTranche); ok && outAmount.IsPositive() {
+			k.Hooks().AfterTrancheFilled(ctx, tranche, inAmount, outAmount)
+		}
+
 		remainingTakerDenom = remainingTakerDenom.Sub(inAmount)
 		totalMakerDenom = totalMakerDenom.Add(outAmount)
 
@@ -104,6 +116,10 @@ func (k Keeper) SwapWithCache(
 
 	writeCache()
 
+	if err == nil && totalIn.IsPositive() {
+		k.Hooks().AfterSwap(ctx, tradePairID, totalIn, totalOut)
+	}
+
 	return totalIn, totalOut, orderFilled, err
 }
 
@@ -6,6 +6,10 @@ import (
 	v3 "github.com/neutron-org/neutron/v5/x/dex/migrations/v3"
 	v4 "github.com/neutron-org/neutron/v5/x/dex/migrations/v4"
 	v5 "github.com/neutron-org/neutron/v5/x/dex/migrations/v5"
+	v6 "github.com/neutron-org/neutron/v5/x/dex/migrations/v6"
+	v7 "github.com/neutron-org/neutron/v5/x/dex/migrations/v7"
+	v8 "github.com/neutron-org/neutron/v5/x/dex/migrations/v8"
+	v9 "github.com/neutron-org/neutron/v5/x/dex/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -32,3 +36,23 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
 }
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
+
+// Migrate8to9 migrates from version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
@@ -143,7 +143,7 @@ func (k MsgServer) PlaceLimitOrder(
 			return &types.MsgPlaceLimitOrderResponse{}, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
 		}
 	}
-	trancheKey, coinIn, swapInCoin, coinOutSwap, err := k.PlaceLimitOrderCore(
+	trancheKey, placedTickIndex, coinIn, swapInCoin, coinOutSwap, err := k.PlaceLimitOrderCore(
 		goCtx,
 		msg.TokenIn,
 		msg.TokenOut,
@@ -153,6 +153,7 @@ func (k MsgServer) PlaceLimitOrder(
 		msg.ExpirationTime,
 		msg.MaxAmountOut,
 		msg.MinAverageSellPrice,
+		msg.PostOnlySlide,
 		callerAddr,
 		receiverAddr,
 	)
@@ -161,10 +162,11 @@ func (k MsgServer) PlaceLimitOrder(
 	}
 
 	return &types.MsgPlaceLimitOrderResponse{
-		TrancheKey:   trancheKey,
-		CoinIn:       coinIn,
-		TakerCoinOut: coinOutSwap,
-		TakerCoinIn:  swapInCoin,
+		TrancheKey:       trancheKey,
+		CoinIn:           coinIn,
+		TakerCoinOut:     coinOutSwap,
+		TakerCoinIn:      swapInCoin,
+		TickIndexInToOut: placedTickIndex,
 	}, nil
 }
 
@@ -260,6 +262,121 @@ func (k MsgServer) MultiHopSwap(
 	}, nil
 }
 
+func (k MsgServer) PlaceTriggerOrder(
+	goCtx context.Context,
+	msg *types.MsgPlaceTriggerOrder,
+) (*types.MsgPlaceTriggerOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPlaceTriggerOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	err := msg.ValidateGoodTilExpiration(ctx.BlockTime())
+	if err != nil {
+		return &types.MsgPlaceTriggerOrderResponse{}, err
+	}
+
+	order, coinIn, err := k.PlaceTriggerOrderCore(goCtx, msg, callerAddr)
+	if err != nil {
+		return &types.MsgPlaceTriggerOrderResponse{}, err
+	}
+
+	return &types.MsgPlaceTriggerOrderResponse{
+		Id:     order.Id,
+		CoinIn: coinIn,
+	}, nil
+}
+
+func (k MsgServer) CancelTriggerOrder(
+	goCtx context.Context,
+	msg *types.MsgCancelTriggerOrder,
+) (*types.MsgCancelTriggerOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCancelTriggerOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	coinOut, err := k.CancelTriggerOrderCore(goCtx, msg.Id, callerAddr)
+	if err != nil {
+		return &types.MsgCancelTriggerOrderResponse{}, err
+	}
+
+	return &types.MsgCancelTriggerOrderResponse{
+		CoinOut: coinOut,
+	}, nil
+}
+
+func (k MsgServer) BatchLimitOrders(
+	goCtx context.Context,
+	msg *types.MsgBatchLimitOrders,
+) (*types.MsgBatchLimitOrdersResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchLimitOrders")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	trancheKeys, coinsIn, coinsOut, err := k.BatchLimitOrdersCore(goCtx, msg, callerAddr)
+	if err != nil {
+		return &types.MsgBatchLimitOrdersResponse{}, err
+	}
+
+	return &types.MsgBatchLimitOrdersResponse{
+		TrancheKeys: trancheKeys,
+		CoinsIn:     coinsIn,
+		CoinsOut:    coinsOut,
+	}, nil
+}
+
+func (k MsgServer) AmendLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgAmendLimitOrder,
+) (*types.MsgAmendLimitOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgAmendLimitOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	trancheKey, coinsIn, coinsOut, err := k.AmendLimitOrderCore(
+		goCtx,
+		msg.TrancheKey,
+		msg.LimitSellPrice,
+		msg.TickIndexInToOut,
+		msg.AmountIn,
+		callerAddr,
+	)
+	if err != nil {
+		return &types.MsgAmendLimitOrderResponse{}, err
+	}
+
+	return &types.MsgAmendLimitOrderResponse{
+		TrancheKey: trancheKey,
+		CoinsIn:    coinsIn,
+		CoinsOut:   coinsOut,
+	}, nil
+}
+
 func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
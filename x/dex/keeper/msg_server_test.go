@@ -1506,6 +1506,18 @@ func (s *DexTestSuite) assertNLimitOrderExpiration(expected int) {
 	s.Assert().Equal(expected, len(exps))
 }
 
+// findActionEvents returns the attributes of the dex message events emitted for the action
+func (s *DexTestSuite) findActionEvents(action string) []map[string]string {
+	var events []map[string]string
+	for _, event := range s.Ctx.EventManager().Events() {
+		attrs := s.ExtractAttributes(event)
+		if attrs[sdk.AttributeKeyAction] == action {
+			events = append(events, attrs)
+		}
+	}
+	return events
+}
+
 func (s *DexTestSuite) nextBlockWithTime(blockTime time.Time) {
 	newCtx := s.Ctx.WithBlockTime(blockTime)
 	s.Ctx = newCtx
@@ -2153,6 +2165,33 @@ func TestMsgPlaceLimitOrderValidate(t *testing.T) {
 			},
 			types.ErrZeroMinAverageSellPrice,
 		},
+		{
+			"post only slide on wrong order type",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+				PostOnlySlide:    true,
+			},
+			types.ErrPostOnlySlideOnWrongOrderType,
+		},
+		{
+			"post only good til without expiration",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_POST_ONLY_GOOD_TIL_TIME,
+			},
+			types.ErrGoodTilOrderWithoutExpiration,
+		},
 	}
 
 	for _, tt := range tests {
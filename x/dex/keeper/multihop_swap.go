@@ -292,5 +292,7 @@ func (k Keeper) SwapFullAmountIn(
 		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("dust coins are negative")
 	}
 
+	k.Hooks().AfterSwap(ctx, tradePairID, swapAmountTakerDenom, swapAmountMakerDenom)
+
 	return dust, swapAmountMakerDenom, err
 }
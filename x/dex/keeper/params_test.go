@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
@@ -24,6 +26,8 @@ func TestSetParams(t *testing.T) {
 		FeeTiers:              []uint64{0, 1},
 		MaxJitsPerBlock:       0,
 		GoodTilPurgeAllowance: 0,
+		ProtocolFeeShare:      math.LegacyZeroDec(),
+		CandleRetentionPeriod: types.DefaultCandleRetentionPeriod,
 	}
 	err := k.SetParams(ctx, newParams)
 	require.NoError(t, err)
@@ -32,11 +36,31 @@ func TestSetParams(t *testing.T) {
 }
 
 func TestValidateParams(t *testing.T) {
+	retention := types.DefaultCandleRetentionPeriod
+
 	goodFees := []uint64{1, 2, 3, 4, 5, 200}
-	require.NoError(t, types.Params{FeeTiers: goodFees}.Validate())
+	require.NoError(t, types.Params{FeeTiers: goodFees, CandleRetentionPeriod: retention}.Validate())
 
 	badFees := []uint64{1, 2, 3, 3}
-	require.Error(t, types.Params{FeeTiers: badFees}.Validate())
+	require.Error(t, types.Params{FeeTiers: badFees, CandleRetentionPeriod: retention}.Validate())
+
+	require.NoError(t, types.Params{
+		ProtocolFeeShare:      math.LegacyNewDecWithPrec(5, 1),
+		CandleRetentionPeriod: retention,
+	}.Validate())
+	require.Error(t, types.Params{ProtocolFeeShare: math.LegacyOneDec()}.Validate())
+	require.Error(t, types.Params{ProtocolFeeShare: math.LegacyNewDec(-1)}.Validate())
+	require.Error(t, types.Params{ProtocolFeeDestination: types.ProtocolFeeDestination_CONTRACT}.Validate())
+	require.Error(t, types.Params{
+		ProtocolFeeDestination: types.ProtocolFeeDestination_CONTRACT,
+		ProtocolFeeContract:    "notAnAddress",
+	}.Validate())
+
+	require.NoError(t, types.Params{TwapRecordKeepPeriod: time.Hour, CandleRetentionPeriod: retention}.Validate())
+	require.Error(t, types.Params{TwapRecordKeepPeriod: -time.Hour, CandleRetentionPeriod: retention}.Validate())
+
+	require.NoError(t, types.Params{CandleRetentionPeriod: 24 * time.Hour}.Validate())
+	require.Error(t, types.Params{CandleRetentionPeriod: 23 * time.Hour}.Validate())
 }
 
 func (s *DexTestSuite) TestPauseDex() {
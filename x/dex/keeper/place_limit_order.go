@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -12,6 +13,7 @@ import (
 )
 
 // PlaceLimitOrderCore handles the logic for MsgPlaceLimitOrder including bank operations and event emissions.
+// It returns the tick the order was placed at, see ExecutePlaceLimitOrder.
 func (k Keeper) PlaceLimitOrderCore(
 	goCtx context.Context,
 	tokenIn string,
@@ -22,16 +24,17 @@ func (k Keeper) PlaceLimitOrderCore(
 	goodTil *time.Time,
 	maxAmountOut *math.Int,
 	minAvgSellPriceP *math_utils.PrecDec,
+	postOnlySlide bool,
 	callerAddr sdk.AccAddress,
 	receiverAddr sdk.AccAddress,
-) (trancheKey string, totalInCoin, swapInCoin, swapOutCoin sdk.Coin, err error) {
+) (trancheKey string, placedTickIndexInToOut int64, totalInCoin, swapInCoin, swapOutCoin sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	takerTradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
 	if err != nil {
-		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		return trancheKey, placedTickIndexInToOut, totalInCoin, swapInCoin, swapOutCoin, err
 	}
-	trancheKey, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
+	trancheKey, placedTickIndexInToOut, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
 		ctx,
 		takerTradePairID,
 		amountIn,
@@ -40,10 +43,11 @@ func (k Keeper) PlaceLimitOrderCore(
 		goodTil,
 		maxAmountOut,
 		minAvgSellPriceP,
+		postOnlySlide,
 		receiverAddr,
 	)
 	if err != nil {
-		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		return trancheKey, placedTickIndexInToOut, totalInCoin, swapInCoin, swapOutCoin, err
 	}
 
 	if swapOutCoin.IsPositive() {
@@ -54,7 +58,7 @@ func (k Keeper) PlaceLimitOrderCore(
 			sdk.Coins{swapOutCoin},
 		)
 		if err != nil {
-			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+			return trancheKey, placedTickIndexInToOut, totalInCoin, swapInCoin, swapOutCoin, err
 		}
 	}
 
@@ -68,7 +72,7 @@ func (k Keeper) PlaceLimitOrderCore(
 			sdk.Coins{totalInCoin},
 		)
 		if err != nil {
-			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+			return trancheKey, placedTickIndexInToOut, totalInCoin, swapInCoin, swapOutCoin, err
 		}
 	}
 
@@ -82,7 +86,7 @@ func (k Keeper) PlaceLimitOrderCore(
 		tokenIn,
 		tokenOut,
 		totalIn,
-		tickIndexInToOut,
+		placedTickIndexInToOut,
 		orderType.String(),
 		minAvgSellPrice,
 		sharesIssued,
@@ -90,12 +94,25 @@ func (k Keeper) PlaceLimitOrderCore(
 		swapInCoin.Amount,
 		swapOutCoin.Amount,
 	))
+	k.Hooks().AfterLimitOrderPlaced(
+		ctx,
+		callerAddr,
+		receiverAddr,
+		takerTradePairID,
+		placedTickIndexInToOut,
+		orderType,
+		trancheKey,
+		totalIn,
+		swapInCoin,
+		swapOutCoin,
+	)
 
-	return trancheKey, totalInCoin, swapInCoin, swapOutCoin, nil
+	return trancheKey, placedTickIndexInToOut, totalInCoin, swapInCoin, swapOutCoin, nil
 }
 
 // ExecutePlaceLimitOrder handles the core logic for PlaceLimitOrder -- performing taker a swap
-// and (when applicable) adding a maker limit order to the orderbook.
+// and (when applicable) adding a maker limit order to the orderbook. It returns the tick the
+// order was placed at, which differs from tickIndexInToOut if a POST_ONLY order slid.
 // IT DOES NOT PERFORM ANY BANKING OPERATIONS
 func (k Keeper) ExecutePlaceLimitOrder(
 	ctx sdk.Context,
@@ -106,9 +123,11 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	goodTil *time.Time,
 	maxAmountOut *math.Int,
 	minAvgSellPriceP *math_utils.PrecDec,
+	postOnlySlide bool,
 	receiverAddr sdk.AccAddress,
 ) (
 	trancheKey string,
+	placedTickIndexInToOut int64,
 	totalIn math.Int,
 	swapInCoin, swapOutCoin sdk.Coin,
 	sharesIssued math.Int,
@@ -117,9 +136,16 @@ func (k Keeper) ExecutePlaceLimitOrder(
 ) {
 	amountLeft := amountIn
 
+	if orderType.IsPostOnly() {
+		tickIndexInToOut, err = k.GetPostOnlyTickIndex(ctx, takerTradePairID, tickIndexInToOut, postOnlySlide)
+		if err != nil {
+			return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+		}
+	}
+
 	limitBuyPrice, err := types.CalcPrice(tickIndexInToOut)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
 	}
 
 	// Use limitPrice for minAvgSellPrice if it has not been specified
@@ -132,17 +158,22 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	// Ensure that after rounding user will get at least 1 token out.
 	err = types.ValidateFairOutput(amountIn, limitBuyPrice)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
 
 	var orderFilled bool
-	if orderType.IsTakerOnly() {
+	switch {
+	case orderType.IsPostOnly():
+		// POST_ONLY orders never swap; GetPostOnlyTickIndex has already ensured the order does not cross
+		swapInCoin = sdk.NewCoin(takerTradePairID.TakerDenom, math.ZeroInt())
+		swapOutCoin = sdk.NewCoin(takerTradePairID.MakerDenom, math.ZeroInt())
+	case orderType.IsTakerOnly():
 		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitBuyPrice, minAvgSellPrice, orderType)
-	} else {
+	default:
 		swapInCoin, swapOutCoin, orderFilled, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitBuyPrice, minAvgSellPrice)
 	}
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
 
 	totalIn = swapInCoin.Amount
@@ -159,7 +190,7 @@ func (k Keeper) ExecutePlaceLimitOrder(
 		orderType,
 	)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
 
 	trancheKey = placeTranche.Key.TrancheKey
@@ -182,7 +213,7 @@ func (k Keeper) ExecutePlaceLimitOrder(
 		// order with the remaining liquidity.
 		err = types.ValidateFairOutput(amountLeft, limitBuyPrice)
 		if err != nil {
-			return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+			return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
 		}
 		placeTranche.PlaceMakerLimitOrder(amountLeft)
 		trancheUser.SharesOwned = trancheUser.SharesOwned.Add(amountLeft)
@@ -208,10 +239,39 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	if orderType.IsJIT() {
 		err = k.AssertCanPlaceJIT(ctx)
 		if err != nil {
-			return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+			return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
 		}
 		k.IncrementJITsInBlock(ctx)
 	}
 
-	return trancheKey, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, nil
+	return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, nil
+}
+
+// GetPostOnlyTickIndex ensures that a POST_ONLY order will not take any liquidity. If the order would cross the
+// current best price it either fails or, when postOnlySlide is set, is moved to the best non-crossing tick.
+func (k Keeper) GetPostOnlyTickIndex(
+	ctx sdk.Context,
+	takerTradePairID *types.TradePairID,
+	tickIndexInToOut int64,
+	postOnlySlide bool,
+) (int64, error) {
+	currTick, found := k.GetCurrTickIndexTakerToMaker(ctx, takerTradePairID)
+	if !found || currTick > tickIndexInToOut {
+		return tickIndexInToOut, nil
+	}
+
+	if !postOnlySlide {
+		return tickIndexInToOut, sdkerrors.Wrapf(types.ErrPostOnlyWouldTake,
+			"Limit tick: %d; Current tick: %d",
+			tickIndexInToOut,
+			currTick,
+		)
+	}
+
+	slideTick := currTick - 1
+	if types.IsTickOutOfRange(slideTick) {
+		return tickIndexInToOut, types.ErrTickOutsideRange
+	}
+
+	return slideTick, nil
 }
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// PlaceTriggerOrderCore handles the logic for MsgPlaceTriggerOrder including bank operations and event emissions.
+func (k Keeper) PlaceTriggerOrderCore(
+	goCtx context.Context,
+	msg *types.MsgPlaceTriggerOrder,
+	callerAddr sdk.AccAddress,
+) (order types.TriggerOrder, coinIn sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	order, err = k.ExecutePlaceTriggerOrder(ctx, msg)
+	if err != nil {
+		return order, coinIn, err
+	}
+
+	// TokenIn is escrowed by the dex module until the order is triggered or canceled
+	coinIn = sdk.NewCoin(msg.TokenIn, msg.AmountIn)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		callerAddr,
+		types.ModuleName,
+		sdk.Coins{coinIn},
+	)
+	if err != nil {
+		return order, coinIn, err
+	}
+
+	ctx.EventManager().EmitEvent(types.PlaceTriggerOrderEvent(order))
+
+	return order, coinIn, nil
+}
+
+// ExecutePlaceTriggerOrder handles the core logic for PlaceTriggerOrder -- assigning an ID to the order
+// and adding it to the trigger index.
+// IT DOES NOT PERFORM ANY BANKING OPERATIONS
+func (k Keeper) ExecutePlaceTriggerOrder(
+	ctx sdk.Context,
+	msg *types.MsgPlaceTriggerOrder,
+) (order types.TriggerOrder, err error) {
+	takerTradePairID, err := types.NewTradePairID(msg.TokenIn, msg.TokenOut)
+	if err != nil {
+		return order, err
+	}
+
+	// Fail early if the triggered limit order could never produce any output
+	limitBuyPrice, err := types.CalcPrice(msg.TickIndexInToOut)
+	if err != nil {
+		return order, err
+	}
+	err = types.ValidateFairOutput(msg.AmountIn, limitBuyPrice)
+	if err != nil {
+		return order, err
+	}
+
+	id := k.GetTriggerOrderCount(ctx)
+	k.SetTriggerOrderCount(ctx, id+1)
+
+	order = types.NewTriggerOrderFromMsg(id, takerTradePairID, msg)
+	k.SetTriggerOrder(ctx, order)
+	ctx.GasMeter().ConsumeGas(types.TriggerOrderGas, "Trigger Order Fee")
+
+	return order, nil
+}
@@ -8,6 +8,8 @@ import (
 )
 
 func (k Keeper) SetPoolReserves(ctx sdk.Context, poolReserves *types.PoolReserves) {
+	k.TouchTwapRecord(ctx, poolReserves.Key.TradePairId.MustPairID())
+
 	tick := types.TickLiquidity{
 		Liquidity: &types.TickLiquidity_PoolReserves{
 			PoolReserves: poolReserves,
@@ -37,6 +39,8 @@ func (k Keeper) GetPoolReserves(
 
 // RemovePoolReserves removes a tickLiquidity from the store
 func (k Keeper) RemovePoolReserves(ctx sdk.Context, poolReservesID *types.PoolReservesKey) {
+	k.TouchTwapRecord(ctx, poolReservesID.TradePairId.MustPairID())
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TickLiquidityKeyPrefix))
 	store.Delete(poolReservesID.KeyMarshal())
 }
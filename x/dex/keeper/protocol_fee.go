@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	consumertypes "github.com/cosmos/interchain-security/v5/x/ccv/consumer/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetProtocolFeeAccrual set a specific protocolFeeAccrual in the store from its index
+func (k Keeper) SetProtocolFeeAccrual(ctx sdk.Context, accrual types.ProtocolFeeAccrual) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeeAccrualKeyPrefix))
+	b := k.cdc.MustMarshal(&accrual)
+	store.Set(types.ProtocolFeeAccrualKey(accrual.PairId), b)
+}
+
+// GetProtocolFeeAccrual returns a protocolFeeAccrual from its index
+func (k Keeper) GetProtocolFeeAccrual(ctx sdk.Context, pairID *types.PairID) (val types.ProtocolFeeAccrual, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeeAccrualKeyPrefix))
+
+	b := store.Get(types.ProtocolFeeAccrualKey(pairID))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllProtocolFeeAccrual returns all protocolFeeAccruals
+func (k Keeper) GetAllProtocolFeeAccrual(ctx sdk.Context) (list []types.ProtocolFeeAccrual) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeeAccrualKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ProtocolFeeAccrual
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AccrueProtocolFee records a protocol fee captured from a pool swap on tradePairID. The fee is denominated in
+// the taker denom and is held by the dex module until it is distributed at the end of the block.
+func (k Keeper) AccrueProtocolFee(ctx sdk.Context, tradePairID *types.TradePairID, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	fee := sdk.NewCoin(tradePairID.TakerDenom, amount)
+
+	pairID := tradePairID.MustPairID()
+	accrual, found := k.GetProtocolFeeAccrual(ctx, pairID)
+	if !found {
+		accrual = types.ProtocolFeeAccrual{PairId: pairID, TotalFees: sdk.NewCoins()}
+	}
+	accrual.TotalFees = accrual.TotalFees.Add(fee)
+	k.SetProtocolFeeAccrual(ctx, accrual)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeePendingKeyPrefix))
+	pending := sdk.NewCoin(fee.Denom, math.ZeroInt())
+	if b := store.Get(types.KeyPrefix(fee.Denom)); b != nil {
+		k.cdc.MustUnmarshal(b, &pending)
+	}
+	pending = pending.Add(fee)
+	store.Set(types.KeyPrefix(fee.Denom), k.cdc.MustMarshal(&pending))
+}
+
+// GetPendingProtocolFees returns the protocol fees that have been collected but not yet distributed
+func (k Keeper) GetPendingProtocolFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeePendingKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	pending := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &coin)
+		pending = pending.Add(coin)
+	}
+
+	return pending
+}
+
+func (k Keeper) clearPendingProtocolFees(ctx sdk.Context, fees sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeePendingKeyPrefix))
+	for _, fee := range fees {
+		store.Delete(types.KeyPrefix(fee.Denom))
+	}
+}
+
+// DistributeProtocolFees sends all pending protocol fees to the destination configured in the dex params.
+// If the transfer fails the fees remain pending and distribution is retried in the next block.
+func (k Keeper) DistributeProtocolFees(ctx sdk.Context) {
+	pending := k.GetPendingProtocolFees(ctx)
+	if pending.IsZero() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	var recipient sdk.AccAddress
+	var err error
+	switch params.ProtocolFeeDestination {
+	case types.ProtocolFeeDestination_FEEBURNER:
+		// x/feeburner burns the NTRN and forwards all other denoms to the treasury from the ConsumerRedistribute account
+		recipient = authtypes.NewModuleAddress(consumertypes.ConsumerRedistributeName)
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, consumertypes.ConsumerRedistributeName, pending)
+	case types.ProtocolFeeDestination_TREASURY:
+		recipient = sdk.MustAccAddressFromBech32(k.authority)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, pending)
+	case types.ProtocolFeeDestination_CONTRACT:
+		recipient, err = sdk.AccAddressFromBech32(params.ProtocolFeeContract)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, pending)
+		}
+	default:
+		err = fmt.Errorf("invalid protocol fee destination: %s", params.ProtocolFeeDestination)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to distribute protocol fees", "fees", pending.String(), "error", err)
+		return
+	}
+
+	k.clearPendingProtocolFees(ctx, pending)
+	ctx.EventManager().EmitEvent(types.ProtocolFeeDistributedEvent(recipient, pending))
+}
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetTriggerOrder sets a TriggerOrder in the store and adds it to the trigger index
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, order types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&order)
+	store.Set(sdk.Uint64ToBigEndian(order.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderIndexKeyPrefix))
+	indexStore.Set(types.TriggerOrderIndexKey(order), sdk.Uint64ToBigEndian(order.Id))
+}
+
+// GetTriggerOrder returns a TriggerOrder from its ID
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, id uint64) (val types.TriggerOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+
+	return val, true
+}
+
+// RemoveTriggerOrder removes a TriggerOrder and its trigger index entry from the store
+func (k Keeper) RemoveTriggerOrder(ctx sdk.Context, order types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(order.Id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderIndexKeyPrefix))
+	indexStore.Delete(types.TriggerOrderIndexKey(order))
+}
+
+// GetAllTriggerOrder returns all TriggerOrders
+func (k Keeper) GetAllTriggerOrder(ctx sdk.Context) (list []types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TriggerOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTriggerOrderCount get the total number of TriggerOrders ever placed
+func (k Keeper) GetTriggerOrderCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TriggerOrderCountKeyPrefix)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTriggerOrderCount set the total number of TriggerOrders ever placed
+func (k Keeper) SetTriggerOrderCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.TriggerOrderCountKeyPrefix)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// nextTriggerOrder returns the first TriggerOrder in the trigger index at or after startKey
+func (k Keeper) nextTriggerOrder(ctx sdk.Context, startKey []byte) (order types.TriggerOrder, indexKey []byte, found bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderIndexKeyPrefix))
+	iterator := indexStore.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return order, nil, false
+	}
+
+	indexKey = iterator.Key()
+	order, found = k.GetTriggerOrder(ctx, sdk.BigEndianToUint64(iterator.Value()))
+	if !found {
+		// This should never happen since the index is always updated alongside the TriggerOrder
+		panic("TriggerOrder index references a missing TriggerOrder")
+	}
+
+	return order, indexKey, true
+}
+
+// ExecuteTriggeredOrders checks the trigger index against the current price of each TradePairID
+// and executes every TriggerOrder whose trigger tick has been reached.
+// Within each TradePairID / TriggerType segment orders are sorted so that the orders closest to
+// triggering come first, which lets us move on to the next segment as soon as an order is not triggered.
+func (k Keeper) ExecuteTriggeredOrders(ctx sdk.Context) {
+	if k.GetParams(ctx).Paused {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + k.GetTriggerOrderExecutionAllowance(ctx)
+	cursor := []byte{}
+	for {
+		order, indexKey, found := k.nextTriggerOrder(ctx, cursor)
+		if !found {
+			return
+		}
+
+		currTick, found := k.GetCurrTickIndexTakerToMaker(ctx, order.TradePairId)
+		if !found || !order.IsTriggered(currTick) {
+			// No other order in this segment can be triggered; skip to the next segment
+			cursor = storetypes.PrefixEndBytes(types.TriggerOrderSegmentPrefix(order.TradePairId, order.TriggerType))
+			continue
+		}
+
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		if gasConsumed >= gasCutoff {
+			// If we hit our gas cutoff stop executing so as not to timeout the block.
+			// Remaining triggered orders will be executed in the following blocks.
+			ctx.EventManager().EmitEvent(types.TriggerOrderHitLimitEvent(gasConsumed))
+
+			return
+		}
+
+		k.ExecuteTriggerOrder(ctx, order)
+
+		// The executed order has been removed from the index so the next order is at or after its key.
+		// The price may have moved as a result of the execution so the segment must be rechecked.
+		cursor = indexKey
+	}
+}
+
+// ExecuteTriggerOrder removes a TriggerOrder from the store and places its limit order.
+// If the limit order cannot be placed the escrowed TokenIn is returned to the creator.
+func (k Keeper) ExecuteTriggerOrder(ctx sdk.Context, order types.TriggerOrder) {
+	k.RemoveTriggerOrder(ctx, order)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.placeTriggeredLimitOrder(cacheCtx, order)
+	if err == nil {
+		writeCache()
+		return
+	}
+
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	refund := sdk.NewCoin(order.TradePairId.TakerDenom, order.AmountIn)
+	// The escrowed TokenIn is always held by the dex module so this should never fail
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{refund}); err != nil {
+		panic(err)
+	}
+
+	k.Logger(ctx).Info("failed to execute trigger order", "id", order.Id, "error", err)
+	ctx.EventManager().EmitEvent(types.TriggerOrderFailedEvent(order, err))
+}
+
+func (k Keeper) placeTriggeredLimitOrder(ctx sdk.Context, order types.TriggerOrder) error {
+	msg := order.ToMsgPlaceLimitOrder()
+	if err := msg.ValidateGoodTilExpiration(ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(order.Receiver)
+	trancheKey, placedTickIndex, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
+		ctx,
+		order.TradePairId,
+		order.AmountIn,
+		order.TickIndexInToOut,
+		order.OrderType,
+		order.ExpirationTime,
+		order.MaxAmountOut,
+		order.MinAverageSellPrice,
+		false,
+		receiverAddr,
+	)
+	if err != nil {
+		return err
+	}
+
+	if swapOutCoin.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddr, sdk.Coins{swapOutCoin})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Return any portion of the escrow that was not used by the limit order
+	unusedIn := order.AmountIn.Sub(totalIn)
+	if unusedIn.IsPositive() {
+		refund := sdk.NewCoin(order.TradePairId.TakerDenom, unusedIn)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{refund})
+		if err != nil {
+			return err
+		}
+	}
+
+	pairID := order.TradePairId.MustPairID()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		types.CreatePlaceLimitOrderEvent(
+			creatorAddr,
+			receiverAddr,
+			pairID.Token0,
+			pairID.Token1,
+			order.TradePairId.TakerDenom,
+			order.TradePairId.MakerDenom,
+			totalIn,
+			placedTickIndex,
+			order.OrderType.String(),
+			minAvgSellPrice,
+			sharesIssued,
+			trancheKey,
+			swapInCoin.Amount,
+			swapOutCoin.Amount,
+		),
+		types.ExecuteTriggerOrderEvent(order, trancheKey, swapInCoin.Amount, swapOutCoin.Amount),
+	})
+	k.Hooks().AfterLimitOrderPlaced(
+		ctx,
+		creatorAddr,
+		receiverAddr,
+		order.TradePairId,
+		placedTickIndex,
+		order.OrderType,
+		trancheKey,
+		totalIn,
+		swapInCoin,
+		swapOutCoin,
+	)
+
+	return nil
+}
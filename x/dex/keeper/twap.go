@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetTwapRecord set a specific twapRecord in the store from its index
+func (k Keeper) SetTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TwapRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.TwapRecordKey(record.PairId, record.Time), b)
+}
+
+// GetAllTwapRecord returns all twapRecords
+func (k Keeper) GetAllTwapRecord(ctx sdk.Context) (list []types.TwapRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TwapRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) twapRecordPairStore(ctx sdk.Context, pairID *types.PairID) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TwapRecordKeyPrefix))
+	return prefix.NewStore(store, types.TwapRecordPairPrefix(pairID))
+}
+
+// twapRecordTimeEnd returns the exclusive iterator end for TwapRecords at or before recordTime
+func twapRecordTimeEnd(recordTime time.Time) []byte {
+	return append(sdk.FormatTimeBytes(recordTime), 0)
+}
+
+// GetLatestTwapRecord returns the most recent twapRecord of a pair
+func (k Keeper) GetLatestTwapRecord(ctx sdk.Context, pairID *types.PairID) (val types.TwapRecord, found bool) {
+	iterator := k.twapRecordPairStore(ctx, pairID).ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// GetTwapRecordAtOrBeforeTime returns the most recent twapRecord of a pair at or before recordTime
+func (k Keeper) GetTwapRecordAtOrBeforeTime(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	recordTime time.Time,
+) (val types.TwapRecord, found bool) {
+	iterator := k.twapRecordPairStore(ctx, pairID).ReverseIterator(nil, twapRecordTimeEnd(recordTime))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &val)
+	return val, true
+}
+
+// PruneTwapRecords removes all twapRecords of a pair before cutoffTime except for the newest record at or before
+// cutoffTime, which is required to compute TWAPs starting at cutoffTime.
+func (k Keeper) PruneTwapRecords(ctx sdk.Context, pairID *types.PairID, cutoffTime time.Time) {
+	store := k.twapRecordPairStore(ctx, pairID)
+	iterator := store.ReverseIterator(nil, twapRecordTimeEnd(cutoffTime))
+
+	var keysToDelete [][]byte
+	if iterator.Valid() {
+		// Keep the newest record at or before the cutoff
+		iterator.Next()
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDelete = append(keysToDelete, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// TouchTwapRecord must be called before the liquidity of a pair is changed. On the first change of a block the
+// accumulators of the latest twapRecord are updated up to the current block time using the spot price from before the
+// change and the pair is marked for a spot price update at the end of the block.
+// It is called by the store setters of the liquidity rather than through the dex hooks, since liquidity also changes
+// without trading activity, e.g. when limit orders expire.
+func (k Keeper) TouchTwapRecord(ctx sdk.Context, pairID *types.PairID) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.TwapChangedPairKeyPrefix))
+	key := []byte(pairID.CanonicalString())
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(pairID))
+
+	latestRecord, found := k.GetLatestTwapRecord(ctx, pairID)
+	if found && latestRecord.Time.Before(ctx.BlockTime()) {
+		k.SetTwapRecord(ctx, latestRecord.WithUpdatedAccumulators(ctx.BlockHeight(), ctx.BlockTime()))
+	}
+}
+
+// getTwapChangedPairs returns all pairs touched in the current block
+func (k Keeper) getTwapChangedPairs(ctx sdk.Context) (list []*types.PairID) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.TwapChangedPairKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pairID types.PairID
+		k.cdc.MustUnmarshal(iterator.Value(), &pairID)
+		list = append(list, &pairID)
+	}
+
+	return
+}
+
+// GetTwapSpotTickIndex returns the mid tick index between the best token0 and token1 liquidity of a pair.
+// If only one side of the pair has liquidity the best tick of that side is used.
+func (k Keeper) GetTwapSpotTickIndex(ctx sdk.Context, pairID *types.PairID) (math_utils.PrecDec, bool) {
+	tick0, found0 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromMaker(pairID, pairID.Token0))
+	tick1, found1 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromMaker(pairID, pairID.Token1))
+
+	switch {
+	case found0 && found1:
+		return math_utils.NewPrecDec(tick0 + tick1).QuoInt64(2), true
+	case found0:
+		return math_utils.NewPrecDec(tick0), true
+	case found1:
+		return math_utils.NewPrecDec(tick1), true
+	default:
+		return math_utils.ZeroPrecDec(), false
+	}
+}
+
+// UpdateTwapRecords records the spot price of all pairs touched in the current block and prunes their records
+// older than the TwapRecordKeepPeriod. Pairs that do not have any liquidity keep their last spot price.
+func (k Keeper) UpdateTwapRecords(ctx sdk.Context) {
+	cutoffTime := ctx.BlockTime().Add(-k.GetParams(ctx).TwapRecordKeepPeriod)
+
+	for _, pairID := range k.getTwapChangedPairs(ctx) {
+		tickIndex, hasLiquidity := k.GetTwapSpotTickIndex(ctx, pairID)
+
+		record, found := k.GetLatestTwapRecord(ctx, pairID)
+		switch {
+		case found:
+			record = record.WithUpdatedAccumulators(ctx.BlockHeight(), ctx.BlockTime())
+			if hasLiquidity {
+				if err := record.SetSpot(tickIndex); err != nil {
+					ctx.Logger().Error("failed to set twap spot price", "pair", pairID.CanonicalString(), "err", err)
+					continue
+				}
+			}
+		case hasLiquidity:
+			var err error
+			record, err = types.NewTwapRecord(pairID, ctx.BlockHeight(), ctx.BlockTime(), tickIndex)
+			if err != nil {
+				ctx.Logger().Error("failed to create twap record", "pair", pairID.CanonicalString(), "err", err)
+				continue
+			}
+		default:
+			continue
+		}
+
+		k.SetTwapRecord(ctx, record)
+		k.PruneTwapRecords(ctx, pairID, cutoffTime)
+	}
+}
+
+// getTwapRecordsForRange returns twapRecords interpolated to startTime and endTime
+func (k Keeper) getTwapRecordsForRange(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	startTime, endTime time.Time,
+) (startRecord, endRecord types.TwapRecord, err error) {
+	if !startTime.Before(endTime) {
+		return startRecord, endRecord, sdkerrors.Wrapf(
+			types.ErrInvalidTwapTimeRange,
+			"start time %s must be before end time %s",
+			startTime,
+			endTime,
+		)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return startRecord, endRecord, sdkerrors.Wrapf(
+			types.ErrInvalidTwapTimeRange,
+			"end time %s must not be after the current block time %s",
+			endTime,
+			ctx.BlockTime(),
+		)
+	}
+
+	startRecord, found := k.GetTwapRecordAtOrBeforeTime(ctx, pairID, startTime)
+	if !found {
+		return startRecord, endRecord, sdkerrors.Wrapf(
+			types.ErrTwapRecordNotFound,
+			"pair %s at %s",
+			pairID.CanonicalString(),
+			startTime,
+		)
+	}
+	startRecord = startRecord.WithUpdatedAccumulators(startRecord.Height, startTime)
+
+	// Since there is a record at or before startTime there is always one at or before endTime
+	endRecord, _ = k.GetTwapRecordAtOrBeforeTime(ctx, pairID, endTime)
+	endRecord = endRecord.WithUpdatedAccumulators(endRecord.Height, endTime)
+
+	return startRecord, endRecord, nil
+}
+
+// GetArithmeticTwap returns the arithmetic time weighted average price of baseDenom in terms of the other token of
+// the pair between startTime and endTime
+func (k Keeper) GetArithmeticTwap(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	baseDenom string,
+	startTime, endTime time.Time,
+) (math_utils.PrecDec, error) {
+	startRecord, endRecord, err := k.getTwapRecordsForRange(ctx, pairID, startTime, endTime)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), err
+	}
+
+	return types.ComputeArithmeticTwap(startRecord, endRecord, baseDenom)
+}
+
+// GetGeometricTwap returns the geometric time weighted average price of baseDenom in terms of the other token of
+// the pair between startTime and endTime
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	baseDenom string,
+	startTime, endTime time.Time,
+) (math_utils.PrecDec, error) {
+	startRecord, endRecord, err := k.getTwapRecordsForRange(ctx, pairID, startTime, endTime)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), err
+	}
+
+	return types.ComputeGeometricTwap(startRecord, endRecord, baseDenom)
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"time"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var twapStartTime = time.Unix(1_000_000, 0).UTC()
+
+// beginTwapBlock moves to a new block at blockTime and clears the transient store as committing a block would
+func (s *DexTestSuite) beginTwapBlock(blockTime time.Time) {
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+
+	store := s.Ctx.TransientStore(s.App.GetTKey(types.TStoreKey))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (s *DexTestSuite) endTwapBlock() {
+	s.App.DexKeeper.UpdateTwapRecords(s.Ctx)
+}
+
+func (s *DexTestSuite) assertLatestTwapRecord(expectedTime time.Time, expectedTick int64, expectedTickAccumulator int64) {
+	record, found := s.App.DexKeeper.GetLatestTwapRecord(s.Ctx, defaultPairID)
+	s.Require().True(found)
+	s.Assert().Equal(expectedTime, record.Time.UTC())
+	s.Assert().Equal(math_utils.NewPrecDec(expectedTick), record.LastTickIndex)
+	s.Assert().Equal(types.MustCalcPrice(expectedTick), record.LastPrice1)
+	s.Assert().Equal(math_utils.NewPrecDec(expectedTickAccumulator), record.TickAccumulator)
+}
+
+func (s *DexTestSuite) assertPriceApproxEqual(expected string, actual math_utils.PrecDec) {
+	diff := actual.Sub(math_utils.MustNewPrecDecFromStr(expected)).Abs()
+	s.Assert().True(diff.LT(math_utils.NewPrecDecWithPrec(1, 20)), "expected %s, got %s", expected, actual)
+}
+
+// setupTwapHistory creates TokenB liquidity at tick 1 at twapStartTime and moves it to tick -999 10 seconds later
+func (s *DexTestSuite) setupTwapHistory() {
+	s.fundAliceBalances(0, 20)
+
+	s.beginTwapBlock(twapStartTime)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	s.endTwapBlock()
+
+	s.beginTwapBlock(twapStartTime.Add(10 * time.Second))
+	s.aliceDeposits(NewDeposit(0, 10, -1000, 1))
+	s.endTwapBlock()
+}
+
+func (s *DexTestSuite) TestTwapRecordCreatedAtEndBlock() {
+	s.fundAliceBalances(0, 10)
+
+	// GIVEN a pair without any liquidity
+	s.beginTwapBlock(twapStartTime)
+
+	// WHEN alice deposits TokenB at tick 0 with fee 1
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// THEN no record is created until the end of the block
+	_, found := s.App.DexKeeper.GetLatestTwapRecord(s.Ctx, defaultPairID)
+	s.Assert().False(found)
+
+	// AND at the end of the block the record holds the tick of the TokenB liquidity
+	s.endTwapBlock()
+	s.assertLatestTwapRecord(twapStartTime, 1, 0)
+}
+
+func (s *DexTestSuite) TestTwapRecordSpotTickIsMidTick() {
+	s.fundAliceBalances(10, 10)
+	s.beginTwapBlock(twapStartTime)
+
+	// WHEN alice deposits TokenA at tick -1100 and TokenB at tick -1000
+	s.aliceDeposits(NewDeposit(10, 0, -1100, 1), NewDeposit(0, 10, -1000, 1))
+	s.endTwapBlock()
+
+	// THEN the spot tick is between the TokenA liquidity at -1101 and TokenB liquidity at -999
+	s.assertLatestTwapRecord(twapStartTime, -1050, 0)
+	record, _ := s.App.DexKeeper.GetLatestTwapRecord(s.Ctx, defaultPairID)
+	s.Assert().Equal(math_utils.OnePrecDec().Quo(types.MustCalcPrice(-1050)), record.LastPrice0)
+}
+
+func (s *DexTestSuite) TestTwapRecordTouchedOnFirstChange() {
+	s.fundAliceBalances(0, 20)
+	s.beginTwapBlock(twapStartTime)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	s.endTwapBlock()
+
+	// WHEN alice deposits at a better tick 10 seconds later
+	s.beginTwapBlock(twapStartTime.Add(10 * time.Second))
+	s.aliceDeposits(NewDeposit(0, 10, -1000, 1))
+
+	// THEN the accumulators are updated up to the current block using the old spot tick
+	s.assertLatestTwapRecord(twapStartTime.Add(10*time.Second), 1, 10_000)
+
+	// AND the new spot tick is recorded at the end of the block without changing the accumulators
+	s.endTwapBlock()
+	s.assertLatestTwapRecord(twapStartTime.Add(10*time.Second), -999, 10_000)
+}
+
+func (s *DexTestSuite) TestTwapRecordKeepsLastTickWithoutLiquidity() {
+	s.fundAliceBalances(0, 10)
+	s.beginTwapBlock(twapStartTime)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+	s.endTwapBlock()
+
+	// WHEN alice withdraws all of the liquidity of the pair
+	s.beginTwapBlock(twapStartTime.Add(10 * time.Second))
+	s.aliceWithdraws(NewWithdrawal(10, 0, 1))
+	s.endTwapBlock()
+
+	// THEN the last spot tick is kept
+	s.assertLatestTwapRecord(twapStartTime.Add(10*time.Second), 1, 10_000)
+}
+
+func (s *DexTestSuite) TestTwap() {
+	// GIVEN TokenB liquidity at tick 1 for 10 seconds and at tick -999 for 20 seconds
+	s.setupTwapHistory()
+	s.beginTwapBlock(twapStartTime.Add(30 * time.Second))
+	endTime := twapStartTime.Add(30 * time.Second)
+
+	// THEN the TWAPs over the whole period weigh both ticks by the time they were held
+	geometric, err := s.App.DexKeeper.GetGeometricTwap(s.Ctx, defaultPairID, "TokenB", twapStartTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("0.935603654195874788739043808", geometric)
+
+	geometric, err = s.App.DexKeeper.GetGeometricTwap(s.Ctx, defaultPairID, "TokenA", twapStartTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("1.068828659994356341008230143", geometric)
+
+	arithmetic, err := s.App.DexKeeper.GetArithmeticTwap(s.Ctx, defaultPairID, "TokenB", twapStartTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("0.936654950751308103313782164", arithmetic)
+
+	arithmetic, err = s.App.DexKeeper.GetArithmeticTwap(s.Ctx, defaultPairID, "TokenA", twapStartTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("1.070003261409347530073782115", arithmetic)
+
+	// AND TWAPs can be computed between records
+	startTime := twapStartTime.Add(5 * time.Second)
+	endTime = twapStartTime.Add(15 * time.Second)
+
+	geometric, err = s.App.DexKeeper.GetGeometricTwap(s.Ctx, defaultPairID, "TokenB", startTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("0.951326925598962983112989081", geometric)
+
+	arithmetic, err = s.App.DexKeeper.GetArithmeticTwap(s.Ctx, defaultPairID, "TokenB", startTime, endTime)
+	s.Require().NoError(err)
+	s.assertPriceApproxEqual("0.952516213063481077485336624", arithmetic)
+}
+
+func (s *DexTestSuite) TestTwapFails() {
+	s.setupTwapHistory()
+	s.beginTwapBlock(twapStartTime.Add(30 * time.Second))
+
+	// start time before the first record
+	_, err := s.App.DexKeeper.GetArithmeticTwap(
+		s.Ctx, defaultPairID, "TokenB", twapStartTime.Add(-time.Second), twapStartTime.Add(time.Second),
+	)
+	s.Assert().ErrorIs(err, types.ErrTwapRecordNotFound)
+
+	// start time not before end time
+	_, err = s.App.DexKeeper.GetArithmeticTwap(s.Ctx, defaultPairID, "TokenB", twapStartTime, twapStartTime)
+	s.Assert().ErrorIs(err, types.ErrInvalidTwapTimeRange)
+
+	// end time after the current block
+	_, err = s.App.DexKeeper.GetGeometricTwap(
+		s.Ctx, defaultPairID, "TokenB", twapStartTime, twapStartTime.Add(31*time.Second),
+	)
+	s.Assert().ErrorIs(err, types.ErrInvalidTwapTimeRange)
+
+	// base denom not in pair
+	_, err = s.App.DexKeeper.GetGeometricTwap(
+		s.Ctx, defaultPairID, "TokenC", twapStartTime, twapStartTime.Add(time.Second),
+	)
+	s.Assert().ErrorIs(err, types.ErrInvalidDenom)
+
+	// pair without records
+	_, err = s.App.DexKeeper.GetGeometricTwap(
+		s.Ctx, types.MustNewPairID("TokenA", "TokenC"), "TokenA", twapStartTime, twapStartTime.Add(time.Second),
+	)
+	s.Assert().ErrorIs(err, types.ErrTwapRecordNotFound)
+}
+
+func (s *DexTestSuite) TestTwapRecordsArePruned() {
+	// GIVEN a keep period of 15 seconds
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.TwapRecordKeepPeriod = 15 * time.Second
+	s.Require().NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// AND records at twapStartTime and 10 seconds later
+	s.setupTwapHistory()
+	s.Assert().Len(s.App.DexKeeper.GetAllTwapRecord(s.Ctx), 2)
+
+	// WHEN the pair is updated 30 seconds after twapStartTime
+	s.fundBobBalances(0, 10)
+	s.beginTwapBlock(twapStartTime.Add(30 * time.Second))
+	s.bobDeposits(NewDeposit(0, 10, 0, 1))
+	s.endTwapBlock()
+
+	// THEN the first record is pruned but the newest record before the cutoff is kept
+	records := s.App.DexKeeper.GetAllTwapRecord(s.Ctx)
+	s.Require().Len(records, 2)
+	s.Assert().Equal(twapStartTime.Add(10*time.Second), records[0].Time.UTC())
+	s.Assert().Equal(twapStartTime.Add(30*time.Second), records[1].Time.UTC())
+
+	// AND TWAPs can still be computed from the cutoff
+	_, err := s.App.DexKeeper.GetArithmeticTwap(
+		s.Ctx, defaultPairID, "TokenB", twapStartTime.Add(15*time.Second), twapStartTime.Add(30*time.Second),
+	)
+	s.Assert().NoError(err)
+
+	_, err = s.App.DexKeeper.GetArithmeticTwap(
+		s.Ctx, defaultPairID, "TokenB", twapStartTime.Add(5*time.Second), twapStartTime.Add(30*time.Second),
+	)
+	s.Assert().ErrorIs(err, types.ErrTwapRecordNotFound)
+}
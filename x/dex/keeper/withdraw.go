@@ -70,6 +70,12 @@ func (k Keeper) WithdrawCore(
 		}
 	}
 
+	amountsWithdrawn := sdk.NewCoins(
+		sdk.NewCoin(pairID.Token0, totalReserve0ToRemove),
+		sdk.NewCoin(pairID.Token1, totalReserve1ToRemove),
+	)
+	k.Hooks().AfterWithdraw(ctx, callerAddr, receiverAddr, pairID, amountsWithdrawn, coinsToBurn)
+
 	return totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn, nil
 }
 
@@ -0,0 +1,56 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v7 "github.com/neutron-org/neutron/v5/x/dex/migrations/v7"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type V7DexMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V7DexMigrationTestSuite))
+}
+
+func (suite *V7DexMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// Write old state
+	oldParams := types.Params{
+		FeeTiers:                       []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200},
+		Paused:                         true,
+		MaxJitsPerBlock:                10,
+		GoodTilPurgeAllowance:          100_000,
+		TriggerOrderExecutionAllowance: 50_000,
+	}
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&oldParams)
+	suite.Require().NoError(err)
+
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	// Run migration
+	suite.NoError(v7.MigrateStore(ctx, cdc, storeKey))
+
+	// Check params are correct
+	newParams := app.DexKeeper.GetParams(ctx)
+	suite.Require().EqualValues(oldParams.FeeTiers, newParams.FeeTiers)
+	suite.Require().EqualValues(oldParams.Paused, newParams.Paused)
+	suite.Require().EqualValues(oldParams.MaxJitsPerBlock, newParams.MaxJitsPerBlock)
+	suite.Require().EqualValues(oldParams.GoodTilPurgeAllowance, newParams.GoodTilPurgeAllowance)
+	suite.Require().EqualValues(oldParams.TriggerOrderExecutionAllowance, newParams.TriggerOrderExecutionAllowance)
+	suite.Require().True(types.DefaultProtocolFeeShare.Equal(newParams.ProtocolFeeShare))
+	suite.Require().EqualValues(types.DefaultProtocolFeeDestination, newParams.ProtocolFeeDestination)
+	suite.Require().EqualValues(types.DefaultProtocolFeeContract, newParams.ProtocolFeeContract)
+}
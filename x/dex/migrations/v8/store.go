@@ -0,0 +1,44 @@
+package v8
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration adds the TwapRecordKeepPeriod dex param used for pruning TWAP records.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating dex params...")
+
+	// fetch old params
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyPrefix(types.ParamsKey))
+	if bz == nil {
+		return errors.New("cannot fetch dex params from KV store")
+	}
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	// add new param values
+	params.TwapRecordKeepPeriod = types.DefaultTwapRecordKeepPeriod
+
+	// set params
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	ctx.Logger().Info("Finished migrating dex params")
+
+	return nil
+}
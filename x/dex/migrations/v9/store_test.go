@@ -0,0 +1,63 @@
+package v9_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v9 "github.com/neutron-org/neutron/v5/x/dex/migrations/v9"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type V9DexMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V9DexMigrationTestSuite))
+}
+
+func (suite *V9DexMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// Write old state
+	oldParams := types.Params{
+		FeeTiers:                       []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200},
+		Paused:                         true,
+		MaxJitsPerBlock:                10,
+		GoodTilPurgeAllowance:          100_000,
+		TriggerOrderExecutionAllowance: 50_000,
+		ProtocolFeeShare:               math.LegacyNewDecWithPrec(1, 1),
+		ProtocolFeeDestination:         types.ProtocolFeeDestination_TREASURY,
+		TwapRecordKeepPeriod:           time.Hour,
+	}
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&oldParams)
+	suite.Require().NoError(err)
+
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	// Run migration
+	suite.NoError(v9.MigrateStore(ctx, cdc, storeKey))
+
+	// Check params are correct
+	newParams := app.DexKeeper.GetParams(ctx)
+	suite.Require().EqualValues(oldParams.FeeTiers, newParams.FeeTiers)
+	suite.Require().EqualValues(oldParams.Paused, newParams.Paused)
+	suite.Require().EqualValues(oldParams.MaxJitsPerBlock, newParams.MaxJitsPerBlock)
+	suite.Require().EqualValues(oldParams.GoodTilPurgeAllowance, newParams.GoodTilPurgeAllowance)
+	suite.Require().EqualValues(oldParams.TriggerOrderExecutionAllowance, newParams.TriggerOrderExecutionAllowance)
+	suite.Require().True(oldParams.ProtocolFeeShare.Equal(newParams.ProtocolFeeShare))
+	suite.Require().EqualValues(oldParams.ProtocolFeeDestination, newParams.ProtocolFeeDestination)
+	suite.Require().EqualValues(oldParams.ProtocolFeeContract, newParams.ProtocolFeeContract)
+	suite.Require().EqualValues(oldParams.TwapRecordKeepPeriod, newParams.TwapRecordKeepPeriod)
+	suite.Require().EqualValues(types.DefaultCandleRetentionPeriod, newParams.CandleRetentionPeriod)
+}
@@ -165,6 +165,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/dex from version 4 to 5: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 5 to 6: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 6 to 7: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 7 to 8: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 8 to 9: %v", err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -199,11 +211,16 @@ func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
 func (am AppModule) BeginBlock(wctx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(wctx)
 	am.keeper.PurgeExpiredLimitOrders(ctx, ctx.BlockTime())
+	am.keeper.ExecuteTriggeredOrders(ctx)
 	return nil
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ context.Context) ([]abci.ValidatorUpdate, error) {
+func (am AppModule) EndBlock(wctx context.Context) ([]abci.ValidatorUpdate, error) {
+	ctx := sdk.UnwrapSDKContext(wctx)
+	am.keeper.DistributeProtocolFees(ctx)
+	am.keeper.UpdateTwapRecords(ctx)
+	am.keeper.PruneCandles(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
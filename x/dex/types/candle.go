@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+// CandleIntervals are all intervals candles are kept for
+var CandleIntervals = []CandleInterval{
+	CandleInterval_ONE_MINUTE,
+	CandleInterval_ONE_HOUR,
+	CandleInterval_ONE_DAY,
+}
+
+// PairStatsWindow is the window of the rolling PairStats. It is covered by the current and previous
+// ONE_HOUR candles.
+const PairStatsWindow = 24 * time.Hour
+
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval_ONE_MINUTE:
+		return time.Minute
+	case CandleInterval_ONE_HOUR:
+		return time.Hour
+	case CandleInterval_ONE_DAY:
+		return 24 * time.Hour
+	default:
+		panic(fmt.Sprintf("invalid candle interval %d", i))
+	}
+}
+
+// Validate returns an error if the interval is not a known CandleInterval
+func (i CandleInterval) Validate() error {
+	if _, ok := CandleInterval_name[int32(i)]; !ok {
+		return fmt.Errorf("invalid candle interval: %d", i)
+	}
+	return nil
+}
+
+// CandleStartTime returns the start time of the candle of the interval containing t
+func (i CandleInterval) CandleStartTime(t time.Time) time.Time {
+	return t.UTC().Truncate(i.Duration())
+}
+
+// NewCandle creates a candle for a single trade
+func NewCandle(
+	pairID *PairID,
+	interval CandleInterval,
+	startTime time.Time,
+	price math_utils.PrecDec,
+	amount0, amount1 math.Int,
+) Candle {
+	return Candle{
+		PairId:     pairID,
+		Interval:   interval,
+		StartTime:  startTime,
+		Open:       price,
+		High:       price,
+		Low:        price,
+		Close:      price,
+		Volume0:    amount0,
+		Volume1:    amount1,
+		TradeCount: 1,
+	}
+}
+
+// AddTrade adds a trade to the candle
+func (c *Candle) AddTrade(price math_utils.PrecDec, amount0, amount1 math.Int) {
+	if price.GT(c.High) {
+		c.High = price
+	}
+	if price.LT(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume0 = c.Volume0.Add(amount0)
+	c.Volume1 = c.Volume1.Add(amount1)
+	c.TradeCount++
+}
@@ -0,0 +1,1162 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/candle.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CandleInterval defines the fixed time intervals candles are kept for.
+type CandleInterval int32
+
+const (
+	// ONE_MINUTE candles start at every full minute.
+	CandleInterval_ONE_MINUTE CandleInterval = 0
+	// ONE_HOUR candles start at every full hour.
+	CandleInterval_ONE_HOUR CandleInterval = 1
+	// ONE_DAY candles start at midnight UTC.
+	CandleInterval_ONE_DAY CandleInterval = 2
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "ONE_MINUTE",
+	1: "ONE_HOUR",
+	2: "ONE_DAY",
+}
+
+var CandleInterval_value = map[string]int32{
+	"ONE_MINUTE": 0,
+	"ONE_HOUR":   1,
+	"ONE_DAY":    2,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f7850eeb7f243562, []int{0}
+}
+
+// Candle holds the open, high, low and close price and the traded volume of a pair for one interval.
+// Prices are the price of token1 in terms of token0.
+type Candle struct {
+	PairId    *PairID        `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Interval  CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=neutron.dex.CandleInterval" json:"interval,omitempty"`
+	StartTime time.Time      `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Price of the first trade in the interval
+	Open github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=open,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"open" yaml:"open"`
+	// Highest trade price in the interval
+	High github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=high,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"high" yaml:"high"`
+	// Lowest trade price in the interval
+	Low github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,6,opt,name=low,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"low" yaml:"low"`
+	// Price of the last trade in the interval
+	Close github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"close" yaml:"close"`
+	// Amount of token0 traded in the interval
+	Volume0 cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=volume0,proto3,customtype=cosmossdk.io/math.Int" json:"volume0" yaml:"volume0"`
+	// Amount of token1 traded in the interval
+	Volume1    cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=volume1,proto3,customtype=cosmossdk.io/math.Int" json:"volume1" yaml:"volume1"`
+	TradeCount uint64                `protobuf:"varint,10,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7850eeb7f243562, []int{0}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *Candle) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return CandleInterval_ONE_MINUTE
+}
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+// PairStats are rolling statistics of the trades of a pair over the last 24 hours.
+type PairStats struct {
+	PairId *PairID `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Amount of token0 traded in the last 24 hours
+	Volume0 cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=volume0,proto3,customtype=cosmossdk.io/math.Int" json:"volume0" yaml:"volume0"`
+	// Amount of token1 traded in the last 24 hours
+	Volume1 cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume1,proto3,customtype=cosmossdk.io/math.Int" json:"volume1" yaml:"volume1"`
+	// Number of trades in the last 24 hours
+	TradeCount uint64 `protobuf:"varint,4,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	// Price of token1 in terms of token0 of the latest trade
+	LastPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=last_price,json=lastPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"last_price" yaml:"last_price"`
+}
+
+func (m *PairStats) Reset()         { *m = PairStats{} }
+func (m *PairStats) String() string { return proto.CompactTextString(m) }
+func (*PairStats) ProtoMessage()    {}
+func (*PairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7850eeb7f243562, []int{1}
+}
+func (m *PairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairStats.Merge(m, src)
+}
+func (m *PairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairStats proto.InternalMessageInfo
+
+func (m *PairStats) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *PairStats) GetTradeCount() uint64 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("neutron.dex.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterType((*Candle)(nil), "neutron.dex.Candle")
+	proto.RegisterType((*PairStats)(nil), "neutron.dex.PairStats")
+}
+
+func init() { proto.RegisterFile("neutron/dex/candle.proto", fileDescriptor_f7850eeb7f243562) }
+
+var fileDescriptor_f7850eeb7f243562 = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x26, 0xcd, 0x9f, 0x4b, 0x15, 0x95, 0x03, 0x24, 0x53, 0x24, 0x5f, 0xe4, 0x29,
+	0x42, 0xd4, 0x26, 0x05, 0x84, 0x54, 0x26, 0x92, 0x54, 0x90, 0x81, 0x36, 0xb8, 0xc9, 0x00, 0x8b,
+	0xe5, 0xd8, 0x87, 0x63, 0xd5, 0xf6, 0x59, 0xf6, 0x39, 0x4d, 0x17, 0x46, 0xe6, 0x7e, 0x09, 0xbe,
+	0x4b, 0xc7, 0x8e, 0x88, 0xc1, 0xa0, 0x64, 0xeb, 0x98, 0x4f, 0x80, 0xee, 0xec, 0xa4, 0x69, 0x17,
+	0x54, 0x85, 0x6e, 0xf7, 0x3e, 0xef, 0xe3, 0xe7, 0x77, 0xf7, 0xca, 0x77, 0x40, 0xf4, 0x71, 0x4c,
+	0x43, 0xe2, 0xab, 0x16, 0x9e, 0xa8, 0xa6, 0xe1, 0x5b, 0x2e, 0x56, 0x82, 0x90, 0x50, 0x02, 0xab,
+	0x59, 0x47, 0xb1, 0xf0, 0x64, 0xe7, 0x91, 0x4d, 0x6c, 0xc2, 0x75, 0x95, 0xad, 0x52, 0xcb, 0x0e,
+	0xb2, 0x09, 0xb1, 0x5d, 0xac, 0xf2, 0x6a, 0x18, 0x7f, 0x55, 0xa9, 0xe3, 0xe1, 0x88, 0x1a, 0x5e,
+	0x90, 0x19, 0x9e, 0xac, 0xa6, 0x07, 0x86, 0x13, 0xea, 0x8e, 0x95, 0xb6, 0xe4, 0x1f, 0x45, 0x50,
+	0x6c, 0x73, 0x1e, 0x7c, 0x0e, 0x4a, 0x59, 0x4f, 0x14, 0xea, 0x42, 0xa3, 0xba, 0xf7, 0x50, 0x59,
+	0x61, 0x2b, 0x3d, 0xc3, 0x09, 0xbb, 0x1d, 0xad, 0xc8, 0x3c, 0x5d, 0x0b, 0xbe, 0x01, 0x65, 0xc7,
+	0xa7, 0x38, 0x1c, 0x1b, 0xae, 0xb8, 0x51, 0x17, 0x1a, 0xb5, 0xbd, 0xa7, 0x37, 0xec, 0x69, 0x68,
+	0x37, 0xb3, 0x68, 0x4b, 0x33, 0x6c, 0x03, 0x10, 0x51, 0x23, 0xa4, 0x3a, 0xdb, 0xa5, 0x98, 0xe7,
+	0xa4, 0x1d, 0x25, 0x3d, 0x82, 0xb2, 0x38, 0x82, 0xd2, 0x5f, 0x1c, 0xa1, 0x55, 0xbe, 0x48, 0x50,
+	0xee, 0xfc, 0x37, 0x12, 0xb4, 0x0a, 0xff, 0x8e, 0x75, 0xa0, 0x0d, 0x0a, 0x24, 0xc0, 0xbe, 0x58,
+	0xa8, 0x0b, 0x8d, 0x4a, 0xeb, 0x98, 0x59, 0x7e, 0x25, 0xe8, 0x95, 0xed, 0xd0, 0x51, 0x3c, 0x54,
+	0x4c, 0xe2, 0xa9, 0xd9, 0x5e, 0x76, 0x49, 0x68, 0x2f, 0xd6, 0xea, 0xf8, 0xb5, 0x1a, 0x53, 0xc7,
+	0x8d, 0x54, 0xcf, 0xa0, 0x23, 0xa5, 0x17, 0x62, 0xb3, 0x83, 0xcd, 0xab, 0x04, 0xf1, 0xac, 0x79,
+	0x82, 0xaa, 0x67, 0x86, 0xe7, 0xee, 0xcb, 0xac, 0x92, 0x35, 0x2e, 0x32, 0xd0, 0xc8, 0xb1, 0x47,
+	0xe2, 0xe6, 0xff, 0x01, 0xb1, 0xac, 0x6b, 0x10, 0xab, 0x64, 0x8d, 0x8b, 0xd0, 0x04, 0x79, 0x97,
+	0x9c, 0x8a, 0x45, 0xce, 0xf9, 0xb4, 0x26, 0x87, 0x45, 0xcd, 0x13, 0x04, 0x52, 0x8c, 0x4b, 0x4e,
+	0x65, 0x8d, 0x49, 0xf0, 0x04, 0x6c, 0x9a, 0x2e, 0x89, 0xb0, 0x58, 0xe2, 0x98, 0xc1, 0x9a, 0x98,
+	0x34, 0x6c, 0x9e, 0xa0, 0xad, 0x14, 0xc4, 0x4b, 0x59, 0x4b, 0x65, 0xd8, 0x07, 0xa5, 0x31, 0x71,
+	0x63, 0x0f, 0xbf, 0x10, 0xcb, 0x1c, 0xb7, 0x9f, 0xe1, 0x1e, 0x9b, 0x24, 0xf2, 0x48, 0x14, 0x59,
+	0x27, 0x8a, 0x43, 0xd2, 0xcc, 0xae, 0x4f, 0xaf, 0x12, 0xb4, 0xf0, 0xcf, 0x13, 0x54, 0x4b, 0x13,
+	0x33, 0x41, 0xd6, 0x16, 0xad, 0xeb, 0xd4, 0xa6, 0x58, 0xb9, 0x53, 0x6a, 0xf3, 0x76, 0x6a, 0x73,
+	0x99, 0xda, 0x84, 0x08, 0x54, 0x69, 0x68, 0x58, 0x58, 0x37, 0x49, 0xec, 0x53, 0x11, 0xd4, 0x85,
+	0x46, 0x41, 0x03, 0x5c, 0x6a, 0x33, 0x45, 0xfe, 0x9e, 0x07, 0x15, 0x76, 0x03, 0x8e, 0xa9, 0x41,
+	0xa3, 0x3b, 0x5e, 0x95, 0x95, 0x41, 0x6c, 0xdc, 0xcb, 0x20, 0xf2, 0xf7, 0x36, 0x88, 0xc2, 0xed,
+	0x41, 0xc0, 0x6f, 0x00, 0xb8, 0x46, 0x44, 0xf5, 0x20, 0x74, 0x4c, 0x9c, 0x5d, 0x0b, 0x7d, 0xcd,
+	0xff, 0x68, 0x25, 0x71, 0x9e, 0xa0, 0x07, 0xd9, 0x5f, 0xbb, 0xd4, 0x64, 0xad, 0xc2, 0x8a, 0x1e,
+	0x5b, 0x3f, 0x7b, 0x0b, 0x6a, 0x37, 0x9f, 0x16, 0x58, 0x03, 0xe0, 0xe8, 0xf0, 0x40, 0xff, 0xd8,
+	0x3d, 0x1c, 0xf4, 0x0f, 0xb6, 0x73, 0x70, 0x0b, 0x94, 0x59, 0xfd, 0xe1, 0x68, 0xa0, 0x6d, 0x0b,
+	0xb0, 0x0a, 0x4a, 0xac, 0xea, 0xbc, 0xfb, 0xbc, 0xbd, 0xd1, 0x7a, 0x7f, 0x31, 0x95, 0x84, 0xcb,
+	0xa9, 0x24, 0xfc, 0x99, 0x4a, 0xc2, 0xf9, 0x4c, 0xca, 0x5d, 0xce, 0xa4, 0xdc, 0xcf, 0x99, 0x94,
+	0xfb, 0xb2, 0xfb, 0xef, 0xad, 0x4f, 0xf8, 0xf3, 0x49, 0xcf, 0x02, 0x1c, 0x0d, 0x8b, 0xfc, 0xa1,
+	0x7a, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x32, 0x83, 0xc0, 0xbf, 0xb8, 0x05, 0x00, 0x00,
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeCount != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Volume1.Size()
+		i -= size
+		if _, err := m.Volume1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Volume0.Size()
+		i -= size
+		if _, err := m.Volume0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCandle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Interval != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCandle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastPrice.Size()
+		i -= size
+		if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TradeCount != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Volume1.Size()
+		i -= size
+		if _, err := m.Volume1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Volume0.Size()
+		i -= size
+		if _, err := m.Volume0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCandle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCandle(uint64(m.Interval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume0.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume1.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	if m.TradeCount != 0 {
+		n += 1 + sovCandle(uint64(m.TradeCount))
+	}
+	return n
+}
+
+func (m *PairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovCandle(uint64(l))
+	}
+	l = m.Volume0.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume1.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	if m.TradeCount != 0 {
+		n += 1 + sovCandle(uint64(m.TradeCount))
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	return n
+}
+
+func sovCandle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandle(x uint64) (n int) {
+	return sovCandle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandle = fmt.Errorf("proto: unexpected end of group")
+)
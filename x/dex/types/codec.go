@@ -14,6 +14,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawFilledLimitOrder{}, "dex/WithdrawFilledLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "dex/CancelLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMultiHopSwap{}, "dex/MultiHopSwap", nil)
+	cdc.RegisterConcrete(&MsgPlaceTriggerOrder{}, "dex/PlaceTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelTriggerOrder{}, "dex/CancelTriggerOrder", nil)
+	cdc.RegisterConcrete(&MsgBatchLimitOrders{}, "dex/BatchLimitOrders", nil)
+	cdc.RegisterConcrete(&MsgAmendLimitOrder{}, "dex/AmendLimitOrder", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -36,6 +40,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMultiHopSwap{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlaceTriggerOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTriggerOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchLimitOrders{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendLimitOrder{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -229,4 +229,69 @@ var (
 		1165,
 		"MinAverageSellPrice must be nil or > 0.",
 	)
+	ErrInvalidTriggerType = sdkerrors.Register(
+		ModuleName,
+		1166,
+		"Invalid trigger type",
+	)
+	ErrJITTriggerOrder = sdkerrors.Register(
+		ModuleName,
+		1167,
+		"Trigger orders cannot be JUST_IN_TIME",
+	)
+	ErrTriggerOrderNotFound = sdkerrors.Register(
+		ModuleName,
+		1168,
+		"Trigger order not found",
+	)
+	ErrPostOnlyWouldTake = sdkerrors.Register(
+		ModuleName,
+		1169,
+		"POST_ONLY limit order would take liquidity",
+	)
+	ErrPostOnlySlideOnWrongOrderType = sdkerrors.Register(
+		ModuleName,
+		1170,
+		"PostOnlySlide can only be used with POST_ONLY order types",
+	)
+	ErrEmptyBatchLimitOrders = sdkerrors.Register(
+		ModuleName,
+		1171,
+		"MsgBatchLimitOrders must contain at least one cancel or placement",
+	)
+	ErrTooManyBatchLimitOrders = sdkerrors.Register(
+		ModuleName,
+		1172,
+		"Too many orders in MsgBatchLimitOrders",
+	)
+	ErrDuplicateCancelTrancheKey = sdkerrors.Register(
+		ModuleName,
+		1173,
+		"Tranche key can only be canceled once per MsgBatchLimitOrders",
+	)
+	ErrEmptyAmendLimitOrder = sdkerrors.Register(
+		ModuleName,
+		1174,
+		"MsgAmendLimitOrder must set LimitSellPrice, TickIndexInToOut and/or AmountIn",
+	)
+	ErrAmendInvalidOrderType = sdkerrors.Register(
+		ModuleName,
+		1175,
+		"Only resting maker limit orders can be amended",
+	)
+	ErrTwapRecordNotFound = sdkerrors.Register(
+		ModuleName,
+		1176,
+		"No TWAP record found for pair at or before the requested time",
+	)
+	ErrInvalidTwapTimeRange = sdkerrors.Register(
+		ModuleName,
+		1177,
+		"Invalid TWAP time range",
+	)
+	ErrNoTradesForPair = sdkerrors.Register(
+		ModuleName,
+		1178,
+		"No trades found for pair",
+	)
 )
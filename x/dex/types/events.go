@@ -56,6 +56,12 @@ const (
 	AttributeSharesOwned          = "SharesOwned"
 	AttributeSharesWithdrawn      = "SharesWithdrawn"
 	AttributeMinAvgSellPrice      = "MinAvgSellPrice"
+	AttributeTriggerOrderID       = "TriggerOrderID"
+	AttributeTriggerType          = "TriggerType"
+	AttributeTriggerTick          = "TriggerTick"
+	AttributeError                = "Error"
+	AttributeRecipient            = "Recipient"
+	AttributeAmount               = "Amount"
 )
 
 // Event Keys
@@ -70,7 +76,13 @@ const (
 	TickUpdateEventKey               = "TickUpdate"
 	EventTypeGoodTilPurgeHitGasLimit = "GoodTilPurgeHitGasLimit"
 	TrancheUserUpdateEventKey        = "TrancheUserUpdate"
+	PlaceTriggerOrderEventKey        = "PlaceTriggerOrder"
+	CancelTriggerOrderEventKey       = "CancelTriggerOrder"
+	ExecuteTriggerOrderEventKey      = "ExecuteTriggerOrder"
+	TriggerOrderFailedEventKey       = "TriggerOrderFailed"
+	EventTypeTriggerOrderHitGasLimit = "TriggerOrderHitGasLimit"
 	EventTypeTrancheUserUpdate       = "TrancheUserUpdate"
+	EventTypeProtocolFeeDistributed  = "ProtocolFeeDistributed"
 	// EventTypeNeutronMessage defines the event type used by the Interchain Queries module events.
 	EventTypeNeutronMessage = "neutron"
 )
@@ -333,6 +345,72 @@ func GoodTilPurgeHitLimitEvent(gas types.Gas) sdk.Event {
 	return sdk.NewEvent(EventTypeGoodTilPurgeHitGasLimit, attrs...)
 }
 
+func triggerOrderAttributes(action string, order TriggerOrder) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, action),
+		sdk.NewAttribute(AttributeTriggerOrderID, strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeReceiver, order.Receiver),
+		sdk.NewAttribute(AttributeTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(AttributeTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(AttributeTriggerType, order.TriggerType.String()),
+		sdk.NewAttribute(AttributeTriggerTick, strconv.FormatInt(order.TriggerTickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeLimitTick, strconv.FormatInt(order.TickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeOrderType, order.OrderType.String()),
+		sdk.NewAttribute(AttributeAmountIn, order.AmountIn.String()),
+	}
+}
+
+func PlaceTriggerOrderEvent(order TriggerOrder) sdk.Event {
+	attrs := triggerOrderAttributes(PlaceTriggerOrderEventKey, order)
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func CancelTriggerOrderEvent(order TriggerOrder) sdk.Event {
+	attrs := triggerOrderAttributes(CancelTriggerOrderEventKey, order)
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func ExecuteTriggerOrderEvent(order TriggerOrder, trancheKey string, swapAmountIn, swapAmountOut math.Int) sdk.Event {
+	attrs := triggerOrderAttributes(ExecuteTriggerOrderEventKey, order)
+	attrs = append(attrs,
+		sdk.NewAttribute(AttributeTrancheKey, trancheKey),
+		sdk.NewAttribute(AttributeSwapAmountIn, swapAmountIn.String()),
+		sdk.NewAttribute(AttributeSwapAmountOut, swapAmountOut.String()),
+	)
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func TriggerOrderFailedEvent(order TriggerOrder, err error) sdk.Event {
+	attrs := triggerOrderAttributes(TriggerOrderFailedEventKey, order)
+	attrs = append(attrs, sdk.NewAttribute(AttributeError, err.Error()))
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func ProtocolFeeDistributedEvent(recipient sdk.AccAddress, amount sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeRecipient, recipient.String()),
+		sdk.NewAttribute(AttributeAmount, amount.String()),
+	}
+
+	return sdk.NewEvent(EventTypeProtocolFeeDistributed, attrs...)
+}
+
+func TriggerOrderHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeTriggerOrderHitGasLimit, attrs...)
+}
+
 func GetEventsWithdrawnAmount(coins sdk.Coins) sdk.Events {
 	events := sdk.Events{}
 	for _, coin := range coins {
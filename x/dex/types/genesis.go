@@ -11,6 +11,10 @@ func DefaultGenesis() *GenesisState {
 		TickLiquidityList:             []*TickLiquidity{},
 		InactiveLimitOrderTrancheList: []*LimitOrderTranche{},
 		PoolMetadataList:              []PoolMetadata{},
+		TriggerOrderList:              []TriggerOrder{},
+		ProtocolFeeAccrualList:        []ProtocolFeeAccrual{},
+		TwapRecordList:                []TwapRecord{},
+		CandleList:                    []Candle{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -68,6 +72,73 @@ func (gs GenesisState) Validate() error {
 		}
 		poolMetadataIDMap[elem.Id] = true
 	}
+	// Check for duplicated ID in triggerOrder
+	triggerOrderIDMap := make(map[uint64]bool)
+	triggerOrderCount := gs.GetTriggerOrderCount()
+	for _, elem := range gs.TriggerOrderList {
+		if _, ok := triggerOrderIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for triggerOrder")
+		}
+		if elem.Id >= triggerOrderCount {
+			return fmt.Errorf("triggerOrder id should be lower or equal than the last id")
+		}
+		if elem.TradePairId == nil {
+			return fmt.Errorf("triggerOrder %d has no tradePairId", elem.Id)
+		}
+		triggerOrderIDMap[elem.Id] = true
+	}
+	// Check for duplicated index in protocolFeeAccrual
+	protocolFeeAccrualIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProtocolFeeAccrualList {
+		if elem.PairId == nil {
+			return fmt.Errorf("protocolFeeAccrual has no pairId")
+		}
+		index := string(ProtocolFeeAccrualKey(elem.PairId))
+		if _, ok := protocolFeeAccrualIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for protocolFeeAccrual")
+		}
+		if err := elem.TotalFees.Validate(); err != nil {
+			return fmt.Errorf("invalid totalFees for protocolFeeAccrual %s: %w", elem.PairId.CanonicalString(), err)
+		}
+		protocolFeeAccrualIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in twapRecord
+	twapRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.TwapRecordList {
+		if elem.PairId == nil {
+			return fmt.Errorf("twapRecord has no pairId")
+		}
+		index := string(TwapRecordKey(elem.PairId, elem.Time))
+		if _, ok := twapRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for twapRecord")
+		}
+		if elem.LastTickIndex.IsNil() || elem.TickAccumulator.IsNil() ||
+			elem.Price0Accumulator.IsNil() || elem.Price1Accumulator.IsNil() {
+			return fmt.Errorf("twapRecord %s has unset tick index or accumulators", elem.PairId.CanonicalString())
+		}
+		twapRecordIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in candle
+	candleIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CandleList {
+		if elem.PairId == nil {
+			return fmt.Errorf("candle has no pairId")
+		}
+		if err := elem.Interval.Validate(); err != nil {
+			return err
+		}
+		index := string(CandleKey(elem.PairId, elem.Interval, elem.StartTime))
+		if _, ok := candleIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for candle")
+		}
+		if elem.Open.IsNil() || elem.High.IsNil() || elem.Low.IsNil() || elem.Close.IsNil() ||
+			elem.Volume0.IsNil() || elem.Volume1.IsNil() {
+			return fmt.Errorf("candle %s has unset prices or volumes", elem.PairId.CanonicalString())
+		}
+		candleIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -31,6 +31,11 @@ type GenesisState struct {
 	LimitOrderTrancheUserList     []*LimitOrderTrancheUser `protobuf:"bytes,4,rep,name=limit_order_tranche_user_list,json=limitOrderTrancheUserList,proto3" json:"limit_order_tranche_user_list,omitempty"`
 	PoolMetadataList              []PoolMetadata           `protobuf:"bytes,5,rep,name=pool_metadata_list,json=poolMetadataList,proto3" json:"pool_metadata_list"`
 	PoolCount                     uint64                   `protobuf:"varint,6,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	TriggerOrderList              []TriggerOrder           `protobuf:"bytes,7,rep,name=trigger_order_list,json=triggerOrderList,proto3" json:"trigger_order_list"`
+	TriggerOrderCount             uint64                   `protobuf:"varint,8,opt,name=trigger_order_count,json=triggerOrderCount,proto3" json:"trigger_order_count,omitempty"`
+	ProtocolFeeAccrualList        []ProtocolFeeAccrual     `protobuf:"bytes,9,rep,name=protocol_fee_accrual_list,json=protocolFeeAccrualList,proto3" json:"protocol_fee_accrual_list"`
+	TwapRecordList                []TwapRecord             `protobuf:"bytes,10,rep,name=twap_record_list,json=twapRecordList,proto3" json:"twap_record_list"`
+	CandleList                    []Candle                 `protobuf:"bytes,11,rep,name=candle_list,json=candleList,proto3" json:"candle_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +113,41 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTriggerOrderList() []TriggerOrder {
+	if m != nil {
+		return m.TriggerOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTriggerOrderCount() uint64 {
+	if m != nil {
+		return m.TriggerOrderCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetProtocolFeeAccrualList() []ProtocolFeeAccrual {
+	if m != nil {
+		return m.ProtocolFeeAccrualList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecordList() []TwapRecord {
+	if m != nil {
+		return m.TwapRecordList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandleList() []Candle {
+	if m != nil {
+		return m.CandleList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.dex.GenesisState")
 }
@@ -115,33 +155,42 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/genesis.proto", fileDescriptor_0c051a8a0d58cd8b) }
 
 var fileDescriptor_0c051a8a0d58cd8b = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xaa, 0xda, 0x40,
-	0x14, 0x86, 0x93, 0x6a, 0x85, 0x8e, 0x5d, 0xb4, 0xb1, 0x8b, 0x18, 0x30, 0xa6, 0x42, 0x41, 0x0a,
-	0x26, 0xd4, 0xd2, 0x17, 0xb0, 0x0b, 0x37, 0x4a, 0xc5, 0xda, 0x4d, 0x37, 0x61, 0x4c, 0x86, 0x38,
-	0x35, 0xc9, 0xa4, 0x93, 0x13, 0xd1, 0xb7, 0xe8, 0x63, 0xb9, 0x74, 0xd9, 0x4d, 0xcb, 0x45, 0x5f,
-	0xe4, 0x92, 0x99, 0x11, 0x12, 0xae, 0xf7, 0xde, 0xdd, 0x70, 0xce, 0x37, 0xff, 0x37, 0x73, 0x66,
-	0x50, 0x37, 0x25, 0x05, 0x70, 0x96, 0x7a, 0x21, 0xd9, 0x7b, 0x11, 0x49, 0x49, 0x4e, 0x73, 0x37,
-	0xe3, 0x0c, 0x98, 0xd1, 0x56, 0x2d, 0x37, 0x24, 0x7b, 0xeb, 0x5d, 0xc4, 0x22, 0x26, 0xea, 0x5e,
-	0xb9, 0x92, 0x88, 0xf5, 0xa1, 0xba, 0x3b, 0xa6, 0x09, 0x05, 0x9f, 0xf1, 0x90, 0x70, 0x1f, 0x38,
-	0x4e, 0x83, 0x0d, 0x51, 0xd8, 0xc7, 0x67, 0x30, 0xbf, 0xc8, 0x09, 0x57, 0xac, 0x59, 0x65, 0x33,
-	0xcc, 0x71, 0xa2, 0xce, 0x63, 0xf5, 0x6b, 0x1d, 0xc6, 0x62, 0x3f, 0x21, 0x80, 0x43, 0x0c, 0x58,
-	0x01, 0x4e, 0x15, 0x00, 0x1a, 0x6c, 0xfd, 0x98, 0xfe, 0x2e, 0x68, 0x48, 0xe1, 0x20, 0x89, 0xc1,
-	0xbf, 0x06, 0x7a, 0x3d, 0x95, 0x97, 0xfc, 0x0e, 0x18, 0x88, 0xf1, 0x09, 0xb5, 0xa4, 0xc3, 0xd4,
-	0x1d, 0x7d, 0xd8, 0x1e, 0x77, 0xdc, 0xca, 0xa5, 0xdd, 0x85, 0x68, 0x4d, 0x9a, 0xc7, 0xff, 0x7d,
-	0x6d, 0xa9, 0x40, 0x63, 0x81, 0x3a, 0xf5, 0x6c, 0x3f, 0xa6, 0x39, 0x98, 0x2f, 0x9c, 0xc6, 0xb0,
-	0x3d, 0xb6, 0x6a, 0xfb, 0x57, 0x34, 0xd8, 0xce, 0xae, 0x98, 0x88, 0xd1, 0x97, 0x6f, 0xa1, 0x5a,
-	0x9c, 0xd1, 0x1c, 0x8c, 0x14, 0xbd, 0xa7, 0x29, 0x0e, 0x80, 0xee, 0x88, 0x7f, 0x6b, 0x3a, 0x22,
-	0xbf, 0x21, 0xf2, 0xed, 0x5a, 0xfe, 0xac, 0x84, 0xbf, 0x95, 0xec, 0x4a, 0xa2, 0xca, 0xd1, 0xbb,
-	0xc6, 0x3d, 0x00, 0x84, 0xef, 0x17, 0xea, 0x3d, 0xf6, 0x08, 0xd2, 0xd5, 0x14, 0xae, 0xc1, 0xd3,
-	0xae, 0x1f, 0x39, 0xe1, 0xca, 0xd7, 0x8d, 0x6f, 0x35, 0x85, 0x6b, 0x8e, 0x8c, 0xda, 0x53, 0x49,
-	0xc1, 0x4b, 0x21, 0xe8, 0xd6, 0x87, 0xcd, 0x58, 0x3c, 0x57, 0x94, 0x1a, 0xf9, 0x9b, 0xac, 0x52,
-	0x13, 0x71, 0x3d, 0x84, 0x44, 0x5c, 0xc0, 0x8a, 0x14, 0xcc, 0x96, 0xa3, 0x0f, 0x9b, 0xcb, 0x57,
-	0x65, 0xe5, 0x6b, 0x59, 0x98, 0x4c, 0x8f, 0x67, 0x5b, 0x3f, 0x9d, 0x6d, 0xfd, 0xee, 0x6c, 0xeb,
-	0x7f, 0x2e, 0xb6, 0x76, 0xba, 0xd8, 0xda, 0xdf, 0x8b, 0xad, 0xfd, 0x1c, 0x45, 0x14, 0x36, 0xc5,
-	0xda, 0x0d, 0x58, 0xe2, 0x29, 0xeb, 0x88, 0xf1, 0xe8, 0xba, 0xf6, 0x76, 0x5f, 0xbc, 0xbd, 0xfc,
-	0x37, 0x87, 0x8c, 0xe4, 0xeb, 0x96, 0xf8, 0x2f, 0x9f, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0x1a, 0x11, 0x18, 0x1f, 0x03, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xdf, 0xfa, 0x2b, 0xcc, 0x41, 0x68, 0x4b, 0x11, 0xb4, 0x95, 0x9a, 0x96, 0x49,
+	0xa0, 0x0a, 0x69, 0x89, 0x18, 0xe2, 0xc2, 0x8d, 0x4d, 0xa2, 0x97, 0x4e, 0x4c, 0xa5, 0x5c, 0xb8,
+	0x18, 0xcf, 0x31, 0x99, 0x59, 0x1a, 0x07, 0xc7, 0xd9, 0xba, 0x77, 0xc1, 0x7b, 0xe2, 0xb2, 0xe3,
+	0x8e, 0x9c, 0x10, 0x6a, 0xdf, 0x08, 0xca, 0x63, 0x07, 0xc5, 0x5a, 0x80, 0x5b, 0xf4, 0x3c, 0x1f,
+	0x7f, 0x3f, 0x8f, 0xff, 0xb4, 0xa8, 0x9f, 0xb2, 0x42, 0x49, 0x91, 0x86, 0x11, 0x5b, 0x85, 0x31,
+	0x4b, 0x59, 0xce, 0xf3, 0x20, 0x93, 0x42, 0x09, 0xcf, 0x35, 0xad, 0x20, 0x62, 0xab, 0xc1, 0x83,
+	0x58, 0xc4, 0x02, 0xea, 0x61, 0xf9, 0xa5, 0x91, 0x41, 0xaf, 0xbe, 0x9a, 0x92, 0x34, 0x4a, 0x98,
+	0xe9, 0x3c, 0xa9, 0x77, 0x12, 0xbe, 0xe4, 0x0a, 0x0b, 0x19, 0x31, 0x89, 0x95, 0x24, 0x29, 0x3d,
+	0xab, 0xb0, 0x67, 0xff, 0xc0, 0x70, 0x91, 0x33, 0xd9, 0x24, 0xcb, 0x88, 0x24, 0x4b, 0x33, 0xe9,
+	0x60, 0x64, 0x75, 0x84, 0x48, 0xf0, 0x92, 0x29, 0x12, 0x11, 0x45, 0x0c, 0xf0, 0xd4, 0x02, 0xca,
+	0x12, 0x15, 0x09, 0xfe, 0xc4, 0x18, 0x26, 0x94, 0xca, 0x82, 0x24, 0x86, 0x1b, 0xd7, 0x39, 0xc5,
+	0xe9, 0x39, 0x4e, 0xf8, 0x97, 0x82, 0x47, 0x5c, 0x5d, 0x35, 0xa9, 0x94, 0xe4, 0x71, 0xcc, 0xa4,
+	0x1e, 0xd9, 0x00, 0x43, 0x0b, 0xb8, 0x24, 0x19, 0x96, 0x8c, 0x0a, 0x19, 0xe9, 0xf6, 0xde, 0xb7,
+	0x0e, 0xba, 0x37, 0xd5, 0xc7, 0xfc, 0x4e, 0x11, 0xc5, 0xbc, 0xe7, 0xa8, 0xa3, 0xf7, 0xd2, 0x73,
+	0xc6, 0xce, 0xc4, 0x3d, 0xe8, 0x06, 0xb5, 0x63, 0x0f, 0x4e, 0xa0, 0x75, 0xd8, 0xbe, 0xfe, 0x31,
+	0x6a, 0xcd, 0x0d, 0xe8, 0x9d, 0xa0, 0xae, 0x3d, 0x1b, 0x4e, 0x78, 0xae, 0x7a, 0xff, 0x8d, 0xb7,
+	0x26, 0xee, 0xc1, 0xc0, 0x5a, 0xbf, 0xe0, 0xf4, 0x7c, 0x56, 0x61, 0x10, 0xe3, 0xcc, 0x77, 0x55,
+	0xbd, 0x38, 0xe3, 0xb9, 0xf2, 0x52, 0xf4, 0x98, 0xa7, 0x84, 0x2a, 0x7e, 0xc1, 0x70, 0xd3, 0x2d,
+	0x40, 0xfe, 0x16, 0xe4, 0xfb, 0x56, 0xfe, 0xac, 0x84, 0xdf, 0x96, 0xec, 0x42, 0xa3, 0xc6, 0x31,
+	0xac, 0xe2, 0x6e, 0x01, 0xe0, 0xfb, 0x8c, 0x86, 0x7f, 0xba, 0x6c, 0xed, 0x6a, 0x83, 0x6b, 0xef,
+	0xef, 0xae, 0xf7, 0x39, 0x93, 0xc6, 0xd7, 0x4f, 0x9a, 0x9a, 0xe0, 0x3a, 0x46, 0x9e, 0xf5, 0x24,
+	0xb4, 0xe0, 0x7f, 0x10, 0xf4, 0xed, 0xc3, 0x16, 0x22, 0x39, 0x36, 0x94, 0x39, 0xf2, 0x9d, 0xac,
+	0x56, 0x83, 0xb8, 0x21, 0x42, 0x10, 0x47, 0x45, 0x91, 0xaa, 0x5e, 0x67, 0xec, 0x4c, 0xda, 0xf3,
+	0xed, 0xb2, 0x72, 0x54, 0x16, 0x4a, 0x9b, 0xf5, 0x2a, 0xb4, 0xed, 0x4e, 0x83, 0x6d, 0xa1, 0x31,
+	0x98, 0xb9, 0xb2, 0xa9, 0x5a, 0x0d, 0x6c, 0x01, 0xea, 0xda, 0x71, 0x5a, 0x7b, 0x17, 0xb4, 0xbb,
+	0x75, 0x5c, 0xeb, 0x3f, 0xa2, 0x7e, 0xd3, 0xf3, 0xd6, 0x53, 0x6c, 0xc3, 0x14, 0x23, 0x7b, 0xcf,
+	0x86, 0x7e, 0xc3, 0xd8, 0x6b, 0xcd, 0x9a, 0x59, 0x1e, 0x66, 0xb7, 0x3a, 0x30, 0xd1, 0x14, 0xed,
+	0xd4, 0x5e, 0xb5, 0x0e, 0x46, 0x10, 0xfc, 0xc8, 0xde, 0xde, 0x25, 0xc9, 0xe6, 0xc0, 0x98, 0xc0,
+	0xfb, 0xea, 0x77, 0x05, 0x82, 0x5e, 0x21, 0x57, 0xff, 0x63, 0xe8, 0x0c, 0x17, 0x32, 0xec, 0xd7,
+	0x7f, 0x04, 0x7d, 0xb3, 0x1e, 0x69, 0xba, 0x5c, 0x7b, 0x38, 0xbd, 0x5e, 0xfb, 0xce, 0xcd, 0xda,
+	0x77, 0x7e, 0xae, 0x7d, 0xe7, 0xeb, 0xc6, 0x6f, 0xdd, 0x6c, 0xfc, 0xd6, 0xf7, 0x8d, 0xdf, 0xfa,
+	0xb0, 0x1f, 0x73, 0x75, 0x56, 0x9c, 0x06, 0x54, 0x2c, 0x43, 0x13, 0xb5, 0x2f, 0x64, 0x5c, 0x7d,
+	0x87, 0x17, 0x2f, 0xc3, 0x95, 0xfe, 0x69, 0x5e, 0x65, 0x2c, 0x3f, 0xed, 0xc0, 0x2e, 0x5f, 0xfc,
+	0x0a, 0x00, 0x00, 0xff, 0xff, 0x57, 0xf6, 0xa2, 0xa8, 0x07, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +213,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CandleList) > 0 {
+		for iNdEx := len(m.CandleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TwapRecordList) > 0 {
+		for iNdEx := len(m.TwapRecordList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecordList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ProtocolFeeAccrualList) > 0 {
+		for iNdEx := len(m.ProtocolFeeAccrualList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeAccrualList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TriggerOrderCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TriggerOrderCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TriggerOrderList) > 0 {
+		for iNdEx := len(m.TriggerOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.PoolCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
 		i--
@@ -284,6 +394,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.PoolCount != 0 {
 		n += 1 + sovGenesis(uint64(m.PoolCount))
 	}
+	if len(m.TriggerOrderList) > 0 {
+		for _, e := range m.TriggerOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TriggerOrderCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TriggerOrderCount))
+	}
+	if len(m.ProtocolFeeAccrualList) > 0 {
+		for _, e := range m.ProtocolFeeAccrualList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecordList) > 0 {
+		for _, e := range m.TwapRecordList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for _, e := range m.CandleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -510,6 +647,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrderList = append(m.TriggerOrderList, TriggerOrder{})
+			if err := m.TriggerOrderList[len(m.TriggerOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderCount", wireType)
+			}
+			m.TriggerOrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerOrderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeAccrualList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeAccrualList = append(m.ProtocolFeeAccrualList, ProtocolFeeAccrual{})
+			if err := m.ProtocolFeeAccrualList[len(m.ProtocolFeeAccrualList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecordList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecordList = append(m.TwapRecordList, TwapRecord{})
+			if err := m.TwapRecordList[len(m.TwapRecordList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleList = append(m.CandleList, Candle{})
+			if err := m.CandleList[len(m.CandleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
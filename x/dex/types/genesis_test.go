@@ -2,9 +2,12 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
@@ -83,6 +86,15 @@ func TestGenesisState_Validate(t *testing.T) {
 					},
 				},
 				PoolCount: 2,
+				TwapRecordList: []types.TwapRecord{
+					newTwapRecord(time.Unix(100, 0)),
+					newTwapRecord(time.Unix(200, 0)),
+				},
+				CandleList: []types.Candle{
+					newCandle(types.CandleInterval_ONE_MINUTE, time.Unix(60, 0)),
+					newCandle(types.CandleInterval_ONE_HOUR, time.Unix(0, 0)),
+				},
+				Params: types.DefaultParams(),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -183,6 +195,86 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated triggerOrder",
+			genState: &types.GenesisState{
+				TriggerOrderList: []types.TriggerOrder{
+					{
+						Id:          0,
+						TradePairId: types.MustNewTradePairID("TokenA", "TokenB"),
+					},
+					{
+						Id:          0,
+						TradePairId: types.MustNewTradePairID("TokenA", "TokenB"),
+					},
+				},
+				TriggerOrderCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid triggerOrderCount",
+			genState: &types.GenesisState{
+				TriggerOrderList: []types.TriggerOrder{
+					{
+						Id:          1,
+						TradePairId: types.MustNewTradePairID("TokenA", "TokenB"),
+					},
+				},
+				TriggerOrderCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated protocolFeeAccrual",
+			genState: &types.GenesisState{
+				ProtocolFeeAccrualList: []types.ProtocolFeeAccrual{
+					{PairId: types.MustNewPairID("TokenA", "TokenB")},
+					{PairId: types.MustNewPairID("TokenA", "TokenB")},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated twapRecord",
+			genState: &types.GenesisState{
+				TwapRecordList: []types.TwapRecord{
+					newTwapRecord(time.Unix(100, 0)),
+					newTwapRecord(time.Unix(100, 0)),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "twapRecord with unset accumulators",
+			genState: &types.GenesisState{
+				TwapRecordList: []types.TwapRecord{
+					{PairId: types.MustNewPairID("TokenA", "TokenB"), Time: time.Unix(100, 0)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated candle",
+			genState: &types.GenesisState{
+				CandleList: []types.Candle{
+					newCandle(types.CandleInterval_ONE_MINUTE, time.Unix(60, 0)),
+					newCandle(types.CandleInterval_ONE_MINUTE, time.Unix(60, 0)),
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "candle with invalid interval",
+			genState: &types.GenesisState{
+				CandleList: []types.Candle{
+					newCandle(types.CandleInterval(3), time.Unix(60, 0)),
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -195,3 +287,22 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func newTwapRecord(recordTime time.Time) types.TwapRecord {
+	record, err := types.NewTwapRecord(types.MustNewPairID("TokenA", "TokenB"), 1, recordTime, math_utils.ZeroPrecDec())
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+func newCandle(interval types.CandleInterval, startTime time.Time) types.Candle {
+	return types.NewCandle(
+		types.MustNewPairID("TokenA", "TokenB"),
+		interval,
+		startTime,
+		math_utils.OnePrecDec(),
+		math.NewInt(10),
+		math.NewInt(10),
+	)
+}
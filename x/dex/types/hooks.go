@@ -0,0 +1,145 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DexHooks allows other modules to react to trading activity in x/dex.
+// Hooks are called with the same context as the operation that triggered them, so any state written by a hook
+// is reverted together with the operation.
+type DexHooks interface {
+	// AfterSwap is called after every swap against the orderbook with a non-zero amount in. This includes the taker
+	// portion of limit orders and each hop of a multi-hop swap.
+	AfterSwap(ctx sdk.Context, tradePairID *TradePairID, amountIn, amountOut sdk.Coin)
+
+	// AfterDeposit is called after Deposit once the funds have been transferred and the shares have been minted
+	AfterDeposit(
+		ctx sdk.Context,
+		creator, receiver sdk.AccAddress,
+		pairID *PairID,
+		amountsDeposited sdk.Coins,
+		sharesIssued sdk.Coins,
+	)
+
+	// AfterWithdraw is called after Withdrawal once the shares have been burned and the funds have been transferred
+	AfterWithdraw(
+		ctx sdk.Context,
+		creator, receiver sdk.AccAddress,
+		pairID *PairID,
+		amountsWithdrawn sdk.Coins,
+		sharesBurned sdk.Coins,
+	)
+
+	// AfterLimitOrderPlaced is called after a limit order has been placed. swapIn and swapOut are the amounts
+	// traded by the taker portion of the order, any remaining amountIn is resting in the tranche with trancheKey.
+	AfterLimitOrderPlaced(
+		ctx sdk.Context,
+		creator, receiver sdk.AccAddress,
+		tradePairID *TradePairID,
+		tickIndexInToOut int64,
+		orderType LimitOrderType,
+		trancheKey string,
+		amountIn math.Int,
+		swapIn, swapOut sdk.Coin,
+	)
+
+	// AfterTrancheFilled is called after a swap (partially) fills a LimitOrderTranche. amountTakerIn is the amount
+	// of the tranche's taker denom paid in and amountMakerOut the amount of the tranche's maker denom taken out.
+	AfterTrancheFilled(ctx sdk.Context, tranche *LimitOrderTranche, amountTakerIn, amountMakerOut math.Int)
+
+	// AfterTrancheCancelled is called after a user cancels their limit order in the tranche with trancheKey
+	AfterTrancheCancelled(
+		ctx sdk.Context,
+		owner sdk.AccAddress,
+		trancheKey string,
+		makerCoinOut, takerCoinOut sdk.Coin,
+	)
+}
+
+var _ DexHooks = MultiDexHooks{}
+
+// combine multiple dex hooks, all hook functions are run in array sequence.
+type MultiDexHooks []DexHooks
+
+// Creates hooks for the Dex Module.
+func NewMultiDexHooks(hooks ...DexHooks) MultiDexHooks {
+	return hooks
+}
+
+func (h MultiDexHooks) AfterSwap(ctx sdk.Context, tradePairID *TradePairID, amountIn, amountOut sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwap(ctx, tradePairID, amountIn, amountOut)
+	}
+}
+
+func (h MultiDexHooks) AfterDeposit(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *PairID,
+	amountsDeposited sdk.Coins,
+	sharesIssued sdk.Coins,
+) {
+	for i := range h {
+		h[i].AfterDeposit(ctx, creator, receiver, pairID, amountsDeposited, sharesIssued)
+	}
+}
+
+func (h MultiDexHooks) AfterWithdraw(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *PairID,
+	amountsWithdrawn sdk.Coins,
+	sharesBurned sdk.Coins,
+) {
+	for i := range h {
+		h[i].AfterWithdraw(ctx, creator, receiver, pairID, amountsWithdrawn, sharesBurned)
+	}
+}
+
+func (h MultiDexHooks) AfterLimitOrderPlaced(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	tradePairID *TradePairID,
+	tickIndexInToOut int64,
+	orderType LimitOrderType,
+	trancheKey string,
+	amountIn math.Int,
+	swapIn, swapOut sdk.Coin,
+) {
+	for i := range h {
+		h[i].AfterLimitOrderPlaced(
+			ctx,
+			creator,
+			receiver,
+			tradePairID,
+			tickIndexInToOut,
+			orderType,
+			trancheKey,
+			amountIn,
+			swapIn,
+			swapOut,
+		)
+	}
+}
+
+func (h MultiDexHooks) AfterTrancheFilled(
+	ctx sdk.Context,
+	tranche *LimitOrderTranche,
+	amountTakerIn, amountMakerOut math.Int,
+) {
+	for i := range h {
+		h[i].AfterTrancheFilled(ctx, tranche, amountTakerIn, amountMakerOut)
+	}
+}
+
+func (h MultiDexHooks) AfterTrancheCancelled(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	trancheKey string,
+	makerCoinOut, takerCoinOut sdk.Coin,
+) {
+	for i := range h {
+		h[i].AfterTrancheCancelled(ctx, owner, trancheKey, makerCoinOut, takerCoinOut)
+	}
+}
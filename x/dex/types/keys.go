@@ -56,6 +56,33 @@ const (
 
 	// JITPerBlock is the key to retrieve the number of JIT limit orders place in a single block
 	JITsInBlockKey = "JITsInBlock/count/"
+
+	// TriggerOrderKeyPrefix is the prefix to retrieve all TriggerOrders by ID
+	TriggerOrderKeyPrefix = "TriggerOrder/value/"
+
+	// TriggerOrderIndexKeyPrefix is the prefix of the TriggerOrder index sorted by TradePairID and trigger tick
+	TriggerOrderIndexKeyPrefix = "TriggerOrder/index/"
+
+	// TriggerOrderCountKeyPrefix is the prefix to retrieve the TriggerOrder count
+	TriggerOrderCountKeyPrefix = "TriggerOrder/count/"
+
+	// ProtocolFeeAccrualKeyPrefix is the prefix to retrieve all ProtocolFeeAccruals
+	ProtocolFeeAccrualKeyPrefix = "ProtocolFeeAccrual/value/"
+
+	// ProtocolFeePendingKeyPrefix is the prefix to retrieve protocol fees that have not yet been distributed
+	ProtocolFeePendingKeyPrefix = "ProtocolFee/pending/"
+
+	// TwapRecordKeyPrefix is the prefix to retrieve all TwapRecords
+	TwapRecordKeyPrefix = "TwapRecord/value/"
+
+	// TwapChangedPairKeyPrefix is the transient prefix used to track pairs whose liquidity changed in the current block
+	TwapChangedPairKeyPrefix = "TwapRecord/changed/"
+
+	// CandleKeyPrefix is the prefix to retrieve all Candles
+	CandleKeyPrefix = "Candle/value/"
+
+	// CandleChangedPairKeyPrefix is the transient prefix used to track pairs traded in the current block
+	CandleChangedPairKeyPrefix = "Candle/changed/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -175,6 +202,63 @@ func LimitOrderExpirationKey(
 	return key
 }
 
+// TriggerOrderTradePairPrefix returns the trigger index prefix of all TriggerOrders for a TradePairID
+func TriggerOrderTradePairPrefix(tradePairID *TradePairID) []byte {
+	var key []byte
+	key = append(key, KeyPrefix(tradePairID.MustPairID().CanonicalString())...)
+	key = append(key, KeyPrefix(tradePairID.TakerDenom)...)
+
+	return key
+}
+
+// TriggerOrderSegmentPrefix returns the trigger index prefix of all TriggerOrders for a TradePairID / TriggerType
+func TriggerOrderSegmentPrefix(tradePairID *TradePairID, triggerType TriggerType) []byte {
+	key := TriggerOrderTradePairPrefix(tradePairID)
+	key = append(key, KeyPrefix(triggerType.String())...)
+
+	return key
+}
+
+// TriggerOrderIndexKey returns the trigger index key of a TriggerOrder
+func TriggerOrderIndexKey(order TriggerOrder) []byte {
+	key := TriggerOrderSegmentPrefix(order.TradePairId, order.TriggerType)
+
+	tickIndexBytes := TickIndexToBytes(order.IndexTick())
+	key = append(key, tickIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	key = append(key, sdk.Uint64ToBigEndian(order.Id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func ProtocolFeeAccrualKey(pairID *PairID) []byte {
+	return KeyPrefix(pairID.CanonicalString())
+}
+
+// TwapRecordPairPrefix returns the prefix of all TwapRecords of a pair. Unlike "/", "|" cannot be part of a denom,
+// so the prefix of a pair can never be a prefix of the TwapRecords of a different pair.
+func TwapRecordPairPrefix(pairID *PairID) []byte {
+	return []byte(pairID.CanonicalString() + "|")
+}
+
+// TwapRecordKey returns the key of a TwapRecord. Records of a pair are sorted by time.
+func TwapRecordKey(pairID *PairID, recordTime time.Time) []byte {
+	return append(TwapRecordPairPrefix(pairID), sdk.FormatTimeBytes(recordTime)...)
+}
+
+// CandleIntervalPrefix returns the prefix of all Candles of a pair for an interval. As for TwapRecordPairPrefix,
+// "|" ensures the prefix of a pair is never a prefix of the Candles of a different pair.
+func CandleIntervalPrefix(pairID *PairID, interval CandleInterval) []byte {
+	return append([]byte(pairID.CanonicalString()+"|"), sdk.Uint64ToBigEndian(uint64(interval))...)
+}
+
+// CandleKey returns the key of a Candle. Candles of a pair and interval are sorted by start time.
+func CandleKey(pairID *PairID, interval CandleInterval, startTime time.Time) []byte {
+	return append(CandleIntervalPrefix(pairID, interval), sdk.FormatTimeBytes(startTime)...)
+}
+
 func PoolIDKey(
 	pairID *PairID,
 	tickIndex int64,
@@ -206,6 +290,7 @@ func JITGoodTilTime() time.Time {
 
 const (
 	ExpiringLimitOrderGas = 10_000
+	TriggerOrderGas       = 10_000
 )
 
 // Dummy Address used for simulate queries
@@ -1,7 +1,8 @@
 package types
 
+// IsGTC returns true for orders that rest on the orderbook until they are cancelled
 func (l LimitOrderType) IsGTC() bool {
-	return l == LimitOrderType_GOOD_TIL_CANCELLED
+	return l == LimitOrderType_GOOD_TIL_CANCELLED || l == LimitOrderType_POST_ONLY
 }
 
 func (l LimitOrderType) IsFoK() bool {
@@ -16,8 +17,13 @@ func (l LimitOrderType) IsJIT() bool {
 	return l == LimitOrderType_JUST_IN_TIME
 }
 
+// IsGoodTil returns true for orders that rest on the orderbook until their expiration time
 func (l LimitOrderType) IsGoodTil() bool {
-	return l == LimitOrderType_GOOD_TIL_TIME
+	return l == LimitOrderType_GOOD_TIL_TIME || l == LimitOrderType_POST_ONLY_GOOD_TIL_TIME
+}
+
+func (l LimitOrderType) IsPostOnly() bool {
+	return l == LimitOrderType_POST_ONLY || l == LimitOrderType_POST_ONLY_GOOD_TIL_TIME
 }
 
 func (l LimitOrderType) IsTakerOnly() bool {
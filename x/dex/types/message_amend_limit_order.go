@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const TypeMsgAmendLimitOrder = "amend_limit_order"
+
+var _ sdk.Msg = &MsgAmendLimitOrder{}
+
+func NewMsgAmendLimitOrder(
+	creator,
+	trancheKey string,
+	limitSellPrice *math_utils.PrecDec,
+	tickIndexInToOut *int64,
+	amountIn *math.Int,
+) *MsgAmendLimitOrder {
+	return &MsgAmendLimitOrder{
+		Creator:          creator,
+		TrancheKey:       trancheKey,
+		LimitSellPrice:   limitSellPrice,
+		TickIndexInToOut: tickIndexInToOut,
+		AmountIn:         amountIn,
+	}
+}
+
+func (msg *MsgAmendLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAmendLimitOrder) Type() string {
+	return TypeMsgAmendLimitOrder
+}
+
+func (msg *MsgAmendLimitOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAmendLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgAmendLimitOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.LimitSellPrice == nil && msg.TickIndexInToOut == nil && msg.AmountIn == nil {
+		return ErrEmptyAmendLimitOrder
+	}
+
+	if msg.TickIndexInToOut != nil && IsTickOutOfRange(*msg.TickIndexInToOut) {
+		return ErrTickOutsideRange
+	}
+
+	if msg.LimitSellPrice != nil && IsPriceOutOfRange(*msg.LimitSellPrice) {
+		return ErrPriceOutsideRange
+	}
+
+	if msg.LimitSellPrice != nil && msg.TickIndexInToOut != nil {
+		return ErrInvalidPriceAndTick
+	}
+
+	if msg.AmountIn != nil && !msg.AmountIn.IsPositive() {
+		return ErrZeroLimitOrder
+	}
+
+	return nil
+}
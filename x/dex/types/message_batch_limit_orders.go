@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgBatchLimitOrders = "batch_limit_orders"
+
+	// MaxBatchLimitOrders is the maximum combined number of cancels and placements in a single MsgBatchLimitOrders
+	MaxBatchLimitOrders = 100
+)
+
+var _ sdk.Msg = &MsgBatchLimitOrders{}
+
+func NewMsgBatchLimitOrders(
+	creator string,
+	cancelTrancheKeys []string,
+	placeOrders []BatchPlaceLimitOrder,
+) *MsgBatchLimitOrders {
+	return &MsgBatchLimitOrders{
+		Creator:           creator,
+		CancelTrancheKeys: cancelTrancheKeys,
+		PlaceOrders:       placeOrders,
+	}
+}
+
+func (msg *MsgBatchLimitOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchLimitOrders) Type() string {
+	return TypeMsgBatchLimitOrders
+}
+
+func (msg *MsgBatchLimitOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchLimitOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgBatchLimitOrders) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	numOrders := len(msg.CancelTrancheKeys) + len(msg.PlaceOrders)
+	if numOrders == 0 {
+		return ErrEmptyBatchLimitOrders
+	}
+
+	if numOrders > MaxBatchLimitOrders {
+		return sdkerrors.Wrapf(ErrTooManyBatchLimitOrders, "max: %d; got: %d", MaxBatchLimitOrders, numOrders)
+	}
+
+	seenTrancheKeys := make(map[string]bool, len(msg.CancelTrancheKeys))
+	for _, trancheKey := range msg.CancelTrancheKeys {
+		if trancheKey == "" {
+			return sdkerrors.Wrapf(ErrValidLimitOrderTrancheNotFound, "empty tranche key")
+		}
+		if seenTrancheKeys[trancheKey] {
+			return sdkerrors.Wrapf(ErrDuplicateCancelTrancheKey, "%s", trancheKey)
+		}
+		seenTrancheKeys[trancheKey] = true
+	}
+
+	for i, order := range msg.PlaceOrders {
+		if err := order.ToMsgPlaceLimitOrder(msg.Creator).Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid place_orders[%d]", i)
+		}
+	}
+
+	return nil
+}
+
+// ToMsgPlaceLimitOrder converts a BatchPlaceLimitOrder into the equivalent MsgPlaceLimitOrder
+// where both creator and receiver are the batch creator.
+func (o BatchPlaceLimitOrder) ToMsgPlaceLimitOrder(creator string) *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Creator:             creator,
+		Receiver:            creator,
+		TokenIn:             o.TokenIn,
+		TokenOut:            o.TokenOut,
+		TickIndexInToOut:    o.TickIndexInToOut,
+		AmountIn:            o.AmountIn,
+		OrderType:           o.OrderType,
+		ExpirationTime:      o.ExpirationTime,
+		MaxAmountOut:        o.MaxAmountOut,
+		LimitSellPrice:      o.LimitSellPrice,
+		MinAverageSellPrice: o.MinAverageSellPrice,
+		PostOnlySlide:       o.PostOnlySlide,
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCancelTriggerOrder = "cancel_trigger_order"
+
+var _ sdk.Msg = &MsgCancelTriggerOrder{}
+
+func NewMsgCancelTriggerOrder(creator string, id uint64) *MsgCancelTriggerOrder {
+	return &MsgCancelTriggerOrder{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelTriggerOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTriggerOrder) Type() string {
+	return TypeMsgCancelTriggerOrder
+}
+
+func (msg *MsgCancelTriggerOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTriggerOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgCancelTriggerOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -125,6 +125,10 @@ func (msg *MsgPlaceLimitOrder) Validate() error {
 		return ErrZeroMinAverageSellPrice
 	}
 
+	if msg.PostOnlySlide && !msg.OrderType.IsPostOnly() {
+		return ErrPostOnlySlideOnWrongOrderType
+	}
+
 	return nil
 }
 
@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -10,14 +13,26 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyFeeTiers                         = []byte("FeeTiers")
-	DefaultFeeTiers                     = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
-	KeyPaused                           = []byte("Paused")
-	DefaultPaused                       = false
-	KeyMaxJITsPerBlock                  = []byte("MaxJITs")
-	DefaultMaxJITsPerBlock       uint64 = 25
-	KeyGoodTilPurgeAllowance            = []byte("PurgeAllowance")
-	DefaultGoodTilPurgeAllowance uint64 = 540_000
+	KeyFeeTiers                                  = []byte("FeeTiers")
+	DefaultFeeTiers                              = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
+	KeyPaused                                    = []byte("Paused")
+	DefaultPaused                                = false
+	KeyMaxJITsPerBlock                           = []byte("MaxJITs")
+	DefaultMaxJITsPerBlock                uint64 = 25
+	KeyGoodTilPurgeAllowance                     = []byte("PurgeAllowance")
+	DefaultGoodTilPurgeAllowance          uint64 = 540_000
+	KeyTriggerOrderExecutionAllowance            = []byte("TriggerAllowance")
+	DefaultTriggerOrderExecutionAllowance uint64 = 1_000_000
+	KeyProtocolFeeShare                          = []byte("ProtocolFeeShare")
+	DefaultProtocolFeeShare                      = math.LegacyZeroDec()
+	KeyProtocolFeeDestination                    = []byte("ProtocolFeeDestination")
+	DefaultProtocolFeeDestination                = ProtocolFeeDestination_FEEBURNER
+	KeyProtocolFeeContract                       = []byte("ProtocolFeeContract")
+	DefaultProtocolFeeContract                   = ""
+	KeyTwapRecordKeepPeriod                      = []byte("TwapRecordKeepPeriod")
+	DefaultTwapRecordKeepPeriod                  = 48 * time.Hour
+	KeyCandleRetentionPeriod                     = []byte("CandleRetentionPeriod")
+	DefaultCandleRetentionPeriod                 = 7 * 24 * time.Hour
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,18 +41,46 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(feeTiers []uint64, paused bool, maxJITsPerBlock, goodTilPurgeAllowance uint64) Params {
+func NewParams(
+	feeTiers []uint64,
+	paused bool,
+	maxJITsPerBlock,
+	goodTilPurgeAllowance,
+	triggerOrderExecutionAllowance uint64,
+	protocolFeeShare math.LegacyDec,
+	protocolFeeDestination ProtocolFeeDestination,
+	protocolFeeContract string,
+	twapRecordKeepPeriod time.Duration,
+	candleRetentionPeriod time.Duration,
+) Params {
 	return Params{
-		FeeTiers:              feeTiers,
-		Paused:                paused,
-		MaxJitsPerBlock:       maxJITsPerBlock,
-		GoodTilPurgeAllowance: goodTilPurgeAllowance,
+		FeeTiers:                       feeTiers,
+		Paused:                         paused,
+		MaxJitsPerBlock:                maxJITsPerBlock,
+		GoodTilPurgeAllowance:          goodTilPurgeAllowance,
+		TriggerOrderExecutionAllowance: triggerOrderExecutionAllowance,
+		ProtocolFeeShare:               protocolFeeShare,
+		ProtocolFeeDestination:         protocolFeeDestination,
+		ProtocolFeeContract:            protocolFeeContract,
+		TwapRecordKeepPeriod:           twapRecordKeepPeriod,
+		CandleRetentionPeriod:          candleRetentionPeriod,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultFeeTiers, DefaultPaused, DefaultMaxJITsPerBlock, DefaultGoodTilPurgeAllowance)
+	return NewParams(
+		DefaultFeeTiers,
+		DefaultPaused,
+		DefaultMaxJITsPerBlock,
+		DefaultGoodTilPurgeAllowance,
+		DefaultTriggerOrderExecutionAllowance,
+		DefaultProtocolFeeShare,
+		DefaultProtocolFeeDestination,
+		DefaultProtocolFeeContract,
+		DefaultTwapRecordKeepPeriod,
+		DefaultCandleRetentionPeriod,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -47,6 +90,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPaused, &p.Paused, validatePaused),
 		paramtypes.NewParamSetPair(KeyMaxJITsPerBlock, &p.MaxJitsPerBlock, validateMaxJITsPerBlock),
 		paramtypes.NewParamSetPair(KeyGoodTilPurgeAllowance, &p.GoodTilPurgeAllowance, validatePurgeAllowance),
+		paramtypes.NewParamSetPair(KeyTriggerOrderExecutionAllowance, &p.TriggerOrderExecutionAllowance, validateTriggerOrderExecutionAllowance),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyProtocolFeeDestination, &p.ProtocolFeeDestination, validateProtocolFeeDestination),
+		paramtypes.NewParamSetPair(KeyProtocolFeeContract, &p.ProtocolFeeContract, validateProtocolFeeContract),
+		paramtypes.NewParamSetPair(KeyTwapRecordKeepPeriod, &p.TwapRecordKeepPeriod, validateTwapRecordKeepPeriod),
+		paramtypes.NewParamSetPair(KeyCandleRetentionPeriod, &p.CandleRetentionPeriod, validateCandleRetentionPeriod),
 	}
 }
 
@@ -73,6 +122,27 @@ func (p Params) Validate() error {
 	if err := validatePurgeAllowance(p.GoodTilPurgeAllowance); err != nil {
 		return err
 	}
+	if err := validateTriggerOrderExecutionAllowance(p.TriggerOrderExecutionAllowance); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeDestination(p.ProtocolFeeDestination); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeContract(p.ProtocolFeeContract); err != nil {
+		return err
+	}
+	if err := validateTwapRecordKeepPeriod(p.TwapRecordKeepPeriod); err != nil {
+		return err
+	}
+	if err := validateCandleRetentionPeriod(p.CandleRetentionPeriod); err != nil {
+		return err
+	}
+	if p.ProtocolFeeDestination == ProtocolFeeDestination_CONTRACT && p.ProtocolFeeContract == "" {
+		return fmt.Errorf("protocol fee contract must be set when protocol fee destination is CONTRACT")
+	}
 	return nil
 }
 
@@ -118,3 +188,87 @@ func validatePurgeAllowance(v interface{}) error {
 
 	return nil
 }
+
+func validateTriggerOrderExecutionAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeShare(v interface{}) error {
+	share, ok := v.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// An unset share is treated as zero
+	if share.IsNil() {
+		return nil
+	}
+
+	if share.IsNegative() || share.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("protocol fee share must be in [0, 1): %s", share)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeDestination(v interface{}) error {
+	destination, ok := v.(ProtocolFeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, ok := ProtocolFeeDestination_name[int32(destination)]; !ok {
+		return fmt.Errorf("invalid protocol fee destination: %d", destination)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeContract(v interface{}) error {
+	contract, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if contract == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return fmt.Errorf("invalid protocol fee contract address: %w", err)
+	}
+
+	return nil
+}
+
+func validateTwapRecordKeepPeriod(v interface{}) error {
+	keepPeriod, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if keepPeriod < 0 {
+		return fmt.Errorf("twap record keep period must not be negative: %s", keepPeriod)
+	}
+
+	return nil
+}
+
+func validateCandleRetentionPeriod(v interface{}) error {
+	retentionPeriod, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// The ONE_HOUR candles of the last 24 hours are required to compute PairStats
+	if retentionPeriod < PairStatsWindow {
+		return fmt.Errorf("candle retention period must be at least %s: %s", PairStatsWindow, retentionPeriod)
+	}
+
+	return nil
+}
@@ -4,18 +4,23 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,12 +28,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProtocolFeeDestination defines where protocol fees collected from dex swaps are sent.
+type ProtocolFeeDestination int32
+
+const (
+	// FEEBURNER sends protocol fees to the x/feeburner module account.
+	ProtocolFeeDestination_FEEBURNER ProtocolFeeDestination = 0
+	// TREASURY sends protocol fees to the admin-module treasury account.
+	ProtocolFeeDestination_TREASURY ProtocolFeeDestination = 1
+	// CONTRACT sends protocol fees to protocol_fee_contract.
+	ProtocolFeeDestination_CONTRACT ProtocolFeeDestination = 2
+)
+
+var ProtocolFeeDestination_name = map[int32]string{
+	0: "FEEBURNER",
+	1: "TREASURY",
+	2: "CONTRACT",
+}
+
+var ProtocolFeeDestination_value = map[string]int32{
+	"FEEBURNER": 0,
+	"TREASURY":  1,
+	"CONTRACT":  2,
+}
+
+func (x ProtocolFeeDestination) String() string {
+	return proto.EnumName(ProtocolFeeDestination_name, int32(x))
+}
+
+func (ProtocolFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84a6bffcfc21009c, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
-	FeeTiers              []uint64 `protobuf:"varint,1,rep,packed,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
-	Paused                bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused"`
-	MaxJitsPerBlock       uint64   `protobuf:"varint,4,opt,name=max_jits_per_block,json=maxJitsPerBlock,proto3" json:"max_jits_per_block,omitempty"`
-	GoodTilPurgeAllowance uint64   `protobuf:"varint,5,opt,name=good_til_purge_allowance,json=goodTilPurgeAllowance,proto3" json:"good_til_purge_allowance,omitempty"`
+	FeeTiers                       []uint64 `protobuf:"varint,1,rep,packed,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
+	Paused                         bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused"`
+	MaxJitsPerBlock                uint64   `protobuf:"varint,4,opt,name=max_jits_per_block,json=maxJitsPerBlock,proto3" json:"max_jits_per_block,omitempty"`
+	GoodTilPurgeAllowance          uint64   `protobuf:"varint,5,opt,name=good_til_purge_allowance,json=goodTilPurgeAllowance,proto3" json:"good_til_purge_allowance,omitempty"`
+	TriggerOrderExecutionAllowance uint64   `protobuf:"varint,6,opt,name=trigger_order_execution_allowance,json=triggerOrderExecutionAllowance,proto3" json:"trigger_order_execution_allowance,omitempty"`
+	// Share of the LP fees earned by pools on swaps that is captured as a protocol fee. Must be in [0, 1).
+	ProtocolFeeShare       cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"protocol_fee_share"`
+	ProtocolFeeDestination ProtocolFeeDestination      `protobuf:"varint,8,opt,name=protocol_fee_destination,json=protocolFeeDestination,proto3,enum=neutron.dex.ProtocolFeeDestination" json:"protocol_fee_destination,omitempty"`
+	// Address protocol fees are sent to when protocol_fee_destination is CONTRACT.
+	ProtocolFeeContract string `protobuf:"bytes,9,opt,name=protocol_fee_contract,json=protocolFeeContract,proto3" json:"protocol_fee_contract,omitempty"`
+	// Duration for which TWAP records are kept. Records older than this are pruned, except for the newest record
+	// before the cutoff which is needed to compute TWAPs starting at the cutoff.
+	TwapRecordKeepPeriod time.Duration `protobuf:"bytes,10,opt,name=twap_record_keep_period,json=twapRecordKeepPeriod,proto3,stdduration" json:"twap_record_keep_period"`
+	// Duration for which candles are kept. Candles of a pair that started before this are pruned when the pair is
+	// traded. Must be at least 24h since the ONE_HOUR candles of the last 24h are used for PairStats.
+	CandleRetentionPeriod time.Duration `protobuf:"bytes,11,opt,name=candle_retention_period,json=candleRetentionPeriod,proto3,stdduration" json:"candle_retention_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -91,33 +140,87 @@ func (m *Params) GetGoodTilPurgeAllowance() uint64 {
 	return 0
 }
 
+func (m *Params) GetTriggerOrderExecutionAllowance() uint64 {
+	if m != nil {
+		return m.TriggerOrderExecutionAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeDestination() ProtocolFeeDestination {
+	if m != nil {
+		return m.ProtocolFeeDestination
+	}
+	return ProtocolFeeDestination_FEEBURNER
+}
+
+func (m *Params) GetProtocolFeeContract() string {
+	if m != nil {
+		return m.ProtocolFeeContract
+	}
+	return ""
+}
+
+func (m *Params) GetTwapRecordKeepPeriod() time.Duration {
+	if m != nil {
+		return m.TwapRecordKeepPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCandleRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.CandleRetentionPeriod
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("neutron.dex.ProtocolFeeDestination", ProtocolFeeDestination_name, ProtocolFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "neutron.dex.Params")
 }
 
 func init() { proto.RegisterFile("neutron/dex/params.proto", fileDescriptor_84a6bffcfc21009c) }
 
 var fileDescriptor_84a6bffcfc21009c = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0xf4, 0x2c, 0x35, 0x0e, 0x42, 0x50, 0x08, 0x0a, 0x69, 0xe9, 0x54, 0x90, 0x36,
-	0x83, 0x88, 0xe0, 0x66, 0x17, 0xc1, 0xa9, 0x94, 0x4e, 0x2e, 0x21, 0xed, 0xbd, 0xc6, 0xe8, 0x5d,
-	0x13, 0x92, 0x9c, 0x9e, 0xdf, 0xc2, 0xd1, 0xd1, 0x2f, 0xe1, 0x77, 0x70, 0xec, 0xe8, 0x24, 0x72,
-	0xb7, 0xf9, 0x29, 0xe4, 0xce, 0xeb, 0xf4, 0xfe, 0xef, 0xff, 0x7b, 0xff, 0xe1, 0xfd, 0x31, 0xdd,
-	0x40, 0x1e, 0x9c, 0xd9, 0xf0, 0x04, 0x0a, 0x6e, 0xa5, 0x93, 0x99, 0x9f, 0x58, 0x67, 0x82, 0x21,
-	0x07, 0x2d, 0x99, 0x24, 0x50, 0x9c, 0x1c, 0x29, 0xa3, 0x4c, 0xe3, 0xf3, 0x5a, 0xfd, 0x9f, 0x0c,
-	0x3f, 0x10, 0xee, 0xce, 0x9a, 0x0c, 0x39, 0xc5, 0xfb, 0x6b, 0x00, 0x11, 0x34, 0x38, 0x4f, 0xd1,
-	0xa0, 0x33, 0x8a, 0xe7, 0xbd, 0x35, 0xc0, 0xa2, 0xde, 0xc9, 0x10, 0x77, 0xad, 0xcc, 0x3d, 0x24,
-	0xb4, 0x33, 0x40, 0xa3, 0xde, 0x14, 0xff, 0x7e, 0xf7, 0x5b, 0x67, 0xde, 0x4e, 0x72, 0x86, 0x49,
-	0x26, 0x0b, 0xf1, 0xa0, 0x83, 0x17, 0x16, 0x9c, 0x58, 0xa6, 0x66, 0xf5, 0x48, 0xe3, 0x01, 0x1a,
-	0xc5, 0xf3, 0xc3, 0x4c, 0x16, 0xb7, 0x3a, 0xf8, 0x19, 0xb8, 0x69, 0x6d, 0x93, 0x4b, 0x4c, 0x95,
-	0x31, 0x89, 0x08, 0x3a, 0x15, 0x36, 0x77, 0x0a, 0x84, 0x4c, 0x53, 0xf3, 0x2c, 0x37, 0x2b, 0xa0,
-	0x7b, 0x4d, 0xe4, 0xb8, 0xe6, 0x0b, 0x9d, 0xce, 0x6a, 0x7a, 0xbd, 0x83, 0x57, 0xf1, 0xdb, 0x7b,
-	0x3f, 0x9a, 0xde, 0x7c, 0x96, 0x0c, 0x6d, 0x4b, 0x86, 0x7e, 0x4a, 0x86, 0x5e, 0x2b, 0x16, 0x6d,
-	0x2b, 0x16, 0x7d, 0x55, 0x2c, 0xba, 0x1b, 0x2b, 0x1d, 0xee, 0xf3, 0xe5, 0x64, 0x65, 0x32, 0xde,
-	0xfe, 0x3f, 0x36, 0x4e, 0xed, 0x34, 0x7f, 0xba, 0xe0, 0x45, 0x53, 0x55, 0x78, 0xb1, 0xe0, 0x97,
-	0xdd, 0xa6, 0x87, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xda, 0x12, 0xf5, 0x46, 0x01,
-	0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x86, 0xe3, 0x12, 0xd2, 0x64, 0xe8, 0x25, 0x9a, 0x72, 0x71, 0x41, 0x72, 0x52, 0xd8, 0x44,
+	0xad, 0xb0, 0x25, 0xaa, 0xaa, 0x52, 0x77, 0x24, 0x84, 0xaa, 0x17, 0x41, 0x3a, 0x84, 0x45, 0xa9,
+	0xaa, 0xd1, 0x64, 0x7c, 0x30, 0x2e, 0x8e, 0xc7, 0x1a, 0x8f, 0x8b, 0x79, 0x8b, 0x2e, 0xd9, 0xb5,
+	0x8f, 0xc3, 0x92, 0x65, 0xd5, 0x05, 0xad, 0x60, 0xd7, 0xa7, 0xa8, 0x66, 0x62, 0x0b, 0x90, 0x58,
+	0x74, 0x95, 0x39, 0xe7, 0xff, 0xcf, 0xa7, 0x9c, 0x8b, 0x91, 0x1d, 0x43, 0xa6, 0xa4, 0x88, 0x3d,
+	0x1f, 0x72, 0x2f, 0x61, 0x92, 0x8d, 0x53, 0x37, 0x91, 0x42, 0x09, 0x3c, 0x53, 0x28, 0xae, 0x0f,
+	0xf9, 0xe2, 0x6c, 0x20, 0x02, 0x61, 0xf2, 0x9e, 0x7e, 0x4d, 0x2c, 0x8b, 0x4e, 0x20, 0x44, 0x10,
+	0x81, 0x67, 0xa2, 0x51, 0xb6, 0xef, 0xf9, 0x99, 0x64, 0x2a, 0x14, 0xf1, 0x44, 0x5f, 0xfe, 0x3e,
+	0x8d, 0x6a, 0x03, 0xc3, 0xc4, 0x4b, 0xa8, 0xb1, 0x0f, 0x40, 0x55, 0x08, 0x32, 0xb5, 0xad, 0xf6,
+	0x54, 0xa7, 0x4a, 0xea, 0xfb, 0x00, 0x43, 0x1d, 0xe3, 0x65, 0x54, 0x4b, 0x58, 0x96, 0x82, 0x6f,
+	0x4f, 0xb5, 0xad, 0x4e, 0xbd, 0x8b, 0xfe, 0x9e, 0xb7, 0x8a, 0x0c, 0x29, 0x7e, 0xf1, 0x33, 0x84,
+	0xc7, 0x2c, 0xa7, 0x5f, 0x42, 0x95, 0xd2, 0x04, 0x24, 0x1d, 0x45, 0x82, 0x1f, 0xda, 0xd5, 0xb6,
+	0xd5, 0xa9, 0x92, 0x87, 0x63, 0x96, 0xbf, 0x0d, 0x55, 0x3a, 0x00, 0xd9, 0xd5, 0x69, 0xfc, 0x12,
+	0xd9, 0x81, 0x10, 0x3e, 0x55, 0x61, 0x44, 0x93, 0x4c, 0x06, 0x40, 0x59, 0x14, 0x89, 0x23, 0x16,
+	0x73, 0xb0, 0xa7, 0x4d, 0xc9, 0x9c, 0xd6, 0x87, 0x61, 0x34, 0xd0, 0xea, 0x7a, 0x29, 0xe2, 0x37,
+	0xe8, 0x89, 0x92, 0x61, 0x10, 0x80, 0xa4, 0x42, 0xfa, 0x20, 0x29, 0xe4, 0xc0, 0x33, 0xdd, 0xd2,
+	0x35, 0x42, 0xcd, 0x10, 0x9c, 0xc2, 0xb8, 0xad, 0x7d, 0xfd, 0xd2, 0x76, 0x85, 0xfa, 0x80, 0xb0,
+	0x99, 0x02, 0x17, 0x11, 0xd5, 0xad, 0xa7, 0x07, 0x4c, 0x82, 0x7d, 0xb7, 0x6d, 0x75, 0x1a, 0xdd,
+	0x95, 0xd3, 0xf3, 0x56, 0xe5, 0xd7, 0x79, 0x6b, 0x89, 0x8b, 0x74, 0x2c, 0xd2, 0xd4, 0x3f, 0x74,
+	0x43, 0xe1, 0x8d, 0x99, 0x3a, 0x70, 0xdf, 0x43, 0xc0, 0xf8, 0xf1, 0x06, 0x70, 0xd2, 0x2c, 0xcb,
+	0x37, 0x01, 0x76, 0x74, 0x31, 0xfe, 0x8c, 0xec, 0x1b, 0x48, 0x1f, 0x52, 0x15, 0xc6, 0x66, 0xe2,
+	0x76, 0xbd, 0x6d, 0x75, 0x1e, 0xac, 0xad, 0xb8, 0xd7, 0xb6, 0xe6, 0x0e, 0xae, 0x00, 0x1b, 0x57,
+	0x56, 0x32, 0x9f, 0xdc, 0x9a, 0xc7, 0x6b, 0x68, 0xee, 0x06, 0x9e, 0x8b, 0x58, 0x49, 0xc6, 0x95,
+	0xdd, 0xd0, 0x7f, 0x9a, 0x3c, 0xba, 0x56, 0xd6, 0x2b, 0x24, 0xbc, 0x87, 0x16, 0xd4, 0x11, 0x4b,
+	0xa8, 0x04, 0x2e, 0xa4, 0x4f, 0x0f, 0x01, 0x12, 0xbd, 0x9e, 0x50, 0xf8, 0x36, 0x6a, 0x5b, 0x9d,
+	0x99, 0xb5, 0xc7, 0xee, 0xe4, 0x48, 0xdc, 0xf2, 0x48, 0xdc, 0x8d, 0xe2, 0x48, 0xba, 0x75, 0x3d,
+	0x85, 0x93, 0xdf, 0x2d, 0x8b, 0xcc, 0x6a, 0x06, 0x31, 0x88, 0x77, 0x00, 0xc9, 0xc0, 0x00, 0xf0,
+	0x27, 0xb4, 0xc0, 0x59, 0xec, 0x47, 0x40, 0x25, 0x28, 0x88, 0xcd, 0x16, 0x0a, 0xf6, 0xcc, 0xff,
+	0xb3, 0xe7, 0x26, 0x0c, 0x52, 0x22, 0x26, 0xf0, 0x57, 0xd5, 0x93, 0x1f, 0xad, 0xca, 0xd3, 0x1e,
+	0x9a, 0xbf, 0x7d, 0x48, 0xf8, 0x3e, 0x6a, 0x6c, 0xf6, 0xfb, 0xdd, 0x5d, 0xb2, 0xd5, 0x27, 0xcd,
+	0x0a, 0xbe, 0x87, 0xea, 0x43, 0xd2, 0x5f, 0xdf, 0xd9, 0x25, 0x1f, 0x9b, 0x96, 0x8e, 0x7a, 0xdb,
+	0x5b, 0x43, 0xb2, 0xde, 0x1b, 0x36, 0xef, 0x74, 0x5f, 0x9f, 0x5e, 0x38, 0xd6, 0xd9, 0x85, 0x63,
+	0xfd, 0xb9, 0x70, 0xac, 0x6f, 0x97, 0x4e, 0xe5, 0xec, 0xd2, 0xa9, 0xfc, 0xbc, 0x74, 0x2a, 0x7b,
+	0xab, 0x41, 0xa8, 0x0e, 0xb2, 0x91, 0xcb, 0xc5, 0xd8, 0x2b, 0x16, 0xb3, 0x2a, 0x64, 0x50, 0xbe,
+	0xbd, 0xaf, 0x2f, 0xbc, 0xdc, 0x7c, 0x79, 0xea, 0x38, 0x81, 0x74, 0x54, 0x33, 0x7d, 0x3c, 0xff,
+	0x17, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x34, 0xb3, 0x7b, 0x95, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +243,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CandleRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CandleRetentionPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapRecordKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapRecordKeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if len(m.ProtocolFeeContract) > 0 {
+		i -= len(m.ProtocolFeeContract)
+		copy(dAtA[i:], m.ProtocolFeeContract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ProtocolFeeContract)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ProtocolFeeDestination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProtocolFeeDestination))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TriggerOrderExecutionAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TriggerOrderExecutionAllowance))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.GoodTilPurgeAllowance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GoodTilPurgeAllowance))
 		i--
@@ -161,20 +307,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.FeeTiers) > 0 {
-		dAtA2 := make([]byte, len(m.FeeTiers)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.FeeTiers)*10)
+		var j3 int
 		for _, num := range m.FeeTiers {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintParams(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -214,6 +360,22 @@ func (m *Params) Size() (n int) {
 	if m.GoodTilPurgeAllowance != 0 {
 		n += 1 + sovParams(uint64(m.GoodTilPurgeAllowance))
 	}
+	if m.TriggerOrderExecutionAllowance != 0 {
+		n += 1 + sovParams(uint64(m.TriggerOrderExecutionAllowance))
+	}
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ProtocolFeeDestination != 0 {
+		n += 1 + sovParams(uint64(m.ProtocolFeeDestination))
+	}
+	l = len(m.ProtocolFeeContract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapRecordKeepPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CandleRetentionPeriod)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -386,6 +548,176 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderExecutionAllowance", wireType)
+			}
+			m.TriggerOrderExecutionAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerOrderExecutionAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeDestination", wireType)
+			}
+			m.ProtocolFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeeDestination |= ProtocolFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecordKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapRecordKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CandleRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
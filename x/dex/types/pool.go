@@ -76,14 +76,7 @@ func (p *Pool) Swap(
 	maxAmountTakerIn math.Int,
 	maxAmountMakerOut *math.Int,
 ) (amountTakerIn, amountMakerOut math.Int) {
-	var takerReserves, makerReserves *PoolReserves
-	if tradePairID.IsMakerDenomToken0() {
-		makerReserves = p.LowerTick0
-		takerReserves = p.UpperTick1
-	} else {
-		makerReserves = p.UpperTick1
-		takerReserves = p.LowerTick0
-	}
+	takerReserves, makerReserves := p.swapReserves(tradePairID)
 
 	if maxAmountTakerIn.Equal(math.ZeroInt()) ||
 		makerReserves.ReservesMakerDenom.Equal(math.ZeroInt()) {
@@ -109,6 +102,45 @@ func (p *Pool) Swap(
 	return amountTakerIn, amountMakerOut
 }
 
+// TakeProtocolFee removes the protocol's share of the LP fee earned on a swap of amountTakerIn for amountMakerOut
+// from the pool's taker reserves and returns it. The LP fee is the amount of taker denom paid in excess of the value
+// of amountMakerOut at the pool's center price, so the pool always retains at least that value.
+func (p *Pool) TakeProtocolFee(
+	tradePairID *TradePairID,
+	amountTakerIn,
+	amountMakerOut math.Int,
+	protocolFeeShare math.LegacyDec,
+) math.Int {
+	if !protocolFeeShare.IsPositive() || !amountTakerIn.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	takerReserves, makerReserves := p.swapReserves(tradePairID)
+
+	feeInt64 := utils.MustSafeUint64ToInt64(p.Fee())
+	centerPrice := MustCalcPrice(makerReserves.Key.TickIndexTakerToMaker - feeInt64)
+	centerValue := centerPrice.MulInt(amountMakerOut).Ceil().TruncateInt()
+
+	lpFee := amountTakerIn.Sub(centerValue)
+	if !lpFee.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	protocolFee := protocolFeeShare.MulInt(lpFee).TruncateInt()
+	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Sub(protocolFee)
+
+	return protocolFee
+}
+
+// swapReserves returns the reserves receiving (taker) and providing (maker) liquidity for a swap on tradePairID
+func (p *Pool) swapReserves(tradePairID *TradePairID) (takerReserves, makerReserves *PoolReserves) {
+	if tradePairID.IsMakerDenomToken0() {
+		return p.UpperTick1, p.LowerTick0
+	}
+
+	return p.LowerTick0, p.UpperTick1
+}
+
 // Mutates the Pool object and returns relevant change variables. Deposit is not committed until
 // pool.save() is called or the underlying ticks are saved; this method does not use any keeper methods.
 func (p *Pool) Deposit(
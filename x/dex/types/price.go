@@ -118,6 +118,40 @@ func MustCalcPrice(relativeTickIndex int64) math_utils.PrecDec {
 	return price
 }
 
+// lnTickBase is ln(1.0001) rounded to PrecDec precision
+var lnTickBase = math_utils.MustNewPrecDecFromStr("0.000099995000333308335333167")
+
+// CalcPriceFractional calculates 1.0001^tickIndex for a tickIndex that is not necessarily an integer (ie. an average
+// of tick indexes). The integer part of the tickIndex uses the precomputed prices and the remaining fraction is
+// approximated with the Taylor series of e^x. Since x < ln(1.0001) the error of the approximation is well below
+// PrecDec precision.
+func CalcPriceFractional(tickIndex math_utils.PrecDec) (math_utils.PrecDec, error) {
+	intPart := tickIndex.TruncateInt64()
+	if tickIndex.IsNegative() && !tickIndex.IsInteger() {
+		intPart--
+	}
+
+	price, err := CalcPrice(intPart)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), err
+	}
+
+	fracPart := tickIndex.Sub(math_utils.NewPrecDec(intPart))
+	if fracPart.IsZero() {
+		return price, nil
+	}
+
+	x := fracPart.Mul(lnTickBase)
+	term := math_utils.OnePrecDec()
+	expX := math_utils.OnePrecDec()
+	for i := int64(1); i <= 4; i++ {
+		term = term.Mul(x).QuoInt64(i)
+		expX = expX.Add(term)
+	}
+
+	return price.Mul(expX), nil
+}
+
 func IsTickOutOfRange(tickIndex int64) bool {
 	return utils.Abs(tickIndex) > MaxTickExp
 }
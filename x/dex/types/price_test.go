@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
@@ -73,3 +74,27 @@ func TestCalcTickIndexFromPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcPriceFractional(t *testing.T) {
+	for _, tc := range []struct {
+		tick     string
+		expected string
+	}{
+		{tick: "0", expected: "1"},
+		{tick: "10", expected: types.MustCalcPrice(10).String()},
+		{tick: "0.5", expected: "1.000049998750062496094023417"},
+		{tick: "-0.5", expected: "0.999950003749687527341289288"},
+		{tick: "-10.25", expected: "0.998975576327148276363598245"},
+		{tick: "100.75", expected: "1.010125414870651429231333554"},
+	} {
+		t.Run(tc.tick, func(t *testing.T) {
+			price, err := types.CalcPriceFractional(math_utils.MustNewPrecDecFromStr(tc.tick))
+			require.NoError(t, err)
+			diff := price.Sub(math_utils.MustNewPrecDecFromStr(tc.expected)).Abs()
+			require.True(t, diff.LT(math_utils.NewPrecDecWithPrec(1, 21)), "expected %s, got %s", tc.expected, price)
+		})
+	}
+
+	_, err := types.CalcPriceFractional(math_utils.NewPrecDec(int64(types.MaxTickExp) + 1))
+	require.ErrorIs(t, err, types.ErrTickOutsideRange)
+}
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/protocol_fee_accrual.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProtocolFeeAccrual tracks the total protocol fees collected from swaps against pools of a pair.
+type ProtocolFeeAccrual struct {
+	PairId    *PairID                                  `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+}
+
+func (m *ProtocolFeeAccrual) Reset()         { *m = ProtocolFeeAccrual{} }
+func (m *ProtocolFeeAccrual) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFeeAccrual) ProtoMessage()    {}
+func (*ProtocolFeeAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c6f44f065ee90d9, []int{0}
+}
+func (m *ProtocolFeeAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFeeAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFeeAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFeeAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFeeAccrual.Merge(m, src)
+}
+func (m *ProtocolFeeAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFeeAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFeeAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFeeAccrual proto.InternalMessageInfo
+
+func (m *ProtocolFeeAccrual) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *ProtocolFeeAccrual) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProtocolFeeAccrual)(nil), "neutron.dex.ProtocolFeeAccrual")
+}
+
+func init() {
+	proto.RegisterFile("neutron/dex/protocol_fee_accrual.proto", fileDescriptor_6c6f44f065ee90d9)
+}
+
+var fileDescriptor_6c6f44f065ee90d9 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x41, 0x4e, 0x02, 0x31,
+	0x14, 0x86, 0xa7, 0x9a, 0x60, 0x1c, 0x76, 0xa3, 0x0b, 0x60, 0x51, 0x88, 0x0b, 0xc3, 0x42, 0x5a,
+	0xc1, 0x78, 0x00, 0xd1, 0x60, 0xd8, 0x11, 0x96, 0x6e, 0x48, 0xa7, 0xf3, 0x1c, 0xab, 0x30, 0x8f,
+	0xb4, 0x85, 0xe0, 0x2d, 0x3c, 0x87, 0x0b, 0xcf, 0xc1, 0x92, 0xa5, 0x2b, 0x35, 0x33, 0x17, 0x31,
+	0xd3, 0xa9, 0x09, 0xae, 0xfa, 0xe7, 0xf5, 0xef, 0xff, 0x7f, 0x7d, 0xe1, 0x79, 0x06, 0x2b, 0xab,
+	0x31, 0xe3, 0x09, 0x6c, 0xf8, 0x52, 0xa3, 0x45, 0x89, 0xf3, 0xd9, 0x23, 0xc0, 0x4c, 0x48, 0xa9,
+	0x57, 0x62, 0xce, 0xdc, 0x30, 0xaa, 0x7b, 0x1f, 0x4b, 0x60, 0xd3, 0xa2, 0x12, 0xcd, 0x02, 0x0d,
+	0x8f, 0x85, 0x01, 0xbe, 0xee, 0xc7, 0x60, 0x45, 0x9f, 0x4b, 0x54, 0x59, 0x65, 0x6e, 0x9d, 0xa6,
+	0x98, 0xa2, 0x93, 0xbc, 0x54, 0x7e, 0xda, 0xfc, 0x57, 0x25, 0x94, 0x9e, 0xa9, 0xa4, 0xba, 0x3a,
+	0xfb, 0x20, 0x61, 0x34, 0xf1, 0xe5, 0x23, 0x80, 0x9b, 0xaa, 0x3a, 0xba, 0x08, 0x8f, 0xbc, 0xaf,
+	0x41, 0x3a, 0xa4, 0x5b, 0x1f, 0x9c, 0xb0, 0x3d, 0x0c, 0x36, 0x11, 0x4a, 0x8f, 0xef, 0xa6, 0xb5,
+	0xd2, 0x33, 0x4e, 0xa2, 0xe7, 0x30, 0xb4, 0x68, 0x85, 0xa3, 0x37, 0x8d, 0x83, 0xce, 0x61, 0xb7,
+	0x3e, 0x68, 0xb2, 0x0a, 0x95, 0x95, 0xa8, 0xcc, 0xa3, 0xb2, 0x5b, 0x54, 0xd9, 0xf0, 0x72, 0xfb,
+	0xd5, 0x0e, 0xde, 0xbf, 0xdb, 0xdd, 0x54, 0xd9, 0xa7, 0x55, 0xcc, 0x24, 0x2e, 0xb8, 0xff, 0x57,
+	0x75, 0xf4, 0x4c, 0xf2, 0xc2, 0xed, 0xeb, 0x12, 0x8c, 0x7b, 0x60, 0xa6, 0xc7, 0x2e, 0x7e, 0x04,
+	0x60, 0x86, 0xf7, 0xdb, 0x9c, 0x92, 0x5d, 0x4e, 0xc9, 0x4f, 0x4e, 0xc9, 0x5b, 0x41, 0x83, 0x5d,
+	0x41, 0x83, 0xcf, 0x82, 0x06, 0x0f, 0xbd, 0xbd, 0x38, 0x0f, 0xdb, 0x43, 0x9d, 0xfe, 0x69, 0xbe,
+	0xbe, 0xe6, 0x1b, 0xb7, 0x01, 0x97, 0x1c, 0xd7, 0xdc, 0x02, 0xae, 0x7e, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x43, 0x27, 0x8a, 0x66, 0x88, 0x01, 0x00, 0x00,
+}
+
+func (m *ProtocolFeeAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFeeAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFeeAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtocolFeeAccrual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProtocolFeeAccrual(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtocolFeeAccrual(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtocolFeeAccrual(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ProtocolFeeAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovProtocolFeeAccrual(uint64(l))
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovProtocolFeeAccrual(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProtocolFeeAccrual(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProtocolFeeAccrual(x uint64) (n int) {
+	return sovProtocolFeeAccrual(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProtocolFeeAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtocolFeeAccrual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFeeAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFeeAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocolFeeAccrual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocolFeeAccrual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocolFeeAccrual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtocolFeeAccrual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtocolFeeAccrual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtocolFeeAccrual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtocolFeeAccrual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtocolFeeAccrual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProtocolFeeAccrual(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProtocolFeeAccrual
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProtocolFeeAccrual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProtocolFeeAccrual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProtocolFeeAccrual
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProtocolFeeAccrual
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProtocolFeeAccrual
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProtocolFeeAccrual        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProtocolFeeAccrual          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProtocolFeeAccrual = fmt.Errorf("proto: unexpected end of group")
+)
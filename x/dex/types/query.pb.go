@@ -1448,6 +1448,8 @@ type QueryEstimatePlaceLimitOrderRequest struct {
 	// expirationTime is only valid iff orderType == GOOD_TIL_TIME.
 	ExpirationTime *time.Time             `protobuf:"bytes,8,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 	MaxAmountOut   *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=maxAmount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	// post_only_slide is only valid for POST_ONLY order types.
+	PostOnlySlide bool `protobuf:"varint,10,opt,name=post_only_slide,json=postOnlySlide,proto3" json:"post_only_slide,omitempty"`
 }
 
 func (m *QueryEstimatePlaceLimitOrderRequest) Reset()         { *m = QueryEstimatePlaceLimitOrderRequest{} }
@@ -1532,6 +1534,13 @@ func (m *QueryEstimatePlaceLimitOrderRequest) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *QueryEstimatePlaceLimitOrderRequest) GetPostOnlySlide() bool {
+	if m != nil {
+		return m.PostOnlySlide
+	}
+	return false
+}
+
 type QueryEstimatePlaceLimitOrderResponse struct {
 	// Total amount of coin used for the limit order
 	// You can derive makerLimitInCoin using the equation: totalInCoin =
@@ -2447,2612 +2456,2907 @@ func (m *QuerySimulateMultiHopSwapResponse) GetResp() *MsgMultiHopSwapResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.dex.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.dex.QueryParamsResponse")
-	proto.RegisterType((*QueryGetLimitOrderTrancheUserRequest)(nil), "neutron.dex.QueryGetLimitOrderTrancheUserRequest")
-	proto.RegisterType((*QueryGetLimitOrderTrancheUserResponse)(nil), "neutron.dex.QueryGetLimitOrderTrancheUserResponse")
-	proto.RegisterType((*QueryAllLimitOrderTrancheUserRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserRequest")
-	proto.RegisterType((*QueryAllLimitOrderTrancheUserResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserResponse")
-	proto.RegisterType((*QueryGetLimitOrderTrancheRequest)(nil), "neutron.dex.QueryGetLimitOrderTrancheRequest")
-	proto.RegisterType((*QueryGetLimitOrderTrancheResponse)(nil), "neutron.dex.QueryGetLimitOrderTrancheResponse")
-	proto.RegisterType((*QueryAllLimitOrderTrancheRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheRequest")
-	proto.RegisterType((*QueryAllLimitOrderTrancheResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheResponse")
-	proto.RegisterType((*QueryAllUserDepositsRequest)(nil), "neutron.dex.QueryAllUserDepositsRequest")
-	proto.RegisterType((*QueryAllUserDepositsResponse)(nil), "neutron.dex.QueryAllUserDepositsResponse")
-	proto.RegisterType((*QueryAllLimitOrderTrancheUserByAddressRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserByAddressRequest")
-	proto.RegisterType((*QueryAllLimitOrderTrancheUserByAddressResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserByAddressResponse")
-	proto.RegisterType((*QueryAllTickLiquidityRequest)(nil), "neutron.dex.QueryAllTickLiquidityRequest")
-	proto.RegisterType((*QueryAllTickLiquidityResponse)(nil), "neutron.dex.QueryAllTickLiquidityResponse")
-	proto.RegisterType((*QueryGetInactiveLimitOrderTrancheRequest)(nil), "neutron.dex.QueryGetInactiveLimitOrderTrancheRequest")
-	proto.RegisterType((*QueryGetInactiveLimitOrderTrancheResponse)(nil), "neutron.dex.QueryGetInactiveLimitOrderTrancheResponse")
-	proto.RegisterType((*QueryAllInactiveLimitOrderTrancheRequest)(nil), "neutron.dex.QueryAllInactiveLimitOrderTrancheRequest")
-	proto.RegisterType((*QueryAllInactiveLimitOrderTrancheResponse)(nil), "neutron.dex.QueryAllInactiveLimitOrderTrancheResponse")
-	proto.RegisterType((*QueryAllPoolReservesRequest)(nil), "neutron.dex.QueryAllPoolReservesRequest")
-	proto.RegisterType((*QueryAllPoolReservesResponse)(nil), "neutron.dex.QueryAllPoolReservesResponse")
-	proto.RegisterType((*QueryGetPoolReservesRequest)(nil), "neutron.dex.QueryGetPoolReservesRequest")
-	proto.RegisterType((*QueryGetPoolReservesResponse)(nil), "neutron.dex.QueryGetPoolReservesResponse")
-	proto.RegisterType((*QueryEstimateMultiHopSwapRequest)(nil), "neutron.dex.QueryEstimateMultiHopSwapRequest")
-	proto.RegisterType((*QueryEstimateMultiHopSwapResponse)(nil), "neutron.dex.QueryEstimateMultiHopSwapResponse")
-	proto.RegisterType((*QueryEstimatePlaceLimitOrderRequest)(nil), "neutron.dex.QueryEstimatePlaceLimitOrderRequest")
-	proto.RegisterType((*QueryEstimatePlaceLimitOrderResponse)(nil), "neutron.dex.QueryEstimatePlaceLimitOrderResponse")
-	proto.RegisterType((*QueryPoolRequest)(nil), "neutron.dex.QueryPoolRequest")
-	proto.RegisterType((*QueryPoolByIDRequest)(nil), "neutron.dex.QueryPoolByIDRequest")
-	proto.RegisterType((*QueryPoolResponse)(nil), "neutron.dex.QueryPoolResponse")
-	proto.RegisterType((*QueryGetPoolMetadataRequest)(nil), "neutron.dex.QueryGetPoolMetadataRequest")
-	proto.RegisterType((*QueryGetPoolMetadataResponse)(nil), "neutron.dex.QueryGetPoolMetadataResponse")
-	proto.RegisterType((*QueryAllPoolMetadataRequest)(nil), "neutron.dex.QueryAllPoolMetadataRequest")
-	proto.RegisterType((*QueryAllPoolMetadataResponse)(nil), "neutron.dex.QueryAllPoolMetadataResponse")
-	proto.RegisterType((*QuerySimulateDepositRequest)(nil), "neutron.dex.QuerySimulateDepositRequest")
-	proto.RegisterType((*QuerySimulateDepositResponse)(nil), "neutron.dex.QuerySimulateDepositResponse")
-	proto.RegisterType((*QuerySimulateWithdrawalRequest)(nil), "neutron.dex.QuerySimulateWithdrawalRequest")
-	proto.RegisterType((*QuerySimulateWithdrawalResponse)(nil), "neutron.dex.QuerySimulateWithdrawalResponse")
-	proto.RegisterType((*QuerySimulatePlaceLimitOrderRequest)(nil), "neutron.dex.QuerySimulatePlaceLimitOrderRequest")
-	proto.RegisterType((*QuerySimulatePlaceLimitOrderResponse)(nil), "neutron.dex.QuerySimulatePlaceLimitOrderResponse")
-	proto.RegisterType((*QuerySimulateWithdrawFilledLimitOrderRequest)(nil), "neutron.dex.QuerySimulateWithdrawFilledLimitOrderRequest")
-	proto.RegisterType((*QuerySimulateWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.QuerySimulateWithdrawFilledLimitOrderResponse")
-	proto.RegisterType((*QuerySimulateCancelLimitOrderRequest)(nil), "neutron.dex.QuerySimulateCancelLimitOrderRequest")
-	proto.RegisterType((*QuerySimulateCancelLimitOrderResponse)(nil), "neutron.dex.QuerySimulateCancelLimitOrderResponse")
-	proto.RegisterType((*QuerySimulateMultiHopSwapRequest)(nil), "neutron.dex.QuerySimulateMultiHopSwapRequest")
-	proto.RegisterType((*QuerySimulateMultiHopSwapResponse)(nil), "neutron.dex.QuerySimulateMultiHopSwapResponse")
+type QueryGetTriggerOrderRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("neutron/dex/query.proto", fileDescriptor_b6613ea5fce61e9c) }
-
-var fileDescriptor_b6613ea5fce61e9c = []byte{
-	// 2746 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdf, 0x6f, 0x1c, 0x47,
-	0x1d, 0xcf, 0xf8, 0x1c, 0xc7, 0x1e, 0x3b, 0xb1, 0x33, 0x76, 0xea, 0xcb, 0xc5, 0xf1, 0xd9, 0xdb,
-	0x24, 0x76, 0xd2, 0xf8, 0x36, 0x76, 0x48, 0x9a, 0x26, 0x14, 0x88, 0xeb, 0x26, 0x31, 0x6d, 0x88,
-	0xd9, 0x84, 0x36, 0x09, 0x45, 0xab, 0xf5, 0xdd, 0xc4, 0x5e, 0xbc, 0xb7, 0xbb, 0xd9, 0xdd, 0x8b,
-	0x7d, 0x8a, 0xf2, 0x52, 0x5e, 0x10, 0xa2, 0x52, 0xa0, 0xfc, 0x50, 0x8b, 0x54, 0x1e, 0x2a, 0x90,
-	0x10, 0x42, 0xe5, 0x97, 0x78, 0xe3, 0x05, 0x09, 0x54, 0x21, 0x84, 0x2a, 0x95, 0x07, 0x04, 0x92,
-	0x41, 0x09, 0x4f, 0xe1, 0x05, 0xf9, 0x2f, 0x40, 0x33, 0xfb, 0xdd, 0xbd, 0xdd, 0xdb, 0xd9, 0xdb,
-	0xb3, 0x73, 0xa0, 0x3e, 0xf9, 0x76, 0xe6, 0x3b, 0x33, 0x9f, 0xef, 0x67, 0xbe, 0x33, 0xdf, 0x99,
-	0xcf, 0x18, 0x8f, 0x9a, 0xb4, 0xe6, 0x39, 0x96, 0x29, 0x57, 0xe8, 0x86, 0x7c, 0xb7, 0x46, 0x9d,
-	0x7a, 0xc9, 0x76, 0x2c, 0xcf, 0x22, 0xfd, 0x50, 0x51, 0xaa, 0xd0, 0x8d, 0xc2, 0x89, 0xb2, 0xe5,
-	0x56, 0x2d, 0x57, 0x5e, 0xd6, 0x5c, 0xea, 0x5b, 0xc9, 0xf7, 0x66, 0x97, 0xa9, 0xa7, 0xcd, 0xca,
-	0xb6, 0xb6, 0xa2, 0x9b, 0x9a, 0xa7, 0x5b, 0xa6, 0xdf, 0xb0, 0x30, 0x1e, 0xb5, 0x0d, 0xac, 0xca,
-	0x96, 0x1e, 0xd4, 0x8f, 0xac, 0x58, 0x2b, 0x16, 0xff, 0x29, 0xb3, 0x5f, 0x50, 0x3a, 0xb6, 0x62,
-	0x59, 0x2b, 0x06, 0x95, 0x35, 0x5b, 0x97, 0x35, 0xd3, 0xb4, 0x3c, 0xde, 0xa5, 0x0b, 0xb5, 0x45,
-	0xa8, 0xe5, 0x5f, 0xcb, 0xb5, 0x3b, 0xb2, 0xa7, 0x57, 0xa9, 0xeb, 0x69, 0x55, 0x1b, 0x0c, 0x26,
-	0xa2, 0x6e, 0x54, 0xa8, 0x6d, 0xb9, 0xba, 0xa7, 0x3a, 0xb4, 0x6c, 0x39, 0x15, 0xb0, 0x38, 0x1a,
-	0xb5, 0x30, 0xf4, 0xaa, 0xee, 0xa9, 0x96, 0x53, 0xa1, 0x8e, 0xea, 0x39, 0x9a, 0x59, 0x5e, 0xa5,
-	0x60, 0x76, 0x22, 0xc3, 0x4c, 0xad, 0xb9, 0xd4, 0x01, 0xdb, 0x7c, 0xd4, 0xd6, 0xd6, 0x1c, 0xad,
-	0x1a, 0xe0, 0x7d, 0x26, 0x56, 0x63, 0x59, 0x46, 0xe0, 0x47, 0x73, 0xb9, 0x5a, 0xa5, 0x9e, 0x56,
-	0xd1, 0x3c, 0x2d, 0xd5, 0xc0, 0xa1, 0x2e, 0x75, 0xee, 0x51, 0x57, 0xe4, 0xa8, 0xa7, 0x97, 0xd7,
-	0x54, 0x43, 0xbf, 0x5b, 0xd3, 0x2b, 0xba, 0x57, 0x0f, 0xf8, 0x8d, 0x59, 0x6c, 0xf8, 0xa5, 0xd2,
-	0x08, 0x26, 0x5f, 0x64, 0xf3, 0xb6, 0xc4, 0x61, 0x2a, 0xf4, 0x6e, 0x8d, 0xba, 0x9e, 0x74, 0x05,
-	0x0f, 0xc7, 0x4a, 0x5d, 0xdb, 0x32, 0x5d, 0x4a, 0x66, 0x71, 0x8f, 0xef, 0x4e, 0x1e, 0x4d, 0xa0,
-	0xe9, 0xfe, 0xb9, 0xe1, 0x52, 0x24, 0x18, 0x4a, 0xbe, 0xf1, 0x7c, 0xf7, 0x87, 0x9b, 0xc5, 0x5d,
-	0x0a, 0x18, 0x4a, 0x3f, 0x40, 0xf8, 0x08, 0xef, 0xea, 0x32, 0xf5, 0x5e, 0x65, 0xb4, 0x5d, 0x63,
-	0xac, 0xdd, 0xf0, 0x49, 0xfb, 0x92, 0x4b, 0x1d, 0x18, 0x92, 0xe4, 0xf1, 0x1e, 0xad, 0x52, 0x71,
-	0xa8, 0xeb, 0x77, 0xde, 0xa7, 0x04, 0x9f, 0xa4, 0x88, 0xfb, 0x03, 0x92, 0xd7, 0x68, 0x3d, 0xdf,
-	0xc5, 0x6b, 0x31, 0x14, 0xbd, 0x42, 0xeb, 0xe4, 0x1c, 0xce, 0x97, 0x35, 0xa3, 0xac, 0xae, 0xeb,
-	0xde, 0x6a, 0xc5, 0xd1, 0xd6, 0xb5, 0x65, 0x83, 0xaa, 0xee, 0xaa, 0xe6, 0x50, 0x37, 0x9f, 0x9b,
-	0x40, 0xd3, 0xbd, 0xca, 0x33, 0xac, 0xfe, 0xf5, 0x48, 0xf5, 0x75, 0x5e, 0x2b, 0x3d, 0xec, 0xc2,
-	0x47, 0x33, 0xd0, 0x81, 0xeb, 0x1a, 0xce, 0xa7, 0xcd, 0x3a, 0x90, 0x21, 0xc5, 0xc8, 0x10, 0xf6,
-	0xc6, 0xb9, 0x41, 0xca, 0x01, 0x43, 0x54, 0x49, 0xbe, 0x86, 0xf0, 0xb0, 0xc8, 0x05, 0xee, 0xf0,
-	0xbc, 0xc2, 0x9a, 0xfe, 0x6d, 0xb3, 0x78, 0xc0, 0x5f, 0x46, 0x6e, 0x65, 0xad, 0xa4, 0x5b, 0x72,
-	0x55, 0xf3, 0x56, 0x4b, 0x8b, 0xa6, 0xf7, 0x64, 0xb3, 0x28, 0x6a, 0xbb, 0xb5, 0x59, 0x2c, 0xd4,
-	0xb5, 0xaa, 0x71, 0x5e, 0x12, 0x54, 0x4a, 0x0a, 0x59, 0x4f, 0x52, 0x62, 0xc2, 0x7c, 0x5d, 0x34,
-	0x8c, 0x96, 0xf3, 0x75, 0x09, 0xe3, 0xc6, 0x12, 0x07, 0x0a, 0x8e, 0x95, 0x7c, 0x70, 0x25, 0xb6,
-	0xc6, 0x4b, 0xfe, 0xae, 0x01, 0x2b, 0xbd, 0xb4, 0xa4, 0xad, 0x50, 0x68, 0xab, 0x44, 0x5a, 0x4a,
-	0x1f, 0x23, 0x98, 0x82, 0xf4, 0x01, 0xdb, 0x9a, 0x82, 0x5c, 0x27, 0xa6, 0xe0, 0x72, 0xcc, 0xa9,
-	0x2e, 0xee, 0xd4, 0x54, 0xa6, 0x53, 0x3e, 0xbe, 0x98, 0x57, 0xdf, 0x43, 0x78, 0x22, 0x35, 0xb0,
-	0x02, 0x0a, 0x47, 0xf1, 0x1e, 0x5b, 0xd3, 0x1d, 0x55, 0xaf, 0x40, 0xc8, 0xf7, 0xb0, 0xcf, 0xc5,
-	0x0a, 0x39, 0x8c, 0x31, 0x5f, 0xc2, 0xba, 0x59, 0xa1, 0x1b, 0x1c, 0x46, 0x4e, 0xe9, 0x63, 0x25,
-	0x8b, 0xac, 0x80, 0x1c, 0xc4, 0xbd, 0x9e, 0xb5, 0x46, 0x4d, 0x55, 0x37, 0x79, 0x7c, 0xf7, 0x29,
-	0x7b, 0xf8, 0xf7, 0xa2, 0xd9, 0xbc, 0x56, 0xba, 0x9b, 0xd7, 0x8a, 0x54, 0xc7, 0x93, 0x2d, 0x70,
-	0x01, 0xd3, 0x37, 0xf0, 0xb0, 0x80, 0x69, 0x98, 0xe4, 0xf1, 0xd6, 0x24, 0x03, 0xc1, 0xfb, 0x13,
-	0x04, 0x4b, 0xef, 0x05, 0x9c, 0x88, 0x66, 0x3a, 0x93, 0x93, 0xa8, 0xd3, 0x5d, 0x71, 0xa7, 0xe3,
-	0xa1, 0x98, 0xdb, 0x71, 0x28, 0xfe, 0x0e, 0x01, 0x39, 0x62, 0x80, 0x59, 0xe4, 0xe4, 0x9e, 0x82,
-	0x9c, 0xce, 0x45, 0xde, 0x4f, 0x11, 0x3e, 0x14, 0x38, 0xc1, 0x62, 0x7a, 0xc1, 0x4f, 0x7a, 0x6e,
-	0xf6, 0x3e, 0x7b, 0x49, 0x00, 0x61, 0x07, 0x34, 0x92, 0x13, 0x78, 0xbf, 0x6e, 0x96, 0x8d, 0x5a,
-	0x85, 0xaa, 0x3c, 0x53, 0xb1, 0x34, 0x06, 0xfb, 0xf0, 0x20, 0x54, 0x2c, 0x59, 0x96, 0xb1, 0xa0,
-	0x79, 0x9a, 0xf4, 0x23, 0x84, 0xc7, 0xc4, 0x68, 0x81, 0xed, 0x4f, 0xe3, 0x5e, 0x48, 0xdb, 0x2e,
-	0x50, 0x5c, 0x88, 0x51, 0x0c, 0x0d, 0x14, 0x9e, 0xd2, 0x81, 0xde, 0xb0, 0x45, 0xe7, 0x58, 0xfd,
-	0x16, 0xc2, 0x33, 0x2d, 0x77, 0xa9, 0xf9, 0xfa, 0x45, 0x9f, 0xc6, 0xff, 0x1b, 0xcf, 0xd2, 0x1f,
-	0x10, 0x2e, 0xb5, 0x8b, 0x09, 0xd8, 0x7c, 0x05, 0x0f, 0x44, 0x62, 0xd7, 0xdd, 0xf6, 0xb6, 0xd9,
-	0xdf, 0x08, 0xdc, 0x0e, 0x92, 0xfb, 0x6e, 0x24, 0x08, 0x6e, 0xe8, 0xe5, 0xb5, 0x57, 0x83, 0x93,
-	0xcb, 0x27, 0x61, 0x53, 0xf8, 0x05, 0xc2, 0x87, 0x53, 0xc0, 0x01, 0xa9, 0x97, 0xf1, 0xbe, 0xf8,
-	0x81, 0x4b, 0x18, 0xa8, 0xb1, 0xb6, 0x40, 0xe7, 0x5e, 0x2f, 0x5a, 0xd8, 0x39, 0x42, 0xdf, 0x43,
-	0x78, 0x3a, 0xd8, 0xe5, 0x17, 0x4d, 0xad, 0xec, 0xe9, 0xf7, 0x68, 0x47, 0x77, 0xdc, 0x78, 0x82,
-	0xca, 0x35, 0x27, 0xa8, 0xcc, 0x2c, 0xf4, 0x6d, 0x84, 0x8f, 0xb7, 0x01, 0x10, 0x08, 0xa6, 0x78,
-	0x4c, 0x07, 0x23, 0xf5, 0x69, 0xf3, 0xd2, 0x41, 0x3d, 0x6d, 0x38, 0xc9, 0x01, 0xd2, 0x2e, 0x1a,
-	0x46, 0x26, 0x69, 0x9d, 0x3a, 0xfd, 0xfc, 0x3d, 0x20, 0xa2, 0xf5, 0xa0, 0x6d, 0x13, 0x91, 0xeb,
-	0x00, 0x11, 0x9d, 0x8b, 0xc3, 0x77, 0x22, 0xb9, 0x88, 0x6d, 0xf9, 0x0a, 0xdc, 0x59, 0x3e, 0x09,
-	0xeb, 0xfa, 0x67, 0x91, 0x4d, 0x27, 0x8e, 0x0d, 0xc8, 0x5e, 0xc0, 0x7b, 0x63, 0x17, 0x2d, 0x60,
-	0xf7, 0x60, 0xfc, 0xce, 0x13, 0x69, 0x09, 0xc4, 0x0e, 0xd8, 0x91, 0xb2, 0xce, 0x71, 0xf9, 0x66,
-	0xc0, 0xe5, 0x65, 0xea, 0x75, 0x8a, 0xcb, 0x8c, 0x65, 0x3c, 0x84, 0x73, 0x77, 0x28, 0xe5, 0xcb,
-	0xb7, 0x5b, 0x61, 0x3f, 0xa5, 0x0a, 0x70, 0x96, 0xc0, 0x90, 0xce, 0x19, 0xda, 0x36, 0x67, 0xd2,
-	0x4f, 0x72, 0x70, 0x50, 0x7c, 0xd9, 0xf5, 0xf4, 0xaa, 0xe6, 0xd1, 0xab, 0x35, 0xc3, 0xd3, 0xaf,
-	0x58, 0xf6, 0xf5, 0x75, 0xcd, 0x8e, 0xe4, 0xd7, 0xb2, 0x43, 0x35, 0xcf, 0x72, 0x82, 0xfc, 0x0a,
-	0x9f, 0xa4, 0x80, 0x7b, 0x1d, 0x5a, 0xa6, 0xfa, 0x3d, 0xea, 0x80, 0xc3, 0xe1, 0x37, 0x99, 0xc3,
-	0x3d, 0x8e, 0x55, 0xf3, 0xf8, 0xc5, 0x30, 0xb9, 0x47, 0x07, 0xe3, 0x28, 0xcc, 0x44, 0x01, 0x4b,
-	0xf2, 0x65, 0xdc, 0xa7, 0x55, 0xad, 0x9a, 0xe9, 0x31, 0x06, 0xf9, 0x5e, 0x36, 0xff, 0x19, 0x76,
-	0xc7, 0x6d, 0x75, 0x19, 0x6b, 0xb4, 0xd8, 0xda, 0x2c, 0x0e, 0xf9, 0x57, 0xb0, 0xb0, 0x48, 0x52,
-	0x7a, 0xfd, 0xdf, 0x8b, 0x26, 0xf9, 0x2e, 0xc2, 0x43, 0x74, 0x43, 0xf7, 0x60, 0x3d, 0xdb, 0x8e,
-	0x5e, 0xa6, 0xf9, 0xdd, 0x7c, 0x90, 0x35, 0x18, 0xe4, 0x53, 0x2b, 0xba, 0xb7, 0x5a, 0x5b, 0x2e,
-	0x95, 0xad, 0xaa, 0x0c, 0x68, 0x67, 0x2c, 0x67, 0x25, 0xf8, 0x2d, 0xdf, 0x3b, 0x23, 0xd7, 0x3c,
-	0xdd, 0x70, 0xfd, 0xf1, 0x97, 0x1c, 0x5a, 0x5e, 0xa0, 0xe5, 0x27, 0x9b, 0xc5, 0x44, 0xbf, 0x5b,
-	0x9b, 0xc5, 0x51, 0x1f, 0x4a, 0x73, 0x8d, 0xa4, 0xec, 0x63, 0x45, 0x7c, 0x2b, 0x58, 0x62, 0x05,
-	0xe4, 0x18, 0x1e, 0xb4, 0x59, 0x68, 0x2c, 0x53, 0xd7, 0x53, 0x39, 0x11, 0xf9, 0x1e, 0x7e, 0x84,
-	0xdb, 0xcb, 0x8a, 0xe7, 0xd9, 0x6a, 0x62, 0x85, 0xec, 0xa2, 0x33, 0xd9, 0x62, 0xae, 0x20, 0x2e,
-	0xee, 0xe2, 0xde, 0xb2, 0xa5, 0x9b, 0xaa, 0x55, 0xf3, 0xc2, 0x90, 0x88, 0xae, 0x81, 0x20, 0xfa,
-	0x5f, 0xb2, 0x74, 0x73, 0xfe, 0x02, 0xf8, 0x3d, 0x15, 0xf1, 0x1b, 0xb4, 0x23, 0xff, 0xcf, 0x8c,
-	0x5b, 0x59, 0x93, 0xbd, 0xba, 0x4d, 0x5d, 0xde, 0xe0, 0xc9, 0x66, 0x31, 0xec, 0x5d, 0xd9, 0xc3,
-	0x7e, 0x5d, 0xab, 0x79, 0xd2, 0xbb, 0xdd, 0xf8, 0xd9, 0x18, 0xb0, 0x25, 0x43, 0x2b, 0x47, 0x36,
-	0xbb, 0xa7, 0x8b, 0xa3, 0x16, 0x57, 0xb0, 0x43, 0xb8, 0xcf, 0xaf, 0x62, 0xce, 0xfa, 0xa9, 0xcf,
-	0xb7, 0xbd, 0x56, 0xf3, 0x48, 0x09, 0x8f, 0x34, 0x56, 0x9c, 0xaa, 0x9b, 0xaa, 0x67, 0x71, 0xbb,
-	0xdd, 0x7c, 0xed, 0x0d, 0x85, 0x6b, 0x6f, 0xd1, 0xbc, 0x61, 0x31, 0xfb, 0x58, 0xec, 0xf5, 0x74,
-	0x38, 0xf6, 0xce, 0x63, 0x0c, 0xf9, 0xa3, 0x6e, 0xd3, 0xfc, 0x9e, 0x09, 0x34, 0xbd, 0x6f, 0xee,
-	0x50, 0x5a, 0xf2, 0xa8, 0xdb, 0x54, 0xe9, 0xb3, 0x82, 0x9f, 0xe4, 0x2a, 0x1e, 0xa4, 0x1b, 0xb6,
-	0xee, 0xf0, 0xcd, 0x49, 0xf5, 0xf4, 0x2a, 0xcd, 0xf7, 0xf2, 0x89, 0x2d, 0x94, 0x7c, 0x4d, 0xae,
-	0x14, 0x68, 0x72, 0xa5, 0x1b, 0x81, 0x26, 0x37, 0xdf, 0xcb, 0x16, 0xfb, 0xc3, 0x7f, 0x14, 0x11,
-	0x0b, 0xb7, 0xa0, 0x31, 0xab, 0x26, 0x55, 0xbc, 0xb7, 0xaa, 0x6d, 0x5c, 0xf4, 0x51, 0x32, 0x42,
-	0xfa, 0xb8, 0xaf, 0x57, 0xb2, 0x44, 0x8f, 0x7d, 0x55, 0x6d, 0x43, 0xd5, 0xc2, 0x66, 0x5b, 0x9b,
-	0xc5, 0x03, 0xbe, 0xc3, 0xf1, 0x72, 0x49, 0x19, 0x08, 0xbb, 0x67, 0xc1, 0xf1, 0x9f, 0x1c, 0xa8,
-	0x1c, 0xa9, 0xc1, 0x01, 0x81, 0xfb, 0x7d, 0x84, 0xf7, 0x7a, 0x96, 0xa7, 0x19, 0x6c, 0xae, 0x58,
-	0x68, 0x65, 0x87, 0xef, 0xcd, 0xed, 0x87, 0x6f, 0x7c, 0x88, 0xad, 0xcd, 0xe2, 0x88, 0xef, 0x44,
-	0xac, 0x58, 0x52, 0xfa, 0xf9, 0xf7, 0xa2, 0xc9, 0x5a, 0x91, 0xb7, 0x11, 0x1e, 0x70, 0xd7, 0x35,
-	0x3b, 0x04, 0xd6, 0x95, 0x05, 0xec, 0xb5, 0xed, 0x03, 0x8b, 0x8d, 0xb0, 0xb5, 0x59, 0x1c, 0xf6,
-	0x71, 0x45, 0x4b, 0x25, 0x05, 0xb3, 0x4f, 0x40, 0xc5, 0xf8, 0xe2, 0xb5, 0x56, 0xcd, 0xf3, 0x61,
-	0xe5, 0xfe, 0x17, 0x7c, 0xc5, 0x86, 0x68, 0xf0, 0x15, 0x2b, 0x96, 0x94, 0x7e, 0xf6, 0x7d, 0xad,
-	0xe6, 0xb1, 0x56, 0xd2, 0x1b, 0x78, 0xc8, 0x97, 0x34, 0x79, 0xa6, 0x79, 0x3a, 0x01, 0x06, 0x12,
-	0x63, 0xae, 0x91, 0x18, 0x65, 0x3c, 0x12, 0xf6, 0x3e, 0x5f, 0x5f, 0x5c, 0x88, 0x8e, 0xc0, 0x12,
-	0x22, 0x8c, 0xd0, 0xad, 0xf4, 0xb0, 0xcf, 0xc5, 0x8a, 0xf4, 0x39, 0xbc, 0x3f, 0x02, 0x07, 0xa2,
-	0xed, 0x39, 0xdc, 0xcd, 0xaa, 0x21, 0xc6, 0xf6, 0x27, 0xb2, 0x26, 0x64, 0x4b, 0x6e, 0x24, 0xcd,
-	0xc4, 0xcf, 0x03, 0x57, 0x41, 0x30, 0x0e, 0x46, 0xde, 0x87, 0xbb, 0xc2, 0x41, 0xbb, 0xf4, 0x4a,
-	0x73, 0xea, 0x6e, 0x98, 0x37, 0x52, 0xf7, 0x52, 0x54, 0x78, 0x4e, 0x4d, 0xdd, 0x41, 0x4b, 0x10,
-	0x7a, 0x07, 0xa2, 0x65, 0x12, 0x8d, 0x1f, 0xf8, 0x9a, 0x41, 0x75, 0xea, 0xd8, 0xdc, 0x7c, 0x78,
-	0x13, 0x79, 0x63, 0x37, 0x79, 0x93, 0x6b, 0xcb, 0x1b, 0x3b, 0x52, 0xd6, 0xb9, 0xc3, 0xdb, 0x15,
-	0xa0, 0xe5, 0xba, 0x5e, 0xad, 0x19, 0x9a, 0x47, 0x43, 0xd5, 0xc2, 0xa7, 0xe5, 0x38, 0xce, 0x55,
-	0xdd, 0x15, 0xe0, 0x63, 0x34, 0x7e, 0x24, 0x71, 0x57, 0x02, 0x63, 0x66, 0x23, 0x5d, 0x07, 0xc7,
-	0x13, 0x3d, 0x81, 0xe3, 0xa7, 0x71, 0xb7, 0x43, 0x5d, 0x1b, 0xfa, 0x2a, 0xa6, 0xf5, 0x15, 0x80,
-	0xe4, 0xc6, 0xd2, 0x17, 0xf0, 0x78, 0xac, 0xd3, 0x50, 0x29, 0x0f, 0x57, 0xca, 0xc9, 0x28, 0xc2,
-	0x42, 0x73, 0xaf, 0x11, 0x7b, 0x0e, 0xf2, 0x16, 0x2e, 0xa6, 0xf6, 0x07, 0x38, 0xcf, 0xc6, 0x70,
-	0x4a, 0x2d, 0x7a, 0x8c, 0x43, 0xbd, 0x09, 0x59, 0x3d, 0xe8, 0x3a, 0x25, 0xab, 0xcf, 0x46, 0xf1,
-	0x26, 0x58, 0x68, 0x6e, 0xc4, 0x41, 0x97, 0x21, 0x25, 0xa4, 0xf6, 0x0c, 0xc8, 0x2f, 0xc4, 0x90,
-	0x4f, 0x65, 0xf5, 0x1d, 0x87, 0xff, 0x55, 0x7c, 0x52, 0xc8, 0xcc, 0x25, 0xdd, 0x30, 0x68, 0x25,
-	0xe9, 0xc7, 0xf9, 0xa8, 0x1f, 0xd3, 0x69, 0x2c, 0x25, 0x5a, 0x73, 0x87, 0x6a, 0x20, 0x59, 0x65,
-	0x8f, 0x15, 0x2e, 0x9a, 0xa8, 0x67, 0xa7, 0xda, 0x1e, 0x2d, 0xee, 0xe2, 0xed, 0x26, 0x1e, 0x5f,
-	0xd2, 0xcc, 0x32, 0x35, 0x92, 0xae, 0xcd, 0x45, 0x5d, 0x9b, 0x68, 0x1e, 0x2c, 0xd1, 0x8a, 0xbb,
-	0x44, 0xe1, 0xad, 0x20, 0xbd, 0xef, 0x50, 0x36, 0x8c, 0xba, 0x32, 0x9d, 0xd9, 0x7b, 0xdc, 0x05,
-	0x05, 0xee, 0x1f, 0xc1, 0x30, 0xa2, 0xfb, 0x47, 0x29, 0x0a, 0x7f, 0xac, 0x79, 0x80, 0x58, 0x0b,
-	0x0e, 0xfd, 0x2b, 0x70, 0x4e, 0x16, 0xf7, 0x09, 0xb0, 0xcf, 0xc5, 0x60, 0x1f, 0x69, 0xd9, 0x6b,
-	0x0c, 0xf2, 0xdc, 0x5b, 0x93, 0x78, 0x37, 0xef, 0x9f, 0xac, 0xe2, 0x1e, 0xff, 0x25, 0x8e, 0xc4,
-	0xe3, 0x3e, 0xf9, 0xcc, 0x57, 0x98, 0x48, 0x37, 0xf0, 0x3b, 0x97, 0x0e, 0xbd, 0xf9, 0xf1, 0xbf,
-	0xde, 0xee, 0x3a, 0x40, 0x86, 0xe5, 0xe4, 0x9b, 0x26, 0xf9, 0x3d, 0xc2, 0x07, 0x84, 0x6a, 0x21,
-	0x99, 0x4d, 0x76, 0x9c, 0xf1, 0xfe, 0x57, 0x98, 0xdb, 0x4e, 0x13, 0x40, 0xf7, 0x32, 0x47, 0xf7,
-	0x59, 0xf2, 0xa2, 0xdc, 0xce, 0xeb, 0xac, 0x7c, 0x1f, 0x14, 0xd8, 0x07, 0xf2, 0xfd, 0x88, 0x3c,
-	0xf5, 0x80, 0xfc, 0x1c, 0xe1, 0xbc, 0x70, 0xa0, 0x8b, 0x86, 0x21, 0x72, 0x25, 0xe3, 0x69, 0x4c,
-	0xe4, 0x4a, 0xd6, 0xe3, 0x96, 0x34, 0xc3, 0x5d, 0x99, 0x22, 0x47, 0xdb, 0x72, 0x85, 0xfc, 0x19,
-	0xe1, 0xc9, 0x34, 0xc8, 0xa1, 0xec, 0x4b, 0xce, 0xb7, 0x0f, 0xa4, 0x59, 0xbf, 0x2e, 0x5c, 0xd8,
-	0x51, 0x5b, 0xf0, 0xe6, 0x14, 0xf7, 0xe6, 0x04, 0x99, 0x8e, 0x79, 0xc3, 0x27, 0x21, 0xaa, 0x3f,
-	0x37, 0x66, 0x84, 0xfc, 0x09, 0xe1, 0xfd, 0x49, 0x25, 0x6a, 0xa6, 0xbd, 0xa0, 0x08, 0x30, 0x97,
-	0xda, 0x35, 0x07, 0x98, 0x37, 0x39, 0x4c, 0x85, 0x2c, 0x65, 0x91, 0x2e, 0xdf, 0x87, 0x73, 0x22,
-	0x0b, 0x1d, 0xb8, 0xf7, 0xb1, 0x9f, 0xe1, 0x19, 0xb1, 0x39, 0xa4, 0x7e, 0x8d, 0xf0, 0x48, 0x62,
-	0x5c, 0x16, 0x4e, 0x33, 0xed, 0xd1, 0xda, 0xc2, 0xa3, 0x56, 0x8f, 0x53, 0xd2, 0x8b, 0xdc, 0xa3,
-	0xe7, 0xc9, 0x99, 0x1d, 0x79, 0x44, 0xbe, 0x83, 0xf0, 0x60, 0xf4, 0x19, 0x86, 0x21, 0x9e, 0x16,
-	0x42, 0x10, 0x3c, 0x2d, 0x15, 0x8e, 0xb7, 0x61, 0x09, 0x38, 0x4f, 0x72, 0x9c, 0xc7, 0xc8, 0x91,
-	0x64, 0x80, 0x04, 0x8f, 0x37, 0x91, 0xe0, 0x78, 0x1f, 0xe1, 0xa1, 0x98, 0x7e, 0xce, 0x70, 0x89,
-	0x47, 0x13, 0xbd, 0x1f, 0x14, 0x4e, 0xb4, 0x63, 0x0a, 0xc8, 0xce, 0x71, 0x64, 0x73, 0xe4, 0x94,
-	0x9c, 0xfe, 0x1f, 0x15, 0x62, 0xf2, 0xfe, 0xd8, 0x85, 0x0f, 0xa6, 0x6a, 0xb8, 0xe4, 0x8c, 0x30,
-	0x36, 0xb3, 0x84, 0xe6, 0xc2, 0xd9, 0xed, 0x36, 0x03, 0x37, 0x7e, 0x8b, 0xb8, 0x1f, 0xbf, 0x41,
-	0xb7, 0x6f, 0x91, 0xd7, 0x63, 0xae, 0xdc, 0xe1, 0xe9, 0x5b, 0xed, 0x44, 0x94, 0xdf, 0x8a, 0x75,
-	0xdc, 0x4a, 0x9a, 0xde, 0x76, 0xd7, 0xff, 0x46, 0x78, 0x2c, 0xd5, 0x4b, 0x36, 0xfd, 0x67, 0x84,
-	0x73, 0xba, 0x13, 0x3e, 0xdb, 0x91, 0xde, 0xa5, 0x37, 0x38, 0x9d, 0xaf, 0xdd, 0x3e, 0x4e, 0xa6,
-	0xda, 0x64, 0x93, 0x1c, 0x6f, 0x9b, 0x1d, 0xf2, 0x43, 0x84, 0x07, 0xa3, 0xb2, 0x68, 0xfa, 0xba,
-	0x13, 0x48, 0xbf, 0x29, 0xeb, 0x4e, 0x24, 0xd0, 0x4a, 0xcf, 0x73, 0x37, 0x66, 0x89, 0x2c, 0xa7,
-	0xfe, 0x43, 0x91, 0x38, 0xb8, 0x3f, 0x40, 0x78, 0x20, 0xda, 0xa3, 0x08, 0x9e, 0x58, 0x99, 0x16,
-	0xc1, 0x4b, 0xd1, 0x8f, 0xa5, 0xcf, 0x73, 0x78, 0x0b, 0x64, 0x7e, 0x9b, 0xf0, 0x9a, 0x22, 0xe9,
-	0x0e, 0xa5, 0x0f, 0xc8, 0x8f, 0x11, 0x1e, 0x11, 0x89, 0x92, 0xa2, 0x2d, 0xb8, 0x85, 0xd0, 0x2c,
-	0xda, 0x82, 0x5b, 0x69, 0x9d, 0x92, 0x2c, 0xdc, 0xda, 0x28, 0x34, 0x51, 0xab, 0xac, 0x8d, 0xba,
-	0x6a, 0xd9, 0xaa, 0xbb, 0xae, 0xd9, 0x5f, 0xef, 0x42, 0xe4, 0x97, 0x08, 0x8f, 0xa6, 0xe8, 0x50,
-	0xe4, 0x54, 0xfa, 0xe0, 0xe2, 0x9b, 0x4f, 0x61, 0x76, 0x1b, 0x2d, 0x00, 0xf1, 0x1c, 0x47, 0xdc,
-	0x1c, 0xd9, 0x21, 0x62, 0x9b, 0x35, 0x8b, 0x86, 0x2d, 0x03, 0xfd, 0x00, 0x77, 0xb3, 0x19, 0x24,
-	0x87, 0x05, 0x47, 0xc8, 0x86, 0xc2, 0x52, 0x18, 0x4f, 0xab, 0x86, 0xa1, 0xcf, 0xf2, 0xa1, 0x4f,
-	0x91, 0x52, 0x62, 0xc2, 0x63, 0xf3, 0x9c, 0x98, 0x5c, 0x07, 0xf7, 0x06, 0x52, 0x0b, 0x99, 0x14,
-	0x8f, 0x11, 0x91, 0x61, 0x32, 0x61, 0x3c, 0xcb, 0x61, 0x1c, 0x26, 0x87, 0x44, 0x30, 0x7c, 0xfd,
-	0xe6, 0x01, 0xf9, 0x26, 0x2c, 0x81, 0x50, 0x1e, 0x48, 0x5f, 0x02, 0x4d, 0xba, 0x47, 0x8b, 0x25,
-	0xd0, 0xac, 0x5c, 0x48, 0x53, 0x1c, 0xca, 0x24, 0x29, 0xca, 0xa9, 0xff, 0x13, 0x28, 0xdf, 0x67,
-	0x70, 0xbe, 0x01, 0x7b, 0x46, 0xd0, 0x43, 0xeb, 0x3d, 0xa3, 0x0d, 0x44, 0x29, 0x5a, 0x8a, 0x24,
-	0x71, 0x44, 0x63, 0xa4, 0x90, 0x8e, 0x88, 0xbc, 0x85, 0xf0, 0x60, 0x93, 0x24, 0x21, 0x02, 0x23,
-	0xd6, 0x3f, 0x44, 0x60, 0x52, 0xf4, 0x0d, 0xe9, 0x28, 0x07, 0x53, 0x24, 0x87, 0x63, 0x60, 0x5c,
-	0xb0, 0x56, 0xe1, 0xf0, 0x40, 0xde, 0x41, 0x98, 0x24, 0xd5, 0x07, 0xf2, 0x5c, 0xfa, 0x40, 0x09,
-	0xcd, 0xa3, 0x70, 0xb2, 0x3d, 0x63, 0x00, 0x36, 0xcd, 0x81, 0x49, 0x64, 0x42, 0x0c, 0x6c, 0xbd,
-	0x01, 0xe2, 0x03, 0x84, 0x47, 0x53, 0x44, 0x06, 0xd1, 0x7a, 0x6f, 0xad, 0x74, 0x88, 0xd6, 0x7b,
-	0x86, 0x82, 0x01, 0x3b, 0x54, 0xf3, 0x7a, 0x0f, 0xa1, 0x26, 0xd6, 0x3b, 0xf9, 0x0b, 0xc2, 0x13,
-	0x59, 0x2a, 0x02, 0x79, 0x21, 0x9b, 0xae, 0x14, 0x95, 0xa3, 0x70, 0x7e, 0x27, 0x4d, 0xc1, 0x99,
-	0x17, 0xb8, 0x33, 0xa7, 0xc9, 0x6c, 0x6b, 0xde, 0xd5, 0x64, 0xa2, 0x26, 0xbf, 0x42, 0x38, 0x9f,
-	0xa6, 0x24, 0x90, 0x16, 0xbc, 0xa6, 0x28, 0x1a, 0xa2, 0x7b, 0x5f, 0x96, 0x50, 0x91, 0x72, 0x53,
-	0x0a, 0xe1, 0x97, 0x79, 0xbb, 0x18, 0xea, 0xf7, 0x11, 0x1e, 0x11, 0x89, 0x08, 0xa2, 0xbc, 0xd6,
-	0x42, 0xc0, 0x10, 0xe5, 0xb5, 0x56, 0xda, 0x44, 0xca, 0x91, 0x3d, 0x44, 0x1a, 0xcf, 0x6b, 0xf3,
-	0x97, 0x3f, 0x7c, 0x34, 0x8e, 0x3e, 0x7a, 0x34, 0x8e, 0xfe, 0xf9, 0x68, 0x1c, 0x3d, 0x7c, 0x3c,
-	0xbe, 0xeb, 0xa3, 0xc7, 0xe3, 0xbb, 0xfe, 0xfa, 0x78, 0x7c, 0xd7, 0xed, 0x99, 0xec, 0xe7, 0xcc,
-	0x0d, 0xff, 0xcc, 0x5d, 0xb7, 0xa9, 0xbb, 0xdc, 0xc3, 0xdf, 0x91, 0x4e, 0xff, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x02, 0x04, 0x07, 0xcf, 0x91, 0x2e, 0x00, 0x00,
+func (m *QueryGetTriggerOrderRequest) Reset()         { *m = QueryGetTriggerOrderRequest{} }
+func (m *QueryGetTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTriggerOrderRequest) ProtoMessage()    {}
+func (*QueryGetTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{47}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a LimitOrderTrancheUser by index.
-	LimitOrderTrancheUser(ctx context.Context, in *QueryGetLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheUserResponse, error)
-	// Queries a list of LimitOrderTranchUser items.
-	LimitOrderTrancheUserAll(ctx context.Context, in *QueryAllLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserResponse, error)
-	// Queries a list of LimitOrderTrancheUser items for a given address.
-	LimitOrderTrancheUserAllByAddress(ctx context.Context, in *QueryAllLimitOrderTrancheUserByAddressRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserByAddressResponse, error)
-	// Queries a LimitOrderTranche by index.
-	LimitOrderTranche(ctx context.Context, in *QueryGetLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheResponse, error)
-	// Queries a list of LimitOrderTranche items for a given pairID / TokenIn
-	// combination.
-	LimitOrderTrancheAll(ctx context.Context, in *QueryAllLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheResponse, error)
-	// Queries a list of UserDeposits items.
-	UserDepositsAll(ctx context.Context, in *QueryAllUserDepositsRequest, opts ...grpc.CallOption) (*QueryAllUserDepositsResponse, error)
-	// Queries a list of TickLiquidity items.
-	TickLiquidityAll(ctx context.Context, in *QueryAllTickLiquidityRequest, opts ...grpc.CallOption) (*QueryAllTickLiquidityResponse, error)
-	// Queries a InactiveLimitOrderTranche by index.
-	InactiveLimitOrderTranche(ctx context.Context, in *QueryGetInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetInactiveLimitOrderTrancheResponse, error)
-	// Queries a list of InactiveLimitOrderTranche items.
-	InactiveLimitOrderTrancheAll(ctx context.Context, in *QueryAllInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllInactiveLimitOrderTrancheResponse, error)
-	// Queries a list of PoolReserves items.
-	PoolReservesAll(ctx context.Context, in *QueryAllPoolReservesRequest, opts ...grpc.CallOption) (*QueryAllPoolReservesResponse, error)
-	// Queries a PoolReserve by index
-	PoolReserves(ctx context.Context, in *QueryGetPoolReservesRequest, opts ...grpc.CallOption) (*QueryGetPoolReservesResponse, error)
-	// DEPRECATED Queries the simulated result of a multihop swap
-	EstimateMultiHopSwap(ctx context.Context, in *QueryEstimateMultiHopSwapRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopSwapResponse, error)
-	// DEPRECATED Queries the simulated result of a PlaceLimit order
-	EstimatePlaceLimitOrder(ctx context.Context, in *QueryEstimatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QueryEstimatePlaceLimitOrderResponse, error)
-	// Queries a pool by pair, tick and fee
-	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// Queries a pool by ID
-	PoolByID(ctx context.Context, in *QueryPoolByIDRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
-	// Queries a PoolMetadata by ID
-	PoolMetadata(ctx context.Context, in *QueryGetPoolMetadataRequest, opts ...grpc.CallOption) (*QueryGetPoolMetadataResponse, error)
-	// Queries a list of PoolMetadata items.
-	PoolMetadataAll(ctx context.Context, in *QueryAllPoolMetadataRequest, opts ...grpc.CallOption) (*QueryAllPoolMetadataResponse, error)
-	// Simulates MsgDeposit
-	SimulateDeposit(ctx context.Context, in *QuerySimulateDepositRequest, opts ...grpc.CallOption) (*QuerySimulateDepositResponse, error)
-	// Simulates MsgWithdrawal
-	SimulateWithdrawal(ctx context.Context, in *QuerySimulateWithdrawalRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawalResponse, error)
-	// Simulates MsgPlaceLimitOrder
-	SimulatePlaceLimitOrder(ctx context.Context, in *QuerySimulatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceLimitOrderResponse, error)
-	// Simulates MsgWithdrawFilledLimitOrder
-	SimulateWithdrawFilledLimitOrder(ctx context.Context, in *QuerySimulateWithdrawFilledLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawFilledLimitOrderResponse, error)
-	// Simulates MsgCancelLimitOrder
-	SimulateCancelLimitOrder(ctx context.Context, in *QuerySimulateCancelLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelLimitOrderResponse, error)
-	// Simulates MsgMultiHopSwap
-	SimulateMultiHopSwap(ctx context.Context, in *QuerySimulateMultiHopSwapRequest, opts ...grpc.CallOption) (*QuerySimulateMultiHopSwapResponse, error)
+func (m *QueryGetTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTriggerOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTriggerOrderRequest.Merge(m, src)
+}
+func (m *QueryGetTriggerOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTriggerOrderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetTriggerOrderRequest proto.InternalMessageInfo
+
+func (m *QueryGetTriggerOrderRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LimitOrderTrancheUser(ctx context.Context, in *QueryGetLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheUserResponse, error) {
-	out := new(QueryGetLimitOrderTrancheUserResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUser", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetTriggerOrderResponse struct {
+	TriggerOrder TriggerOrder `protobuf:"bytes,1,opt,name=trigger_order,json=triggerOrder,proto3" json:"trigger_order"`
 }
 
-func (c *queryClient) LimitOrderTrancheUserAll(ctx context.Context, in *QueryAllLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserResponse, error) {
-	out := new(QueryAllLimitOrderTrancheUserResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUserAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetTriggerOrderResponse) Reset()         { *m = QueryGetTriggerOrderResponse{} }
+func (m *QueryGetTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTriggerOrderResponse) ProtoMessage()    {}
+func (*QueryGetTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{48}
+}
+func (m *QueryGetTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTriggerOrderResponse.Merge(m, src)
+}
+func (m *QueryGetTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTriggerOrderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LimitOrderTrancheUserAllByAddress(ctx context.Context, in *QueryAllLimitOrderTrancheUserByAddressRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserByAddressResponse, error) {
-	out := new(QueryAllLimitOrderTrancheUserByAddressResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUserAllByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetTriggerOrderResponse proto.InternalMessageInfo
+
+func (m *QueryGetTriggerOrderResponse) GetTriggerOrder() TriggerOrder {
+	if m != nil {
+		return m.TriggerOrder
 	}
-	return out, nil
+	return TriggerOrder{}
 }
 
-func (c *queryClient) LimitOrderTranche(ctx context.Context, in *QueryGetLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheResponse, error) {
-	out := new(QueryGetLimitOrderTrancheResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTranche", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllTriggerOrderRequest struct {
+	PairId     string             `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	TokenIn    string             `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) LimitOrderTrancheAll(ctx context.Context, in *QueryAllLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheResponse, error) {
-	out := new(QueryAllLimitOrderTrancheResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTriggerOrderRequest) Reset()         { *m = QueryAllTriggerOrderRequest{} }
+func (m *QueryAllTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTriggerOrderRequest) ProtoMessage()    {}
+func (*QueryAllTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{49}
+}
+func (m *QueryAllTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTriggerOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTriggerOrderRequest.Merge(m, src)
+}
+func (m *QueryAllTriggerOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTriggerOrderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserDepositsAll(ctx context.Context, in *QueryAllUserDepositsRequest, opts ...grpc.CallOption) (*QueryAllUserDepositsResponse, error) {
-	out := new(QueryAllUserDepositsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/UserDepositsAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTriggerOrderRequest proto.InternalMessageInfo
+
+func (m *QueryAllTriggerOrderRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TickLiquidityAll(ctx context.Context, in *QueryAllTickLiquidityRequest, opts ...grpc.CallOption) (*QueryAllTickLiquidityResponse, error) {
-	out := new(QueryAllTickLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/TickLiquidityAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTriggerOrderRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InactiveLimitOrderTranche(ctx context.Context, in *QueryGetInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetInactiveLimitOrderTrancheResponse, error) {
-	out := new(QueryGetInactiveLimitOrderTrancheResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/InactiveLimitOrderTranche", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTriggerOrderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InactiveLimitOrderTrancheAll(ctx context.Context, in *QueryAllInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllInactiveLimitOrderTrancheResponse, error) {
-	out := new(QueryAllInactiveLimitOrderTrancheResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/InactiveLimitOrderTrancheAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllTriggerOrderResponse struct {
+	TriggerOrders []TriggerOrder      `protobuf:"bytes,1,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PoolReservesAll(ctx context.Context, in *QueryAllPoolReservesRequest, opts ...grpc.CallOption) (*QueryAllPoolReservesResponse, error) {
-	out := new(QueryAllPoolReservesResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolReservesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllTriggerOrderResponse) Reset()         { *m = QueryAllTriggerOrderResponse{} }
+func (m *QueryAllTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTriggerOrderResponse) ProtoMessage()    {}
+func (*QueryAllTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{50}
 }
-
-func (c *queryClient) PoolReserves(ctx context.Context, in *QueryGetPoolReservesRequest, opts ...grpc.CallOption) (*QueryGetPoolReservesResponse, error) {
-	out := new(QueryGetPoolReservesResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolReserves", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Deprecated: Do not use.
-func (c *queryClient) EstimateMultiHopSwap(ctx context.Context, in *QueryEstimateMultiHopSwapRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopSwapResponse, error) {
-	out := new(QueryEstimateMultiHopSwapResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/EstimateMultiHopSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTriggerOrderResponse.Merge(m, src)
+}
+func (m *QueryAllTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTriggerOrderResponse.DiscardUnknown(m)
 }
 
-// Deprecated: Do not use.
-func (c *queryClient) EstimatePlaceLimitOrder(ctx context.Context, in *QueryEstimatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QueryEstimatePlaceLimitOrderResponse, error) {
-	out := new(QueryEstimatePlaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/EstimatePlaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTriggerOrderResponse proto.InternalMessageInfo
+
+func (m *QueryAllTriggerOrderResponse) GetTriggerOrders() []TriggerOrder {
+	if m != nil {
+		return m.TriggerOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/Pool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllTriggerOrderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoolByID(ctx context.Context, in *QueryPoolByIDRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
-	out := new(QueryPoolResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolByID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySimulatePlaceTriggerOrderRequest struct {
+	Msg *MsgPlaceTriggerOrder `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (c *queryClient) PoolMetadata(ctx context.Context, in *QueryGetPoolMetadataRequest, opts ...grpc.CallOption) (*QueryGetPoolMetadataResponse, error) {
-	out := new(QueryGetPoolMetadataResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulatePlaceTriggerOrderRequest) Reset()         { *m = QuerySimulatePlaceTriggerOrderRequest{} }
+func (m *QuerySimulatePlaceTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePlaceTriggerOrderRequest) ProtoMessage()    {}
+func (*QuerySimulatePlaceTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{51}
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePlaceTriggerOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePlaceTriggerOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePlaceTriggerOrderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolMetadataAll(ctx context.Context, in *QueryAllPoolMetadataRequest, opts ...grpc.CallOption) (*QueryAllPoolMetadataResponse, error) {
-	out := new(QueryAllPoolMetadataResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolMetadataAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulatePlaceTriggerOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePlaceTriggerOrderRequest) GetMsg() *MsgPlaceTriggerOrder {
+	if m != nil {
+		return m.Msg
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SimulateDeposit(ctx context.Context, in *QuerySimulateDepositRequest, opts ...grpc.CallOption) (*QuerySimulateDepositResponse, error) {
-	out := new(QuerySimulateDepositResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySimulatePlaceTriggerOrderResponse struct {
+	Resp *MsgPlaceTriggerOrderResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
 
-func (c *queryClient) SimulateWithdrawal(ctx context.Context, in *QuerySimulateWithdrawalRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawalResponse, error) {
-	out := new(QuerySimulateWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulatePlaceTriggerOrderResponse) Reset() {
+	*m = QuerySimulatePlaceTriggerOrderResponse{}
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePlaceTriggerOrderResponse) ProtoMessage()    {}
+func (*QuerySimulatePlaceTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{52}
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePlaceTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePlaceTriggerOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePlaceTriggerOrderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SimulatePlaceLimitOrder(ctx context.Context, in *QuerySimulatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceLimitOrderResponse, error) {
-	out := new(QuerySimulatePlaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulatePlaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulatePlaceTriggerOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePlaceTriggerOrderResponse) GetResp() *MsgPlaceTriggerOrderResponse {
+	if m != nil {
+		return m.Resp
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SimulateWithdrawFilledLimitOrder(ctx context.Context, in *QuerySimulateWithdrawFilledLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawFilledLimitOrderResponse, error) {
-	out := new(QuerySimulateWithdrawFilledLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateWithdrawFilledLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QuerySimulateCancelTriggerOrderRequest struct {
+	Msg *MsgCancelTriggerOrder `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (c *queryClient) SimulateCancelLimitOrder(ctx context.Context, in *QuerySimulateCancelLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelLimitOrderResponse, error) {
-	out := new(QuerySimulateCancelLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateCancelLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateCancelTriggerOrderRequest) Reset() {
+	*m = QuerySimulateCancelTriggerOrderRequest{}
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCancelTriggerOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateCancelTriggerOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{53}
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCancelTriggerOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCancelTriggerOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCancelTriggerOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCancelTriggerOrderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SimulateMultiHopSwap(ctx context.Context, in *QuerySimulateMultiHopSwapRequest, opts ...grpc.CallOption) (*QuerySimulateMultiHopSwapResponse, error) {
-	out := new(QuerySimulateMultiHopSwapResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateMultiHopSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateCancelTriggerOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateCancelTriggerOrderRequest) GetMsg() *MsgCancelTriggerOrder {
+	if m != nil {
+		return m.Msg
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a LimitOrderTrancheUser by index.
-	LimitOrderTrancheUser(context.Context, *QueryGetLimitOrderTrancheUserRequest) (*QueryGetLimitOrderTrancheUserResponse, error)
-	// Queries a list of LimitOrderTranchUser items.
-	LimitOrderTrancheUserAll(context.Context, *QueryAllLimitOrderTrancheUserRequest) (*QueryAllLimitOrderTrancheUserResponse, error)
-	// Queries a list of LimitOrderTrancheUser items for a given address.
-	LimitOrderTrancheUserAllByAddress(context.Context, *QueryAllLimitOrderTrancheUserByAddressRequest) (*QueryAllLimitOrderTrancheUserByAddressResponse, error)
-	// Queries a LimitOrderTranche by index.
-	LimitOrderTranche(context.Context, *QueryGetLimitOrderTrancheRequest) (*QueryGetLimitOrderTrancheResponse, error)
-	// Queries a list of LimitOrderTranche items for a given pairID / TokenIn
-	// combination.
-	LimitOrderTrancheAll(context.Context, *QueryAllLimitOrderTrancheRequest) (*QueryAllLimitOrderTrancheResponse, error)
-	// Queries a list of UserDeposits items.
-	UserDepositsAll(context.Context, *QueryAllUserDepositsRequest) (*QueryAllUserDepositsResponse, error)
-	// Queries a list of TickLiquidity items.
-	TickLiquidityAll(context.Context, *QueryAllTickLiquidityRequest) (*QueryAllTickLiquidityResponse, error)
-	// Queries a InactiveLimitOrderTranche by index.
-	InactiveLimitOrderTranche(context.Context, *QueryGetInactiveLimitOrderTrancheRequest) (*QueryGetInactiveLimitOrderTrancheResponse, error)
-	// Queries a list of InactiveLimitOrderTranche items.
-	InactiveLimitOrderTrancheAll(context.Context, *QueryAllInactiveLimitOrderTrancheRequest) (*QueryAllInactiveLimitOrderTrancheResponse, error)
-	// Queries a list of PoolReserves items.
-	PoolReservesAll(context.Context, *QueryAllPoolReservesRequest) (*QueryAllPoolReservesResponse, error)
-	// Queries a PoolReserve by index
-	PoolReserves(context.Context, *QueryGetPoolReservesRequest) (*QueryGetPoolReservesResponse, error)
-	// DEPRECATED Queries the simulated result of a multihop swap
-	EstimateMultiHopSwap(context.Context, *QueryEstimateMultiHopSwapRequest) (*QueryEstimateMultiHopSwapResponse, error)
-	// DEPRECATED Queries the simulated result of a PlaceLimit order
-	EstimatePlaceLimitOrder(context.Context, *QueryEstimatePlaceLimitOrderRequest) (*QueryEstimatePlaceLimitOrderResponse, error)
-	// Queries a pool by pair, tick and fee
-	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
-	// Queries a pool by ID
-	PoolByID(context.Context, *QueryPoolByIDRequest) (*QueryPoolResponse, error)
-	// Queries a PoolMetadata by ID
-	PoolMetadata(context.Context, *QueryGetPoolMetadataRequest) (*QueryGetPoolMetadataResponse, error)
-	// Queries a list of PoolMetadata items.
-	PoolMetadataAll(context.Context, *QueryAllPoolMetadataRequest) (*QueryAllPoolMetadataResponse, error)
-	// Simulates MsgDeposit
-	SimulateDeposit(context.Context, *QuerySimulateDepositRequest) (*QuerySimulateDepositResponse, error)
-	// Simulates MsgWithdrawal
-	SimulateWithdrawal(context.Context, *QuerySimulateWithdrawalRequest) (*QuerySimulateWithdrawalResponse, error)
-	// Simulates MsgPlaceLimitOrder
-	SimulatePlaceLimitOrder(context.Context, *QuerySimulatePlaceLimitOrderRequest) (*QuerySimulatePlaceLimitOrderResponse, error)
-	// Simulates MsgWithdrawFilledLimitOrder
-	SimulateWithdrawFilledLimitOrder(context.Context, *QuerySimulateWithdrawFilledLimitOrderRequest) (*QuerySimulateWithdrawFilledLimitOrderResponse, error)
-	// Simulates MsgCancelLimitOrder
-	SimulateCancelLimitOrder(context.Context, *QuerySimulateCancelLimitOrderRequest) (*QuerySimulateCancelLimitOrderResponse, error)
-	// Simulates MsgMultiHopSwap
-	SimulateMultiHopSwap(context.Context, *QuerySimulateMultiHopSwapRequest) (*QuerySimulateMultiHopSwapResponse, error)
+type QuerySimulateCancelTriggerOrderResponse struct {
+	Resp *MsgCancelTriggerOrderResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySimulateCancelTriggerOrderResponse) Reset() {
+	*m = QuerySimulateCancelTriggerOrderResponse{}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCancelTriggerOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateCancelTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{54}
 }
-func (*UnimplementedQueryServer) LimitOrderTrancheUser(ctx context.Context, req *QueryGetLimitOrderTrancheUserRequest) (*QueryGetLimitOrderTrancheUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUser not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LimitOrderTrancheUserAll(ctx context.Context, req *QueryAllLimitOrderTrancheUserRequest) (*QueryAllLimitOrderTrancheUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUserAll not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCancelTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LimitOrderTrancheUserAllByAddress(ctx context.Context, req *QueryAllLimitOrderTrancheUserByAddressRequest) (*QueryAllLimitOrderTrancheUserByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUserAllByAddress not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCancelTriggerOrderResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LimitOrderTranche(ctx context.Context, req *QueryGetLimitOrderTrancheRequest) (*QueryGetLimitOrderTrancheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTranche not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LimitOrderTrancheAll(ctx context.Context, req *QueryAllLimitOrderTrancheRequest) (*QueryAllLimitOrderTrancheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheAll not implemented")
+func (m *QuerySimulateCancelTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCancelTriggerOrderResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) UserDepositsAll(ctx context.Context, req *QueryAllUserDepositsRequest) (*QueryAllUserDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserDepositsAll not implemented")
+
+var xxx_messageInfo_QuerySimulateCancelTriggerOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateCancelTriggerOrderResponse) GetResp() *MsgCancelTriggerOrderResponse {
+	if m != nil {
+		return m.Resp
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) TickLiquidityAll(ctx context.Context, req *QueryAllTickLiquidityRequest) (*QueryAllTickLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TickLiquidityAll not implemented")
+
+type QuerySimulateBatchLimitOrdersRequest struct {
+	Msg *MsgBatchLimitOrders `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
-func (*UnimplementedQueryServer) InactiveLimitOrderTranche(ctx context.Context, req *QueryGetInactiveLimitOrderTrancheRequest) (*QueryGetInactiveLimitOrderTrancheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InactiveLimitOrderTranche not implemented")
-}
-func (*UnimplementedQueryServer) InactiveLimitOrderTrancheAll(ctx context.Context, req *QueryAllInactiveLimitOrderTrancheRequest) (*QueryAllInactiveLimitOrderTrancheResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InactiveLimitOrderTrancheAll not implemented")
+
+func (m *QuerySimulateBatchLimitOrdersRequest) Reset()         { *m = QuerySimulateBatchLimitOrdersRequest{} }
+func (m *QuerySimulateBatchLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBatchLimitOrdersRequest) ProtoMessage()    {}
+func (*QuerySimulateBatchLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{55}
 }
-func (*UnimplementedQueryServer) PoolReservesAll(ctx context.Context, req *QueryAllPoolReservesRequest) (*QueryAllPoolReservesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolReservesAll not implemented")
+func (m *QuerySimulateBatchLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PoolReserves(ctx context.Context, req *QueryGetPoolReservesRequest) (*QueryGetPoolReservesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolReserves not implemented")
+func (m *QuerySimulateBatchLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBatchLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EstimateMultiHopSwap(ctx context.Context, req *QueryEstimateMultiHopSwapRequest) (*QueryEstimateMultiHopSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateMultiHopSwap not implemented")
+func (m *QuerySimulateBatchLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBatchLimitOrdersRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimatePlaceLimitOrder(ctx context.Context, req *QueryEstimatePlaceLimitOrderRequest) (*QueryEstimatePlaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimatePlaceLimitOrder not implemented")
+func (m *QuerySimulateBatchLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+func (m *QuerySimulateBatchLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBatchLimitOrdersRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PoolByID(ctx context.Context, req *QueryPoolByIDRequest) (*QueryPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolByID not implemented")
+
+var xxx_messageInfo_QuerySimulateBatchLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateBatchLimitOrdersRequest) GetMsg() *MsgBatchLimitOrders {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PoolMetadata(ctx context.Context, req *QueryGetPoolMetadataRequest) (*QueryGetPoolMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolMetadata not implemented")
+
+type QuerySimulateBatchLimitOrdersResponse struct {
+	Resp *MsgBatchLimitOrdersResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
-func (*UnimplementedQueryServer) PoolMetadataAll(ctx context.Context, req *QueryAllPoolMetadataRequest) (*QueryAllPoolMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolMetadataAll not implemented")
+
+func (m *QuerySimulateBatchLimitOrdersResponse) Reset()         { *m = QuerySimulateBatchLimitOrdersResponse{} }
+func (m *QuerySimulateBatchLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBatchLimitOrdersResponse) ProtoMessage()    {}
+func (*QuerySimulateBatchLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{56}
 }
-func (*UnimplementedQueryServer) SimulateDeposit(ctx context.Context, req *QuerySimulateDepositRequest) (*QuerySimulateDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateDeposit not implemented")
+func (m *QuerySimulateBatchLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SimulateWithdrawal(ctx context.Context, req *QuerySimulateWithdrawalRequest) (*QuerySimulateWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithdrawal not implemented")
+func (m *QuerySimulateBatchLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBatchLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SimulatePlaceLimitOrder(ctx context.Context, req *QuerySimulatePlaceLimitOrderRequest) (*QuerySimulatePlaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulatePlaceLimitOrder not implemented")
+func (m *QuerySimulateBatchLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBatchLimitOrdersResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SimulateWithdrawFilledLimitOrder(ctx context.Context, req *QuerySimulateWithdrawFilledLimitOrderRequest) (*QuerySimulateWithdrawFilledLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithdrawFilledLimitOrder not implemented")
+func (m *QuerySimulateBatchLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SimulateCancelLimitOrder(ctx context.Context, req *QuerySimulateCancelLimitOrderRequest) (*QuerySimulateCancelLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateCancelLimitOrder not implemented")
+func (m *QuerySimulateBatchLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBatchLimitOrdersResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SimulateMultiHopSwap(ctx context.Context, req *QuerySimulateMultiHopSwapRequest) (*QuerySimulateMultiHopSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateMultiHopSwap not implemented")
+
+var xxx_messageInfo_QuerySimulateBatchLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateBatchLimitOrdersResponse) GetResp() *MsgBatchLimitOrdersResponse {
+	if m != nil {
+		return m.Resp
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QuerySimulateAmendLimitOrderRequest struct {
+	Msg *MsgAmendLimitOrder `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySimulateAmendLimitOrderRequest) Reset()         { *m = QuerySimulateAmendLimitOrderRequest{} }
+func (m *QuerySimulateAmendLimitOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAmendLimitOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateAmendLimitOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{57}
+}
+func (m *QuerySimulateAmendLimitOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAmendLimitOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAmendLimitOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulateAmendLimitOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAmendLimitOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulateAmendLimitOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAmendLimitOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAmendLimitOrderRequest.DiscardUnknown(m)
 }
 
-func _Query_LimitOrderTrancheUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLimitOrderTrancheUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LimitOrderTrancheUser(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUser",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LimitOrderTrancheUser(ctx, req.(*QueryGetLimitOrderTrancheUserRequest))
+var xxx_messageInfo_QuerySimulateAmendLimitOrderRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateAmendLimitOrderRequest) GetMsg() *MsgAmendLimitOrder {
+	if m != nil {
+		return m.Msg
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LimitOrderTrancheUserAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLimitOrderTrancheUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LimitOrderTrancheUserAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUserAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LimitOrderTrancheUserAll(ctx, req.(*QueryAllLimitOrderTrancheUserRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QuerySimulateAmendLimitOrderResponse struct {
+	Resp *MsgAmendLimitOrderResponse `protobuf:"bytes,1,opt,name=resp,proto3" json:"resp,omitempty"`
 }
 
-func _Query_LimitOrderTrancheUserAllByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLimitOrderTrancheUserByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LimitOrderTrancheUserAllByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUserAllByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LimitOrderTrancheUserAllByAddress(ctx, req.(*QueryAllLimitOrderTrancheUserByAddressRequest))
+func (m *QuerySimulateAmendLimitOrderResponse) Reset()         { *m = QuerySimulateAmendLimitOrderResponse{} }
+func (m *QuerySimulateAmendLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateAmendLimitOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateAmendLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{58}
+}
+func (m *QuerySimulateAmendLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateAmendLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateAmendLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulateAmendLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateAmendLimitOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulateAmendLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateAmendLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateAmendLimitOrderResponse.DiscardUnknown(m)
 }
 
-func _Query_LimitOrderTranche_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLimitOrderTrancheRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LimitOrderTranche(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/LimitOrderTranche",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LimitOrderTranche(ctx, req.(*QueryGetLimitOrderTrancheRequest))
+var xxx_messageInfo_QuerySimulateAmendLimitOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateAmendLimitOrderResponse) GetResp() *MsgAmendLimitOrderResponse {
+	if m != nil {
+		return m.Resp
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LimitOrderTrancheAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLimitOrderTrancheRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LimitOrderTrancheAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/LimitOrderTrancheAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LimitOrderTrancheAll(ctx, req.(*QueryAllLimitOrderTrancheRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProtocolFeeAccrualRequest struct {
+	PairId string `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
 }
 
-func _Query_UserDepositsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllUserDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserDepositsAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/UserDepositsAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserDepositsAll(ctx, req.(*QueryAllUserDepositsRequest))
+func (m *QueryGetProtocolFeeAccrualRequest) Reset()         { *m = QueryGetProtocolFeeAccrualRequest{} }
+func (m *QueryGetProtocolFeeAccrualRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtocolFeeAccrualRequest) ProtoMessage()    {}
+func (*QueryGetProtocolFeeAccrualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{59}
+}
+func (m *QueryGetProtocolFeeAccrualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtocolFeeAccrualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtocolFeeAccrualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtocolFeeAccrualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtocolFeeAccrualRequest.Merge(m, src)
+}
+func (m *QueryGetProtocolFeeAccrualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtocolFeeAccrualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtocolFeeAccrualRequest.DiscardUnknown(m)
 }
 
-func _Query_TickLiquidityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTickLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TickLiquidityAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/TickLiquidityAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TickLiquidityAll(ctx, req.(*QueryAllTickLiquidityRequest))
+var xxx_messageInfo_QueryGetProtocolFeeAccrualRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtocolFeeAccrualRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_InactiveLimitOrderTranche_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInactiveLimitOrderTrancheRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InactiveLimitOrderTranche(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/InactiveLimitOrderTranche",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InactiveLimitOrderTranche(ctx, req.(*QueryGetInactiveLimitOrderTrancheRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetProtocolFeeAccrualResponse struct {
+	ProtocolFeeAccrual ProtocolFeeAccrual `protobuf:"bytes,1,opt,name=protocol_fee_accrual,json=protocolFeeAccrual,proto3" json:"protocol_fee_accrual"`
 }
 
-func _Query_InactiveLimitOrderTrancheAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInactiveLimitOrderTrancheRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InactiveLimitOrderTrancheAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/InactiveLimitOrderTrancheAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InactiveLimitOrderTrancheAll(ctx, req.(*QueryAllInactiveLimitOrderTrancheRequest))
+func (m *QueryGetProtocolFeeAccrualResponse) Reset()         { *m = QueryGetProtocolFeeAccrualResponse{} }
+func (m *QueryGetProtocolFeeAccrualResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtocolFeeAccrualResponse) ProtoMessage()    {}
+func (*QueryGetProtocolFeeAccrualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{60}
+}
+func (m *QueryGetProtocolFeeAccrualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtocolFeeAccrualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtocolFeeAccrualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtocolFeeAccrualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtocolFeeAccrualResponse.Merge(m, src)
+}
+func (m *QueryGetProtocolFeeAccrualResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtocolFeeAccrualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtocolFeeAccrualResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolReservesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPoolReservesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolReservesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/PoolReservesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolReservesAll(ctx, req.(*QueryAllPoolReservesRequest))
+var xxx_messageInfo_QueryGetProtocolFeeAccrualResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtocolFeeAccrualResponse) GetProtocolFeeAccrual() ProtocolFeeAccrual {
+	if m != nil {
+		return m.ProtocolFeeAccrual
 	}
-	return interceptor(ctx, in, info, handler)
+	return ProtocolFeeAccrual{}
 }
 
-func _Query_PoolReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolReservesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolReserves(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/PoolReserves",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolReserves(ctx, req.(*QueryGetPoolReservesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllProtocolFeeAccrualRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_EstimateMultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateMultiHopSwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateMultiHopSwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/EstimateMultiHopSwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateMultiHopSwap(ctx, req.(*QueryEstimateMultiHopSwapRequest))
+func (m *QueryAllProtocolFeeAccrualRequest) Reset()         { *m = QueryAllProtocolFeeAccrualRequest{} }
+func (m *QueryAllProtocolFeeAccrualRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProtocolFeeAccrualRequest) ProtoMessage()    {}
+func (*QueryAllProtocolFeeAccrualRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{61}
+}
+func (m *QueryAllProtocolFeeAccrualRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProtocolFeeAccrualRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProtocolFeeAccrualRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllProtocolFeeAccrualRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProtocolFeeAccrualRequest.Merge(m, src)
+}
+func (m *QueryAllProtocolFeeAccrualRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProtocolFeeAccrualRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProtocolFeeAccrualRequest.DiscardUnknown(m)
 }
 
-func _Query_EstimatePlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimatePlaceLimitOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimatePlaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/EstimatePlaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimatePlaceLimitOrder(ctx, req.(*QueryEstimatePlaceLimitOrderRequest))
+var xxx_messageInfo_QueryAllProtocolFeeAccrualRequest proto.InternalMessageInfo
+
+func (m *QueryAllProtocolFeeAccrualRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/Pool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllProtocolFeeAccrualResponse struct {
+	ProtocolFeeAccrual []ProtocolFeeAccrual `protobuf:"bytes,1,rep,name=protocol_fee_accrual,json=protocolFeeAccrual,proto3" json:"protocol_fee_accrual"`
+	Pagination         *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PoolByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/PoolByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolByID(ctx, req.(*QueryPoolByIDRequest))
+func (m *QueryAllProtocolFeeAccrualResponse) Reset()         { *m = QueryAllProtocolFeeAccrualResponse{} }
+func (m *QueryAllProtocolFeeAccrualResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllProtocolFeeAccrualResponse) ProtoMessage()    {}
+func (*QueryAllProtocolFeeAccrualResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{62}
+}
+func (m *QueryAllProtocolFeeAccrualResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllProtocolFeeAccrualResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllProtocolFeeAccrualResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllProtocolFeeAccrualResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllProtocolFeeAccrualResponse.Merge(m, src)
+}
+func (m *QueryAllProtocolFeeAccrualResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllProtocolFeeAccrualResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllProtocolFeeAccrualResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoolMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/PoolMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolMetadata(ctx, req.(*QueryGetPoolMetadataRequest))
+var xxx_messageInfo_QueryAllProtocolFeeAccrualResponse proto.InternalMessageInfo
+
+func (m *QueryAllProtocolFeeAccrualResponse) GetProtocolFeeAccrual() []ProtocolFeeAccrual {
+	if m != nil {
+		return m.ProtocolFeeAccrual
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PoolMetadataAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPoolMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolMetadataAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/PoolMetadataAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolMetadataAll(ctx, req.(*QueryAllPoolMetadataRequest))
+func (m *QueryAllProtocolFeeAccrualResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SimulateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateDeposit(ctx, in)
+type QueryArithmeticTwapRequest struct {
+	PairId    string    `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	BaseDenom string    `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defaults to the current block time if not set
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryArithmeticTwapRequest) Reset()         { *m = QueryArithmeticTwapRequest{} }
+func (m *QueryArithmeticTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArithmeticTwapRequest) ProtoMessage()    {}
+func (*QueryArithmeticTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{63}
+}
+func (m *QueryArithmeticTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArithmeticTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArithmeticTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulateDeposit",
+}
+func (m *QueryArithmeticTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArithmeticTwapRequest.Merge(m, src)
+}
+func (m *QueryArithmeticTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArithmeticTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArithmeticTwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArithmeticTwapRequest proto.InternalMessageInfo
+
+func (m *QueryArithmeticTwapRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateDeposit(ctx, req.(*QuerySimulateDepositRequest))
+	return ""
+}
+
+func (m *QueryArithmeticTwapRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SimulateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateWithdrawalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryArithmeticTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateWithdrawal(ctx, in)
+	return time.Time{}
+}
+
+func (m *QueryArithmeticTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulateWithdrawal",
+	return nil
+}
+
+type QueryArithmeticTwapResponse struct {
+	ArithmeticTwap github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,1,opt,name=arithmetic_twap,json=arithmeticTwap,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"arithmetic_twap" yaml:"arithmetic_twap"`
+}
+
+func (m *QueryArithmeticTwapResponse) Reset()         { *m = QueryArithmeticTwapResponse{} }
+func (m *QueryArithmeticTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArithmeticTwapResponse) ProtoMessage()    {}
+func (*QueryArithmeticTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{64}
+}
+func (m *QueryArithmeticTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArithmeticTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArithmeticTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateWithdrawal(ctx, req.(*QuerySimulateWithdrawalRequest))
+}
+func (m *QueryArithmeticTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArithmeticTwapResponse.Merge(m, src)
+}
+func (m *QueryArithmeticTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArithmeticTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArithmeticTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArithmeticTwapResponse proto.InternalMessageInfo
+
+type QueryGeometricTwapRequest struct {
+	PairId    string    `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	BaseDenom string    `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defaults to the current block time if not set
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryGeometricTwapRequest) Reset()         { *m = QueryGeometricTwapRequest{} }
+func (m *QueryGeometricTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGeometricTwapRequest) ProtoMessage()    {}
+func (*QueryGeometricTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{65}
+}
+func (m *QueryGeometricTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGeometricTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGeometricTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGeometricTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGeometricTwapRequest.Merge(m, src)
+}
+func (m *QueryGeometricTwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGeometricTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGeometricTwapRequest.DiscardUnknown(m)
 }
 
-func _Query_SimulatePlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulatePlaceLimitOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGeometricTwapRequest proto.InternalMessageInfo
+
+func (m *QueryGeometricTwapRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulatePlaceLimitOrder(ctx, in)
+	return ""
+}
+
+func (m *QueryGeometricTwapRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulatePlaceLimitOrder",
+	return ""
+}
+
+func (m *QueryGeometricTwapRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulatePlaceLimitOrder(ctx, req.(*QuerySimulatePlaceLimitOrderRequest))
+	return time.Time{}
+}
+
+func (m *QueryGeometricTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SimulateWithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateWithdrawFilledLimitOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryGeometricTwapResponse struct {
+	GeometricTwap github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,1,opt,name=geometric_twap,json=geometricTwap,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"geometric_twap" yaml:"geometric_twap"`
+}
+
+func (m *QueryGeometricTwapResponse) Reset()         { *m = QueryGeometricTwapResponse{} }
+func (m *QueryGeometricTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGeometricTwapResponse) ProtoMessage()    {}
+func (*QueryGeometricTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{66}
+}
+func (m *QueryGeometricTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGeometricTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGeometricTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateWithdrawFilledLimitOrder(ctx, in)
+}
+func (m *QueryGeometricTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGeometricTwapResponse.Merge(m, src)
+}
+func (m *QueryGeometricTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGeometricTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGeometricTwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGeometricTwapResponse proto.InternalMessageInfo
+
+type QueryPairStatsRequest struct {
+	PairId string `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+}
+
+func (m *QueryPairStatsRequest) Reset()         { *m = QueryPairStatsRequest{} }
+func (m *QueryPairStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPairStatsRequest) ProtoMessage()    {}
+func (*QueryPairStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{67}
+}
+func (m *QueryPairStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulateWithdrawFilledLimitOrder",
+}
+func (m *QueryPairStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairStatsRequest.Merge(m, src)
+}
+func (m *QueryPairStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPairStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPairStatsRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateWithdrawFilledLimitOrder(ctx, req.(*QuerySimulateWithdrawFilledLimitOrderRequest))
+	return ""
+}
+
+type QueryPairStatsResponse struct {
+	PairStats PairStats `protobuf:"bytes,1,opt,name=pair_stats,json=pairStats,proto3" json:"pair_stats"`
+}
+
+func (m *QueryPairStatsResponse) Reset()         { *m = QueryPairStatsResponse{} }
+func (m *QueryPairStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPairStatsResponse) ProtoMessage()    {}
+func (*QueryPairStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{68}
+}
+func (m *QueryPairStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPairStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPairStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPairStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPairStatsResponse.Merge(m, src)
+}
+func (m *QueryPairStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPairStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPairStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_SimulateCancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateCancelLimitOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPairStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPairStatsResponse) GetPairStats() PairStats {
+	if m != nil {
+		return m.PairStats
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateCancelLimitOrder(ctx, in)
+	return PairStats{}
+}
+
+type QueryCandlesRequest struct {
+	PairId   string         `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Interval CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=neutron.dex.CandleInterval" json:"interval,omitempty"`
+	// Only candles starting at or after start_time are returned if set
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// Only candles starting before end_time are returned if set
+	EndTime    *time.Time         `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{69}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulateCancelLimitOrder",
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetPairId() string {
+	if m != nil {
+		return m.PairId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateCancelLimitOrder(ctx, req.(*QuerySimulateCancelLimitOrderRequest))
+	return ""
+}
+
+func (m *QueryCandlesRequest) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
 	}
-	return interceptor(ctx, in, info, handler)
+	return CandleInterval_ONE_MINUTE
 }
 
-func _Query_SimulateMultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimulateMultiHopSwapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryCandlesRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimulateMultiHopSwap(ctx, in)
+	return nil
+}
+
+func (m *QueryCandlesRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Query/SimulateMultiHopSwap",
+	return nil
+}
+
+func (m *QueryCandlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimulateMultiHopSwap(ctx, req.(*QuerySimulateMultiHopSwapRequest))
+	return nil
+}
+
+type QueryCandlesResponse struct {
+	Candles    []Candle            `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6613ea5fce61e9c, []int{70}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.dex.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LimitOrderTrancheUser",
-			Handler:    _Query_LimitOrderTrancheUser_Handler,
-		},
-		{
-			MethodName: "LimitOrderTrancheUserAll",
-			Handler:    _Query_LimitOrderTrancheUserAll_Handler,
-		},
-		{
-			MethodName: "LimitOrderTrancheUserAllByAddress",
-			Handler:    _Query_LimitOrderTrancheUserAllByAddress_Handler,
-		},
-		{
-			MethodName: "LimitOrderTranche",
-			Handler:    _Query_LimitOrderTranche_Handler,
-		},
-		{
-			MethodName: "LimitOrderTrancheAll",
-			Handler:    _Query_LimitOrderTrancheAll_Handler,
-		},
-		{
-			MethodName: "UserDepositsAll",
-			Handler:    _Query_UserDepositsAll_Handler,
-		},
-		{
-			MethodName: "TickLiquidityAll",
-			Handler:    _Query_TickLiquidityAll_Handler,
-		},
-		{
-			MethodName: "InactiveLimitOrderTranche",
-			Handler:    _Query_InactiveLimitOrderTranche_Handler,
-		},
-		{
-			MethodName: "InactiveLimitOrderTrancheAll",
-			Handler:    _Query_InactiveLimitOrderTrancheAll_Handler,
-		},
-		{
-			MethodName: "PoolReservesAll",
-			Handler:    _Query_PoolReservesAll_Handler,
-		},
-		{
-			MethodName: "PoolReserves",
-			Handler:    _Query_PoolReserves_Handler,
-		},
-		{
-			MethodName: "EstimateMultiHopSwap",
-			Handler:    _Query_EstimateMultiHopSwap_Handler,
-		},
-		{
-			MethodName: "EstimatePlaceLimitOrder",
-			Handler:    _Query_EstimatePlaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "PoolByID",
-			Handler:    _Query_PoolByID_Handler,
-		},
-		{
-			MethodName: "PoolMetadata",
-			Handler:    _Query_PoolMetadata_Handler,
-		},
-		{
-			MethodName: "PoolMetadataAll",
-			Handler:    _Query_PoolMetadataAll_Handler,
-		},
-		{
-			MethodName: "SimulateDeposit",
-			Handler:    _Query_SimulateDeposit_Handler,
-		},
-		{
-			MethodName: "SimulateWithdrawal",
-			Handler:    _Query_SimulateWithdrawal_Handler,
-		},
-		{
-			MethodName: "SimulatePlaceLimitOrder",
-			Handler:    _Query_SimulatePlaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "SimulateWithdrawFilledLimitOrder",
-			Handler:    _Query_SimulateWithdrawFilledLimitOrder_Handler,
-		},
-		{
-			MethodName: "SimulateCancelLimitOrder",
-			Handler:    _Query_SimulateCancelLimitOrder_Handler,
-		},
-		{
-			MethodName: "SimulateMultiHopSwap",
-			Handler:    _Query_SimulateMultiHopSwap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/dex/query.proto",
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.dex.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.dex.QueryParamsResponse")
+	proto.RegisterType((*QueryGetLimitOrderTrancheUserRequest)(nil), "neutron.dex.QueryGetLimitOrderTrancheUserRequest")
+	proto.RegisterType((*QueryGetLimitOrderTrancheUserResponse)(nil), "neutron.dex.QueryGetLimitOrderTrancheUserResponse")
+	proto.RegisterType((*QueryAllLimitOrderTrancheUserRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserRequest")
+	proto.RegisterType((*QueryAllLimitOrderTrancheUserResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserResponse")
+	proto.RegisterType((*QueryGetLimitOrderTrancheRequest)(nil), "neutron.dex.QueryGetLimitOrderTrancheRequest")
+	proto.RegisterType((*QueryGetLimitOrderTrancheResponse)(nil), "neutron.dex.QueryGetLimitOrderTrancheResponse")
+	proto.RegisterType((*QueryAllLimitOrderTrancheRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheRequest")
+	proto.RegisterType((*QueryAllLimitOrderTrancheResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheResponse")
+	proto.RegisterType((*QueryAllUserDepositsRequest)(nil), "neutron.dex.QueryAllUserDepositsRequest")
+	proto.RegisterType((*QueryAllUserDepositsResponse)(nil), "neutron.dex.QueryAllUserDepositsResponse")
+	proto.RegisterType((*QueryAllLimitOrderTrancheUserByAddressRequest)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserByAddressRequest")
+	proto.RegisterType((*QueryAllLimitOrderTrancheUserByAddressResponse)(nil), "neutron.dex.QueryAllLimitOrderTrancheUserByAddressResponse")
+	proto.RegisterType((*QueryAllTickLiquidityRequest)(nil), "neutron.dex.QueryAllTickLiquidityRequest")
+	proto.RegisterType((*QueryAllTickLiquidityResponse)(nil), "neutron.dex.QueryAllTickLiquidityResponse")
+	proto.RegisterType((*QueryGetInactiveLimitOrderTrancheRequest)(nil), "neutron.dex.QueryGetInactiveLimitOrderTrancheRequest")
+	proto.RegisterType((*QueryGetInactiveLimitOrderTrancheResponse)(nil), "neutron.dex.QueryGetInactiveLimitOrderTrancheResponse")
+	proto.RegisterType((*QueryAllInactiveLimitOrderTrancheRequest)(nil), "neutron.dex.QueryAllInactiveLimitOrderTrancheRequest")
+	proto.RegisterType((*QueryAllInactiveLimitOrderTrancheResponse)(nil), "neutron.dex.QueryAllInactiveLimitOrderTrancheResponse")
+	proto.RegisterType((*QueryAllPoolReservesRequest)(nil), "neutron.dex.QueryAllPoolReservesRequest")
+	proto.RegisterType((*QueryAllPoolReservesResponse)(nil), "neutron.dex.QueryAllPoolReservesResponse")
+	proto.RegisterType((*QueryGetPoolReservesRequest)(nil), "neutron.dex.QueryGetPoolReservesRequest")
+	proto.RegisterType((*QueryGetPoolReservesResponse)(nil), "neutron.dex.QueryGetPoolReservesResponse")
+	proto.RegisterType((*QueryEstimateMultiHopSwapRequest)(nil), "neutron.dex.QueryEstimateMultiHopSwapRequest")
+	proto.RegisterType((*QueryEstimateMultiHopSwapResponse)(nil), "neutron.dex.QueryEstimateMultiHopSwapResponse")
+	proto.RegisterType((*QueryEstimatePlaceLimitOrderRequest)(nil), "neutron.dex.QueryEstimatePlaceLimitOrderRequest")
+	proto.RegisterType((*QueryEstimatePlaceLimitOrderResponse)(nil), "neutron.dex.QueryEstimatePlaceLimitOrderResponse")
+	proto.RegisterType((*QueryPoolRequest)(nil), "neutron.dex.QueryPoolRequest")
+	proto.RegisterType((*QueryPoolByIDRequest)(nil), "neutron.dex.QueryPoolByIDRequest")
+	proto.RegisterType((*QueryPoolResponse)(nil), "neutron.dex.QueryPoolResponse")
+	proto.RegisterType((*QueryGetPoolMetadataRequest)(nil), "neutron.dex.QueryGetPoolMetadataRequest")
+	proto.RegisterType((*QueryGetPoolMetadataResponse)(nil), "neutron.dex.QueryGetPoolMetadataResponse")
+	proto.RegisterType((*QueryAllPoolMetadataRequest)(nil), "neutron.dex.QueryAllPoolMetadataRequest")
+	proto.RegisterType((*QueryAllPoolMetadataResponse)(nil), "neutron.dex.QueryAllPoolMetadataResponse")
+	proto.RegisterType((*QuerySimulateDepositRequest)(nil), "neutron.dex.QuerySimulateDepositRequest")
+	proto.RegisterType((*QuerySimulateDepositResponse)(nil), "neutron.dex.QuerySimulateDepositResponse")
+	proto.RegisterType((*QuerySimulateWithdrawalRequest)(nil), "neutron.dex.QuerySimulateWithdrawalRequest")
+	proto.RegisterType((*QuerySimulateWithdrawalResponse)(nil), "neutron.dex.QuerySimulateWithdrawalResponse")
+	proto.RegisterType((*QuerySimulatePlaceLimitOrderRequest)(nil), "neutron.dex.QuerySimulatePlaceLimitOrderRequest")
+	proto.RegisterType((*QuerySimulatePlaceLimitOrderResponse)(nil), "neutron.dex.QuerySimulatePlaceLimitOrderResponse")
+	proto.RegisterType((*QuerySimulateWithdrawFilledLimitOrderRequest)(nil), "neutron.dex.QuerySimulateWithdrawFilledLimitOrderRequest")
+	proto.RegisterType((*QuerySimulateWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.QuerySimulateWithdrawFilledLimitOrderResponse")
+	proto.RegisterType((*QuerySimulateCancelLimitOrderRequest)(nil), "neutron.dex.QuerySimulateCancelLimitOrderRequest")
+	proto.RegisterType((*QuerySimulateCancelLimitOrderResponse)(nil), "neutron.dex.QuerySimulateCancelLimitOrderResponse")
+	proto.RegisterType((*QuerySimulateMultiHopSwapRequest)(nil), "neutron.dex.QuerySimulateMultiHopSwapRequest")
+	proto.RegisterType((*QuerySimulateMultiHopSwapResponse)(nil), "neutron.dex.QuerySimulateMultiHopSwapResponse")
+	proto.RegisterType((*QueryGetTriggerOrderRequest)(nil), "neutron.dex.QueryGetTriggerOrderRequest")
+	proto.RegisterType((*QueryGetTriggerOrderResponse)(nil), "neutron.dex.QueryGetTriggerOrderResponse")
+	proto.RegisterType((*QueryAllTriggerOrderRequest)(nil), "neutron.dex.QueryAllTriggerOrderRequest")
+	proto.RegisterType((*QueryAllTriggerOrderResponse)(nil), "neutron.dex.QueryAllTriggerOrderResponse")
+	proto.RegisterType((*QuerySimulatePlaceTriggerOrderRequest)(nil), "neutron.dex.QuerySimulatePlaceTriggerOrderRequest")
+	proto.RegisterType((*QuerySimulatePlaceTriggerOrderResponse)(nil), "neutron.dex.QuerySimulatePlaceTriggerOrderResponse")
+	proto.RegisterType((*QuerySimulateCancelTriggerOrderRequest)(nil), "neutron.dex.QuerySimulateCancelTriggerOrderRequest")
+	proto.RegisterType((*QuerySimulateCancelTriggerOrderResponse)(nil), "neutron.dex.QuerySimulateCancelTriggerOrderResponse")
+	proto.RegisterType((*QuerySimulateBatchLimitOrdersRequest)(nil), "neutron.dex.QuerySimulateBatchLimitOrdersRequest")
+	proto.RegisterType((*QuerySimulateBatchLimitOrdersResponse)(nil), "neutron.dex.QuerySimulateBatchLimitOrdersResponse")
+	proto.RegisterType((*QuerySimulateAmendLimitOrderRequest)(nil), "neutron.dex.QuerySimulateAmendLimitOrderRequest")
+	proto.RegisterType((*QuerySimulateAmendLimitOrderResponse)(nil), "neutron.dex.QuerySimulateAmendLimitOrderResponse")
+	proto.RegisterType((*QueryGetProtocolFeeAccrualRequest)(nil), "neutron.dex.QueryGetProtocolFeeAccrualRequest")
+	proto.RegisterType((*QueryGetProtocolFeeAccrualResponse)(nil), "neutron.dex.QueryGetProtocolFeeAccrualResponse")
+	proto.RegisterType((*QueryAllProtocolFeeAccrualRequest)(nil), "neutron.dex.QueryAllProtocolFeeAccrualRequest")
+	proto.RegisterType((*QueryAllProtocolFeeAccrualResponse)(nil), "neutron.dex.QueryAllProtocolFeeAccrualResponse")
+	proto.RegisterType((*QueryArithmeticTwapRequest)(nil), "neutron.dex.QueryArithmeticTwapRequest")
+	proto.RegisterType((*QueryArithmeticTwapResponse)(nil), "neutron.dex.QueryArithmeticTwapResponse")
+	proto.RegisterType((*QueryGeometricTwapRequest)(nil), "neutron.dex.QueryGeometricTwapRequest")
+	proto.RegisterType((*QueryGeometricTwapResponse)(nil), "neutron.dex.QueryGeometricTwapResponse")
+	proto.RegisterType((*QueryPairStatsRequest)(nil), "neutron.dex.QueryPairStatsRequest")
+	proto.RegisterType((*QueryPairStatsResponse)(nil), "neutron.dex.QueryPairStatsResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "neutron.dex.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "neutron.dex.QueryCandlesResponse")
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("neutron/dex/query.proto", fileDescriptor_b6613ea5fce61e9c) }
+
+var fileDescriptor_b6613ea5fce61e9c = []byte{
+	// 3659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x5b, 0x6f, 0x1c, 0xc7,
+	0x95, 0x56, 0x73, 0x28, 0x5e, 0x4a, 0x22, 0x25, 0x95, 0x28, 0x89, 0x1a, 0x49, 0x1c, 0xb2, 0x65,
+	0x89, 0xd4, 0x85, 0x33, 0x22, 0x29, 0xf9, 0x22, 0xf9, 0xb2, 0xa2, 0x64, 0x49, 0x5c, 0xdb, 0x2b,
+	0xee, 0x48, 0xbe, 0x69, 0xbd, 0xdb, 0x68, 0xce, 0x94, 0x86, 0xbd, 0xec, 0xe9, 0x1e, 0x75, 0xd7,
+	0x88, 0x24, 0x04, 0x62, 0xb1, 0xde, 0x97, 0x45, 0x90, 0x04, 0x8e, 0xed, 0x24, 0x70, 0x12, 0x38,
+	0x01, 0x8c, 0x04, 0x48, 0x02, 0xc3, 0x89, 0x73, 0x05, 0x82, 0xbc, 0x18, 0x88, 0x61, 0x04, 0x41,
+	0x60, 0xc0, 0x79, 0x48, 0x1c, 0x80, 0x09, 0xec, 0x3c, 0x39, 0x2f, 0x81, 0x7e, 0x41, 0x50, 0xd5,
+	0xa7, 0x7b, 0xba, 0xba, 0xab, 0xbb, 0x87, 0xd4, 0x24, 0x31, 0xf2, 0x24, 0x4e, 0x55, 0x9d, 0x53,
+	0xdf, 0xf9, 0xea, 0xd4, 0x39, 0xd5, 0x75, 0x4a, 0x68, 0x9f, 0x45, 0x9a, 0xd4, 0xb1, 0xad, 0x52,
+	0x95, 0xac, 0x94, 0x6e, 0x35, 0x89, 0xb3, 0x5a, 0x6c, 0x38, 0x36, 0xb5, 0xf1, 0x36, 0xe8, 0x28,
+	0x56, 0xc9, 0x4a, 0xfe, 0x78, 0xc5, 0x76, 0xeb, 0xb6, 0x5b, 0x5a, 0xd0, 0x5d, 0xe2, 0x8d, 0x2a,
+	0xdd, 0x9e, 0x5a, 0x20, 0x54, 0x9f, 0x2a, 0x35, 0xf4, 0x9a, 0x61, 0xe9, 0xd4, 0xb0, 0x2d, 0x4f,
+	0x30, 0x3f, 0x12, 0x1e, 0xeb, 0x8f, 0xaa, 0xd8, 0x86, 0xdf, 0x3f, 0x54, 0xb3, 0x6b, 0x36, 0xff,
+	0xb3, 0xc4, 0xfe, 0x82, 0xd6, 0x83, 0x35, 0xdb, 0xae, 0x99, 0xa4, 0xa4, 0x37, 0x8c, 0x92, 0x6e,
+	0x59, 0x36, 0xe5, 0x2a, 0x5d, 0xe8, 0x2d, 0x40, 0x2f, 0xff, 0xb5, 0xd0, 0xbc, 0x59, 0xa2, 0x46,
+	0x9d, 0xb8, 0x54, 0xaf, 0x37, 0x60, 0xc0, 0x70, 0xd8, 0x8c, 0x8a, 0x6e, 0x55, 0x4d, 0x02, 0x3d,
+	0xa3, 0xe1, 0x9e, 0x2a, 0x69, 0xd8, 0xae, 0x41, 0x35, 0x87, 0x54, 0x6c, 0xa7, 0x0a, 0x23, 0x8e,
+	0x84, 0x47, 0x98, 0x46, 0xdd, 0xa0, 0x9a, 0xed, 0x54, 0x89, 0xa3, 0x51, 0x47, 0xb7, 0x2a, 0x8b,
+	0xbe, 0xa2, 0xe3, 0x19, 0xc3, 0xb4, 0xa6, 0x4b, 0x1c, 0x19, 0x9c, 0x86, 0xee, 0xe8, 0x75, 0xdf,
+	0x92, 0xbd, 0x42, 0x8f, 0x6d, 0x9b, 0xbe, 0x85, 0xd1, 0x76, 0xad, 0x4e, 0xa8, 0x5e, 0xd5, 0xa9,
+	0x9e, 0x38, 0xc0, 0x21, 0x2e, 0x71, 0x6e, 0x13, 0x5f, 0xf3, 0x51, 0x61, 0x00, 0x6b, 0xaa, 0xd8,
+	0xa6, 0x76, 0x93, 0x10, 0x4d, 0xaf, 0x54, 0x9c, 0xa6, 0x6e, 0xca, 0x08, 0xa1, 0x46, 0x65, 0x49,
+	0x33, 0x8d, 0x5b, 0x4d, 0xa3, 0x6a, 0xd0, 0x55, 0xd9, 0x54, 0xd4, 0x31, 0x6a, 0x35, 0xe2, 0x78,
+	0xb6, 0xfa, 0x4b, 0x28, 0x0c, 0x58, 0xf1, 0x5a, 0xd5, 0x21, 0x84, 0xff, 0x9d, 0xb9, 0xc6, 0x3c,
+	0xb7, 0xb7, 0x4c, 0x6e, 0x35, 0x89, 0x4b, 0xd5, 0x2b, 0x68, 0xb7, 0xd0, 0xea, 0x36, 0x6c, 0xcb,
+	0x25, 0x78, 0x0a, 0xf5, 0x78, 0xbc, 0x0c, 0x2b, 0xa3, 0xca, 0xc4, 0xb6, 0xe9, 0xdd, 0xc5, 0x90,
+	0xbf, 0x15, 0xbd, 0xc1, 0xb3, 0xdd, 0xef, 0xad, 0x17, 0xb6, 0x94, 0x61, 0xa0, 0xfa, 0x55, 0x05,
+	0xdd, 0xc7, 0x55, 0x5d, 0x26, 0xf4, 0x49, 0xc6, 0xff, 0x55, 0x06, 0xe9, 0xba, 0xc7, 0xfe, 0xd3,
+	0x2e, 0x71, 0x60, 0x4a, 0x3c, 0x8c, 0x7a, 0xf5, 0x6a, 0xd5, 0x21, 0xae, 0xa7, 0xbc, 0xbf, 0xec,
+	0xff, 0xc4, 0x05, 0xb4, 0xcd, 0x5f, 0xad, 0x25, 0xb2, 0x3a, 0xdc, 0xc5, 0x7b, 0x11, 0x34, 0x3d,
+	0x41, 0x56, 0xf1, 0x83, 0x68, 0xb8, 0xa2, 0x9b, 0x15, 0x6d, 0xd9, 0xa0, 0x8b, 0x55, 0x47, 0x5f,
+	0xd6, 0x17, 0x4c, 0xa2, 0xb9, 0x8b, 0xba, 0x43, 0xdc, 0xe1, 0xdc, 0xa8, 0x32, 0xd1, 0x57, 0xde,
+	0xcb, 0xfa, 0x9f, 0x0d, 0x75, 0x5f, 0xe3, 0xbd, 0xea, 0x4b, 0x5d, 0xe8, 0x48, 0x06, 0x3a, 0x30,
+	0x5d, 0x47, 0xc3, 0x49, 0xee, 0x03, 0x64, 0xa8, 0x02, 0x19, 0x52, 0x6d, 0x9c, 0x1b, 0xa5, 0xbc,
+	0xc7, 0x94, 0x75, 0xe2, 0xff, 0x53, 0xd0, 0x6e, 0x99, 0x09, 0xdc, 0xe0, 0xd9, 0x32, 0x13, 0xfd,
+	0x70, 0xbd, 0xb0, 0xc7, 0xdb, 0xa9, 0x6e, 0x75, 0xa9, 0x68, 0xd8, 0xa5, 0xba, 0x4e, 0x17, 0x8b,
+	0x73, 0x16, 0xfd, 0x64, 0xbd, 0x20, 0x93, 0xbd, 0xbb, 0x5e, 0xc8, 0xaf, 0xea, 0x75, 0xf3, 0xac,
+	0x2a, 0xe9, 0x54, 0xcb, 0x78, 0x39, 0x4e, 0x89, 0x05, 0xeb, 0x75, 0xde, 0x34, 0x53, 0xd7, 0xeb,
+	0x12, 0x42, 0xad, 0x28, 0x02, 0x14, 0x1c, 0x2d, 0x7a, 0xe0, 0x8a, 0x2c, 0x8c, 0x14, 0xbd, 0xc0,
+	0x04, 0xc1, 0xa4, 0x38, 0xaf, 0xd7, 0x08, 0xc8, 0x96, 0x43, 0x92, 0xea, 0x07, 0x0a, 0x2c, 0x41,
+	0xf2, 0x84, 0x6d, 0x2d, 0x41, 0xae, 0x13, 0x4b, 0x70, 0x59, 0x30, 0xaa, 0x8b, 0x1b, 0x35, 0x9e,
+	0x69, 0x94, 0x87, 0x4f, 0xb0, 0xea, 0x4b, 0x0a, 0x1a, 0x4d, 0x74, 0x2c, 0x9f, 0xc2, 0x7d, 0xa8,
+	0xb7, 0xa1, 0x1b, 0x8e, 0x66, 0x54, 0xc1, 0xe5, 0x7b, 0xd8, 0xcf, 0xb9, 0x2a, 0x3e, 0x84, 0x10,
+	0xdf, 0xe3, 0x86, 0x55, 0x25, 0x2b, 0x1c, 0x46, 0xae, 0xdc, 0xcf, 0x5a, 0xe6, 0x58, 0x03, 0xde,
+	0x8f, 0xfa, 0xa8, 0xbd, 0x44, 0x2c, 0xcd, 0xb0, 0xb8, 0x7f, 0xf7, 0x97, 0x7b, 0xf9, 0xef, 0x39,
+	0x2b, 0xba, 0x57, 0xba, 0xa3, 0x7b, 0x45, 0x5d, 0x45, 0x63, 0x29, 0xb8, 0x80, 0xe9, 0xeb, 0x68,
+	0xb7, 0x84, 0x69, 0x58, 0xe4, 0x91, 0x74, 0x92, 0x81, 0xe0, 0x5d, 0x31, 0x82, 0xd5, 0xd7, 0x7d,
+	0x4e, 0x64, 0x2b, 0x9d, 0xc9, 0x49, 0xd8, 0xe8, 0x2e, 0xd1, 0x68, 0xd1, 0x15, 0x73, 0x9b, 0x76,
+	0xc5, 0x77, 0x14, 0x20, 0x47, 0x0e, 0x30, 0x8b, 0x9c, 0xdc, 0x3d, 0x90, 0xd3, 0x39, 0xcf, 0xfb,
+	0xae, 0x82, 0x0e, 0xf8, 0x46, 0x30, 0x9f, 0xbe, 0xe8, 0x65, 0x4f, 0x37, 0x3b, 0xce, 0x5e, 0x92,
+	0x40, 0xd8, 0x04, 0x8d, 0xf8, 0x38, 0xda, 0x65, 0x58, 0x15, 0xb3, 0x59, 0x25, 0x1a, 0x4f, 0x79,
+	0x2c, 0x1f, 0x42, 0x1c, 0xde, 0x01, 0x1d, 0xf3, 0xb6, 0x6d, 0x5e, 0xd4, 0xa9, 0xae, 0x7e, 0x53,
+	0x41, 0x07, 0xe5, 0x68, 0x81, 0xed, 0x87, 0x51, 0x1f, 0xe4, 0x7f, 0x17, 0x28, 0xce, 0x0b, 0x14,
+	0x83, 0x40, 0x99, 0x9f, 0x0d, 0x80, 0xde, 0x40, 0xa2, 0x73, 0xac, 0x7e, 0x41, 0x41, 0x93, 0xa9,
+	0x51, 0x6a, 0x76, 0xf5, 0xbc, 0x47, 0xe3, 0xdf, 0x8d, 0x67, 0xf5, 0x5d, 0x05, 0x15, 0xdb, 0xc5,
+	0x04, 0x6c, 0x3e, 0x81, 0xb6, 0x87, 0x7c, 0xd7, 0xdd, 0x70, 0xd8, 0xdc, 0xd6, 0x72, 0xdc, 0x0e,
+	0x92, 0xfb, 0x95, 0x90, 0x13, 0x5c, 0x37, 0x2a, 0x4b, 0x4f, 0xfa, 0x47, 0x9b, 0x4f, 0x43, 0x50,
+	0xf8, 0xbe, 0x82, 0x0e, 0x25, 0x80, 0x03, 0x52, 0x2f, 0xa3, 0x41, 0xf1, 0x44, 0x26, 0x75, 0x54,
+	0x41, 0x16, 0xe8, 0x1c, 0xa0, 0xe1, 0xc6, 0xce, 0x11, 0xfa, 0xba, 0x82, 0x26, 0xfc, 0x28, 0x3f,
+	0x67, 0xe9, 0x15, 0x6a, 0xdc, 0x26, 0x1d, 0x8d, 0xb8, 0x62, 0x82, 0xca, 0x45, 0x13, 0x54, 0x66,
+	0x16, 0x7a, 0x59, 0x41, 0xc7, 0xda, 0x00, 0x08, 0x04, 0x13, 0x74, 0xd0, 0x80, 0x41, 0xda, 0xbd,
+	0xe6, 0xa5, 0xfd, 0x46, 0xd2, 0x74, 0xaa, 0x03, 0xa4, 0x9d, 0x37, 0xcd, 0x4c, 0xd2, 0x3a, 0x75,
+	0xfa, 0xf9, 0xbd, 0x4f, 0x44, 0xfa, 0xa4, 0x6d, 0x13, 0x91, 0xeb, 0x00, 0x11, 0x9d, 0xf3, 0xc3,
+	0xd7, 0x42, 0xb9, 0x88, 0x85, 0xfc, 0x32, 0x7c, 0xfc, 0x7c, 0x1a, 0xf6, 0xf5, 0x9b, 0xa1, 0xa0,
+	0x23, 0x62, 0x03, 0xb2, 0x2f, 0xa2, 0x01, 0xe1, 0x8b, 0x0d, 0xd8, 0xdd, 0x2f, 0x7e, 0xf3, 0x84,
+	0x24, 0x81, 0xd8, 0xed, 0x8d, 0x50, 0x5b, 0xe7, 0xb8, 0x7c, 0xd1, 0xe7, 0xf2, 0x32, 0xa1, 0x9d,
+	0xe2, 0x32, 0x63, 0x1b, 0xef, 0x44, 0xb9, 0x9b, 0x84, 0xf0, 0xed, 0xdb, 0x5d, 0x66, 0x7f, 0xaa,
+	0x55, 0xe0, 0x2c, 0x86, 0x21, 0x99, 0x33, 0x65, 0xc3, 0x9c, 0xa9, 0xdf, 0xce, 0xc1, 0x41, 0xf1,
+	0x71, 0x97, 0x1a, 0x75, 0x9d, 0x92, 0xa7, 0x9a, 0x26, 0x35, 0xae, 0xd8, 0x8d, 0x6b, 0xcb, 0x7a,
+	0x23, 0x94, 0x5f, 0x2b, 0x0e, 0xd1, 0xa9, 0xed, 0xf8, 0xf9, 0x15, 0x7e, 0xe2, 0x3c, 0xea, 0x73,
+	0x48, 0x85, 0x18, 0xb7, 0x89, 0x03, 0x06, 0x07, 0xbf, 0xf1, 0x34, 0xea, 0x71, 0xec, 0x26, 0xe5,
+	0x1f, 0x86, 0xf1, 0x18, 0xed, 0xcf, 0x53, 0x66, 0x43, 0xca, 0x30, 0x12, 0xff, 0x07, 0xea, 0xd7,
+	0xeb, 0x76, 0xd3, 0xa2, 0x8c, 0x41, 0x1e, 0xcb, 0x66, 0x1f, 0x65, 0xdf, 0xb8, 0x69, 0x1f, 0x63,
+	0x2d, 0x89, 0xbb, 0xeb, 0x85, 0x9d, 0xde, 0x27, 0x58, 0xd0, 0xa4, 0x96, 0xfb, 0xbc, 0xbf, 0xe7,
+	0x2c, 0xfc, 0x45, 0x05, 0xed, 0x24, 0x2b, 0x06, 0x85, 0xfd, 0xdc, 0x70, 0x8c, 0x0a, 0x19, 0xde,
+	0xca, 0x27, 0x59, 0x82, 0x49, 0x4e, 0xd7, 0x0c, 0xba, 0xd8, 0x5c, 0x28, 0x56, 0xec, 0x7a, 0x09,
+	0xd0, 0x4e, 0xda, 0x4e, 0xcd, 0xff, 0xbb, 0x74, 0xfb, 0x4c, 0xa9, 0x49, 0x0d, 0xd3, 0xf5, 0xe6,
+	0x9f, 0x77, 0x48, 0xe5, 0x22, 0xa9, 0x7c, 0xb2, 0x5e, 0x88, 0xe9, 0xbd, 0xbb, 0x5e, 0xd8, 0xe7,
+	0x41, 0x89, 0xf6, 0xa8, 0xe5, 0x41, 0xd6, 0xc4, 0x43, 0xc1, 0x3c, 0x6b, 0xc0, 0x47, 0xd1, 0x8e,
+	0x06, 0x73, 0x8d, 0x05, 0xe2, 0x52, 0x8d, 0x13, 0x31, 0xdc, 0xc3, 0x8f, 0x70, 0x03, 0xac, 0x79,
+	0x96, 0xed, 0x26, 0xd6, 0xc8, 0x3e, 0x74, 0xc6, 0x52, 0xd6, 0x0a, 0xfc, 0xe2, 0x16, 0xea, 0xab,
+	0xd8, 0x86, 0xa5, 0xd9, 0x4d, 0x1a, 0xb8, 0x44, 0x78, 0x0f, 0xf8, 0xde, 0x7f, 0xc1, 0x36, 0xac,
+	0xd9, 0x73, 0x60, 0xf7, 0x78, 0xc8, 0x6e, 0xb8, 0x9e, 0xf2, 0xfe, 0x99, 0x74, 0xab, 0x4b, 0x25,
+	0xba, 0xda, 0x20, 0x2e, 0x17, 0xf8, 0x64, 0xbd, 0x10, 0x68, 0x2f, 0xf7, 0xb2, 0xbf, 0xae, 0x36,
+	0xa9, 0xfa, 0x4e, 0x37, 0x3a, 0x2c, 0x00, 0x9b, 0x37, 0xf5, 0x4a, 0x28, 0xd8, 0xdd, 0x9b, 0x1f,
+	0xa5, 0x7c, 0x82, 0x1d, 0x40, 0xfd, 0x5e, 0x17, 0x33, 0xd6, 0x4b, 0x7d, 0xde, 0xd8, 0xab, 0x4d,
+	0x8a, 0x8b, 0x68, 0xa8, 0xb5, 0xe3, 0x34, 0xc3, 0xd2, 0xa8, 0xcd, 0xc7, 0x6d, 0xe5, 0x7b, 0x6f,
+	0x67, 0xb0, 0xf7, 0xe6, 0xac, 0xeb, 0x36, 0x1b, 0x2f, 0xf8, 0x5e, 0x4f, 0x87, 0x7d, 0xef, 0x2c,
+	0x42, 0x90, 0x3f, 0x56, 0x1b, 0x64, 0xb8, 0x77, 0x54, 0x99, 0x18, 0x9c, 0x3e, 0x90, 0x94, 0x3c,
+	0x56, 0x1b, 0xa4, 0xdc, 0x6f, 0xfb, 0x7f, 0xe2, 0xa7, 0xd0, 0x0e, 0xb2, 0xd2, 0x30, 0x1c, 0x1e,
+	0x9c, 0x34, 0x6a, 0xd4, 0xc9, 0x70, 0x1f, 0x5f, 0xd8, 0x7c, 0xd1, 0xbb, 0xf6, 0x2b, 0xfa, 0xd7,
+	0x7e, 0xc5, 0xeb, 0xfe, 0xb5, 0xdf, 0x6c, 0x1f, 0xdb, 0xec, 0x2f, 0xfd, 0xa1, 0xa0, 0x30, 0x77,
+	0xf3, 0x85, 0x59, 0x37, 0xae, 0xa3, 0x81, 0xba, 0xbe, 0x72, 0xde, 0x43, 0xc9, 0x08, 0xe9, 0xe7,
+	0xb6, 0x5e, 0xc9, 0xba, 0xf4, 0x18, 0xac, 0xeb, 0x2b, 0x9a, 0x1e, 0x88, 0xdd, 0x5d, 0x2f, 0xec,
+	0xf1, 0x0c, 0x16, 0xdb, 0xd5, 0xf2, 0xf6, 0x40, 0x3d, 0xa3, 0x95, 0x79, 0xb7, 0xed, 0x52, 0xcd,
+	0xb6, 0xcc, 0x55, 0xcd, 0x35, 0x8d, 0x2a, 0x19, 0x46, 0xe0, 0xdd, 0xb6, 0x4b, 0xaf, 0x5a, 0xe6,
+	0xea, 0x35, 0xd6, 0xa8, 0xfe, 0x25, 0x07, 0xb7, 0x21, 0x89, 0x4e, 0x04, 0x0e, 0xfe, 0x65, 0x05,
+	0x0d, 0x50, 0x9b, 0xea, 0x26, 0x5b, 0x53, 0xe6, 0x82, 0xd9, 0x6e, 0xfe, 0xdc, 0xc6, 0xdd, 0x5c,
+	0x9c, 0xe2, 0xee, 0x7a, 0x61, 0xc8, 0x33, 0x56, 0x68, 0x56, 0xcb, 0xdb, 0xf8, 0xef, 0x39, 0x8b,
+	0x49, 0xe1, 0x57, 0x14, 0xb4, 0xdd, 0x5d, 0xd6, 0x1b, 0x01, 0xb0, 0xae, 0x2c, 0x60, 0xcf, 0x6c,
+	0x1c, 0x98, 0x30, 0xc3, 0xdd, 0xf5, 0xc2, 0x6e, 0x0f, 0x57, 0xb8, 0x55, 0x2d, 0x23, 0xf6, 0x13,
+	0x50, 0x31, 0xbe, 0x78, 0xaf, 0xdd, 0xa4, 0x1e, 0xac, 0xdc, 0xdf, 0x82, 0x2f, 0x61, 0x8a, 0x16,
+	0x5f, 0x42, 0xb3, 0x5a, 0xde, 0xc6, 0x7e, 0x5f, 0x6d, 0x52, 0x26, 0xa5, 0xbe, 0x80, 0x76, 0x7a,
+	0x57, 0x9f, 0x3c, 0x23, 0xdd, 0xdb, 0x45, 0x0d, 0x24, 0xd0, 0x5c, 0x2b, 0x81, 0x96, 0xd0, 0x50,
+	0xa0, 0x7d, 0x76, 0x75, 0xee, 0x62, 0x78, 0x06, 0x96, 0x38, 0x61, 0x86, 0xee, 0x72, 0x0f, 0xfb,
+	0x39, 0x57, 0x55, 0xff, 0x05, 0xed, 0x0a, 0xc1, 0x01, 0x6f, 0x3b, 0x81, 0xba, 0x59, 0x37, 0xf8,
+	0xd8, 0xae, 0x58, 0x76, 0x85, 0xac, 0xca, 0x07, 0xa9, 0x93, 0xe2, 0xb9, 0xe1, 0x29, 0xb8, 0xa1,
+	0xf6, 0x67, 0x1e, 0x44, 0x5d, 0xc1, 0xa4, 0x5d, 0x46, 0x35, 0x9a, 0xe2, 0x5b, 0xc3, 0x5b, 0x29,
+	0x7e, 0x3e, 0x7c, 0xd3, 0x9d, 0x98, 0xe2, 0x7d, 0x49, 0xb8, 0x10, 0xde, 0x1e, 0x6e, 0x53, 0x89,
+	0x78, 0x30, 0x8c, 0x82, 0xea, 0xd4, 0xf1, 0x3a, 0x7a, 0xc8, 0x93, 0x59, 0xd3, 0x88, 0x58, 0x93,
+	0x6b, 0xcb, 0x9a, 0x46, 0xa8, 0xad, 0x73, 0x87, 0xbc, 0x2b, 0x40, 0xcb, 0x35, 0xa3, 0xde, 0x34,
+	0x75, 0x4a, 0x82, 0xdb, 0x0d, 0x8f, 0x96, 0x63, 0x28, 0x57, 0x77, 0x6b, 0xc0, 0xc7, 0x3e, 0xf1,
+	0xe8, 0xe2, 0xd6, 0xfc, 0xc1, 0x6c, 0x8c, 0x7a, 0x0d, 0x0c, 0x8f, 0x69, 0x02, 0xc3, 0x67, 0x50,
+	0xb7, 0x43, 0xdc, 0x06, 0xe8, 0x2a, 0x24, 0xe9, 0xf2, 0x41, 0xf2, 0xc1, 0xea, 0xbf, 0xa1, 0x11,
+	0x41, 0x69, 0x70, 0xa3, 0x1e, 0xec, 0x94, 0x93, 0x61, 0x84, 0xf9, 0xa8, 0xd6, 0xd0, 0x78, 0x0e,
+	0xf2, 0x79, 0x54, 0x48, 0xd4, 0x07, 0x38, 0xef, 0x17, 0x70, 0xaa, 0x29, 0x1a, 0x45, 0xa8, 0xcf,
+	0x41, 0xf6, 0xf7, 0x55, 0x27, 0x64, 0xff, 0xa9, 0x30, 0xde, 0x18, 0x0b, 0x51, 0x21, 0x0e, 0xba,
+	0x02, 0x29, 0x21, 0x51, 0x33, 0x20, 0x3f, 0x27, 0x20, 0x1f, 0xcf, 0xd2, 0x2d, 0xc2, 0xff, 0x6f,
+	0x74, 0x52, 0xca, 0xcc, 0x25, 0xc3, 0x34, 0x49, 0x35, 0x6e, 0xc7, 0xd9, 0xb0, 0x1d, 0x13, 0x49,
+	0x2c, 0xc5, 0xa4, 0xb9, 0x41, 0x4d, 0xb8, 0xda, 0xca, 0x9e, 0x2b, 0xd8, 0x34, 0x61, 0xcb, 0x4e,
+	0xb5, 0x3d, 0x9b, 0x68, 0xe2, 0x8d, 0x08, 0x8f, 0x17, 0x74, 0xab, 0x42, 0xcc, 0xb8, 0x69, 0xd3,
+	0x61, 0xd3, 0x46, 0xa3, 0x93, 0xc5, 0xa4, 0xb8, 0x49, 0x04, 0x6a, 0x0a, 0xc9, 0xba, 0x83, 0xeb,
+	0xc5, 0xb0, 0x29, 0x13, 0x99, 0xda, 0x45, 0x13, 0xca, 0xf0, 0x9d, 0xe2, 0x4f, 0x23, 0xfb, 0x4e,
+	0x29, 0x86, 0xe1, 0x1f, 0x8c, 0x4e, 0x20, 0x48, 0x70, 0xe8, 0xff, 0x09, 0xe7, 0x69, 0xb9, 0x4e,
+	0x80, 0xfd, 0xa0, 0x00, 0xfb, 0xbe, 0x54, 0xad, 0x22, 0xe4, 0x50, 0x36, 0xb8, 0xee, 0x15, 0x09,
+	0x05, 0xb2, 0x53, 0xb2, 0x81, 0x38, 0xbc, 0x15, 0x3f, 0x85, 0x5a, 0xa3, 0x34, 0x1b, 0x84, 0x25,
+	0xfd, 0xf8, 0x49, 0x43, 0x6d, 0xc2, 0x3d, 0x81, 0x0c, 0xd5, 0x3f, 0xf2, 0x9e, 0xe0, 0xad, 0xf0,
+	0xe5, 0xa4, 0x8c, 0x82, 0x4b, 0x68, 0x50, 0xa0, 0x40, 0x7e, 0x51, 0x20, 0xe1, 0x60, 0x20, 0xcc,
+	0x41, 0x07, 0x6f, 0x0a, 0x5e, 0x88, 0x38, 0x3f, 0x8f, 0x34, 0x32, 0x5a, 0x67, 0xc2, 0xae, 0x39,
+	0x26, 0x0d, 0x50, 0x82, 0x18, 0xf7, 0xcf, 0x1a, 0x3a, 0x9a, 0xa5, 0x1d, 0x88, 0x79, 0x44, 0x70,
+	0xd2, 0x63, 0xd9, 0xfa, 0x45, 0x4f, 0xfd, 0xaf, 0xc8, 0x44, 0xde, 0x5e, 0x94, 0xd9, 0x71, 0x3a,
+	0x6c, 0x87, 0x2a, 0xdf, 0xc3, 0x71, 0x43, 0x0c, 0x34, 0x9e, 0xa9, 0x1f, 0x2c, 0x79, 0x54, 0xb0,
+	0xe4, 0x78, 0x1b, 0x33, 0xa4, 0x87, 0xba, 0x59, 0x9d, 0x56, 0x16, 0x5b, 0x51, 0xc5, 0x6d, 0x2f,
+	0xd4, 0xc5, 0xa4, 0xa4, 0xa1, 0x2e, 0xae, 0xbb, 0xbd, 0x50, 0x97, 0x24, 0x97, 0x90, 0x4f, 0xcf,
+	0xd7, 0x89, 0x55, 0xdd, 0x68, 0x3e, 0x8d, 0x0a, 0x49, 0xf3, 0x69, 0x4c, 0x73, 0x7b, 0xf9, 0x34,
+	0x41, 0x0c, 0xe0, 0x3f, 0xdc, 0x2a, 0x7b, 0xce, 0xc3, 0x2b, 0x8b, 0x4b, 0x84, 0x9c, 0xf7, 0xde,
+	0x58, 0x64, 0x85, 0x19, 0x75, 0x0d, 0xa9, 0x69, 0xd2, 0x00, 0xf0, 0x59, 0x34, 0x24, 0x7b, 0xc1,
+	0x21, 0x25, 0x23, 0xae, 0x06, 0x82, 0x02, 0x6e, 0xc4, 0x7a, 0xd4, 0xa5, 0x56, 0x59, 0x32, 0x19,
+	0x7c, 0xa7, 0x8e, 0xcc, 0xef, 0x2a, 0x60, 0x6c, 0xc2, 0x6c, 0x99, 0xc6, 0xe6, 0xee, 0xc9, 0xd8,
+	0xce, 0x85, 0xc1, 0x0f, 0x15, 0x94, 0xf7, 0x0c, 0x71, 0x0c, 0xba, 0x58, 0x27, 0xd4, 0xa8, 0x5c,
+	0x0f, 0xe5, 0xe5, 0xb4, 0x6f, 0x3a, 0x36, 0x8d, 0x56, 0x25, 0x96, 0x5d, 0x87, 0xac, 0xd2, 0xcf,
+	0x5a, 0x2e, 0xb2, 0x06, 0x7c, 0x01, 0x21, 0x97, 0xea, 0x0e, 0xf5, 0xee, 0x3c, 0x72, 0x6d, 0xdd,
+	0x79, 0x6c, 0xe1, 0x77, 0x1e, 0xfd, 0x5c, 0x8e, 0x5f, 0x77, 0x3c, 0x86, 0xfa, 0x88, 0x55, 0xf5,
+	0x54, 0x74, 0x6f, 0xe0, 0xda, 0xa4, 0x97, 0x58, 0x55, 0xd6, 0xae, 0xbe, 0x1d, 0x64, 0xcc, 0x88,
+	0x71, 0xb0, 0x3c, 0x2f, 0x2b, 0x68, 0x87, 0x1e, 0x74, 0x69, 0x74, 0x59, 0xf7, 0x36, 0x4e, 0xff,
+	0xac, 0x71, 0x8f, 0xb7, 0x8a, 0x51, 0xb5, 0x77, 0xd7, 0x0b, 0x7b, 0xe1, 0x8e, 0x49, 0xec, 0x50,
+	0xcb, 0x83, 0xba, 0x00, 0x4e, 0xfd, 0x9d, 0x82, 0xf6, 0xc3, 0x3e, 0xb2, 0xeb, 0x84, 0x3a, 0xff,
+	0x4c, 0x0b, 0xf2, 0xa6, 0xef, 0x6d, 0x11, 0xdb, 0x60, 0x3d, 0x3e, 0xaf, 0xa0, 0xc1, 0x9a, 0xdf,
+	0x13, 0x5e, 0x8e, 0xda, 0x3d, 0x2e, 0x47, 0x44, 0x6b, 0xeb, 0x02, 0x4c, 0x6c, 0x57, 0xcb, 0x03,
+	0xb5, 0x30, 0x30, 0xf5, 0x14, 0xda, 0x03, 0x2f, 0xbc, 0x0c, 0xe7, 0x1a, 0xd5, 0x69, 0x66, 0x1d,
+	0x41, 0x7d, 0x1a, 0xed, 0x8d, 0x4a, 0x04, 0x91, 0x19, 0x71, 0x11, 0x97, 0xb5, 0x42, 0xe8, 0xd9,
+	0x1b, 0x79, 0x1a, 0x06, 0x32, 0xb0, 0xf1, 0xfb, 0x1b, 0x7e, 0x83, 0xfa, 0xa3, 0x2e, 0x78, 0x6b,
+	0x76, 0x81, 0x3f, 0x00, 0xcc, 0xae, 0x67, 0x3c, 0x80, 0xfa, 0x0c, 0x8b, 0x12, 0xe7, 0xb6, 0x6e,
+	0x72, 0x67, 0x88, 0xde, 0x59, 0x7a, 0x7a, 0xe6, 0x60, 0x48, 0x39, 0x18, 0xbc, 0x29, 0x47, 0x51,
+	0x3a, 0xed, 0x28, 0x91, 0x38, 0xbd, 0x75, 0xd3, 0x71, 0xfa, 0x55, 0x05, 0xae, 0x92, 0x02, 0xde,
+	0x82, 0x2f, 0xfb, 0x5e, 0xef, 0x2d, 0xa5, 0x7f, 0x10, 0xdd, 0x2d, 0xa1, 0x07, 0xd6, 0xc1, 0x1f,
+	0xd9, 0xb1, 0xa8, 0x3b, 0xfd, 0xd3, 0x53, 0x68, 0x2b, 0x87, 0x85, 0x17, 0x51, 0x8f, 0xf7, 0x22,
+	0x10, 0x8b, 0xd9, 0x20, 0xfe, 0xdc, 0x30, 0x3f, 0x9a, 0x3c, 0xc0, 0x9b, 0x42, 0x3d, 0xf0, 0xe2,
+	0x07, 0x7f, 0x7a, 0xa5, 0x6b, 0x0f, 0xde, 0x5d, 0x8a, 0x3f, 0xd2, 0xc4, 0xbf, 0x50, 0xd0, 0x1e,
+	0xe9, 0xab, 0x05, 0x3c, 0x15, 0x57, 0x9c, 0xf1, 0x0e, 0x31, 0x3f, 0xbd, 0x11, 0x11, 0x40, 0xf7,
+	0x38, 0x47, 0xf7, 0x18, 0x7e, 0xa4, 0xd4, 0xce, 0x73, 0xd3, 0xd2, 0x1d, 0x78, 0x09, 0xb2, 0x56,
+	0xba, 0x13, 0x2a, 0x93, 0xaf, 0xe1, 0xef, 0x29, 0x68, 0x58, 0x3a, 0xd1, 0x79, 0xd3, 0x94, 0x99,
+	0x92, 0xf1, 0x44, 0x4f, 0x66, 0x4a, 0xd6, 0x23, 0x3b, 0x75, 0x92, 0x9b, 0x32, 0x8e, 0x8f, 0xb4,
+	0x65, 0x0a, 0xfe, 0xb5, 0x82, 0xc6, 0x92, 0x20, 0x07, 0xcf, 0x4f, 0xf0, 0xd9, 0xf6, 0x81, 0x44,
+	0xdf, 0xd1, 0xe4, 0xcf, 0x6d, 0x4a, 0x16, 0xac, 0x39, 0xc5, 0xad, 0x39, 0x8e, 0x27, 0x04, 0x6b,
+	0xf8, 0x22, 0x84, 0xdf, 0xc1, 0xb4, 0x56, 0x04, 0xff, 0x4a, 0x41, 0xbb, 0xe2, 0x15, 0xf1, 0xc9,
+	0xf6, 0x9c, 0xc2, 0xc7, 0x5c, 0x6c, 0x77, 0x38, 0xc0, 0x7c, 0x8e, 0xc3, 0x2c, 0xe3, 0xf9, 0x2c,
+	0xd2, 0x4b, 0x77, 0x20, 0x26, 0x32, 0xd7, 0x81, 0x0f, 0x5f, 0xf6, 0x67, 0x70, 0x07, 0x1d, 0x75,
+	0xa9, 0x1f, 0x2a, 0x68, 0x28, 0x36, 0x2f, 0x73, 0xa7, 0xc9, 0xf6, 0x68, 0x4d, 0xb1, 0x28, 0xed,
+	0x91, 0x9c, 0xfa, 0x08, 0xb7, 0xe8, 0x01, 0x7c, 0x66, 0x53, 0x16, 0xe1, 0x57, 0x15, 0xb4, 0x23,
+	0xfc, 0x1c, 0x8c, 0x21, 0x9e, 0x90, 0x42, 0x90, 0x3c, 0x71, 0xcb, 0x1f, 0x6b, 0x63, 0x24, 0xe0,
+	0x3c, 0xc9, 0x71, 0x1e, 0xc5, 0xf7, 0xc5, 0x1d, 0xc4, 0x7f, 0x44, 0x16, 0x72, 0x8e, 0x37, 0x14,
+	0xb4, 0x53, 0x78, 0xc7, 0xc3, 0x70, 0xc9, 0x67, 0x93, 0xbd, 0x63, 0xca, 0x1f, 0x6f, 0x67, 0x28,
+	0x20, 0x7b, 0x90, 0x23, 0x9b, 0xc6, 0xa7, 0x4a, 0xc9, 0x4f, 0xbf, 0xe5, 0xe4, 0xfd, 0xb2, 0x0b,
+	0xed, 0x4f, 0x7c, 0x4b, 0x82, 0xcf, 0x48, 0x7d, 0x33, 0xeb, 0xc1, 0x4b, 0xfe, 0xfe, 0x8d, 0x8a,
+	0x81, 0x19, 0x3f, 0x57, 0xb8, 0x1d, 0x3f, 0x56, 0x6e, 0x3c, 0x8f, 0x9f, 0x15, 0x4c, 0xb9, 0xc9,
+	0xaf, 0x07, 0xb5, 0x4e, 0x78, 0xf9, 0xf3, 0x82, 0xe2, 0xb4, 0x27, 0x32, 0x1b, 0x56, 0xfd, 0x67,
+	0x05, 0x1d, 0x4c, 0xb4, 0x92, 0x2d, 0xff, 0x19, 0xe9, 0x9a, 0x6e, 0x86, 0xcf, 0x76, 0x9e, 0x00,
+	0xa9, 0x2f, 0x70, 0x3a, 0x9f, 0xc1, 0xc7, 0xda, 0x36, 0xf9, 0xc6, 0x31, 0x3c, 0xde, 0x26, 0xf1,
+	0xf8, 0xeb, 0x0a, 0xda, 0x11, 0x7e, 0x9e, 0x91, 0xbc, 0xef, 0x24, 0x4f, 0x50, 0x12, 0xf6, 0x9d,
+	0xec, 0xa1, 0x88, 0xfa, 0x00, 0x37, 0x63, 0x0a, 0x97, 0x4a, 0x89, 0xff, 0x43, 0x42, 0xee, 0xdc,
+	0x6f, 0x29, 0x68, 0x7b, 0x58, 0xa3, 0x0c, 0x9e, 0xfc, 0x85, 0x8c, 0x0c, 0x5e, 0xc2, 0x3b, 0x16,
+	0xf5, 0x5f, 0x39, 0xbc, 0x8b, 0x78, 0x76, 0x83, 0xf0, 0x22, 0x9e, 0x74, 0x93, 0x90, 0x35, 0xfc,
+	0x2d, 0x05, 0x0d, 0xc9, 0x1e, 0x47, 0xc8, 0x42, 0x70, 0xca, 0x83, 0x17, 0x59, 0x08, 0x4e, 0x7b,
+	0x73, 0xa1, 0x96, 0xa4, 0xa1, 0x8d, 0x80, 0x88, 0x56, 0x67, 0x32, 0xda, 0xa2, 0xdd, 0xd0, 0xdc,
+	0x65, 0xbd, 0xf1, 0xff, 0x5d, 0x0a, 0x7e, 0x5b, 0x41, 0xfb, 0x12, 0xea, 0xdc, 0xf8, 0x54, 0xf2,
+	0xe4, 0xf2, 0xca, 0x4a, 0x7e, 0x6a, 0x03, 0x12, 0x80, 0x78, 0x9a, 0x23, 0x8e, 0xba, 0x6b, 0x80,
+	0xb8, 0xc1, 0xc4, 0xc2, 0x6e, 0xcb, 0x40, 0xaf, 0xa1, 0x6e, 0xb6, 0x82, 0xf8, 0x90, 0xe4, 0x08,
+	0xd9, 0xaa, 0xe0, 0xe6, 0x47, 0x92, 0xba, 0x61, 0xea, 0xfb, 0xf9, 0xd4, 0xa7, 0x70, 0x31, 0xb6,
+	0xe0, 0xc2, 0x3a, 0xc7, 0x16, 0xd7, 0x41, 0x7d, 0x7e, 0x29, 0x17, 0x8f, 0xc9, 0xe7, 0x08, 0x95,
+	0x79, 0x33, 0x61, 0x1c, 0xe6, 0x30, 0x0e, 0xe1, 0x03, 0x32, 0x18, 0x5e, 0x7d, 0x78, 0x0d, 0x7f,
+	0x16, 0xb6, 0x40, 0x50, 0x7e, 0x4c, 0xde, 0x02, 0x91, 0xba, 0x6a, 0xca, 0x16, 0x88, 0x56, 0x46,
+	0xd5, 0x71, 0x0e, 0x65, 0x0c, 0x17, 0x4a, 0x89, 0xff, 0xc9, 0xa9, 0x74, 0x87, 0xc1, 0xf9, 0x0c,
+	0xc4, 0x0c, 0x5f, 0x43, 0x7a, 0xcc, 0x68, 0x03, 0x51, 0x42, 0xad, 0x56, 0x55, 0x39, 0xa2, 0x83,
+	0x38, 0x9f, 0x8c, 0x08, 0x7f, 0x4e, 0x41, 0x3b, 0x22, 0x25, 0x4f, 0x19, 0x18, 0x79, 0x7d, 0x55,
+	0x06, 0x26, 0xa1, 0x7e, 0xaa, 0x1e, 0xe1, 0x60, 0x0a, 0xf8, 0x90, 0x00, 0xc6, 0x85, 0xd1, 0x1a,
+	0x1c, 0x1e, 0xf0, 0x6b, 0x0a, 0xc2, 0xf1, 0xea, 0x26, 0x3e, 0x91, 0x3c, 0x51, 0xac, 0xa6, 0x9a,
+	0x3f, 0xd9, 0xde, 0x60, 0x00, 0x36, 0xc1, 0x81, 0xa9, 0x78, 0x54, 0x0e, 0x6c, 0xb9, 0x05, 0xe2,
+	0x2d, 0x05, 0xed, 0x4b, 0x28, 0x62, 0xca, 0xf6, 0x7b, 0x7a, 0x25, 0x55, 0xb6, 0xdf, 0x33, 0x2a,
+	0xa4, 0x10, 0xa1, 0xa2, 0xfb, 0x3d, 0x80, 0x1a, 0xdb, 0xef, 0xf8, 0x37, 0x0a, 0x1a, 0xcd, 0xaa,
+	0x52, 0xe2, 0x87, 0xb2, 0xe9, 0x4a, 0xa8, 0xa2, 0xe6, 0xcf, 0x6e, 0x46, 0x14, 0x8c, 0x79, 0x88,
+	0x1b, 0x33, 0x83, 0xa7, 0xd2, 0x79, 0xd7, 0xe2, 0xd9, 0x17, 0xff, 0x40, 0x41, 0xc3, 0x49, 0x95,
+	0x4a, 0x9c, 0xc2, 0x6b, 0x42, 0xc5, 0x54, 0xf6, 0xdd, 0x97, 0x55, 0x08, 0x4d, 0xf8, 0x52, 0x0a,
+	0xe0, 0x57, 0xb8, 0x9c, 0x80, 0xfa, 0x0d, 0x05, 0x0d, 0xc9, 0x8a, 0x94, 0xb2, 0xbc, 0x96, 0x52,
+	0x20, 0x95, 0xe5, 0xb5, 0xb4, 0xda, 0x67, 0xc2, 0x91, 0x3d, 0x40, 0x2a, 0xe6, 0x35, 0x1e, 0x2c,
+	0xc3, 0x95, 0x99, 0x84, 0x60, 0x29, 0x29, 0x2b, 0x25, 0x04, 0x4b, 0x59, 0x99, 0x27, 0x21, 0x58,
+	0x0a, 0x65, 0x41, 0x2f, 0x58, 0xb2, 0x03, 0x56, 0x58, 0x43, 0x72, 0xb0, 0x6c, 0x13, 0x51, 0x42,
+	0x55, 0x32, 0xe1, 0x80, 0x15, 0x41, 0x24, 0x3b, 0x60, 0xfd, 0x44, 0x41, 0xfb, 0x13, 0x6b, 0x7b,
+	0x78, 0x3a, 0x63, 0x97, 0xcb, 0x50, 0xcf, 0x6c, 0x48, 0x06, 0xf0, 0x4f, 0x71, 0xfc, 0x27, 0x22,
+	0xe7, 0xdc, 0x48, 0x6c, 0x10, 0xcc, 0xc1, 0x3f, 0x53, 0x50, 0x3e, 0xb9, 0x98, 0x87, 0x67, 0xb2,
+	0x76, 0x85, 0x0c, 0xfb, 0xe9, 0x8d, 0x09, 0x09, 0x07, 0x99, 0x93, 0xf8, 0x78, 0xea, 0x66, 0x12,
+	0xd1, 0x87, 0x83, 0x40, 0xb4, 0x16, 0x97, 0x16, 0x04, 0x12, 0x6a, 0x89, 0x69, 0x41, 0x20, 0xa9,
+	0xd4, 0x97, 0x15, 0x04, 0x16, 0x98, 0x5c, 0x38, 0x06, 0xb8, 0x42, 0x0e, 0x89, 0x54, 0xe0, 0xd2,
+	0x72, 0x88, 0xbc, 0x7a, 0x98, 0x96, 0x43, 0x12, 0xca, 0x7b, 0x59, 0x39, 0x44, 0x67, 0x62, 0x42,
+	0xd8, 0xfa, 0x8e, 0x82, 0x70, 0xbc, 0x20, 0x85, 0xe5, 0x57, 0x36, 0x89, 0xe5, 0xb6, 0x7c, 0xa9,
+	0xed, 0xf1, 0x00, 0x74, 0x86, 0x03, 0x9d, 0xc4, 0x27, 0x4a, 0x59, 0xff, 0xe5, 0xbb, 0xb5, 0x2f,
+	0x59, 0x8c, 0xdd, 0x13, 0xd7, 0xc9, 0x82, 0x86, 0xfc, 0x42, 0x66, 0x43, 0x78, 0x53, 0x0b, 0x7c,
+	0xea, 0x31, 0x8e, 0xf7, 0x30, 0x1e, 0xcb, 0xc4, 0x8b, 0xbf, 0xa1, 0xa0, 0x41, 0xb1, 0x0e, 0x85,
+	0xc7, 0x25, 0xd3, 0xc9, 0xca, 0x70, 0xf9, 0x89, 0xec, 0x81, 0x00, 0xe8, 0x1c, 0x07, 0x74, 0x06,
+	0xcf, 0x08, 0x80, 0x22, 0x45, 0xa7, 0x70, 0x4c, 0x6b, 0x15, 0x8d, 0xd6, 0xf0, 0xd7, 0x14, 0x34,
+	0x20, 0x54, 0x66, 0xf0, 0x51, 0xd9, 0x02, 0xc6, 0xcb, 0x52, 0xf9, 0xf1, 0xcc, 0x71, 0x80, 0xef,
+	0x2c, 0xc7, 0x77, 0x1a, 0x4f, 0x0b, 0xf8, 0xc4, 0x32, 0x4c, 0x12, 0xbc, 0xff, 0x41, 0xfd, 0x41,
+	0x89, 0x04, 0xab, 0xb2, 0xdb, 0x70, 0xb1, 0x4a, 0x93, 0x3f, 0x9c, 0x3a, 0x26, 0x7d, 0x09, 0x83,
+	0x52, 0x4d, 0xc8, 0xd1, 0xfe, 0x57, 0x41, 0xbd, 0x50, 0x48, 0xc0, 0x92, 0xdb, 0x78, 0xb1, 0x36,
+	0x93, 0x1f, 0x4b, 0x19, 0x91, 0x1a, 0xbf, 0xa1, 0xdc, 0x10, 0xa6, 0xc1, 0x2f, 0xcf, 0xac, 0xcd,
+	0x5e, 0x7e, 0xef, 0xa3, 0x11, 0xe5, 0xfd, 0x8f, 0x46, 0x94, 0x3f, 0x7e, 0x34, 0xa2, 0xbc, 0xf4,
+	0xf1, 0xc8, 0x96, 0xf7, 0x3f, 0x1e, 0xd9, 0xf2, 0xdb, 0x8f, 0x47, 0xb6, 0xdc, 0x98, 0xcc, 0x2e,
+	0x8e, 0xad, 0x78, 0xf9, 0x6d, 0xb5, 0x41, 0xdc, 0x85, 0x1e, 0xee, 0xa5, 0x33, 0x7f, 0x0d, 0x00,
+	0x00, 0xff, 0xff, 0x4a, 0xd7, 0x06, 0xf3, 0x27, 0x43, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a LimitOrderTrancheUser by index.
+	LimitOrderTrancheUser(ctx context.Context, in *QueryGetLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheUserResponse, error)
+	// Queries a list of LimitOrderTranchUser items.
+	LimitOrderTrancheUserAll(ctx context.Context, in *QueryAllLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserResponse, error)
+	// Queries a list of LimitOrderTrancheUser items for a given address.
+	LimitOrderTrancheUserAllByAddress(ctx context.Context, in *QueryAllLimitOrderTrancheUserByAddressRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserByAddressResponse, error)
+	// Queries a LimitOrderTranche by index.
+	LimitOrderTranche(ctx context.Context, in *QueryGetLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheResponse, error)
+	// Queries a list of LimitOrderTranche items for a given pairID / TokenIn
+	// combination.
+	LimitOrderTrancheAll(ctx context.Context, in *QueryAllLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheResponse, error)
+	// Queries a list of UserDeposits items.
+	UserDepositsAll(ctx context.Context, in *QueryAllUserDepositsRequest, opts ...grpc.CallOption) (*QueryAllUserDepositsResponse, error)
+	// Queries a list of TickLiquidity items.
+	TickLiquidityAll(ctx context.Context, in *QueryAllTickLiquidityRequest, opts ...grpc.CallOption) (*QueryAllTickLiquidityResponse, error)
+	// Queries a InactiveLimitOrderTranche by index.
+	InactiveLimitOrderTranche(ctx context.Context, in *QueryGetInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetInactiveLimitOrderTrancheResponse, error)
+	// Queries a list of InactiveLimitOrderTranche items.
+	InactiveLimitOrderTrancheAll(ctx context.Context, in *QueryAllInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllInactiveLimitOrderTrancheResponse, error)
+	// Queries a list of PoolReserves items.
+	PoolReservesAll(ctx context.Context, in *QueryAllPoolReservesRequest, opts ...grpc.CallOption) (*QueryAllPoolReservesResponse, error)
+	// Queries a PoolReserve by index
+	PoolReserves(ctx context.Context, in *QueryGetPoolReservesRequest, opts ...grpc.CallOption) (*QueryGetPoolReservesResponse, error)
+	// DEPRECATED Queries the simulated result of a multihop swap
+	EstimateMultiHopSwap(ctx context.Context, in *QueryEstimateMultiHopSwapRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopSwapResponse, error)
+	// DEPRECATED Queries the simulated result of a PlaceLimit order
+	EstimatePlaceLimitOrder(ctx context.Context, in *QueryEstimatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QueryEstimatePlaceLimitOrderResponse, error)
+	// Queries a pool by pair, tick and fee
+	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// Queries a pool by ID
+	PoolByID(ctx context.Context, in *QueryPoolByIDRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
+	// Queries a PoolMetadata by ID
+	PoolMetadata(ctx context.Context, in *QueryGetPoolMetadataRequest, opts ...grpc.CallOption) (*QueryGetPoolMetadataResponse, error)
+	// Queries a list of PoolMetadata items.
+	PoolMetadataAll(ctx context.Context, in *QueryAllPoolMetadataRequest, opts ...grpc.CallOption) (*QueryAllPoolMetadataResponse, error)
+	// Simulates MsgDeposit
+	SimulateDeposit(ctx context.Context, in *QuerySimulateDepositRequest, opts ...grpc.CallOption) (*QuerySimulateDepositResponse, error)
+	// Simulates MsgWithdrawal
+	SimulateWithdrawal(ctx context.Context, in *QuerySimulateWithdrawalRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawalResponse, error)
+	// Simulates MsgPlaceLimitOrder
+	SimulatePlaceLimitOrder(ctx context.Context, in *QuerySimulatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceLimitOrderResponse, error)
+	// Simulates MsgWithdrawFilledLimitOrder
+	SimulateWithdrawFilledLimitOrder(ctx context.Context, in *QuerySimulateWithdrawFilledLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawFilledLimitOrderResponse, error)
+	// Simulates MsgCancelLimitOrder
+	SimulateCancelLimitOrder(ctx context.Context, in *QuerySimulateCancelLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelLimitOrderResponse, error)
+	// Simulates MsgMultiHopSwap
+	SimulateMultiHopSwap(ctx context.Context, in *QuerySimulateMultiHopSwapRequest, opts ...grpc.CallOption) (*QuerySimulateMultiHopSwapResponse, error)
+	// Queries a TriggerOrder by ID
+	TriggerOrder(ctx context.Context, in *QueryGetTriggerOrderRequest, opts ...grpc.CallOption) (*QueryGetTriggerOrderResponse, error)
+	// Queries a list of TriggerOrder items for a given pairID / TokenIn
+	// combination.
+	TriggerOrderAll(ctx context.Context, in *QueryAllTriggerOrderRequest, opts ...grpc.CallOption) (*QueryAllTriggerOrderResponse, error)
+	// Simulates MsgPlaceTriggerOrder
+	SimulatePlaceTriggerOrder(ctx context.Context, in *QuerySimulatePlaceTriggerOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceTriggerOrderResponse, error)
+	// Simulates MsgCancelTriggerOrder
+	SimulateCancelTriggerOrder(ctx context.Context, in *QuerySimulateCancelTriggerOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelTriggerOrderResponse, error)
+	// Simulates MsgBatchLimitOrders
+	SimulateBatchLimitOrders(ctx context.Context, in *QuerySimulateBatchLimitOrdersRequest, opts ...grpc.CallOption) (*QuerySimulateBatchLimitOrdersResponse, error)
+	// Simulates MsgAmendLimitOrder
+	SimulateAmendLimitOrder(ctx context.Context, in *QuerySimulateAmendLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateAmendLimitOrderResponse, error)
+	// Queries the protocol fees accrued from swaps against pools of a pair
+	ProtocolFeeAccrual(ctx context.Context, in *QueryGetProtocolFeeAccrualRequest, opts ...grpc.CallOption) (*QueryGetProtocolFeeAccrualResponse, error)
+	// Queries the protocol fees accrued for all pairs
+	ProtocolFeeAccrualAll(ctx context.Context, in *QueryAllProtocolFeeAccrualRequest, opts ...grpc.CallOption) (*QueryAllProtocolFeeAccrualResponse, error)
+	// Queries the arithmetic time weighted average price of base_denom in terms of the other token of a pair
+	ArithmeticTwap(ctx context.Context, in *QueryArithmeticTwapRequest, opts ...grpc.CallOption) (*QueryArithmeticTwapResponse, error)
+	// Queries the geometric time weighted average price of base_denom in terms of the other token of a pair
+	GeometricTwap(ctx context.Context, in *QueryGeometricTwapRequest, opts ...grpc.CallOption) (*QueryGeometricTwapResponse, error)
+	// Queries the rolling 24 hour trading statistics of a pair
+	PairStats(ctx context.Context, in *QueryPairStatsRequest, opts ...grpc.CallOption) (*QueryPairStatsResponse, error)
+	// Queries the candles of a pair for an interval
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGetLimitOrderTrancheUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetLimitOrderTrancheUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CalcWithdrawableShares {
-		i--
-		if m.CalcWithdrawableShares {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LimitOrderTrancheUser(ctx context.Context, in *QueryGetLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheUserResponse, error) {
+	out := new(QueryGetLimitOrderTrancheUserResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUser", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheUserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrderTrancheUserAll(ctx context.Context, in *QueryAllLimitOrderTrancheUserRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserResponse, error) {
+	out := new(QueryAllLimitOrderTrancheUserResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUserAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheUserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrderTrancheUserAllByAddress(ctx context.Context, in *QueryAllLimitOrderTrancheUserByAddressRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheUserByAddressResponse, error) {
+	out := new(QueryAllLimitOrderTrancheUserByAddressResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheUserAllByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawableShares != nil {
-		{
-			size := m.WithdrawableShares.Size()
-			i -= size
-			if _, err := m.WithdrawableShares.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LimitOrderTrancheUser != nil {
-		{
-			size, err := m.LimitOrderTrancheUser.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LimitOrderTranche(ctx context.Context, in *QueryGetLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetLimitOrderTrancheResponse, error) {
+	out := new(QueryGetLimitOrderTrancheResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTranche", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LimitOrderTrancheAll(ctx context.Context, in *QueryAllLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllLimitOrderTrancheResponse, error) {
+	out := new(QueryAllLimitOrderTrancheResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/LimitOrderTrancheAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) UserDepositsAll(ctx context.Context, in *QueryAllUserDepositsRequest, opts ...grpc.CallOption) (*QueryAllUserDepositsResponse, error) {
+	out := new(QueryAllUserDepositsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/UserDepositsAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TickLiquidityAll(ctx context.Context, in *QueryAllTickLiquidityRequest, opts ...grpc.CallOption) (*QueryAllTickLiquidityResponse, error) {
+	out := new(QueryAllTickLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/TickLiquidityAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InactiveLimitOrderTranche(ctx context.Context, in *QueryGetInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryGetInactiveLimitOrderTrancheResponse, error) {
+	out := new(QueryGetInactiveLimitOrderTrancheResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/InactiveLimitOrderTranche", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InactiveLimitOrderTrancheAll(ctx context.Context, in *QueryAllInactiveLimitOrderTrancheRequest, opts ...grpc.CallOption) (*QueryAllInactiveLimitOrderTrancheResponse, error) {
+	out := new(QueryAllInactiveLimitOrderTrancheResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/InactiveLimitOrderTrancheAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LimitOrderTrancheUser) > 0 {
-		for iNdEx := len(m.LimitOrderTrancheUser) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LimitOrderTrancheUser[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolReservesAll(ctx context.Context, in *QueryAllPoolReservesRequest, opts ...grpc.CallOption) (*QueryAllPoolReservesResponse, error) {
+	out := new(QueryAllPoolReservesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolReservesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolReserves(ctx context.Context, in *QueryGetPoolReservesRequest, opts ...grpc.CallOption) (*QueryGetPoolReservesResponse, error) {
+	out := new(QueryGetPoolReservesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolReserves", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *queryClient) EstimateMultiHopSwap(ctx context.Context, in *QueryEstimateMultiHopSwapRequest, opts ...grpc.CallOption) (*QueryEstimateMultiHopSwapResponse, error) {
+	out := new(QueryEstimateMultiHopSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/EstimateMultiHopSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+// Deprecated: Do not use.
+func (c *queryClient) EstimatePlaceLimitOrder(ctx context.Context, in *QueryEstimatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QueryEstimatePlaceLimitOrderResponse, error) {
+	out := new(QueryEstimatePlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/EstimatePlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/Pool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolByID(ctx context.Context, in *QueryPoolByIDRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error) {
+	out := new(QueryPoolResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LimitOrderTranche != nil {
-		{
-			size, err := m.LimitOrderTranche.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PoolMetadata(ctx context.Context, in *QueryGetPoolMetadataRequest, opts ...grpc.CallOption) (*QueryGetPoolMetadataResponse, error) {
+	out := new(QueryGetPoolMetadataResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolMetadataAll(ctx context.Context, in *QueryAllPoolMetadataRequest, opts ...grpc.CallOption) (*QueryAllPoolMetadataResponse, error) {
+	out := new(QueryAllPoolMetadataResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PoolMetadataAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateDeposit(ctx context.Context, in *QuerySimulateDepositRequest, opts ...grpc.CallOption) (*QuerySimulateDepositResponse, error) {
+	out := new(QuerySimulateDepositResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SimulateWithdrawal(ctx context.Context, in *QuerySimulateWithdrawalRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawalResponse, error) {
+	out := new(QuerySimulateWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulatePlaceLimitOrder(ctx context.Context, in *QuerySimulatePlaceLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceLimitOrderResponse, error) {
+	out := new(QuerySimulatePlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulatePlaceLimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateWithdrawFilledLimitOrder(ctx context.Context, in *QuerySimulateWithdrawFilledLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateWithdrawFilledLimitOrderResponse, error) {
+	out := new(QuerySimulateWithdrawFilledLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateWithdrawFilledLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LimitOrderTranche) > 0 {
-		for iNdEx := len(m.LimitOrderTranche) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LimitOrderTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) SimulateCancelLimitOrder(ctx context.Context, in *QuerySimulateCancelLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelLimitOrderResponse, error) {
+	out := new(QuerySimulateCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateCancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateMultiHopSwap(ctx context.Context, in *QuerySimulateMultiHopSwapRequest, opts ...grpc.CallOption) (*QuerySimulateMultiHopSwapResponse, error) {
+	out := new(QuerySimulateMultiHopSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateMultiHopSwap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TriggerOrder(ctx context.Context, in *QueryGetTriggerOrderRequest, opts ...grpc.CallOption) (*QueryGetTriggerOrderResponse, error) {
+	out := new(QueryGetTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/TriggerOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncludePoolData {
-		i--
-		if m.IncludePoolData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TriggerOrderAll(ctx context.Context, in *QueryAllTriggerOrderRequest, opts ...grpc.CallOption) (*QueryAllTriggerOrderResponse, error) {
+	out := new(QueryAllTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/TriggerOrderAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulatePlaceTriggerOrder(ctx context.Context, in *QuerySimulatePlaceTriggerOrderRequest, opts ...grpc.CallOption) (*QuerySimulatePlaceTriggerOrderResponse, error) {
+	out := new(QuerySimulatePlaceTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulatePlaceTriggerOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SimulateCancelTriggerOrder(ctx context.Context, in *QuerySimulateCancelTriggerOrderRequest, opts ...grpc.CallOption) (*QuerySimulateCancelTriggerOrderResponse, error) {
+	out := new(QuerySimulateCancelTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateCancelTriggerOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllUserDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) SimulateBatchLimitOrders(ctx context.Context, in *QuerySimulateBatchLimitOrdersRequest, opts ...grpc.CallOption) (*QuerySimulateBatchLimitOrdersResponse, error) {
+	out := new(QuerySimulateBatchLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateBatchLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) SimulateAmendLimitOrder(ctx context.Context, in *QuerySimulateAmendLimitOrderRequest, opts ...grpc.CallOption) (*QuerySimulateAmendLimitOrderResponse, error) {
+	out := new(QuerySimulateAmendLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/SimulateAmendLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProtocolFeeAccrual(ctx context.Context, in *QueryGetProtocolFeeAccrualRequest, opts ...grpc.CallOption) (*QueryGetProtocolFeeAccrualResponse, error) {
+	out := new(QueryGetProtocolFeeAccrualResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/ProtocolFeeAccrual", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllLimitOrderTrancheUserByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProtocolFeeAccrualAll(ctx context.Context, in *QueryAllProtocolFeeAccrualRequest, opts ...grpc.CallOption) (*QueryAllProtocolFeeAccrualResponse, error) {
+	out := new(QueryAllProtocolFeeAccrualResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/ProtocolFeeAccrualAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllLimitOrderTrancheUserByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LimitOrders) > 0 {
-		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ArithmeticTwap(ctx context.Context, in *QueryArithmeticTwapRequest, opts ...grpc.CallOption) (*QueryArithmeticTwapResponse, error) {
+	out := new(QueryArithmeticTwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/ArithmeticTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllTickLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GeometricTwap(ctx context.Context, in *QueryGeometricTwapRequest, opts ...grpc.CallOption) (*QueryGeometricTwapResponse, error) {
+	out := new(QueryGeometricTwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/GeometricTwap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllTickLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllTickLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PairStats(ctx context.Context, in *QueryPairStatsRequest, opts ...grpc.CallOption) (*QueryPairStatsResponse, error) {
+	out := new(QueryPairStatsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/PairStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllTickLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Query/Candles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllTickLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a LimitOrderTrancheUser by index.
+	LimitOrderTrancheUser(context.Context, *QueryGetLimitOrderTrancheUserRequest) (*QueryGetLimitOrderTrancheUserResponse, error)
+	// Queries a list of LimitOrderTranchUser items.
+	LimitOrderTrancheUserAll(context.Context, *QueryAllLimitOrderTrancheUserRequest) (*QueryAllLimitOrderTrancheUserResponse, error)
+	// Queries a list of LimitOrderTrancheUser items for a given address.
+	LimitOrderTrancheUserAllByAddress(context.Context, *QueryAllLimitOrderTrancheUserByAddressRequest) (*QueryAllLimitOrderTrancheUserByAddressResponse, error)
+	// Queries a LimitOrderTranche by index.
+	LimitOrderTranche(context.Context, *QueryGetLimitOrderTrancheRequest) (*QueryGetLimitOrderTrancheResponse, error)
+	// Queries a list of LimitOrderTranche items for a given pairID / TokenIn
+	// combination.
+	LimitOrderTrancheAll(context.Context, *QueryAllLimitOrderTrancheRequest) (*QueryAllLimitOrderTrancheResponse, error)
+	// Queries a list of UserDeposits items.
+	UserDepositsAll(context.Context, *QueryAllUserDepositsRequest) (*QueryAllUserDepositsResponse, error)
+	// Queries a list of TickLiquidity items.
+	TickLiquidityAll(context.Context, *QueryAllTickLiquidityRequest) (*QueryAllTickLiquidityResponse, error)
+	// Queries a InactiveLimitOrderTranche by index.
+	InactiveLimitOrderTranche(context.Context, *QueryGetInactiveLimitOrderTrancheRequest) (*QueryGetInactiveLimitOrderTrancheResponse, error)
+	// Queries a list of InactiveLimitOrderTranche items.
+	InactiveLimitOrderTrancheAll(context.Context, *QueryAllInactiveLimitOrderTrancheRequest) (*QueryAllInactiveLimitOrderTrancheResponse, error)
+	// Queries a list of PoolReserves items.
+	PoolReservesAll(context.Context, *QueryAllPoolReservesRequest) (*QueryAllPoolReservesResponse, error)
+	// Queries a PoolReserve by index
+	PoolReserves(context.Context, *QueryGetPoolReservesRequest) (*QueryGetPoolReservesResponse, error)
+	// DEPRECATED Queries the simulated result of a multihop swap
+	EstimateMultiHopSwap(context.Context, *QueryEstimateMultiHopSwapRequest) (*QueryEstimateMultiHopSwapResponse, error)
+	// DEPRECATED Queries the simulated result of a PlaceLimit order
+	EstimatePlaceLimitOrder(context.Context, *QueryEstimatePlaceLimitOrderRequest) (*QueryEstimatePlaceLimitOrderResponse, error)
+	// Queries a pool by pair, tick and fee
+	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
+	// Queries a pool by ID
+	PoolByID(context.Context, *QueryPoolByIDRequest) (*QueryPoolResponse, error)
+	// Queries a PoolMetadata by ID
+	PoolMetadata(context.Context, *QueryGetPoolMetadataRequest) (*QueryGetPoolMetadataResponse, error)
+	// Queries a list of PoolMetadata items.
+	PoolMetadataAll(context.Context, *QueryAllPoolMetadataRequest) (*QueryAllPoolMetadataResponse, error)
+	// Simulates MsgDeposit
+	SimulateDeposit(context.Context, *QuerySimulateDepositRequest) (*QuerySimulateDepositResponse, error)
+	// Simulates MsgWithdrawal
+	SimulateWithdrawal(context.Context, *QuerySimulateWithdrawalRequest) (*QuerySimulateWithdrawalResponse, error)
+	// Simulates MsgPlaceLimitOrder
+	SimulatePlaceLimitOrder(context.Context, *QuerySimulatePlaceLimitOrderRequest) (*QuerySimulatePlaceLimitOrderResponse, error)
+	// Simulates MsgWithdrawFilledLimitOrder
+	SimulateWithdrawFilledLimitOrder(context.Context, *QuerySimulateWithdrawFilledLimitOrderRequest) (*QuerySimulateWithdrawFilledLimitOrderResponse, error)
+	// Simulates MsgCancelLimitOrder
+	SimulateCancelLimitOrder(context.Context, *QuerySimulateCancelLimitOrderRequest) (*QuerySimulateCancelLimitOrderResponse, error)
+	// Simulates MsgMultiHopSwap
+	SimulateMultiHopSwap(context.Context, *QuerySimulateMultiHopSwapRequest) (*QuerySimulateMultiHopSwapResponse, error)
+	// Queries a TriggerOrder by ID
+	TriggerOrder(context.Context, *QueryGetTriggerOrderRequest) (*QueryGetTriggerOrderResponse, error)
+	// Queries a list of TriggerOrder items for a given pairID / TokenIn
+	// combination.
+	TriggerOrderAll(context.Context, *QueryAllTriggerOrderRequest) (*QueryAllTriggerOrderResponse, error)
+	// Simulates MsgPlaceTriggerOrder
+	SimulatePlaceTriggerOrder(context.Context, *QuerySimulatePlaceTriggerOrderRequest) (*QuerySimulatePlaceTriggerOrderResponse, error)
+	// Simulates MsgCancelTriggerOrder
+	SimulateCancelTriggerOrder(context.Context, *QuerySimulateCancelTriggerOrderRequest) (*QuerySimulateCancelTriggerOrderResponse, error)
+	// Simulates MsgBatchLimitOrders
+	SimulateBatchLimitOrders(context.Context, *QuerySimulateBatchLimitOrdersRequest) (*QuerySimulateBatchLimitOrdersResponse, error)
+	// Simulates MsgAmendLimitOrder
+	SimulateAmendLimitOrder(context.Context, *QuerySimulateAmendLimitOrderRequest) (*QuerySimulateAmendLimitOrderResponse, error)
+	// Queries the protocol fees accrued from swaps against pools of a pair
+	ProtocolFeeAccrual(context.Context, *QueryGetProtocolFeeAccrualRequest) (*QueryGetProtocolFeeAccrualResponse, error)
+	// Queries the protocol fees accrued for all pairs
+	ProtocolFeeAccrualAll(context.Context, *QueryAllProtocolFeeAccrualRequest) (*QueryAllProtocolFeeAccrualResponse, error)
+	// Queries the arithmetic time weighted average price of base_denom in terms of the other token of a pair
+	ArithmeticTwap(context.Context, *QueryArithmeticTwapRequest) (*QueryArithmeticTwapResponse, error)
+	// Queries the geometric time weighted average price of base_denom in terms of the other token of a pair
+	GeometricTwap(context.Context, *QueryGeometricTwapRequest) (*QueryGeometricTwapResponse, error)
+	// Queries the rolling 24 hour trading statistics of a pair
+	PairStats(context.Context, *QueryPairStatsRequest) (*QueryPairStatsResponse, error)
+	// Queries the candles of a pair for an interval
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
 }
 
-func (m *QueryAllTickLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TickLiquidity) > 0 {
-		for iNdEx := len(m.TickLiquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TickLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetInactiveLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetInactiveLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LimitOrderTrancheUser(ctx context.Context, req *QueryGetLimitOrderTrancheUserRequest) (*QueryGetLimitOrderTrancheUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUser not implemented")
 }
-
-func (m *QueryGetInactiveLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) LimitOrderTrancheUserAll(ctx context.Context, req *QueryAllLimitOrderTrancheUserRequest) (*QueryAllLimitOrderTrancheUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUserAll not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderTrancheUserAllByAddress(ctx context.Context, req *QueryAllLimitOrderTrancheUserByAddressRequest) (*QueryAllLimitOrderTrancheUserByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheUserAllByAddress not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderTranche(ctx context.Context, req *QueryGetLimitOrderTrancheRequest) (*QueryGetLimitOrderTrancheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTranche not implemented")
+}
+func (*UnimplementedQueryServer) LimitOrderTrancheAll(ctx context.Context, req *QueryAllLimitOrderTrancheRequest) (*QueryAllLimitOrderTrancheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LimitOrderTrancheAll not implemented")
+}
+func (*UnimplementedQueryServer) UserDepositsAll(ctx context.Context, req *QueryAllUserDepositsRequest) (*QueryAllUserDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserDepositsAll not implemented")
+}
+func (*UnimplementedQueryServer) TickLiquidityAll(ctx context.Context, req *QueryAllTickLiquidityRequest) (*QueryAllTickLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickLiquidityAll not implemented")
+}
+func (*UnimplementedQueryServer) InactiveLimitOrderTranche(ctx context.Context, req *QueryGetInactiveLimitOrderTrancheRequest) (*QueryGetInactiveLimitOrderTrancheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveLimitOrderTranche not implemented")
+}
+func (*UnimplementedQueryServer) InactiveLimitOrderTrancheAll(ctx context.Context, req *QueryAllInactiveLimitOrderTrancheRequest) (*QueryAllInactiveLimitOrderTrancheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveLimitOrderTrancheAll not implemented")
+}
+func (*UnimplementedQueryServer) PoolReservesAll(ctx context.Context, req *QueryAllPoolReservesRequest) (*QueryAllPoolReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolReservesAll not implemented")
+}
+func (*UnimplementedQueryServer) PoolReserves(ctx context.Context, req *QueryGetPoolReservesRequest) (*QueryGetPoolReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolReserves not implemented")
+}
+func (*UnimplementedQueryServer) EstimateMultiHopSwap(ctx context.Context, req *QueryEstimateMultiHopSwapRequest) (*QueryEstimateMultiHopSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateMultiHopSwap not implemented")
+}
+func (*UnimplementedQueryServer) EstimatePlaceLimitOrder(ctx context.Context, req *QueryEstimatePlaceLimitOrderRequest) (*QueryEstimatePlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatePlaceLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pool not implemented")
+}
+func (*UnimplementedQueryServer) PoolByID(ctx context.Context, req *QueryPoolByIDRequest) (*QueryPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolByID not implemented")
+}
+func (*UnimplementedQueryServer) PoolMetadata(ctx context.Context, req *QueryGetPoolMetadataRequest) (*QueryGetPoolMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolMetadata not implemented")
+}
+func (*UnimplementedQueryServer) PoolMetadataAll(ctx context.Context, req *QueryAllPoolMetadataRequest) (*QueryAllPoolMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolMetadataAll not implemented")
+}
+func (*UnimplementedQueryServer) SimulateDeposit(ctx context.Context, req *QuerySimulateDepositRequest) (*QuerySimulateDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateDeposit not implemented")
+}
+func (*UnimplementedQueryServer) SimulateWithdrawal(ctx context.Context, req *QuerySimulateWithdrawalRequest) (*QuerySimulateWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithdrawal not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePlaceLimitOrder(ctx context.Context, req *QuerySimulatePlaceLimitOrderRequest) (*QuerySimulatePlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePlaceLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateWithdrawFilledLimitOrder(ctx context.Context, req *QuerySimulateWithdrawFilledLimitOrderRequest) (*QuerySimulateWithdrawFilledLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWithdrawFilledLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCancelLimitOrder(ctx context.Context, req *QuerySimulateCancelLimitOrderRequest) (*QuerySimulateCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCancelLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateMultiHopSwap(ctx context.Context, req *QuerySimulateMultiHopSwapRequest) (*QuerySimulateMultiHopSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMultiHopSwap not implemented")
+}
+func (*UnimplementedQueryServer) TriggerOrder(ctx context.Context, req *QueryGetTriggerOrderRequest) (*QueryGetTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerOrder not implemented")
+}
+func (*UnimplementedQueryServer) TriggerOrderAll(ctx context.Context, req *QueryAllTriggerOrderRequest) (*QueryAllTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerOrderAll not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePlaceTriggerOrder(ctx context.Context, req *QuerySimulatePlaceTriggerOrderRequest) (*QuerySimulatePlaceTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePlaceTriggerOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCancelTriggerOrder(ctx context.Context, req *QuerySimulateCancelTriggerOrderRequest) (*QuerySimulateCancelTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCancelTriggerOrder not implemented")
+}
+func (*UnimplementedQueryServer) SimulateBatchLimitOrders(ctx context.Context, req *QuerySimulateBatchLimitOrdersRequest) (*QuerySimulateBatchLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBatchLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) SimulateAmendLimitOrder(ctx context.Context, req *QuerySimulateAmendLimitOrderRequest) (*QuerySimulateAmendLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateAmendLimitOrder not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFeeAccrual(ctx context.Context, req *QueryGetProtocolFeeAccrualRequest) (*QueryGetProtocolFeeAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFeeAccrual not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFeeAccrualAll(ctx context.Context, req *QueryAllProtocolFeeAccrualRequest) (*QueryAllProtocolFeeAccrualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFeeAccrualAll not implemented")
+}
+func (*UnimplementedQueryServer) ArithmeticTwap(ctx context.Context, req *QueryArithmeticTwapRequest) (*QueryArithmeticTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArithmeticTwap not implemented")
+}
+func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *QueryGeometricTwapRequest) (*QueryGeometricTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwap not implemented")
+}
+func (*UnimplementedQueryServer) PairStats(ctx context.Context, req *QueryPairStatsRequest) (*QueryPairStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PairStats not implemented")
+}
+func (*UnimplementedQueryServer) Candles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
 }
 
-func (m *QueryGetInactiveLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInactiveLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LimitOrderTrancheUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLimitOrderTrancheUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderTrancheUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderTrancheUser(ctx, req.(*QueryGetLimitOrderTrancheUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInactiveLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InactiveLimitOrderTranche != nil {
-		{
-			size, err := m.InactiveLimitOrderTranche.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_LimitOrderTrancheUserAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLimitOrderTrancheUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderTrancheUserAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUserAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderTrancheUserAll(ctx, req.(*QueryAllLimitOrderTrancheUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LimitOrderTrancheUserAllByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLimitOrderTrancheUserByAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderTrancheUserAllByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/LimitOrderTrancheUserAllByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderTrancheUserAllByAddress(ctx, req.(*QueryAllLimitOrderTrancheUserByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LimitOrderTranche_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLimitOrderTrancheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderTranche(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/LimitOrderTranche",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderTranche(ctx, req.(*QueryGetLimitOrderTrancheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_LimitOrderTrancheAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLimitOrderTrancheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LimitOrderTrancheAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/LimitOrderTrancheAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LimitOrderTrancheAll(ctx, req.(*QueryAllLimitOrderTrancheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserDepositsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllUserDepositsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserDepositsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/UserDepositsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserDepositsAll(ctx, req.(*QueryAllUserDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllInactiveLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_TickLiquidityAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTickLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InactiveLimitOrderTranche) > 0 {
-		for iNdEx := len(m.InactiveLimitOrderTranche) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InactiveLimitOrderTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TickLiquidityAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/TickLiquidityAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickLiquidityAll(ctx, req.(*QueryAllTickLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPoolReservesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InactiveLimitOrderTranche_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInactiveLimitOrderTrancheRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPoolReservesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllPoolReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveLimitOrderTranche(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/InactiveLimitOrderTranche",
 	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveLimitOrderTranche(ctx, req.(*QueryGetInactiveLimitOrderTrancheRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllPoolReservesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InactiveLimitOrderTrancheAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInactiveLimitOrderTrancheRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllPoolReservesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllPoolReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveLimitOrderTrancheAll(ctx, in)
 	}
-	if len(m.PoolReserves) > 0 {
-		for iNdEx := len(m.PoolReserves) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/InactiveLimitOrderTrancheAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveLimitOrderTrancheAll(ctx, req.(*QueryAllInactiveLimitOrderTrancheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolReservesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolReservesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolReservesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPoolReservesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).PoolReservesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PoolReservesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolReservesAll(ctx, req.(*QueryAllPoolReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fee != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
-		i--
-		dAtA[i] = 0x20
+func _Query_PoolReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).PoolReserves(ctx, in)
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PoolReserves",
 	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolReserves(ctx, req.(*QueryGetPoolReservesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolReservesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateMultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateMultiHopSwapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateMultiHopSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/EstimateMultiHopSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateMultiHopSwap(ctx, req.(*QueryEstimateMultiHopSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolReservesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimatePlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatePlaceLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatePlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/EstimatePlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatePlaceLimitOrder(ctx, req.(*QueryEstimatePlaceLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolReserves != nil {
-		{
-			size, err := m.PoolReserves.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Pool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Pool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/Pool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pool(ctx, req.(*QueryPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateMultiHopSwapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PoolByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolByIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryEstimateMultiHopSwapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).PoolByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PoolByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolByID(ctx, req.(*QueryPoolByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateMultiHopSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PickBestRoute {
-		i--
-		if m.PickBestRoute {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func _Query_PoolMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoolMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.ExitLimitPrice.Size()
-		i -= size
-		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolMetadata(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PoolMetadata",
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolMetadata(ctx, req.(*QueryGetPoolMetadataRequest))
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolMetadataAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).PoolMetadataAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PoolMetadataAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolMetadataAll(ctx, req.(*QueryAllPoolMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SimulateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateDepositRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateDeposit(ctx, req.(*QuerySimulateDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SimulateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateWithdrawal(ctx, req.(*QuerySimulateWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimateMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CoinOut.Size()
-		i -= size
-		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_SimulatePlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePlaceLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulatePlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePlaceLimitOrder(ctx, req.(*QuerySimulatePlaceLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimatePlaceLimitOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SimulateWithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateWithdrawFilledLimitOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateWithdrawFilledLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateWithdrawFilledLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateWithdrawFilledLimitOrder(ctx, req.(*QuerySimulateWithdrawFilledLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimatePlaceLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SimulateCancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCancelLimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateCancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCancelLimitOrder(ctx, req.(*QuerySimulateCancelLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimatePlaceLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxAmountOut != nil {
-		{
-			size := m.MaxAmountOut.Size()
-			i -= size
-			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.ExpirationTime != nil {
-		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err21 != nil {
-			return 0, err21
-		}
-		i -= n21
-		i = encodeVarintQuery(dAtA, i, uint64(n21))
-		i--
-		dAtA[i] = 0x42
+func _Query_SimulateMultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateMultiHopSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.OrderType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateMultiHopSwap(ctx, in)
 	}
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateMultiHopSwap",
 	}
-	i--
-	dAtA[i] = 0x32
-	if m.TickIndexInToOut != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndexInToOut))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateMultiHopSwap(ctx, req.(*QuerySimulateMultiHopSwapRequest))
 	}
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTriggerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).TriggerOrder(ctx, in)
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/TriggerOrder",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TriggerOrder(ctx, req.(*QueryGetTriggerOrderRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEstimatePlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TriggerOrderAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTriggerOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryEstimatePlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryEstimatePlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SwapOutCoin.Size()
-		i -= size
-		if _, err := m.SwapOutCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).TriggerOrderAll(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.SwapInCoin.Size()
-		i -= size
-		if _, err := m.SwapInCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/TriggerOrderAll",
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalInCoin.Size()
-		i -= size
-		if _, err := m.TotalInCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TriggerOrderAll(ctx, req.(*QueryAllTriggerOrderRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SimulatePlaceTriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePlaceTriggerOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePlaceTriggerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulatePlaceTriggerOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePlaceTriggerOrder(ctx, req.(*QuerySimulatePlaceTriggerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SimulateCancelTriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCancelTriggerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCancelTriggerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateCancelTriggerOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCancelTriggerOrder(ctx, req.(*QuerySimulateCancelTriggerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Fee != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
-		i--
-		dAtA[i] = 0x18
+func _Query_SimulateBatchLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBatchLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBatchLimitOrders(ctx, in)
 	}
-	if len(m.PairId) > 0 {
-		i -= len(m.PairId)
-		copy(dAtA[i:], m.PairId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateBatchLimitOrders",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBatchLimitOrders(ctx, req.(*QuerySimulateBatchLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SimulateAmendLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateAmendLimitOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateAmendLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/SimulateAmendLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateAmendLimitOrder(ctx, req.(*QuerySimulateAmendLimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ProtocolFeeAccrual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtocolFeeAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFeeAccrual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/ProtocolFeeAccrual",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFeeAccrual(ctx, req.(*QueryGetProtocolFeeAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func _Query_ProtocolFeeAccrualAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllProtocolFeeAccrualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFeeAccrualAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/ProtocolFeeAccrualAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFeeAccrualAll(ctx, req.(*QueryAllProtocolFeeAccrualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ArithmeticTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArithmeticTwapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ArithmeticTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/ArithmeticTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArithmeticTwap(ctx, req.(*QueryArithmeticTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GeometricTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGeometricTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GeometricTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/GeometricTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GeometricTwap(ctx, req.(*QueryGeometricTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_PairStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPairStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).PairStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/PairStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PairStats(ctx, req.(*QueryPairStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Query/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.dex.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LimitOrderTrancheUser",
+			Handler:    _Query_LimitOrderTrancheUser_Handler,
+		},
+		{
+			MethodName: "LimitOrderTrancheUserAll",
+			Handler:    _Query_LimitOrderTrancheUserAll_Handler,
+		},
+		{
+			MethodName: "LimitOrderTrancheUserAllByAddress",
+			Handler:    _Query_LimitOrderTrancheUserAllByAddress_Handler,
+		},
+		{
+			MethodName: "LimitOrderTranche",
+			Handler:    _Query_LimitOrderTranche_Handler,
+		},
+		{
+			MethodName: "LimitOrderTrancheAll",
+			Handler:    _Query_LimitOrderTrancheAll_Handler,
+		},
+		{
+			MethodName: "UserDepositsAll",
+			Handler:    _Query_UserDepositsAll_Handler,
+		},
+		{
+			MethodName: "TickLiquidityAll",
+			Handler:    _Query_TickLiquidityAll_Handler,
+		},
+		{
+			MethodName: "InactiveLimitOrderTranche",
+			Handler:    _Query_InactiveLimitOrderTranche_Handler,
+		},
+		{
+			MethodName: "InactiveLimitOrderTrancheAll",
+			Handler:    _Query_InactiveLimitOrderTrancheAll_Handler,
+		},
+		{
+			MethodName: "PoolReservesAll",
+			Handler:    _Query_PoolReservesAll_Handler,
+		},
+		{
+			MethodName: "PoolReserves",
+			Handler:    _Query_PoolReserves_Handler,
+		},
+		{
+			MethodName: "EstimateMultiHopSwap",
+			Handler:    _Query_EstimateMultiHopSwap_Handler,
+		},
+		{
+			MethodName: "EstimatePlaceLimitOrder",
+			Handler:    _Query_EstimatePlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "PoolByID",
+			Handler:    _Query_PoolByID_Handler,
+		},
+		{
+			MethodName: "PoolMetadata",
+			Handler:    _Query_PoolMetadata_Handler,
+		},
+		{
+			MethodName: "PoolMetadataAll",
+			Handler:    _Query_PoolMetadataAll_Handler,
+		},
+		{
+			MethodName: "SimulateDeposit",
+			Handler:    _Query_SimulateDeposit_Handler,
+		},
+		{
+			MethodName: "SimulateWithdrawal",
+			Handler:    _Query_SimulateWithdrawal_Handler,
+		},
+		{
+			MethodName: "SimulatePlaceLimitOrder",
+			Handler:    _Query_SimulatePlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "SimulateWithdrawFilledLimitOrder",
+			Handler:    _Query_SimulateWithdrawFilledLimitOrder_Handler,
+		},
+		{
+			MethodName: "SimulateCancelLimitOrder",
+			Handler:    _Query_SimulateCancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "SimulateMultiHopSwap",
+			Handler:    _Query_SimulateMultiHopSwap_Handler,
+		},
+		{
+			MethodName: "TriggerOrder",
+			Handler:    _Query_TriggerOrder_Handler,
+		},
+		{
+			MethodName: "TriggerOrderAll",
+			Handler:    _Query_TriggerOrderAll_Handler,
+		},
+		{
+			MethodName: "SimulatePlaceTriggerOrder",
+			Handler:    _Query_SimulatePlaceTriggerOrder_Handler,
+		},
+		{
+			MethodName: "SimulateCancelTriggerOrder",
+			Handler:    _Query_SimulateCancelTriggerOrder_Handler,
+		},
+		{
+			MethodName: "SimulateBatchLimitOrders",
+			Handler:    _Query_SimulateBatchLimitOrders_Handler,
+		},
+		{
+			MethodName: "SimulateAmendLimitOrder",
+			Handler:    _Query_SimulateAmendLimitOrder_Handler,
+		},
+		{
+			MethodName: "ProtocolFeeAccrual",
+			Handler:    _Query_ProtocolFeeAccrual_Handler,
+		},
+		{
+			MethodName: "ProtocolFeeAccrualAll",
+			Handler:    _Query_ProtocolFeeAccrualAll_Handler,
+		},
+		{
+			MethodName: "ArithmeticTwap",
+			Handler:    _Query_ArithmeticTwap_Handler,
+		},
+		{
+			MethodName: "GeometricTwap",
+			Handler:    _Query_GeometricTwap_Handler,
+		},
+		{
+			MethodName: "PairStats",
+			Handler:    _Query_PairStats_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/dex/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5062,25 +5366,20 @@ func (m *QueryGetPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPoolMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPoolMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5090,18 +5389,18 @@ func (m *QueryGetPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPoolMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPoolMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PoolMetadata.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5113,7 +5412,7 @@ func (m *QueryGetPoolMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLimitOrderTrancheUserRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5123,32 +5422,44 @@ func (m *QueryAllPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPoolMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPoolMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.CalcWithdrawableShares {
+		i--
+		if m.CalcWithdrawableShares {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLimitOrderTrancheUserResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5158,46 +5469,44 @@ func (m *QueryAllPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPoolMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheUserResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPoolMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.WithdrawableShares != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.WithdrawableShares.Size()
+			i -= size
+			if _, err := m.WithdrawableShares.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.PoolMetadata) > 0 {
-		for iNdEx := len(m.PoolMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.LimitOrderTrancheUser != nil {
+		{
+			size, err := m.LimitOrderTrancheUser.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateDepositRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheUserRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5207,19 +5516,19 @@ func (m *QuerySimulateDepositRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5232,7 +5541,7 @@ func (m *QuerySimulateDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateDepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheUserResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5242,19 +5551,19 @@ func (m *QuerySimulateDepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5262,12 +5571,26 @@ func (m *QuerySimulateDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrderTrancheUser) > 0 {
+		for iNdEx := len(m.LimitOrderTrancheUser) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderTrancheUser[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5277,32 +5600,46 @@ func (m *QuerySimulateWithdrawalRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5312,19 +5649,19 @@ func (m *QuerySimulateWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.LimitOrderTranche != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LimitOrderTranche.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5337,7 +5674,7 @@ func (m *QuerySimulateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulatePlaceLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5347,19 +5684,19 @@ func (m *QuerySimulatePlaceLimitOrderRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulatePlaceLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulatePlaceLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5367,12 +5704,26 @@ func (m *QuerySimulatePlaceLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulatePlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5382,19 +5733,19 @@ func (m *QuerySimulatePlaceLimitOrderResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulatePlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulatePlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5402,12 +5753,26 @@ func (m *QuerySimulatePlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrderTranche) > 0 {
+		for iNdEx := len(m.LimitOrderTranche) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllUserDepositsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5417,19 +5782,29 @@ func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllUserDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllUserDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
+	if m.IncludePoolData {
+		i--
+		if m.IncludePoolData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5437,12 +5812,19 @@ func (m *QuerySimulateWithdrawFilledLimitOrderRequest) MarshalToSizedBuffer(dAtA
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllUserDepositsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5452,19 +5834,19 @@ func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllUserDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllUserDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5472,12 +5854,26 @@ func (m *QuerySimulateWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAt
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateCancelLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5487,19 +5883,19 @@ func (m *QuerySimulateCancelLimitOrderRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateCancelLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateCancelLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5507,12 +5903,19 @@ func (m *QuerySimulateCancelLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5522,19 +5925,19 @@ func (m *QuerySimulateCancelLimitOrderResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLimitOrderTrancheUserByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5542,12 +5945,26 @@ func (m *QuerySimulateCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateMultiHopSwapRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllTickLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5557,19 +5974,19 @@ func (m *QuerySimulateMultiHopSwapRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateMultiHopSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllTickLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateMultiHopSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllTickLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5577,12 +5994,26 @@ func (m *QuerySimulateMultiHopSwapRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllTickLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5592,19 +6023,19 @@ func (m *QuerySimulateMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllTickLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllTickLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5612,807 +6043,5546 @@ func (m *QuerySimulateMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.TickLiquidity) > 0 {
+		for iNdEx := len(m.TickLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetInactiveLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetInactiveLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLimitOrderTrancheUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetInactiveLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.CalcWithdrawableShares {
-		n += 2
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLimitOrderTrancheUserResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LimitOrderTrancheUser != nil {
-		l = m.LimitOrderTrancheUser.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.WithdrawableShares != nil {
-		l = m.WithdrawableShares.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetInactiveLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetInactiveLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLimitOrderTrancheUserResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetInactiveLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LimitOrderTrancheUser) > 0 {
-		for _, e := range m.LimitOrderTrancheUser {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.InactiveLimitOrderTranche != nil {
+		{
+			size, err := m.InactiveLimitOrderTranche.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLimitOrderTrancheRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllInactiveLimitOrderTrancheRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetLimitOrderTrancheResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LimitOrderTranche != nil {
-		l = m.LimitOrderTranche.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllInactiveLimitOrderTrancheRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLimitOrderTrancheRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllInactiveLimitOrderTrancheRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLimitOrderTrancheResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LimitOrderTranche) > 0 {
-		for _, e := range m.LimitOrderTranche {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllInactiveLimitOrderTrancheResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllUserDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludePoolData {
-		n += 2
-	}
-	return n
+func (m *QueryAllInactiveLimitOrderTrancheResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllUserDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllInactiveLimitOrderTrancheResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InactiveLimitOrderTranche) > 0 {
+		for iNdEx := len(m.InactiveLimitOrderTranche) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveLimitOrderTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllPoolReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LimitOrders) > 0 {
-		for _, e := range m.LimitOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllPoolReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllTickLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllPoolReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllTickLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllPoolReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TickLiquidity) > 0 {
-		for _, e := range m.TickLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolReserves) > 0 {
+		for iNdEx := len(m.PoolReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInactiveLimitOrderTrancheRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPoolReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoolReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *QueryGetInactiveLimitOrderTrancheResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.InactiveLimitOrderTranche != nil {
-		l = m.InactiveLimitOrderTranche.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPoolReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInactiveLimitOrderTrancheResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPoolReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoolReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InactiveLimitOrderTranche) > 0 {
-		for _, e := range m.InactiveLimitOrderTranche {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.PoolReserves != nil {
+		{
+			size, err := m.PoolReserves.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPoolReservesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateMultiHopSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPoolReservesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateMultiHopSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateMultiHopSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolReserves) > 0 {
-		for _, e := range m.PoolReserves {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetPoolReservesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.ExitLimitPrice.Size()
+		i -= size
+		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Fee != 0 {
-		n += 1 + sovQuery(uint64(m.Fee))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPoolReservesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolReserves != nil {
-		l = m.PoolReserves.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEstimateMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEstimateMultiHopSwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimateMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ExitLimitPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.PickBestRoute {
-		n += 2
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEstimateMultiHopSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimatePlaceLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CoinOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEstimatePlaceLimitOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimatePlaceLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatePlaceLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PostOnlySlide {
+		i--
+		if m.PostOnlySlide {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExpirationTime != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintQuery(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.OrderType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	if m.TickIndexInToOut != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndexInToOut))
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovQuery(uint64(m.OrderType))
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaxAmountOut != nil {
-		l = m.MaxAmountOut.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEstimatePlaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEstimatePlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalInCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SwapInCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SwapOutCoin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEstimatePlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatePlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PairId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.SwapOutCoin.Size()
+		i -= size
+		if _, err := m.SwapOutCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapInCoin.Size()
+		i -= size
+		if _, err := m.SwapInCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Fee != 0 {
-		n += 1 + sovQuery(uint64(m.Fee))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalInCoin.Size()
+		i -= size
+		if _, err := m.TotalInCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+func (m *QueryPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPoolMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if m.Fee != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPoolMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.PoolMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPoolMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPoolByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPoolMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolMetadata) > 0 {
-		for _, e := range m.PoolMetadata {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetPoolMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateWithdrawalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPoolMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySimulatePlaceLimitOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetPoolMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulatePlaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPoolMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.PoolMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllPoolMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllPoolMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateCancelLimitOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllPoolMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySimulateCancelLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllPoolMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySimulateMultiHopSwapRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllPoolMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySimulateMultiHopSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllPoolMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolMetadata) > 0 {
+		for iNdEx := len(m.PoolMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCancelLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCancelLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCancelLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMultiHopSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMultiHopSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMultiHopSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TriggerOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePlaceTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePlaceTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePlaceTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCancelTriggerOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCancelTriggerOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCancelTriggerOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCancelTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCancelTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCancelTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBatchLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBatchLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBatchLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBatchLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBatchLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBatchLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAmendLimitOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAmendLimitOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAmendLimitOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateAmendLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateAmendLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateAmendLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtocolFeeAccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtocolFeeAccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtocolFeeAccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtocolFeeAccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtocolFeeAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtocolFeeAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProtocolFeeAccrual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProtocolFeeAccrualRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProtocolFeeAccrualRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProtocolFeeAccrualRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllProtocolFeeAccrualResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllProtocolFeeAccrualResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllProtocolFeeAccrualResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtocolFeeAccrual) > 0 {
+		for iNdEx := len(m.ProtocolFeeAccrual) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeeAccrual[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArithmeticTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArithmeticTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArithmeticTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n55, err55 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err55 != nil {
+			return 0, err55
+		}
+		i -= n55
+		i = encodeVarintQuery(dAtA, i, uint64(n55))
+		i--
+		dAtA[i] = 0x22
+	}
+	n56, err56 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err56 != nil {
+		return 0, err56
+	}
+	i -= n56
+	i = encodeVarintQuery(dAtA, i, uint64(n56))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArithmeticTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArithmeticTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArithmeticTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ArithmeticTwap.Size()
+		i -= size
+		if _, err := m.ArithmeticTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGeometricTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGeometricTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGeometricTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n57, err57 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err57 != nil {
+			return 0, err57
+		}
+		i -= n57
+		i = encodeVarintQuery(dAtA, i, uint64(n57))
+		i--
+		dAtA[i] = 0x22
+	}
+	n58, err58 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err58 != nil {
+		return 0, err58
+	}
+	i -= n58
+	i = encodeVarintQuery(dAtA, i, uint64(n58))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGeometricTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGeometricTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGeometricTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GeometricTwap.Size()
+		i -= size
+		if _, err := m.GeometricTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPairStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPairStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPairStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPairStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PairStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndTime != nil {
+		n61, err61 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err61 != nil {
+			return 0, err61
+		}
+		i -= n61
+		i = encodeVarintQuery(dAtA, i, uint64(n61))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n62, err62 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err62 != nil {
+			return 0, err62
+		}
+		i -= n62
+		i = encodeVarintQuery(dAtA, i, uint64(n62))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetLimitOrderTrancheUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CalcWithdrawableShares {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetLimitOrderTrancheUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LimitOrderTrancheUser != nil {
+		l = m.LimitOrderTrancheUser.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithdrawableShares != nil {
+		l = m.WithdrawableShares.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheUserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrderTrancheUser) > 0 {
+		for _, e := range m.LimitOrderTrancheUser {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLimitOrderTrancheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLimitOrderTrancheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LimitOrderTranche != nil {
+		l = m.LimitOrderTranche.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrderTranche) > 0 {
+		for _, e := range m.LimitOrderTranche {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllUserDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludePoolData {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllUserDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTickLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTickLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TickLiquidity) > 0 {
+		for _, e := range m.TickLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInactiveLimitOrderTrancheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInactiveLimitOrderTrancheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InactiveLimitOrderTranche != nil {
+		l = m.InactiveLimitOrderTranche.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInactiveLimitOrderTrancheRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInactiveLimitOrderTrancheResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InactiveLimitOrderTranche) > 0 {
+		for _, e := range m.InactiveLimitOrderTranche {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolReserves) > 0 {
+		for _, e := range m.PoolReserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *QueryGetPoolReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolReserves != nil {
+		l = m.PoolReserves.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateMultiHopSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExitLimitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEstimateMultiHopSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimatePlaceLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovQuery(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PostOnlySlide {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEstimatePlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalInCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapInCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapOutCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovQuery(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *QueryPoolByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPoolMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetPoolMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPoolMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPoolMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolMetadata) > 0 {
+		for _, e := range m.PoolMetadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulatePlaceLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulatePlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCancelLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateMultiHopSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateMultiHopSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TriggerOrder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulatePlaceTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulatePlaceTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCancelTriggerOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCancelTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBatchLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBatchLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateAmendLimitOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateAmendLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtocolFeeAccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtocolFeeAccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtocolFeeAccrual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllProtocolFeeAccrualRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllProtocolFeeAccrualResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolFeeAccrual) > 0 {
+		for _, e := range m.ProtocolFeeAccrual {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArithmeticTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArithmeticTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArithmeticTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGeometricTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGeometricTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GeometricTwap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPairStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPairStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PairStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalcWithdrawableShares", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CalcWithdrawableShares = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitOrderTrancheUser == nil {
+				m.LimitOrderTrancheUser = &LimitOrderTrancheUser{}
+			}
+			if err := m.LimitOrderTrancheUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.WithdrawableShares = &v
+			if err := m.WithdrawableShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderTrancheUser = append(m.LimitOrderTrancheUser, &LimitOrderTrancheUser{})
+			if err := m.LimitOrderTrancheUser[len(m.LimitOrderTrancheUser)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTranche", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LimitOrderTranche == nil {
+				m.LimitOrderTranche = &LimitOrderTranche{}
+			}
+			if err := m.LimitOrderTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTranche", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderTranche = append(m.LimitOrderTranche, &LimitOrderTranche{})
+			if err := m.LimitOrderTranche[len(m.LimitOrderTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePoolData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePoolData = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllUserDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllUserDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &DepositRecord{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, &LimitOrderTrancheUser{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTickLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTickLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTickLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTickLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTickLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickLiquidity = append(m.TickLiquidity, &TickLiquidity{})
+			if err := m.TickLiquidity[len(m.TickLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTranche", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InactiveLimitOrderTranche == nil {
+				m.InactiveLimitOrderTranche = &LimitOrderTranche{}
+			}
+			if err := m.InactiveLimitOrderTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6435,12 +11605,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6462,7 +11668,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6485,15 +11691,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTranche", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6520,7 +11726,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InactiveLimitOrderTranche = append(m.InactiveLimitOrderTranche, &LimitOrderTranche{})
+			if err := m.InactiveLimitOrderTranche[len(m.InactiveLimitOrderTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6545,7 +11788,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolReservesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6568,15 +11811,15 @@ func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolReservesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6604,11 +11847,11 @@ func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PairId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6636,13 +11879,13 @@ func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalcWithdrawableShares", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6652,12 +11895,148 @@ func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CalcWithdrawableShares = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolReserves = append(m.PoolReserves, &PoolReserves{})
+			if err := m.PoolReserves[len(m.PoolReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6679,7 +12058,7 @@ func (m *QueryGetLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPoolReservesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6702,17 +12081,17 @@ func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPoolReservesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPoolReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6722,31 +12101,27 @@ func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LimitOrderTrancheUser == nil {
-				m.LimitOrderTrancheUser = &LimitOrderTrancheUser{}
-			}
-			if err := m.LimitOrderTrancheUser.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6774,12 +12149,46 @@ func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.WithdrawableShares = &v
-			if err := m.WithdrawableShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6801,7 +12210,7 @@ func (m *QueryGetLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPoolReservesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6824,15 +12233,15 @@ func (m *QueryAllLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPoolReservesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPoolReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolReserves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6859,10 +12268,10 @@ func (m *QueryAllLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.PoolReserves == nil {
+				m.PoolReserves = &PoolReserves{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolReserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6887,7 +12296,7 @@ func (m *QueryAllLimitOrderTrancheUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6910,17 +12319,17 @@ func (m *QueryAllLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateMultiHopSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateMultiHopSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6930,31 +12339,29 @@ func (m *QueryAllLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitOrderTrancheUser = append(m.LimitOrderTrancheUser, &LimitOrderTrancheUser{})
-			if err := m.LimitOrderTrancheUser[len(m.LimitOrderTrancheUser)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6964,83 +12371,29 @@ func (m *QueryAllLimitOrderTrancheUserResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7050,46 +12403,29 @@ func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 3:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7117,11 +12453,13 @@ func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7149,8 +12487,30 @@ func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PickBestRoute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7172,7 +12532,7 @@ func (m *QueryGetLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7195,15 +12555,15 @@ func (m *QueryGetLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateMultiHopSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTranche", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7230,10 +12590,7 @@ func (m *QueryGetLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LimitOrderTranche == nil {
-				m.LimitOrderTranche = &LimitOrderTranche{}
-			}
-			if err := m.LimitOrderTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7258,7 +12615,7 @@ func (m *QueryGetLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7281,15 +12638,15 @@ func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7317,9 +12674,41 @@ func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
@@ -7351,9 +12740,113 @@ func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7380,13 +12873,69 @@ func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnlySlide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnlySlide = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7408,7 +12957,7 @@ func (m *QueryAllLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7431,15 +12980,48 @@ func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTranche", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalInCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7466,14 +13048,13 @@ func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitOrderTranche = append(m.LimitOrderTranche, &LimitOrderTranche{})
-			if err := m.LimitOrderTranche[len(m.LimitOrderTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapInCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7500,10 +13081,7 @@ func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapOutCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7528,7 +13106,7 @@ func (m *QueryAllLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7551,15 +13129,15 @@ func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7587,13 +13165,13 @@ func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PairId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
 			}
-			var msglen int
+			m.TickIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7603,33 +13181,16 @@ func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TickIndex |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePoolData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var v int
+			m.Fee = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7639,12 +13200,11 @@ func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Fee |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IncludePoolData = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7666,7 +13226,7 @@ func (m *QueryAllUserDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7689,17 +13249,17 @@ func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUserDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUserDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7709,29 +13269,64 @@ func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &DepositRecord{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7758,10 +13353,10 @@ func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7786,7 +13381,7 @@ func (m *QueryAllUserDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7809,17 +13404,17 @@ func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPoolMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPoolMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7829,27 +13424,64 @@ func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoolMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoolMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoolMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7876,10 +13508,7 @@ func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7904,7 +13533,7 @@ func (m *QueryAllLimitOrderTrancheUserByAddressRequest) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7927,47 +13556,13 @@ func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLimitOrderTrancheUserByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LimitOrders = append(m.LimitOrders, &LimitOrderTrancheUser{})
-			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7997,7 +13592,7 @@ func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Unmarshal(dAtA []byte)
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8024,7 +13619,7 @@ func (m *QueryAllLimitOrderTrancheUserByAddressResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8047,17 +13642,17 @@ func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTickLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPoolMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTickLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPoolMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8067,57 +13662,27 @@ func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PoolMetadata = append(m.PoolMetadata, PoolMetadata{})
+			if err := m.PoolMetadata[len(m.PoolMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8147,7 +13712,7 @@ func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8174,7 +13739,7 @@ func (m *QueryAllTickLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTickLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8197,49 +13762,15 @@ func (m *QueryAllTickLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTickLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTickLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TickLiquidity = append(m.TickLiquidity, &TickLiquidity{})
-			if err := m.TickLiquidity[len(m.TickLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8266,10 +13797,10 @@ func (m *QueryAllTickLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Msg == nil {
+				m.Msg = &MsgDeposit{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8294,7 +13825,7 @@ func (m *QueryAllTickLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8317,17 +13848,17 @@ func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8337,80 +13868,83 @@ func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			if m.Resp == nil {
+				m.Resp = &MsgDepositResponse{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8420,23 +13954,27 @@ func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			if m.Msg == nil {
+				m.Msg = &MsgWithdrawal{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8459,7 +13997,7 @@ func (m *QueryGetInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8482,15 +14020,15 @@ func (m *QueryGetInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInactiveLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTranche", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8517,10 +14055,10 @@ func (m *QueryGetInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InactiveLimitOrderTranche == nil {
-				m.InactiveLimitOrderTranche = &LimitOrderTranche{}
+			if m.Resp == nil {
+				m.Resp = &MsgWithdrawalResponse{}
 			}
-			if err := m.InactiveLimitOrderTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8545,7 +14083,7 @@ func (m *QueryGetInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8568,15 +14106,15 @@ func (m *QueryAllInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8603,10 +14141,10 @@ func (m *QueryAllInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Msg == nil {
+				m.Msg = &MsgPlaceLimitOrder{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8631,7 +14169,7 @@ func (m *QueryAllInactiveLimitOrderTrancheRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8654,49 +14192,15 @@ func (m *QueryAllInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInactiveLimitOrderTrancheResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTranche", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InactiveLimitOrderTranche = append(m.InactiveLimitOrderTranche, &LimitOrderTranche{})
-			if err := m.InactiveLimitOrderTranche[len(m.InactiveLimitOrderTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8723,10 +14227,10 @@ func (m *QueryAllInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Resp == nil {
+				m.Resp = &MsgPlaceLimitOrderResponse{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8751,7 +14255,7 @@ func (m *QueryAllInactiveLimitOrderTrancheResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllPoolReservesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8774,79 +14278,15 @@ func (m *QueryAllPoolReservesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolReservesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8873,10 +14313,10 @@ func (m *QueryAllPoolReservesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Msg == nil {
+				m.Msg = &MsgWithdrawFilledLimitOrder{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8901,7 +14341,7 @@ func (m *QueryAllPoolReservesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolReservesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8924,49 +14364,15 @@ func (m *QueryAllPoolReservesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolReservesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolReserves", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolReserves = append(m.PoolReserves, &PoolReserves{})
-			if err := m.PoolReserves[len(m.PoolReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8993,10 +14399,10 @@ func (m *QueryAllPoolReservesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Resp == nil {
+				m.Resp = &MsgWithdrawFilledLimitOrderResponse{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9021,7 +14427,7 @@ func (m *QueryAllPoolReservesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolReservesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCancelLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9044,81 +14450,17 @@ func (m *QueryGetPoolReservesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolReservesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.TickIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9128,30 +14470,28 @@ func (m *QueryGetPoolReservesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Fee = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Fee |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgCancelLimitOrder{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9173,7 +14513,7 @@ func (m *QueryGetPoolReservesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolReservesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9196,15 +14536,15 @@ func (m *QueryGetPoolReservesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolReservesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolReserves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9231,10 +14571,10 @@ func (m *QueryGetPoolReservesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolReserves == nil {
-				m.PoolReserves = &PoolReserves{}
+			if m.Resp == nil {
+				m.Resp = &MsgCancelLimitOrderResponse{}
 			}
-			if err := m.PoolReserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9259,7 +14599,7 @@ func (m *QueryGetPoolReservesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9282,17 +14622,17 @@ func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateMultiHopSwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateMultiHopSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateMultiHopSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateMultiHopSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9302,59 +14642,81 @@ func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if m.Msg == nil {
+				m.Msg = &MsgMultiHopSwap{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateMultiHopSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9381,84 +14743,68 @@ func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &MultiHopRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resp == nil {
+				m.Resp = &MsgMultiHopSwapResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTriggerOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9468,12 +14814,11 @@ func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PickBestRoute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9495,7 +14840,7 @@ func (m *QueryEstimateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTriggerOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9518,15 +14863,15 @@ func (m *QueryEstimateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateMultiHopSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTriggerOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9553,7 +14898,7 @@ func (m *QueryEstimateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TriggerOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9578,7 +14923,7 @@ func (m *QueryEstimateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTriggerOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9601,15 +14946,15 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTriggerOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9637,11 +14982,11 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.PairId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9669,13 +15014,13 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9685,29 +15030,83 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9717,48 +15116,31 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
-			}
-			m.TickIndexInToOut = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndexInToOut |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9768,48 +15150,81 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.OrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderType |= LimitOrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulatePlaceTriggerOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulatePlaceTriggerOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulatePlaceTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9836,18 +15251,68 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			if m.Msg == nil {
+				m.Msg = &MsgPlaceTriggerOrder{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulatePlaceTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulatePlaceTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulatePlaceTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9857,25 +15322,25 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MaxAmountOut = &v
-			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resp == nil {
+				m.Resp = &MsgPlaceTriggerOrderResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9900,7 +15365,7 @@ func (m *QueryEstimatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCancelTriggerOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9923,15 +15388,15 @@ func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCancelTriggerOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimatePlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCancelTriggerOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalInCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9958,13 +15423,66 @@ func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalInCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &MsgCancelTriggerOrder{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateCancelTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateCancelTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateCancelTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapInCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9991,13 +15509,66 @@ func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapInCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Resp == nil {
+				m.Resp = &MsgCancelTriggerOrderResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBatchLimitOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBatchLimitOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBatchLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapOutCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10024,7 +15595,10 @@ func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapOutCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &MsgBatchLimitOrders{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10049,7 +15623,7 @@ func (m *QueryEstimatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateBatchLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10072,17 +15646,17 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateBatchLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateBatchLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10092,62 +15666,28 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if m.Resp == nil {
+				m.Resp = &MsgBatchLimitOrdersResponse{}
 			}
-			m.Fee = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Fee |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10169,7 +15709,7 @@ func (m *QueryPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAmendLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10192,17 +15732,17 @@ func (m *QueryPoolByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAmendLimitOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAmendLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10212,11 +15752,28 @@ func (m *QueryPoolByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgAmendLimitOrder{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10238,7 +15795,7 @@ func (m *QueryPoolByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateAmendLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10261,15 +15818,15 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateAmendLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateAmendLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10296,10 +15853,10 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.Resp == nil {
+				m.Resp = &MsgAmendLimitOrderResponse{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10324,7 +15881,7 @@ func (m *QueryPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtocolFeeAccrualRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10347,17 +15904,17 @@ func (m *QueryGetPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtocolFeeAccrualRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtocolFeeAccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10367,11 +15924,24 @@ func (m *QueryGetPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10393,7 +15963,7 @@ func (m *QueryGetPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoolMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtocolFeeAccrualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10416,15 +15986,15 @@ func (m *QueryGetPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoolMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtocolFeeAccrualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoolMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtocolFeeAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeAccrual", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10451,7 +16021,7 @@ func (m *QueryGetPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolFeeAccrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10476,7 +16046,7 @@ func (m *QueryGetPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllProtocolFeeAccrualRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10499,10 +16069,10 @@ func (m *QueryAllPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllProtocolFeeAccrualRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllProtocolFeeAccrualRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10562,7 +16132,7 @@ func (m *QueryAllPoolMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPoolMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllProtocolFeeAccrualResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10585,15 +16155,15 @@ func (m *QueryAllPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPoolMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllProtocolFeeAccrualResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPoolMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllProtocolFeeAccrualResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeAccrual", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10620,8 +16190,8 @@ func (m *QueryAllPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolMetadata = append(m.PoolMetadata, PoolMetadata{})
-			if err := m.PoolMetadata[len(m.PoolMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProtocolFeeAccrual = append(m.ProtocolFeeAccrual, ProtocolFeeAccrual{})
+			if err := m.ProtocolFeeAccrual[len(m.ProtocolFeeAccrual)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10682,7 +16252,7 @@ func (m *QueryAllPoolMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArithmeticTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10705,17 +16275,17 @@ func (m *QuerySimulateDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArithmeticTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArithmeticTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10725,81 +16295,92 @@ func (m *QuerySimulateDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgDeposit{}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySimulateDepositResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateDepositResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10826,10 +16407,10 @@ func (m *QuerySimulateDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgDepositResponse{}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10854,7 +16435,7 @@ func (m *QuerySimulateDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateWithdrawalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryArithmeticTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10877,17 +16458,17 @@ func (m *QuerySimulateWithdrawalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryArithmeticTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryArithmeticTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArithmeticTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10897,25 +16478,23 @@ func (m *QuerySimulateWithdrawalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgWithdrawal{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArithmeticTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10940,7 +16519,7 @@ func (m *QuerySimulateWithdrawalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGeometricTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10963,17 +16542,17 @@ func (m *QuerySimulateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGeometricTwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGeometricTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10983,81 +16562,92 @@ func (m *QuerySimulateWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgWithdrawalResponse{}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySimulatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11084,10 +16674,10 @@ func (m *QuerySimulatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgPlaceLimitOrder{}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11112,7 +16702,7 @@ func (m *QuerySimulatePlaceLimitOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGeometricTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11135,17 +16725,17 @@ func (m *QuerySimulatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGeometricTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulatePlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGeometricTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GeometricTwap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11155,25 +16745,23 @@ func (m *QuerySimulatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgPlaceLimitOrderResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GeometricTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11198,7 +16786,7 @@ func (m *QuerySimulatePlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPairStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11221,17 +16809,17 @@ func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11241,27 +16829,23 @@ func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgWithdrawFilledLimitOrder{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11284,7 +16868,7 @@ func (m *QuerySimulateWithdrawFilledLimitOrderRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPairStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11307,15 +16891,15 @@ func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPairStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPairStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11342,10 +16926,7 @@ func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgWithdrawFilledLimitOrderResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PairStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11370,7 +16951,7 @@ func (m *QuerySimulateWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QuerySimulateCancelLimitOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11393,15 +16974,66 @@ func (m *QuerySimulateCancelLimitOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11428,66 +17060,52 @@ func (m *QuerySimulateCancelLimitOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgCancelLimitOrder{}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySimulateCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11514,10 +17132,10 @@ func (m *QuerySimulateCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgCancelLimitOrderResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11542,7 +17160,7 @@ func (m *QuerySimulateCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimulateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11565,15 +17183,15 @@ func (m *QuerySimulateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateMultiHopSwapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateMultiHopSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11600,66 +17218,14 @@ func (m *QuerySimulateMultiHopSwapRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgMultiHopSwap{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySimulateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimulateMultiHopSwapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimulateMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11686,10 +17252,10 @@ func (m *QuerySimulateMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resp == nil {
-				m.Resp = &MsgMultiHopSwapResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
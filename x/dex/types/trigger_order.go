@@ -0,0 +1,66 @@
+package types
+
+func (t TriggerType) IsValid() bool {
+	_, ok := TriggerType_name[int32(t)]
+	return ok
+}
+
+func (t TriggerType) IsStopLoss() bool {
+	return t == TriggerType_STOP_LOSS
+}
+
+func (t TriggerType) IsTakeProfit() bool {
+	return t == TriggerType_TAKE_PROFIT
+}
+
+func NewTriggerOrderFromMsg(id uint64, tradePairID *TradePairID, msg *MsgPlaceTriggerOrder) TriggerOrder {
+	return TriggerOrder{
+		Id:                      id,
+		Creator:                 msg.Creator,
+		Receiver:                msg.Receiver,
+		TradePairId:             tradePairID,
+		TriggerType:             msg.TriggerType,
+		TriggerTickIndexInToOut: msg.TriggerTickIndexInToOut,
+		TickIndexInToOut:        msg.TickIndexInToOut,
+		AmountIn:                msg.AmountIn,
+		OrderType:               msg.OrderType,
+		ExpirationTime:          msg.ExpirationTime,
+		MaxAmountOut:            msg.MaxAmountOut,
+		MinAverageSellPrice:     msg.MinAverageSellPrice,
+	}
+}
+
+// IndexTick is the tick used to sort orders within the trigger index so that
+// the orders closest to being triggered are always iterated first.
+// STOP_LOSS orders trigger as the price moves up (lowest trigger tick first),
+// TAKE_PROFIT orders trigger as the price moves down (highest trigger tick first).
+func (t TriggerOrder) IndexTick() int64 {
+	if t.TriggerType.IsTakeProfit() {
+		return -t.TriggerTickIndexInToOut
+	}
+	return t.TriggerTickIndexInToOut
+}
+
+// IsTriggered checks whether the order should be executed given the current
+// taker-to-maker tick for the order's TradePairID.
+func (t TriggerOrder) IsTriggered(currTickIndexTakerToMaker int64) bool {
+	if t.TriggerType.IsTakeProfit() {
+		return currTickIndexTakerToMaker <= t.TriggerTickIndexInToOut
+	}
+	return currTickIndexTakerToMaker >= t.TriggerTickIndexInToOut
+}
+
+func (t TriggerOrder) ToMsgPlaceLimitOrder() *MsgPlaceLimitOrder {
+	return &MsgPlaceLimitOrder{
+		Creator:             t.Creator,
+		Receiver:            t.Receiver,
+		TokenIn:             t.TradePairId.TakerDenom,
+		TokenOut:            t.TradePairId.MakerDenom,
+		TickIndexInToOut:    t.TickIndexInToOut,
+		AmountIn:            t.AmountIn,
+		OrderType:           t.OrderType,
+		ExpirationTime:      t.ExpirationTime,
+		MaxAmountOut:        t.MaxAmountOut,
+		MinAverageSellPrice: t.MinAverageSellPrice,
+	}
+}
@@ -0,0 +1,849 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/trigger_order.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TriggerOrder is a limit order held in escrow until the current tick of its
+// trade pair crosses the trigger tick.
+type TriggerOrder struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Creator  string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// trade_pair_id is the taker side of the order, ie. taker_denom is token_in.
+	TradePairId             *TradePairID                                          `protobuf:"bytes,4,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	TriggerType             TriggerType                                           `protobuf:"varint,5,opt,name=trigger_type,json=triggerType,proto3,enum=neutron.dex.TriggerType" json:"trigger_type,omitempty"`
+	TriggerTickIndexInToOut int64                                                 `protobuf:"varint,6,opt,name=trigger_tick_index_in_to_out,json=triggerTickIndexInToOut,proto3" json:"trigger_tick_index_in_to_out,omitempty"`
+	TickIndexInToOut        int64                                                 `protobuf:"varint,7,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	AmountIn                cosmossdk_io_math.Int                                 `protobuf:"bytes,8,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	OrderType               LimitOrderType                                        `protobuf:"varint,9,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	ExpirationTime          *time.Time                                            `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	MaxAmountOut            *cosmossdk_io_math.Int                                `protobuf:"bytes,11,opt,name=max_amount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	MinAverageSellPrice     *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+}
+
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e20823ab38e6f8, []int{0}
+}
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
+func (m *TriggerOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *TriggerOrder) GetTriggerType() TriggerType {
+	if m != nil {
+		return m.TriggerType
+	}
+	return TriggerType_STOP_LOSS
+}
+
+func (m *TriggerOrder) GetTriggerTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TriggerTickIndexInToOut
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return LimitOrderType_GOOD_TIL_CANCELLED
+}
+
+func (m *TriggerOrder) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TriggerOrder)(nil), "neutron.dex.TriggerOrder")
+}
+
+func init() { proto.RegisterFile("neutron/dex/trigger_order.proto", fileDescriptor_89e20823ab38e6f8) }
+
+var fileDescriptor_89e20823ab38e6f8 = []byte{
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xdb, 0xd2, 0x26, 0x93, 0x10, 0x2a, 0xd3, 0x82, 0x15, 0x20, 0x8e, 0xb2, 0xca, 0xa6,
+	0xb6, 0xc4, 0x63, 0x53, 0x1e, 0x52, 0xab, 0x4a, 0x10, 0x09, 0xd4, 0xca, 0x64, 0x05, 0x8b, 0xd1,
+	0xd4, 0xbe, 0xb8, 0xa3, 0xda, 0x33, 0xd6, 0x78, 0x5c, 0x9c, 0xbf, 0xe8, 0x87, 0xf0, 0x1b, 0x48,
+	0x5d, 0x76, 0x89, 0x58, 0x18, 0xd4, 0xee, 0xba, 0xec, 0x17, 0xa0, 0x19, 0xdb, 0x29, 0x29, 0x05,
+	0x76, 0xf7, 0x9e, 0x39, 0xc7, 0x73, 0xee, 0x99, 0x6b, 0x64, 0x33, 0xc8, 0xa4, 0xe0, 0xcc, 0x0d,
+	0x20, 0x77, 0xa5, 0xa0, 0x61, 0x08, 0x02, 0x73, 0x11, 0x80, 0x70, 0x12, 0xc1, 0x25, 0x37, 0xdb,
+	0x15, 0xc1, 0x09, 0x20, 0xef, 0xad, 0x85, 0x3c, 0xe4, 0x1a, 0x77, 0x55, 0x55, 0x52, 0x7a, 0x76,
+	0xc8, 0x79, 0x18, 0x81, 0xab, 0xbb, 0xfd, 0xec, 0x93, 0x2b, 0x69, 0x0c, 0xa9, 0x24, 0x71, 0x52,
+	0x13, 0xe6, 0x2f, 0x21, 0x01, 0xe0, 0x84, 0x50, 0x81, 0x69, 0x50, 0x11, 0xd6, 0xe6, 0x08, 0x79,
+	0x89, 0x0e, 0xbf, 0x2e, 0xa3, 0xce, 0xa4, 0xb4, 0xb4, 0xab, 0x1c, 0x99, 0x5d, 0xb4, 0x40, 0x03,
+	0xcb, 0x18, 0x18, 0xa3, 0x25, 0x6f, 0x81, 0x06, 0xa6, 0x85, 0x56, 0x7c, 0x01, 0x44, 0x72, 0x61,
+	0x2d, 0x0c, 0x8c, 0x51, 0xcb, 0xab, 0x5b, 0xb3, 0x87, 0x9a, 0x02, 0x7c, 0xa0, 0x47, 0x20, 0xac,
+	0x45, 0x7d, 0x34, 0xeb, 0xcd, 0x17, 0xe8, 0xf6, 0x9c, 0x07, 0x6b, 0x69, 0x60, 0x8c, 0xda, 0x8f,
+	0x2d, 0xe7, 0xb7, 0x49, 0x9d, 0x89, 0x62, 0xec, 0x11, 0x2a, 0xc6, 0x3b, 0x5e, 0x5b, 0xce, 0x9a,
+	0xc0, 0x7c, 0x8e, 0x3a, 0x75, 0x4c, 0x72, 0x9a, 0x80, 0x75, 0x6b, 0x60, 0x8c, 0xba, 0x7f, 0x88,
+	0x35, 0x61, 0x32, 0x4d, 0x40, 0x89, 0x67, 0x8d, 0xf9, 0x12, 0x3d, 0x9c, 0x89, 0xa9, 0x7f, 0x88,
+	0x29, 0x0b, 0x20, 0xc7, 0x94, 0x61, 0xc9, 0x31, 0xcf, 0xa4, 0xb5, 0x3c, 0x30, 0x46, 0x8b, 0xde,
+	0xfd, 0x5a, 0x42, 0xfd, 0xc3, 0xb1, 0x62, 0x8c, 0xd9, 0x84, 0xef, 0x66, 0xd2, 0x74, 0xd0, 0xda,
+	0x8d, 0xb2, 0x15, 0x2d, 0x5b, 0x95, 0xd7, 0xf9, 0x1f, 0x51, 0x8b, 0xc4, 0x3c, 0x63, 0x12, 0x53,
+	0x66, 0x35, 0x55, 0x0c, 0xdb, 0xaf, 0x4e, 0x0a, 0xbb, 0xf1, 0xbd, 0xb0, 0xd7, 0x7d, 0x9e, 0xc6,
+	0x3c, 0x4d, 0x83, 0x43, 0x87, 0x72, 0x37, 0x26, 0xf2, 0xc0, 0x19, 0x33, 0x79, 0x51, 0xd8, 0x57,
+	0x8a, 0xcb, 0xc2, 0x5e, 0x9d, 0x92, 0x38, 0xda, 0x1c, 0xce, 0xa0, 0xa1, 0xd7, 0x2c, 0xeb, 0x31,
+	0x33, 0x37, 0x11, 0xd2, 0x7b, 0x52, 0xc6, 0xd0, 0xd2, 0x31, 0x3c, 0x98, 0x8b, 0xe1, 0x2d, 0x8d,
+	0xa9, 0xd4, 0x2f, 0xa7, 0x93, 0x68, 0xf1, 0xba, 0x34, 0xdf, 0xa1, 0x3b, 0x90, 0x27, 0x54, 0x10,
+	0x49, 0x39, 0xc3, 0x6a, 0x5d, 0x2c, 0xa4, 0x1f, 0xa1, 0xe7, 0x94, 0xbb, 0xe4, 0xd4, 0xbb, 0xe4,
+	0x4c, 0xea, 0x5d, 0xda, 0x6e, 0x9e, 0x14, 0xb6, 0x71, 0xfc, 0xc3, 0x36, 0xbc, 0xee, 0x95, 0x58,
+	0x1d, 0x9b, 0x0c, 0x75, 0x63, 0x92, 0xe3, 0xca, 0xa6, 0x4a, 0xa4, 0xad, 0x87, 0x7d, 0xa3, 0x14,
+	0xff, 0x1a, 0xf6, 0x9a, 0xec, 0xb2, 0xb0, 0xd7, 0xcb, 0x89, 0xe7, 0xf1, 0xa1, 0xd7, 0x89, 0x49,
+	0xbe, 0xa5, 0x7b, 0x95, 0xeb, 0x17, 0x03, 0xdd, 0x8b, 0x29, 0xc3, 0xe4, 0x08, 0x04, 0x09, 0x01,
+	0xa7, 0x10, 0x45, 0x38, 0x11, 0xd4, 0x07, 0xab, 0xa3, 0x2f, 0xfe, 0x5c, 0x5d, 0xfc, 0x34, 0xa4,
+	0xf2, 0x20, 0xdb, 0x77, 0x7c, 0x1e, 0xbb, 0x55, 0x32, 0x1b, 0x5c, 0x84, 0x75, 0xed, 0x1e, 0x3d,
+	0x73, 0x33, 0x49, 0xa3, 0xb4, 0xf4, 0xb4, 0x27, 0xc0, 0xdf, 0x01, 0xff, 0xa2, 0xb0, 0xff, 0xf2,
+	0xf5, 0xcb, 0xc2, 0x7e, 0x54, 0xf9, 0xbb, 0xf1, 0x7c, 0xe8, 0xdd, 0x8d, 0x29, 0xdb, 0x2a, 0xf1,
+	0xf7, 0x10, 0x45, 0x7b, 0x0a, 0xdd, 0x7e, 0x7d, 0x72, 0xd6, 0x37, 0x4e, 0xcf, 0xfa, 0xc6, 0xcf,
+	0xb3, 0xbe, 0x71, 0x7c, 0xde, 0x6f, 0x9c, 0x9e, 0xf7, 0x1b, 0xdf, 0xce, 0xfb, 0x8d, 0x0f, 0x1b,
+	0xff, 0xf7, 0x97, 0x97, 0xff, 0xe4, 0x34, 0x81, 0x74, 0x7f, 0x59, 0xbf, 0xca, 0x93, 0x5f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0x6b, 0xc8, 0x62, 0x6a, 0x35, 0x04, 0x00, 0x00,
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpirationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TriggerTickIndexInToOut))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TriggerType != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TriggerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTriggerOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTriggerOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.TriggerType != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TriggerType))
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TriggerTickIndexInToOut))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTriggerOrder(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	return n
+}
+
+func sovTriggerOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTriggerOrder(x uint64) (n int) {
+	return sovTriggerOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerType", wireType)
+			}
+			m.TriggerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerType |= TriggerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTickIndexInToOut", wireType)
+			}
+			m.TriggerTickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerTickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTriggerOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTriggerOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTriggerOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTriggerOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTriggerOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTriggerOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTriggerOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTriggerOrder = fmt.Errorf("proto: unexpected end of group")
+)
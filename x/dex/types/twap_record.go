@@ -0,0 +1,120 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+// NewTwapRecord creates the first TwapRecord of a pair with zeroed accumulators
+func NewTwapRecord(
+	pairID *PairID,
+	height int64,
+	recordTime time.Time,
+	tickIndex math_utils.PrecDec,
+) (TwapRecord, error) {
+	record := TwapRecord{
+		PairId:            pairID,
+		Height:            height,
+		Time:              recordTime,
+		TickAccumulator:   math_utils.ZeroPrecDec(),
+		Price0Accumulator: math_utils.ZeroPrecDec(),
+		Price1Accumulator: math_utils.ZeroPrecDec(),
+	}
+	err := record.SetSpot(tickIndex)
+
+	return record, err
+}
+
+// SetSpot sets the spot tick index and prices of the record. The accumulators are not changed.
+func (r *TwapRecord) SetSpot(tickIndex math_utils.PrecDec) error {
+	price1, err := CalcPriceFractional(tickIndex)
+	if err != nil {
+		return err
+	}
+
+	r.LastTickIndex = tickIndex
+	r.LastPrice1 = price1
+	r.LastPrice0 = math_utils.OnePrecDec().Quo(price1)
+
+	return nil
+}
+
+// WithUpdatedAccumulators returns a copy of the record moved forward to newTime, with the accumulators
+// increased by the last spot values held for the time elapsed since the record.
+// If newTime is not after the record time, only the height and time of the record are updated.
+func (r TwapRecord) WithUpdatedAccumulators(height int64, newTime time.Time) TwapRecord {
+	newRecord := r
+	newRecord.Height = height
+	newRecord.Time = newTime
+
+	timeDeltaMs := newTime.Sub(r.Time).Milliseconds()
+	if timeDeltaMs <= 0 {
+		return newRecord
+	}
+
+	newRecord.TickAccumulator = r.TickAccumulator.Add(r.LastTickIndex.MulInt64(timeDeltaMs))
+	newRecord.Price0Accumulator = r.Price0Accumulator.Add(r.LastPrice0.MulInt64(timeDeltaMs))
+	newRecord.Price1Accumulator = r.Price1Accumulator.Add(r.LastPrice1.MulInt64(timeDeltaMs))
+
+	return newRecord
+}
+
+func twapTimeDeltaMs(startRecord, endRecord TwapRecord) (int64, error) {
+	timeDeltaMs := endRecord.Time.Sub(startRecord.Time).Milliseconds()
+	if timeDeltaMs <= 0 {
+		return 0, sdkerrors.Wrapf(
+			ErrInvalidTwapTimeRange,
+			"start time %s must be before end time %s",
+			startRecord.Time,
+			endRecord.Time,
+		)
+	}
+
+	return timeDeltaMs, nil
+}
+
+// ComputeArithmeticTwap returns the arithmetic mean of the price of baseDenom between two records of the same pair
+func ComputeArithmeticTwap(startRecord, endRecord TwapRecord, baseDenom string) (math_utils.PrecDec, error) {
+	timeDeltaMs, err := twapTimeDeltaMs(startRecord, endRecord)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), err
+	}
+
+	var accumDiff math_utils.PrecDec
+	switch baseDenom {
+	case endRecord.PairId.Token0:
+		accumDiff = endRecord.Price0Accumulator.Sub(startRecord.Price0Accumulator)
+	case endRecord.PairId.Token1:
+		accumDiff = endRecord.Price1Accumulator.Sub(startRecord.Price1Accumulator)
+	default:
+		return math_utils.ZeroPrecDec(), invalidBaseDenomErr(endRecord.PairId, baseDenom)
+	}
+
+	return accumDiff.QuoInt64(timeDeltaMs), nil
+}
+
+// ComputeGeometricTwap returns the geometric mean of the price of baseDenom between two records of the same pair.
+// This is the price at the arithmetic mean of the tick index.
+func ComputeGeometricTwap(startRecord, endRecord TwapRecord, baseDenom string) (math_utils.PrecDec, error) {
+	timeDeltaMs, err := twapTimeDeltaMs(startRecord, endRecord)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), err
+	}
+
+	avgTickIndex := endRecord.TickAccumulator.Sub(startRecord.TickAccumulator).QuoInt64(timeDeltaMs)
+	switch baseDenom {
+	case endRecord.PairId.Token0:
+		return CalcPriceFractional(avgTickIndex.Neg())
+	case endRecord.PairId.Token1:
+		return CalcPriceFractional(avgTickIndex)
+	default:
+		return math_utils.ZeroPrecDec(), invalidBaseDenomErr(endRecord.PairId, baseDenom)
+	}
+}
+
+func invalidBaseDenomErr(pairID *PairID, baseDenom string) error {
+	return sdkerrors.Wrapf(ErrInvalidDenom, "%s is not a denom of pair %s", baseDenom, pairID.CanonicalString())
+}
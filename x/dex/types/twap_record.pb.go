@@ -0,0 +1,727 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/twap_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapRecord is a snapshot of the spot price of a pair and the price accumulators used to compute TWAPs.
+// Accumulators are the sum of the spot value multiplied by the number of milliseconds it was held for,
+// so the TWAP between two records is (end_accumulator - start_accumulator) / (end_time - start_time).
+type TwapRecord struct {
+	PairId *PairID   `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Height int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Mid tick index of the pair, price of token1 in terms of token0 is 1.0001^last_tick_index
+	LastTickIndex github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=last_tick_index,json=lastTickIndex,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"last_tick_index" yaml:"last_tick_index"`
+	// Spot price of token0 in terms of token1
+	LastPrice0 github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=last_price0,json=lastPrice0,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"last_price0" yaml:"last_price0"`
+	// Spot price of token1 in terms of token0
+	LastPrice1 github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,6,opt,name=last_price1,json=lastPrice1,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"last_price1" yaml:"last_price1"`
+	// Accumulator of the mid tick index, used for geometric TWAPs
+	TickAccumulator github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=tick_accumulator,json=tickAccumulator,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"tick_accumulator" yaml:"tick_accumulator"`
+	// Accumulator of the price of token0 in terms of token1, used for arithmetic TWAPs
+	Price0Accumulator github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=price0_accumulator,json=price0Accumulator,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"price0_accumulator" yaml:"price0_accumulator"`
+	// Accumulator of the price of token1 in terms of token0, used for arithmetic TWAPs
+	Price1Accumulator github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,9,opt,name=price1_accumulator,json=price1Accumulator,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"price1_accumulator" yaml:"price1_accumulator"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d67b3f7ce22ab0d1, []int{0}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *TwapRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TwapRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*TwapRecord)(nil), "neutron.dex.TwapRecord")
+}
+
+func init() { proto.RegisterFile("neutron/dex/twap_record.proto", fileDescriptor_d67b3f7ce22ab0d1) }
+
+var fileDescriptor_d67b3f7ce22ab0d1 = []byte{
+	// 491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd4, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x07, 0xf0, 0x1c, 0x2d, 0x69, 0x7b, 0x11, 0x2a, 0x1c, 0xa8, 0xb8, 0x91, 0xb0, 0x23, 0x4f,
+	0x19, 0xe8, 0x1d, 0xe6, 0x45, 0x42, 0x6c, 0x44, 0x95, 0x50, 0xb6, 0xc8, 0xca, 0xc4, 0x12, 0x5d,
+	0xce, 0x87, 0x73, 0x8a, 0x9d, 0xb3, 0x2e, 0x67, 0x9a, 0xae, 0x48, 0xec, 0x65, 0x61, 0xe4, 0xf3,
+	0x74, 0xec, 0x88, 0x18, 0x0c, 0x4a, 0x36, 0xc6, 0x7c, 0x02, 0xe4, 0xb3, 0x23, 0xdc, 0x34, 0x82,
+	0x21, 0xdd, 0xee, 0x79, 0xc9, 0xff, 0x7e, 0x3a, 0x29, 0x86, 0x4f, 0x26, 0x3c, 0xd5, 0x4a, 0x4e,
+	0x48, 0xc0, 0x67, 0x44, 0x9f, 0xd1, 0x64, 0xa0, 0x38, 0x93, 0x2a, 0xc0, 0x89, 0x92, 0x5a, 0xa2,
+	0x46, 0x39, 0xc6, 0x01, 0x9f, 0x35, 0x1f, 0x85, 0x32, 0x94, 0xa6, 0x4f, 0xf2, 0x53, 0xb1, 0xd2,
+	0x74, 0x42, 0x29, 0xc3, 0x88, 0x13, 0x53, 0x0d, 0xd3, 0x0f, 0x44, 0x8b, 0x98, 0x4f, 0x35, 0x8d,
+	0x93, 0x72, 0xe1, 0xb8, 0x7a, 0x45, 0x42, 0x85, 0x1a, 0x88, 0x32, 0xde, 0xfd, 0xbc, 0x0f, 0x61,
+	0xff, 0x8c, 0x26, 0xbe, 0xb9, 0x13, 0x3d, 0x85, 0x7b, 0xe5, 0xdc, 0x02, 0x2d, 0xd0, 0x6e, 0x3c,
+	0x7f, 0x88, 0x2b, 0xf7, 0xe3, 0x1e, 0x15, 0xaa, 0x7b, 0xea, 0xd7, 0xf3, 0x9d, 0x6e, 0x80, 0x8e,
+	0x60, 0x7d, 0xc4, 0x45, 0x38, 0xd2, 0xd6, 0x9d, 0x16, 0x68, 0xef, 0xf8, 0x65, 0x85, 0x5e, 0xc3,
+	0xdd, 0x9c, 0x60, 0xed, 0x98, 0x88, 0x26, 0x2e, 0x7c, 0x78, 0xe5, 0xc3, 0xfd, 0x95, 0xaf, 0xb3,
+	0x7f, 0x99, 0x39, 0xb5, 0x8b, 0x9f, 0x0e, 0xf0, 0xcd, 0x2f, 0xd0, 0x17, 0x00, 0x0f, 0x23, 0x3a,
+	0xd5, 0x03, 0x2d, 0xd8, 0x78, 0x20, 0x26, 0x01, 0x9f, 0x59, 0xbb, 0x2d, 0xd0, 0x3e, 0xe8, 0x88,
+	0x7c, 0xf3, 0x47, 0xe6, 0xbc, 0x0c, 0x85, 0x1e, 0xa5, 0x43, 0xcc, 0x64, 0x4c, 0x4a, 0xda, 0x89,
+	0x54, 0xe1, 0xea, 0x4c, 0x3e, 0xbe, 0x22, 0xa9, 0x16, 0xd1, 0x94, 0xc4, 0x54, 0x8f, 0x70, 0x4f,
+	0x71, 0x76, 0xca, 0xd9, 0xef, 0xcc, 0x59, 0x8f, 0x5d, 0x66, 0xce, 0xd1, 0x39, 0x8d, 0xa3, 0x37,
+	0xee, 0xda, 0xc0, 0xf5, 0xef, 0xe5, 0x9d, 0xbe, 0x60, 0xe3, 0x6e, 0x5e, 0xa3, 0x4f, 0x00, 0x36,
+	0xcc, 0x4e, 0xa2, 0x04, 0xe3, 0xcf, 0xac, 0xbb, 0xc6, 0x43, 0xb7, 0xf4, 0x54, 0x23, 0x97, 0x99,
+	0x83, 0x2a, 0x96, 0xa2, 0xe9, 0xfa, 0x30, 0xaf, 0x7a, 0xa6, 0x58, 0x43, 0x78, 0x56, 0xfd, 0xb6,
+	0x11, 0xde, 0x26, 0x84, 0x57, 0x45, 0x78, 0xe8, 0x2b, 0x80, 0xf7, 0xcd, 0x43, 0x51, 0xc6, 0xd2,
+	0x38, 0x8d, 0xa8, 0x96, 0xca, 0xda, 0x33, 0x92, 0xf1, 0x96, 0x92, 0x1b, 0xb9, 0xcb, 0xcc, 0x79,
+	0x5c, 0x70, 0xd6, 0x27, 0xae, 0x7f, 0x98, 0xb7, 0xde, 0xfe, 0xed, 0xa0, 0x6f, 0x00, 0xa2, 0xe2,
+	0xd5, 0xae, 0xd1, 0xf6, 0x0d, 0x4d, 0x6e, 0x49, 0xdb, 0x90, 0xbc, 0xcc, 0x9c, 0xe3, 0x02, 0x77,
+	0x73, 0xe6, 0xfa, 0x0f, 0x8a, 0xe6, 0x46, 0xa0, 0x77, 0x0d, 0x78, 0x70, 0x8b, 0x40, 0xef, 0x1f,
+	0x40, 0x6f, 0x13, 0xd0, 0xab, 0x00, 0x3b, 0xef, 0x2e, 0xe7, 0x36, 0xb8, 0x9a, 0xdb, 0xe0, 0xd7,
+	0xdc, 0x06, 0x17, 0x0b, 0xbb, 0x76, 0xb5, 0xb0, 0x6b, 0xdf, 0x17, 0x76, 0xed, 0xfd, 0xc9, 0xff,
+	0x55, 0xb3, 0xe2, 0xdb, 0x75, 0x9e, 0xf0, 0xe9, 0xb0, 0x6e, 0xfe, 0xe5, 0x2f, 0xfe, 0x04, 0x00,
+	0x00, 0xff, 0xff, 0x31, 0x9f, 0xa3, 0x51, 0xd7, 0x04, 0x00, 0x00,
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price1Accumulator.Size()
+		i -= size
+		if _, err := m.Price1Accumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Price0Accumulator.Size()
+		i -= size
+		if _, err := m.Price0Accumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TickAccumulator.Size()
+		i -= size
+		if _, err := m.TickAccumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LastPrice1.Size()
+		i -= size
+		if _, err := m.LastPrice1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LastPrice0.Size()
+		i -= size
+		if _, err := m.LastPrice0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LastTickIndex.Size()
+		i -= size
+		if _, err := m.LastTickIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTwapRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTwapRecord(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTwapRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwapRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwapRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTwapRecord(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTwapRecord(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.LastTickIndex.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.LastPrice0.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.LastPrice1.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.TickAccumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Price0Accumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	l = m.Price1Accumulator.Size()
+	n += 1 + l + sovTwapRecord(uint64(l))
+	return n
+}
+
+func sovTwapRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwapRecord(x uint64) (n int) {
+	return sovTwapRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTickIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickAccumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price0Accumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price0Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price1Accumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price1Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwapRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwapRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwapRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwapRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwapRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwapRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwapRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwapRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwapRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwapRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwapRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,8 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -21,6 +21,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -47,6 +48,10 @@ const (
 	LimitOrderType_IMMEDIATE_OR_CANCEL LimitOrderType = 2
 	LimitOrderType_JUST_IN_TIME        LimitOrderType = 3
 	LimitOrderType_GOOD_TIL_TIME       LimitOrderType = 4
+	// POST_ONLY orders are only ever placed as maker orders and never swap against existing liquidity.
+	LimitOrderType_POST_ONLY LimitOrderType = 5
+	// POST_ONLY_GOOD_TIL_TIME is a POST_ONLY order that expires at expiration_time.
+	LimitOrderType_POST_ONLY_GOOD_TIL_TIME LimitOrderType = 6
 )
 
 var LimitOrderType_name = map[int32]string{
@@ -55,14 +60,18 @@ var LimitOrderType_name = map[int32]string{
 	2: "IMMEDIATE_OR_CANCEL",
 	3: "JUST_IN_TIME",
 	4: "GOOD_TIL_TIME",
+	5: "POST_ONLY",
+	6: "POST_ONLY_GOOD_TIL_TIME",
 }
 
 var LimitOrderType_value = map[string]int32{
-	"GOOD_TIL_CANCELLED":  0,
-	"FILL_OR_KILL":        1,
-	"IMMEDIATE_OR_CANCEL": 2,
-	"JUST_IN_TIME":        3,
-	"GOOD_TIL_TIME":       4,
+	"GOOD_TIL_CANCELLED":      0,
+	"FILL_OR_KILL":            1,
+	"IMMEDIATE_OR_CANCEL":     2,
+	"JUST_IN_TIME":            3,
+	"GOOD_TIL_TIME":           4,
+	"POST_ONLY":               5,
+	"POST_ONLY_GOOD_TIL_TIME": 6,
 }
 
 func (x LimitOrderType) String() string {
@@ -73,6 +82,38 @@ func (LimitOrderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a489f6e187d5e074, []int{0}
 }
 
+// TriggerType defines the condition under which a trigger order is turned into a
+// limit order. Conditions are expressed in terms of the current taker tick of the
+// token_in -> token_out trade pair, the same units as tick_index_in_to_out.
+type TriggerType int32
+
+const (
+	// STOP_LOSS triggers once the current tick rises to or above the trigger tick,
+	// ie. once the price of token_in has fallen to the trigger price.
+	TriggerType_STOP_LOSS TriggerType = 0
+	// TAKE_PROFIT triggers once the current tick falls to or below the trigger tick,
+	// ie. once the price of token_in has risen to the trigger price.
+	TriggerType_TAKE_PROFIT TriggerType = 1
+)
+
+var TriggerType_name = map[int32]string{
+	0: "STOP_LOSS",
+	1: "TAKE_PROFIT",
+}
+
+var TriggerType_value = map[string]int32{
+	"STOP_LOSS":   0,
+	"TAKE_PROFIT": 1,
+}
+
+func (x TriggerType) String() string {
+	return proto.EnumName(TriggerType_name, int32(x))
+}
+
+func (TriggerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{1}
+}
+
 type DepositOptions struct {
 	DisableAutoswap bool `protobuf:"varint,1,opt,name=disable_autoswap,json=disableAutoswap,proto3" json:"disable_autoswap,omitempty"`
 	FailTxOnBel     bool `protobuf:"varint,2,opt,name=fail_tx_on_bel,json=failTxOnBel,proto3" json:"fail_tx_on_bel,omitempty"`
@@ -459,6 +500,9 @@ type MsgPlaceLimitOrder struct {
 	// if the min_average_sell_price is not met the trade will fail.
 	// If min_average_sell_price is omitted limit_sell_price will be used instead
 	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+	// post_only_slide is only valid for POST_ONLY order types. If set, an order that would take liquidity
+	// is moved to the best non-crossing tick instead of failing.
+	PostOnlySlide bool `protobuf:"varint,13,opt,name=post_only_slide,json=postOnlySlide,proto3" json:"post_only_slide,omitempty"`
 }
 
 func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
@@ -544,6 +588,13 @@ func (m *MsgPlaceLimitOrder) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *MsgPlaceLimitOrder) GetPostOnlySlide() bool {
+	if m != nil {
+		return m.PostOnlySlide
+	}
+	return false
+}
+
 type MsgPlaceLimitOrderResponse struct {
 	TrancheKey string `protobuf:"bytes,1,opt,name=trancheKey,proto3" json:"trancheKey,omitempty"`
 	// Total amount of coin used for the limit order
@@ -555,6 +606,9 @@ type MsgPlaceLimitOrderResponse struct {
 	TakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=taker_coin_out,json=takerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_out" yaml:"taker_coin_out"`
 	// Total amount of the token in that was immediately swapped for takerOutCoin
 	TakerCoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=taker_coin_in,json=takerCoinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_in" yaml:"taker_coin_in"`
+	// Tick the limit order was placed at. It differs from the requested tick if a
+	// POST_ONLY order slid to the best non-crossing tick.
+	TickIndexInToOut int64 `protobuf:"varint,5,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
 }
 
 func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
@@ -597,6 +651,13 @@ func (m *MsgPlaceLimitOrderResponse) GetTrancheKey() string {
 	return ""
 }
 
+func (m *MsgPlaceLimitOrderResponse) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
+	}
+	return 0
+}
+
 type MsgWithdrawFilledLimitOrder struct {
 	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	TrancheKey string `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
@@ -1037,1155 +1098,1246 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
-	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
-	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
-	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
-	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
-	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
-	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
-	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
-	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
-	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
-	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
-	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+type MsgPlaceTriggerOrder struct {
+	Creator                 string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver                string      `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TokenIn                 string      `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut                string      `protobuf:"bytes,4,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	TriggerType             TriggerType `protobuf:"varint,5,opt,name=trigger_type,json=triggerType,proto3,enum=neutron.dex.TriggerType" json:"trigger_type,omitempty"`
+	TriggerTickIndexInToOut int64       `protobuf:"varint,6,opt,name=trigger_tick_index_in_to_out,json=triggerTickIndexInToOut,proto3" json:"trigger_tick_index_in_to_out,omitempty"`
+	// tick_index_in_to_out is the limit tick of the order placed once triggered.
+	TickIndexInToOut int64                 `protobuf:"varint,7,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	AmountIn         cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// order_type is the type of the limit order placed once triggered. JUST_IN_TIME is not supported.
+	OrderType LimitOrderType `protobuf:"varint,9,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	// expirationTime is only valid iff orderType == GOOD_TIL_TIME.
+	ExpirationTime      *time.Time                                            `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	MaxAmountOut        *cosmossdk_io_math.Int                                `protobuf:"bytes,11,opt,name=max_amount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
 }
 
-func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
-
-var fileDescriptor_a489f6e187d5e074 = []byte{
-	// 1940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x4f, 0xdb, 0x79, 0xd8, 0x95, 0xc4, 0xf1, 0x74, 0x32, 0x93, 0x1e, 0x0f, 0xa4, 0xad, 0x9e,
-	0xd5, 0x8e, 0x19, 0x31, 0xf6, 0x78, 0x60, 0xf7, 0x90, 0x03, 0x92, 0x9d, 0xc7, 0xae, 0x59, 0x7b,
-	0x12, 0xf5, 0x78, 0x05, 0xda, 0x95, 0x68, 0xda, 0xee, 0x8a, 0xd3, 0x4a, 0x77, 0x97, 0xd5, 0x55,
-	0x76, 0x1c, 0x2e, 0xac, 0x10, 0xa7, 0x3d, 0xed, 0x05, 0x81, 0xc4, 0x3f, 0x00, 0x12, 0x87, 0x39,
-	0xec, 0x99, 0xf3, 0x70, 0x5b, 0x21, 0x21, 0x01, 0x07, 0x03, 0x33, 0x87, 0x91, 0xf6, 0x98, 0x03,
-	0x5c, 0x10, 0x42, 0xf5, 0x70, 0xbf, 0xec, 0x24, 0x93, 0xdd, 0x59, 0xc4, 0x61, 0x2f, 0x71, 0xd7,
-	0xef, 0xfb, 0xea, 0xab, 0x5f, 0xd5, 0xf7, 0xa8, 0xaf, 0x3b, 0x60, 0xc3, 0x83, 0x03, 0xe2, 0x23,
-	0xaf, 0x62, 0xc1, 0x51, 0x85, 0x8c, 0xca, 0x7d, 0x1f, 0x11, 0x24, 0x2f, 0x0b, 0xb4, 0x6c, 0xc1,
-	0x51, 0xe1, 0x86, 0xe9, 0xda, 0x1e, 0xaa, 0xb0, 0xbf, 0x5c, 0x5e, 0xd8, 0xea, 0x22, 0xec, 0x22,
-	0x5c, 0xe9, 0x98, 0x18, 0x56, 0x86, 0xd5, 0x0e, 0x24, 0x66, 0xb5, 0xd2, 0x45, 0xb6, 0x27, 0xe4,
-	0x9b, 0x42, 0xee, 0xe2, 0x5e, 0x65, 0x58, 0xa5, 0x3f, 0x42, 0x70, 0x9b, 0x0b, 0x0c, 0x36, 0xaa,
-	0xf0, 0x81, 0x10, 0x6d, 0xf4, 0x50, 0x0f, 0x71, 0x9c, 0x3e, 0x09, 0x54, 0xed, 0x21, 0xd4, 0x73,
-	0x60, 0x85, 0x8d, 0x3a, 0x83, 0xa3, 0x0a, 0xb1, 0x5d, 0x88, 0x89, 0xe9, 0xf6, 0x85, 0x82, 0x12,
-	0xdd, 0x40, 0xdf, 0xf4, 0x4d, 0x57, 0x18, 0xd4, 0x7e, 0x0c, 0x72, 0xbb, 0xb0, 0x8f, 0xb0, 0x4d,
-	0x0e, 0xfa, 0xc4, 0x46, 0x1e, 0x96, 0xbf, 0x05, 0xf2, 0x96, 0x8d, 0xcd, 0x8e, 0x03, 0x0d, 0x73,
-	0x40, 0x10, 0x3e, 0x35, 0xfb, 0x8a, 0x54, 0x94, 0x4a, 0x19, 0x7d, 0x4d, 0xe0, 0x35, 0x01, 0xcb,
-	0x77, 0x41, 0xee, 0xc8, 0xb4, 0x1d, 0x83, 0x8c, 0x0c, 0xe4, 0x19, 0x1d, 0xe8, 0x28, 0x29, 0xa6,
-	0xb8, 0x4c, 0xd1, 0xf6, 0xe8, 0xc0, 0xab, 0x43, 0x47, 0x7b, 0x96, 0x06, 0xa0, 0x85, 0x7b, 0x62,
-	0x15, 0x59, 0x01, 0x4b, 0x5d, 0x1f, 0x9a, 0x04, 0xf9, 0xcc, 0x6a, 0x56, 0x9f, 0x0c, 0xe5, 0x02,
-	0xc8, 0xf8, 0xb0, 0x0b, 0xed, 0x21, 0xf4, 0x99, 0x9d, 0xac, 0x1e, 0x8c, 0xe5, 0x4d, 0xb0, 0x44,
-	0xd0, 0x09, 0xf4, 0x0c, 0x53, 0x49, 0x33, 0xd1, 0x22, 0x1b, 0xd6, 0x42, 0x41, 0x47, 0x99, 0x8f,
-	0x08, 0xea, 0xf2, 0x87, 0x20, 0x6b, 0xba, 0x68, 0xe0, 0x11, 0x6c, 0x98, 0xca, 0x42, 0x31, 0x5d,
-	0xca, 0xd6, 0xbf, 0xf7, 0x6c, 0xac, 0xce, 0xfd, 0x75, 0xac, 0xde, 0xe4, 0x47, 0x8a, 0xad, 0x93,
-	0xb2, 0x8d, 0x2a, 0xae, 0x49, 0x8e, 0xcb, 0x0d, 0x8f, 0x7c, 0x3e, 0x56, 0xc3, 0x19, 0xe7, 0x63,
-	0x35, 0x7f, 0x66, 0xba, 0xce, 0xb6, 0x16, 0x40, 0x9a, 0x9e, 0x11, 0xcf, 0xb5, 0xa8, 0xf1, 0x8e,
-	0xb2, 0x78, 0x4d, 0xe3, 0x9d, 0x69, 0xe3, 0x9d, 0xd0, 0x78, 0x5d, 0xfe, 0x36, 0x58, 0x27, 0x76,
-	0xf7, 0xc4, 0xb0, 0x3d, 0x0b, 0x8e, 0x20, 0x36, 0x4c, 0x83, 0x20, 0xa3, 0xa3, 0x2c, 0x15, 0xd3,
-	0xa5, 0xb4, 0xbe, 0x46, 0x45, 0x0d, 0x2e, 0xa9, 0xb5, 0x51, 0x5d, 0x96, 0xc1, 0xfc, 0x11, 0x84,
-	0x58, 0xc9, 0x14, 0xd3, 0xa5, 0x79, 0x9d, 0x3d, 0xcb, 0x6f, 0x81, 0x25, 0xc4, 0xbd, 0xa9, 0x64,
-	0x8b, 0xe9, 0xd2, 0xf2, 0xa3, 0x3b, 0xe5, 0x48, 0xac, 0x96, 0xe3, 0x0e, 0xd7, 0x27, 0xba, 0xdb,
-	0xea, 0xcf, 0x5e, 0x3e, 0xbd, 0x3f, 0x71, 0xc7, 0xc7, 0x2f, 0x9f, 0xde, 0xcf, 0xd1, 0x70, 0x09,
-	0x7d, 0xa7, 0xed, 0x83, 0xd5, 0x7d, 0xd3, 0x76, 0xa0, 0x35, 0x71, 0xa6, 0x0a, 0x96, 0x2d, 0xfe,
-	0x68, 0xd8, 0xd6, 0x88, 0x39, 0x74, 0x5e, 0x07, 0x02, 0x6a, 0x58, 0x23, 0x79, 0x03, 0x2c, 0x40,
-	0xdf, 0x47, 0x13, 0x87, 0xf2, 0x81, 0xf6, 0xcf, 0x34, 0x90, 0x43, 0xb3, 0x3a, 0xc4, 0x7d, 0xe4,
-	0x61, 0x28, 0xff, 0x14, 0xc8, 0x3e, 0xc4, 0xd0, 0x1f, 0xc2, 0x87, 0x86, 0xb0, 0x01, 0x2d, 0x45,
-	0x62, 0xc7, 0x7b, 0x78, 0xd5, 0xf1, 0xce, 0x98, 0x7a, 0x3e, 0x56, 0x6f, 0xf3, 0x73, 0x9e, 0x96,
-	0x69, 0xfa, 0x8d, 0x09, 0xb8, 0x3b, 0xc1, 0x22, 0x04, 0xaa, 0x11, 0x02, 0xa9, 0xeb, 0x11, 0xa8,
-	0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0xb0, 0x03, 0xd6, 0x8e, 0xd8, 0x01, 0x4f, 0xf4,
-	0xb0, 0x92, 0x66, 0x0e, 0x2c, 0xc4, 0x1c, 0x18, 0x73, 0x82, 0x9e, 0x3b, 0x8a, 0x0e, 0xb1, 0xfc,
-	0x2b, 0x09, 0xac, 0xe2, 0x63, 0xd3, 0x87, 0xd8, 0xb0, 0x31, 0x1e, 0x40, 0x4b, 0x99, 0x67, 0x36,
-	0x6e, 0x97, 0x45, 0x29, 0xa1, 0x05, 0xa9, 0x2c, 0x0a, 0x52, 0x79, 0x07, 0xd9, 0x5e, 0xfd, 0x87,
-	0x62, 0x73, 0xf7, 0x7a, 0x36, 0x39, 0x1e, 0x74, 0xca, 0x5d, 0xe4, 0x8a, 0xba, 0x23, 0x7e, 0x1e,
-	0x60, 0xeb, 0xa4, 0x42, 0xce, 0xfa, 0x10, 0xb3, 0x09, 0x9f, 0x8f, 0xd5, 0xf8, 0x12, 0xe7, 0x63,
-	0x75, 0x83, 0xef, 0x34, 0x06, 0x6b, 0xfa, 0x0a, 0x1f, 0x37, 0xf8, 0xf0, 0x4f, 0x29, 0xb0, 0xda,
-	0xc2, 0xbd, 0x1f, 0xd8, 0xe4, 0xd8, 0xf2, 0xcd, 0x53, 0xd3, 0xf9, 0x9f, 0x95, 0x83, 0x21, 0xc8,
-	0x0b, 0x66, 0x04, 0x19, 0x3e, 0x74, 0xd1, 0x10, 0x8a, 0xaa, 0xd0, 0xbc, 0xca, 0xb1, 0x53, 0x13,
-	0xcf, 0xc7, 0xea, 0x66, 0x6c, 0xb3, 0x81, 0x44, 0xd3, 0x73, 0x1c, 0x6a, 0x23, 0x9d, 0x01, 0x17,
-	0x25, 0xf3, 0xe2, 0xe5, 0xc9, 0xbc, 0x14, 0x26, 0xf3, 0xb6, 0x96, 0xcc, 0xca, 0x1b, 0x22, 0x2b,
-	0xc3, 0x53, 0xd4, 0x3e, 0x4d, 0x83, 0x9b, 0x31, 0x64, 0x66, 0x4e, 0x9d, 0x0a, 0xb1, 0xc7, 0x8f,
-	0xfa, 0x3a, 0x39, 0x15, 0x4c, 0x9d, 0x91, 0x53, 0x81, 0x2c, 0x92, 0x53, 0x13, 0x26, 0x5e, 0x2c,
-	0xa7, 0x42, 0x02, 0xa9, 0xeb, 0x11, 0xa8, 0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0x10,
-	0x49, 0x87, 0xce, 0xc0, 0xf7, 0xa0, 0x25, 0x52, 0xea, 0xab, 0x49, 0x07, 0xbe, 0xc4, 0x54, 0x3a,
-	0x70, 0x38, 0x48, 0x87, 0x3a, 0x1f, 0xfe, 0x67, 0x91, 0xd5, 0xc1, 0x43, 0xc7, 0xec, 0xc2, 0xa6,
-	0xed, 0xda, 0xe4, 0xc0, 0xb7, 0xa0, 0xff, 0x05, 0x73, 0xe2, 0x36, 0xc8, 0xf0, 0xd0, 0xb7, 0x3d,
-	0x91, 0x14, 0x3c, 0x15, 0x1a, 0x9e, 0x7c, 0x07, 0x64, 0xb9, 0x08, 0x0d, 0x88, 0xc8, 0x0b, 0xae,
-	0x7b, 0x30, 0x20, 0xf2, 0x23, 0xb0, 0x11, 0x46, 0xa8, 0x61, 0x7b, 0x34, 0x40, 0xa9, 0xde, 0x42,
-	0x51, 0x2a, 0xa5, 0xeb, 0x29, 0x45, 0xd2, 0xf3, 0x41, 0x98, 0x36, 0xbc, 0x36, 0xa2, 0x73, 0x82,
-	0xfb, 0x8f, 0x2e, 0xb6, 0xc4, 0x7c, 0xf9, 0xaa, 0xf7, 0x9f, 0x61, 0x7b, 0xc9, 0xfb, 0xcf, 0xb0,
-	0xbd, 0xe0, 0xfe, 0x6b, 0x78, 0xf2, 0x36, 0x00, 0x88, 0x9e, 0x83, 0x41, 0x0f, 0x58, 0xc9, 0x14,
-	0xa5, 0x52, 0x2e, 0x71, 0x81, 0x85, 0x67, 0xd5, 0x3e, 0xeb, 0x43, 0x3d, 0x8b, 0x26, 0x8f, 0x72,
-	0x0b, 0xac, 0xc1, 0x51, 0xdf, 0xf6, 0x4d, 0x7a, 0xa3, 0x19, 0xb4, 0x0d, 0x52, 0xb2, 0x45, 0x89,
-	0x15, 0x50, 0xde, 0x23, 0x95, 0x27, 0x3d, 0x52, 0xb9, 0x3d, 0xe9, 0x91, 0xea, 0x99, 0x67, 0x63,
-	0x55, 0xfa, 0xe4, 0x6f, 0xaa, 0xa4, 0xe7, 0xc2, 0xc9, 0x54, 0x2c, 0x7b, 0x20, 0xe7, 0x9a, 0x23,
-	0x43, 0xd0, 0xa4, 0xa7, 0x02, 0xd8, 0x66, 0xdf, 0xa5, 0x33, 0x2e, 0xdb, 0x6c, 0x62, 0xda, 0xf9,
-	0x58, 0xbd, 0xc9, 0x77, 0x1c, 0xc7, 0x35, 0x7d, 0xc5, 0x35, 0x47, 0x35, 0x36, 0xa6, 0xe7, 0xfa,
-	0x0b, 0x09, 0xe4, 0x1d, 0xba, 0x39, 0x03, 0x43, 0xc7, 0x31, 0xfa, 0xbe, 0xdd, 0x85, 0xca, 0x32,
-	0x5b, 0xf2, 0x44, 0x2c, 0xf9, 0xdd, 0x48, 0x4c, 0x8a, 0x33, 0x79, 0x80, 0xfc, 0xde, 0xe4, 0xb9,
-	0x32, 0x7c, 0xab, 0x32, 0x20, 0xb6, 0x83, 0x39, 0x9b, 0x43, 0x1f, 0x76, 0x77, 0x61, 0x97, 0x56,
-	0xb1, 0xa4, 0xdd, 0xb0, 0x8a, 0x25, 0x25, 0x9a, 0x9e, 0x63, 0xd0, 0x13, 0xe8, 0x38, 0x87, 0x14,
-	0x90, 0x7f, 0x27, 0x81, 0x5b, 0xae, 0xed, 0x19, 0xe6, 0x10, 0xfa, 0x66, 0x0f, 0x46, 0xd9, 0xad,
-	0x30, 0x76, 0xa7, 0x5f, 0x92, 0xdd, 0x05, 0xd6, 0xcf, 0xc7, 0xea, 0x37, 0xc5, 0xb9, 0xcd, 0x94,
-	0x6b, 0xfa, 0xba, 0x6b, 0x7b, 0x35, 0x8e, 0x07, 0x74, 0xb7, 0xef, 0x25, 0x4b, 0xe6, 0x2d, 0x51,
-	0x32, 0x13, 0x99, 0xa6, 0xfd, 0x2b, 0x0d, 0x0a, 0xd3, 0x70, 0x50, 0x3c, 0xb7, 0x00, 0x20, 0xbe,
-	0xe9, 0x75, 0x8f, 0xe1, 0x7b, 0xf0, 0x4c, 0xe4, 0x62, 0x04, 0x91, 0x3f, 0x92, 0xc0, 0x12, 0x6d,
-	0xe8, 0x69, 0x16, 0xa4, 0x58, 0x98, 0x5d, 0x52, 0x54, 0x9a, 0xd7, 0x2f, 0x2a, 0x13, 0xe3, 0xe7,
-	0x63, 0x35, 0xc7, 0x8f, 0x41, 0x00, 0x9a, 0xbe, 0x48, 0x9f, 0x1a, 0x9e, 0xfc, 0x6b, 0x09, 0xe4,
-	0x88, 0x79, 0x02, 0x7d, 0x83, 0x89, 0x68, 0x88, 0xa6, 0xaf, 0x62, 0xf2, 0xc1, 0xf5, 0x99, 0x24,
-	0xd6, 0x08, 0xe3, 0x39, 0x8e, 0x6b, 0xfa, 0x0a, 0x03, 0xe8, 0x2c, 0x1a, 0xcf, 0xbf, 0x94, 0xc0,
-	0x6a, 0x44, 0xc3, 0xf6, 0x58, 0xf5, 0x79, 0xed, 0xb5, 0x37, 0xb6, 0x44, 0x58, 0x7b, 0x63, 0xb0,
-	0xa6, 0x2f, 0x07, 0xd4, 0x1a, 0x9e, 0xf6, 0xb1, 0x04, 0xee, 0x44, 0x6e, 0xcc, 0x7d, 0xdb, 0x71,
-	0xa0, 0xf5, 0x4a, 0x35, 0x58, 0x05, 0xcb, 0x22, 0x04, 0x8c, 0x13, 0x78, 0x26, 0xca, 0x70, 0x24,
-	0x2a, 0xb6, 0x1f, 0x26, 0xa3, 0x4f, 0x4d, 0x5c, 0xd8, 0xc9, 0xc5, 0xb4, 0x7f, 0xa4, 0xc0, 0xdd,
-	0x4b, 0xe4, 0x41, 0x3c, 0xce, 0x70, 0xb6, 0xf4, 0xff, 0xe3, 0x6c, 0xca, 0xce, 0x8d, 0xb3, 0x4b,
-	0x7d, 0x15, 0xec, 0xdc, 0x0b, 0xd8, 0xb9, 0x49, 0x76, 0x6e, 0x84, 0x9d, 0xf6, 0x13, 0xb0, 0xde,
-	0xc2, 0xbd, 0x1d, 0xd3, 0xeb, 0x42, 0xe7, 0xf5, 0xf8, 0xb9, 0x94, 0xf4, 0xf3, 0xa6, 0xf0, 0x73,
-	0x72, 0x11, 0xed, 0x2f, 0x29, 0x16, 0x6c, 0x49, 0xfc, 0x6b, 0xbf, 0xbe, 0x06, 0xbf, 0xde, 0x05,
-	0xab, 0xad, 0x81, 0x43, 0xec, 0x77, 0x51, 0x5f, 0x47, 0x03, 0x02, 0x69, 0x0f, 0x7d, 0x8c, 0xfa,
-	0x98, 0xbf, 0x37, 0xea, 0xec, 0x59, 0xfb, 0x7d, 0x1a, 0xac, 0xb5, 0x70, 0x6f, 0xa2, 0xf8, 0xe4,
-	0xd4, 0xec, 0x7f, 0xc1, 0x2e, 0xeb, 0x11, 0x58, 0xf4, 0xe9, 0x32, 0xb3, 0x5f, 0xcc, 0x62, 0x4c,
-	0x74, 0xa1, 0x19, 0xef, 0x96, 0xe6, 0x5f, 0x73, 0xb7, 0x44, 0x5b, 0x06, 0x38, 0xb2, 0x89, 0xc1,
-	0x6f, 0x71, 0x7e, 0x29, 0x2f, 0x04, 0x2d, 0xc3, 0xdc, 0x97, 0x69, 0x19, 0x92, 0x76, 0xc3, 0x96,
-	0x21, 0x29, 0xd1, 0x68, 0xeb, 0x64, 0x13, 0x16, 0xdb, 0xbc, 0x65, 0x78, 0x13, 0xac, 0xf5, 0x69,
-	0x5b, 0xd9, 0x81, 0x98, 0x18, 0xec, 0x20, 0x94, 0x45, 0xf6, 0x71, 0x68, 0x95, 0xc2, 0x75, 0x88,
-	0x09, 0x3b, 0xa4, 0xed, 0x37, 0x92, 0x59, 0xb4, 0x2e, 0xb2, 0x28, 0xea, 0x2c, 0xed, 0x0f, 0x29,
-	0xb0, 0x99, 0xc0, 0x82, 0xec, 0xf9, 0xb9, 0x04, 0x32, 0xaf, 0x9e, 0x37, 0x8f, 0xaf, 0x1f, 0x99,
-	0x99, 0x48, 0x4c, 0xae, 0x45, 0xee, 0x61, 0x16, 0x8d, 0xec, 0x8e, 0xa6, 0x69, 0xf2, 0x10, 0x2c,
-	0xf0, 0x6d, 0xa6, 0x44, 0xc3, 0x79, 0x71, 0x60, 0x70, 0x45, 0x79, 0x00, 0xe6, 0xad, 0x01, 0x26,
-	0x57, 0xbf, 0x8f, 0xec, 0x5f, 0x9f, 0x33, 0xb3, 0x7c, 0x3e, 0x56, 0x97, 0x39, 0x5f, 0x3a, 0xd2,
-	0x74, 0x06, 0x6a, 0xbf, 0x95, 0x58, 0x32, 0xbc, 0xdf, 0xb7, 0x4c, 0x02, 0x0f, 0xd9, 0xc7, 0x40,
-	0xf9, 0x6d, 0x90, 0x35, 0x07, 0xe4, 0x18, 0xf9, 0x36, 0x11, 0x8d, 0x4e, 0x5d, 0xf9, 0xe3, 0xa7,
-	0x0f, 0x36, 0x04, 0xa5, 0x9a, 0x65, 0xf9, 0x10, 0xe3, 0x27, 0xc4, 0xb7, 0xbd, 0x9e, 0x1e, 0xaa,
-	0xca, 0x6f, 0x83, 0x45, 0xfe, 0x39, 0x51, 0xec, 0x7a, 0x3d, 0xb6, 0x6b, 0x6e, 0xbc, 0x9e, 0xa5,
-	0xf4, 0x7f, 0xf3, 0xf2, 0xe9, 0x7d, 0x49, 0x17, 0xda, 0xdb, 0x6f, 0x52, 0xaf, 0x87, 0x76, 0xa2,
-	0x7e, 0x8f, 0xf2, 0xd2, 0x6e, 0x33, 0xb7, 0x47, 0xa1, 0x89, 0xdb, 0xef, 0x8f, 0x40, 0x2e, 0xfe,
-	0x1e, 0x20, 0xdf, 0x02, 0xf2, 0x3b, 0x07, 0x07, 0xbb, 0x46, 0xbb, 0xd1, 0x34, 0x76, 0x6a, 0x8f,
-	0x77, 0xf6, 0x9a, 0xcd, 0xbd, 0xdd, 0xfc, 0x9c, 0x9c, 0x07, 0x2b, 0xfb, 0x8d, 0x66, 0xd3, 0x38,
-	0xd0, 0x8d, 0xf7, 0x1a, 0xcd, 0x66, 0x5e, 0x92, 0x37, 0xc1, 0x7a, 0xa3, 0xd5, 0xda, 0xdb, 0x6d,
-	0xd4, 0xda, 0x7b, 0x14, 0xe6, 0xda, 0xf9, 0x14, 0x55, 0xfd, 0xfe, 0xfb, 0x4f, 0xda, 0x46, 0xe3,
-	0xb1, 0xd1, 0x6e, 0xb4, 0xf6, 0xf2, 0x69, 0xf9, 0x06, 0x58, 0x0d, 0x8c, 0x32, 0x68, 0xfe, 0xd1,
-	0xbf, 0xe7, 0x41, 0xba, 0x85, 0x7b, 0xf2, 0x0e, 0x58, 0x9a, 0x7c, 0x08, 0xdb, 0x8c, 0x7b, 0x3b,
-	0xf8, 0xb6, 0x55, 0x50, 0x2f, 0x10, 0x04, 0xd1, 0xdb, 0x04, 0x20, 0xf2, 0x39, 0xa4, 0x90, 0x54,
-	0x0f, 0x65, 0x05, 0xed, 0x62, 0x59, 0x60, 0xed, 0x43, 0xb0, 0x96, 0x7c, 0x9b, 0x9c, 0x62, 0x90,
-	0x50, 0x28, 0xdc, 0xbb, 0x42, 0x21, 0x30, 0x3e, 0x04, 0xca, 0x85, 0xfd, 0x52, 0xe9, 0x22, 0x72,
-	0x49, 0xcd, 0xc2, 0xc3, 0x57, 0xd5, 0x0c, 0xd6, 0xfd, 0x11, 0xc8, 0x4f, 0xdd, 0xdb, 0xc5, 0xa4,
-	0x95, 0xa4, 0x46, 0xa1, 0x74, 0x95, 0x46, 0x60, 0x5f, 0x07, 0x2b, 0xb1, 0x9b, 0xe1, 0x1b, 0xc9,
-	0x99, 0x51, 0x69, 0xe1, 0x8d, 0xcb, 0xa4, 0x51, 0x9b, 0xb1, 0x04, 0x9b, 0xb2, 0x19, 0x95, 0x4e,
-	0xdb, 0x9c, 0x15, 0xf1, 0x85, 0x85, 0x8f, 0x68, 0x0e, 0xd5, 0xdf, 0x79, 0xf6, 0x7c, 0x4b, 0xfa,
-	0xec, 0xf9, 0x96, 0xf4, 0xf7, 0xe7, 0x5b, 0xd2, 0x27, 0x2f, 0xb6, 0xe6, 0x3e, 0x7b, 0xb1, 0x35,
-	0xf7, 0xe7, 0x17, 0x5b, 0x73, 0x1f, 0x3c, 0xb8, 0xba, 0xd0, 0x8f, 0xf8, 0xff, 0x30, 0x68, 0xa9,
-	0xe8, 0x2c, 0xb2, 0x77, 0xe1, 0xef, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x95, 0x04, 0x7c, 0xe0,
-	0xdf, 0x18, 0x00, 0x00,
+func (m *MsgPlaceTriggerOrder) Reset()         { *m = MsgPlaceTriggerOrder{} }
+func (m *MsgPlaceTriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceTriggerOrder) ProtoMessage()    {}
+func (*MsgPlaceTriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{17}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgPlaceTriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgPlaceTriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceTriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceTriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceTriggerOrder.Merge(m, src)
+}
+func (m *MsgPlaceTriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceTriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceTriggerOrder.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgPlaceTriggerOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceTriggerOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
-	out := new(MsgWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
-	out := new(MsgPlaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	out := new(MsgWithdrawFilledLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetTriggerType() TriggerType {
+	if m != nil {
+		return m.TriggerType
 	}
-	return out, nil
+	return TriggerType_STOP_LOSS
 }
 
-func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
-	out := new(MsgCancelLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetTriggerTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TriggerTickIndexInToOut
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
-	out := new(MsgMultiHopSwapResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceTriggerOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	return out, nil
+	return LimitOrderType_GOOD_TIL_CANCELLED
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgPlaceTriggerOrder) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgPlaceTriggerOrderResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Amount of coin escrowed until the order is triggered or canceled
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in" yaml:"coin_in"`
 }
 
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgPlaceTriggerOrderResponse) Reset()         { *m = MsgPlaceTriggerOrderResponse{} }
+func (m *MsgPlaceTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceTriggerOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{18}
 }
-func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+func (m *MsgPlaceTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+func (m *MsgPlaceTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+func (m *MsgPlaceTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceTriggerOrderResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+func (m *MsgPlaceTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+func (m *MsgPlaceTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceTriggerOrderResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgPlaceTriggerOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceTriggerOrderResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgCancelTriggerOrder struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgCancelTriggerOrder) Reset()         { *m = MsgCancelTriggerOrder{} }
+func (m *MsgCancelTriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTriggerOrder) ProtoMessage()    {}
+func (*MsgCancelTriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{19}
+}
+func (m *MsgCancelTriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelTriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTriggerOrder.Merge(m, src)
+}
+func (m *MsgCancelTriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTriggerOrder.DiscardUnknown(m)
 }
 
-func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Withdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+var xxx_messageInfo_MsgCancelTriggerOrder proto.InternalMessageInfo
+
+func (m *MsgCancelTriggerOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelTriggerOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFilledLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgCancelTriggerOrderResponse struct {
+	// Escrowed coin returned to the creator
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
 }
 
-func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
+func (m *MsgCancelTriggerOrderResponse) Reset()         { *m = MsgCancelTriggerOrderResponse{} }
+func (m *MsgCancelTriggerOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTriggerOrderResponse) ProtoMessage()    {}
+func (*MsgCancelTriggerOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{20}
+}
+func (m *MsgCancelTriggerOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTriggerOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTriggerOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+}
+func (m *MsgCancelTriggerOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTriggerOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelTriggerOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTriggerOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTriggerOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTriggerOrderResponse proto.InternalMessageInfo
+
+// BatchPlaceLimitOrder is a single limit order placed as part of a MsgBatchLimitOrders.
+// Fields have the same semantics as their MsgPlaceLimitOrder counterparts.
+type BatchPlaceLimitOrder struct {
+	TokenIn          string                `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut         string                `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	TickIndexInToOut int64                 `protobuf:"varint,3,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	AmountIn         cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	OrderType        LimitOrderType        `protobuf:"varint,5,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	// expirationTime is only valid iff orderType == GOOD_TIL_TIME.
+	ExpirationTime      *time.Time                                            `protobuf:"bytes,6,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
+	MaxAmountOut        *cosmossdk_io_math.Int                                `protobuf:"bytes,7,opt,name=max_amount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	LimitSellPrice      *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
+	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,9,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+	PostOnlySlide       bool                                                  `protobuf:"varint,10,opt,name=post_only_slide,json=postOnlySlide,proto3" json:"post_only_slide,omitempty"`
+}
+
+func (m *BatchPlaceLimitOrder) Reset()         { *m = BatchPlaceLimitOrder{} }
+func (m *BatchPlaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchPlaceLimitOrder) ProtoMessage()    {}
+func (*BatchPlaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{21}
+}
+func (m *BatchPlaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchPlaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchPlaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BatchPlaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPlaceLimitOrder.Merge(m, src)
+}
+func (m *BatchPlaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchPlaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPlaceLimitOrder.DiscardUnknown(m)
 }
 
-func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiHopSwap)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_BatchPlaceLimitOrder proto.InternalMessageInfo
+
+func (m *BatchPlaceLimitOrder) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiHopSwap(ctx, in)
+	return ""
+}
+
+func (m *BatchPlaceLimitOrder) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
+	return ""
+}
+
+func (m *BatchPlaceLimitOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
+	return 0
+}
+
+func (m *BatchPlaceLimitOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	return interceptor(ctx, in, info, handler)
+	return LimitOrderType_GOOD_TIL_CANCELLED
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchPlaceLimitOrder) GetExpirationTime() *time.Time {
+	if m != nil {
+		return m.ExpirationTime
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return nil
+}
+
+func (m *BatchPlaceLimitOrder) GetPostOnlySlide() bool {
+	if m != nil {
+		return m.PostOnlySlide
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/UpdateParams",
+	return false
+}
+
+// MsgBatchLimitOrders atomically cancels and places limit orders for the creator.
+// All cancels are executed first, followed by all placements. Funds are netted
+// so that at most a single bank transfer per direction is performed.
+type MsgBatchLimitOrders struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Tranche keys of the creator's limit orders to cancel
+	CancelTrancheKeys []string               `protobuf:"bytes,2,rep,name=cancel_tranche_keys,json=cancelTrancheKeys,proto3" json:"cancel_tranche_keys,omitempty"`
+	PlaceOrders       []BatchPlaceLimitOrder `protobuf:"bytes,3,rep,name=place_orders,json=placeOrders,proto3" json:"place_orders"`
+}
+
+func (m *MsgBatchLimitOrders) Reset()         { *m = MsgBatchLimitOrders{} }
+func (m *MsgBatchLimitOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchLimitOrders) ProtoMessage()    {}
+func (*MsgBatchLimitOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{22}
+}
+func (m *MsgBatchLimitOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchLimitOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchLimitOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgBatchLimitOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchLimitOrders.Merge(m, src)
+}
+func (m *MsgBatchLimitOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchLimitOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchLimitOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchLimitOrders proto.InternalMessageInfo
+
+func (m *MsgBatchLimitOrders) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.dex.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdrawal",
-			Handler:    _Msg_Withdrawal_Handler,
-		},
-		{
-			MethodName: "PlaceLimitOrder",
-			Handler:    _Msg_PlaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "WithdrawFilledLimitOrder",
-			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
-		},
-		{
-			MethodName: "CancelLimitOrder",
-			Handler:    _Msg_CancelLimitOrder_Handler,
-		},
-		{
-			MethodName: "MultiHopSwap",
-			Handler:    _Msg_MultiHopSwap_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/dex/tx.proto",
+func (m *MsgBatchLimitOrders) GetCancelTrancheKeys() []string {
+	if m != nil {
+		return m.CancelTrancheKeys
+	}
+	return nil
 }
 
-func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchLimitOrders) GetPlaceOrders() []BatchPlaceLimitOrder {
+	if m != nil {
+		return m.PlaceOrders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchLimitOrdersResponse struct {
+	// Tranche keys of the placed limit orders, in the same order as place_orders
+	TrancheKeys []string `protobuf:"bytes,1,rep,name=tranche_keys,json=trancheKeys,proto3" json:"tranche_keys,omitempty"`
+	// Net amount of coins transferred from the creator to the dex
+	CoinsIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins_in,json=coinsIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_in" yaml:"coins_in"`
+	// Net amount of coins transferred from the dex to the creator
+	CoinsOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins_out,json=coinsOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_out" yaml:"coins_out"`
 }
 
-func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailTxOnBel {
-		i--
-		if m.FailTxOnBel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.DisableAutoswap {
-		i--
-		if m.DisableAutoswap {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgBatchLimitOrdersResponse) Reset()         { *m = MsgBatchLimitOrdersResponse{} }
+func (m *MsgBatchLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchLimitOrdersResponse) ProtoMessage()    {}
+func (*MsgBatchLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{23}
+}
+func (m *MsgBatchLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchLimitOrdersResponse.Merge(m, src)
+}
+func (m *MsgBatchLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *MsgBatchLimitOrdersResponse) GetTrancheKeys() []string {
+	if m != nil {
+		return m.TrancheKeys
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchLimitOrdersResponse) GetCoinsIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsIn
+	}
+	return nil
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.Fees) > 0 {
-		dAtA2 := make([]byte, len(m.Fees)*10)
-		var j1 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x42
+func (m *MsgBatchLimitOrdersResponse) GetCoinsOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsOut
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j3 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+	return nil
+}
+
+// MsgAmendLimitOrder moves the unfilled remainder of a resting limit order to a new price
+// and/or changes its size. Any filled proceeds are withdrawn as part of the amendment.
+// At least one of limit_sell_price, tick_index_in_to_out or amount_in must be set, and
+// only one of limit_sell_price or tick_index_in_to_out.
+type MsgAmendLimitOrder struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TrancheKey string `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// New limit sell price of the order. If omitted the order keeps its current price.
+	LimitSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,3,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
+	// New unfilled amount of the order. If omitted the unfilled remainder of the order is used.
+	AmountIn *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// New limit tick of the order. If omitted the order keeps its current price.
+	TickIndexInToOut *int64 `protobuf:"bytes,5,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3,wktptr" json:"tick_index_in_to_out" yaml:"tick_index_in_to_out"`
+}
+
+func (m *MsgAmendLimitOrder) Reset()         { *m = MsgAmendLimitOrder{} }
+func (m *MsgAmendLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendLimitOrder) ProtoMessage()    {}
+func (*MsgAmendLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{24}
+}
+func (m *MsgAmendLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.AmountsB) > 0 {
-		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsB[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *MsgAmendLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendLimitOrder.Merge(m, src)
+}
+func (m *MsgAmendLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendLimitOrder proto.InternalMessageInfo
+
+func (m *MsgAmendLimitOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.AmountsA) > 0 {
-		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsA[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return ""
+}
+
+func (m *MsgAmendLimitOrder) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
 	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *MsgAmendLimitOrder) GetTickIndexInToOut() *int64 {
+	if m != nil {
+		return m.TickIndexInToOut
 	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type MsgAmendLimitOrderResponse struct {
+	// Tranche key of the amended limit order
+	TrancheKey string `protobuf:"bytes,1,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Net amount of coins transferred from the creator to the dex
+	CoinsIn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins_in,json=coinsIn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_in" yaml:"coins_in"`
+	// Net amount of coins transferred from the dex to the creator, including any filled proceeds
+	CoinsOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins_out,json=coinsOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins_out" yaml:"coins_out"`
+}
+
+func (m *MsgAmendLimitOrderResponse) Reset()         { *m = MsgAmendLimitOrderResponse{} }
+func (m *MsgAmendLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendLimitOrderResponse) ProtoMessage()    {}
+func (*MsgAmendLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{25}
+}
+func (m *MsgAmendLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgAmendLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgAmendLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgAmendLimitOrderResponse) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAmendLimitOrderResponse) GetCoinsIn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsIn
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAmendLimitOrderResponse) GetCoinsOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CoinsOut
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
+	proto.RegisterEnum("neutron.dex.TriggerType", TriggerType_name, TriggerType_value)
+	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
+	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
+	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
+	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
+	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
+	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPlaceTriggerOrder)(nil), "neutron.dex.MsgPlaceTriggerOrder")
+	proto.RegisterType((*MsgPlaceTriggerOrderResponse)(nil), "neutron.dex.MsgPlaceTriggerOrderResponse")
+	proto.RegisterType((*MsgCancelTriggerOrder)(nil), "neutron.dex.MsgCancelTriggerOrder")
+	proto.RegisterType((*MsgCancelTriggerOrderResponse)(nil), "neutron.dex.MsgCancelTriggerOrderResponse")
+	proto.RegisterType((*BatchPlaceLimitOrder)(nil), "neutron.dex.BatchPlaceLimitOrder")
+	proto.RegisterType((*MsgBatchLimitOrders)(nil), "neutron.dex.MsgBatchLimitOrders")
+	proto.RegisterType((*MsgBatchLimitOrdersResponse)(nil), "neutron.dex.MsgBatchLimitOrdersResponse")
+	proto.RegisterType((*MsgAmendLimitOrder)(nil), "neutron.dex.MsgAmendLimitOrder")
+	proto.RegisterType((*MsgAmendLimitOrderResponse)(nil), "neutron.dex.MsgAmendLimitOrderResponse")
 }
 
-func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DepositIdx != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
+
+var fileDescriptor_a489f6e187d5e074 = []byte{
+	// 2565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0x12, 0x45, 0x0e, 0x25, 0x8a, 0x5a, 0x29, 0xd1, 0x8a, 0x72, 0xb4, 0xf2, 0x3a,
+	0x88, 0x65, 0xe1, 0x6f, 0xd2, 0xf2, 0x3f, 0xf1, 0x41, 0x41, 0x0b, 0x88, 0xfa, 0x48, 0x18, 0x53,
+	0xa6, 0xba, 0x62, 0xfa, 0x11, 0x03, 0xdd, 0x2e, 0xb9, 0x63, 0x6a, 0xa1, 0xe5, 0x2e, 0xb1, 0xb3,
+	0x94, 0xa8, 0x5e, 0x1a, 0x04, 0x2d, 0xd0, 0xe6, 0x94, 0x4b, 0xd1, 0x02, 0xbd, 0xf4, 0x50, 0xa4,
+	0x1f, 0xe8, 0xc1, 0x05, 0x72, 0xee, 0xad, 0x80, 0x7b, 0x4b, 0x8b, 0x06, 0x68, 0x7b, 0x60, 0x5a,
+	0xfb, 0x60, 0xc0, 0x47, 0x1d, 0x8a, 0x1e, 0x8b, 0xf9, 0xd8, 0x4f, 0x92, 0xa2, 0x14, 0xcb, 0x89,
+	0x03, 0xe4, 0x62, 0xef, 0xbc, 0xf7, 0xe6, 0xcd, 0x9b, 0x79, 0xef, 0xfd, 0xe6, 0xbd, 0x11, 0xc1,
+	0xac, 0x09, 0xdb, 0x8e, 0x6d, 0x99, 0x05, 0x0d, 0x76, 0x0a, 0x4e, 0x27, 0xdf, 0xb2, 0x2d, 0xc7,
+	0xe2, 0xd3, 0x8c, 0x9a, 0xd7, 0x60, 0x27, 0x37, 0xad, 0x36, 0x75, 0xd3, 0x2a, 0x90, 0x7f, 0x29,
+	0x3f, 0xb7, 0x58, 0xb7, 0x50, 0xd3, 0x42, 0x85, 0x9a, 0x8a, 0x60, 0xe1, 0x70, 0xb5, 0x06, 0x1d,
+	0x75, 0xb5, 0x50, 0xb7, 0x74, 0x93, 0xf1, 0xe7, 0x18, 0xbf, 0x89, 0x1a, 0x85, 0xc3, 0x55, 0xfc,
+	0x1f, 0x63, 0xcc, 0x53, 0x86, 0x42, 0x46, 0x05, 0x3a, 0x60, 0xac, 0xd9, 0x86, 0xd5, 0xb0, 0x28,
+	0x1d, 0x7f, 0x31, 0xaa, 0xd8, 0xb0, 0xac, 0x86, 0x01, 0x0b, 0x64, 0x54, 0x6b, 0xdf, 0x2b, 0x38,
+	0x7a, 0x13, 0x22, 0x47, 0x6d, 0xb6, 0x5c, 0x53, 0xa2, 0x02, 0x47, 0xb6, 0xda, 0x6a, 0x41, 0xdb,
+	0x55, 0x2b, 0x04, 0x37, 0xd8, 0x52, 0x6d, 0xb5, 0xc9, 0x38, 0xd2, 0xf7, 0x40, 0x66, 0x13, 0xb6,
+	0x2c, 0xa4, 0x3b, 0x95, 0x96, 0xa3, 0x5b, 0x26, 0xe2, 0xaf, 0x81, 0xac, 0xa6, 0x23, 0xb5, 0x66,
+	0x40, 0x45, 0x6d, 0x3b, 0x16, 0x3a, 0x52, 0x5b, 0x02, 0xb7, 0xc4, 0x2d, 0x27, 0xe5, 0x29, 0x46,
+	0x5f, 0x67, 0x64, 0xfe, 0x0a, 0xc8, 0xdc, 0x53, 0x75, 0x43, 0x71, 0x3a, 0x8a, 0x65, 0x2a, 0x35,
+	0x68, 0x08, 0x31, 0x22, 0x98, 0xc6, 0xd4, 0x6a, 0xa7, 0x62, 0x16, 0xa1, 0x21, 0x3d, 0x88, 0x03,
+	0xb0, 0x83, 0x1a, 0x6c, 0x15, 0x5e, 0x00, 0xe3, 0x75, 0x1b, 0xaa, 0x8e, 0x65, 0x13, 0xad, 0x29,
+	0xd9, 0x1d, 0xf2, 0x39, 0x90, 0xb4, 0x61, 0x1d, 0xea, 0x87, 0xd0, 0x26, 0x7a, 0x52, 0xb2, 0x37,
+	0xe6, 0xe7, 0xc0, 0xb8, 0x63, 0x1d, 0x40, 0x53, 0x51, 0x85, 0x38, 0x61, 0x25, 0xc8, 0x70, 0xdd,
+	0x67, 0xd4, 0x84, 0xd1, 0x00, 0xa3, 0xc8, 0xdf, 0x05, 0x29, 0xb5, 0x69, 0xb5, 0x4d, 0x07, 0x29,
+	0xaa, 0x30, 0xb6, 0x14, 0x5f, 0x4e, 0x15, 0xbf, 0xfe, 0xa0, 0x2b, 0x8e, 0xfc, 0xb3, 0x2b, 0xbe,
+	0x40, 0x8f, 0x1c, 0x69, 0x07, 0x79, 0xdd, 0x2a, 0x34, 0x55, 0x67, 0x3f, 0x5f, 0x32, 0x9d, 0x27,
+	0x5d, 0xd1, 0x9f, 0x71, 0xd2, 0x15, 0xb3, 0xc7, 0x6a, 0xd3, 0x58, 0x93, 0x3c, 0x92, 0x24, 0x27,
+	0xd9, 0xf7, 0x7a, 0x50, 0x79, 0x4d, 0x48, 0x9c, 0x53, 0x79, 0xad, 0x57, 0x79, 0xcd, 0x57, 0x5e,
+	0xe4, 0xff, 0x0f, 0xcc, 0x38, 0x7a, 0xfd, 0x40, 0xd1, 0x4d, 0x0d, 0x76, 0x20, 0x52, 0x54, 0xc5,
+	0xb1, 0x94, 0x9a, 0x30, 0xbe, 0x14, 0x5f, 0x8e, 0xcb, 0x53, 0x98, 0x55, 0xa2, 0x9c, 0xf5, 0xaa,
+	0x55, 0xe4, 0x79, 0x30, 0x7a, 0x0f, 0x42, 0x24, 0x24, 0x97, 0xe2, 0xcb, 0xa3, 0x32, 0xf9, 0xe6,
+	0x5f, 0x03, 0xe3, 0x16, 0xf5, 0xa6, 0x90, 0x5a, 0x8a, 0x2f, 0xa7, 0x6f, 0x2e, 0xe4, 0x03, 0xb1,
+	0x9c, 0x0f, 0x3b, 0x5c, 0x76, 0x65, 0xd7, 0xc4, 0xf7, 0x1e, 0xdf, 0x5f, 0x71, 0xdd, 0xf1, 0xfe,
+	0xe3, 0xfb, 0x2b, 0x19, 0x1c, 0x2e, 0xbe, 0xef, 0xa4, 0x6d, 0x30, 0xb9, 0xad, 0xea, 0x06, 0xd4,
+	0x5c, 0x67, 0x8a, 0x20, 0xad, 0xd1, 0x4f, 0x45, 0xd7, 0x3a, 0xc4, 0xa1, 0xa3, 0x32, 0x60, 0xa4,
+	0x92, 0xd6, 0xe1, 0x67, 0xc1, 0x18, 0xb4, 0x6d, 0xcb, 0x75, 0x28, 0x1d, 0x48, 0xff, 0x89, 0x03,
+	0xde, 0x57, 0x2b, 0x43, 0xd4, 0xb2, 0x4c, 0x04, 0xf9, 0x1f, 0x00, 0xde, 0x86, 0x08, 0xda, 0x87,
+	0xf0, 0x86, 0xc2, 0x74, 0x40, 0x4d, 0xe0, 0xc8, 0xf1, 0xee, 0x0e, 0x3b, 0xde, 0x3e, 0x53, 0x4f,
+	0xba, 0xe2, 0x3c, 0x3d, 0xe7, 0x5e, 0x9e, 0x24, 0x4f, 0xbb, 0xc4, 0x4d, 0x97, 0x16, 0x30, 0x60,
+	0x35, 0x60, 0x40, 0xec, 0x7c, 0x06, 0xac, 0x9e, 0x62, 0xc0, 0x6a, 0x3f, 0x03, 0x56, 0x7d, 0x03,
+	0x36, 0xc0, 0xd4, 0x3d, 0x72, 0xc0, 0xae, 0x1c, 0x12, 0xe2, 0xc4, 0x81, 0xb9, 0x90, 0x03, 0x43,
+	0x4e, 0x90, 0x33, 0xf7, 0x82, 0x43, 0xc4, 0xff, 0x9c, 0x03, 0x93, 0x68, 0x5f, 0xb5, 0x21, 0x52,
+	0x74, 0x84, 0xda, 0x50, 0x13, 0x46, 0x89, 0x8e, 0xf9, 0x3c, 0x83, 0x1a, 0x0c, 0x58, 0x79, 0x06,
+	0x58, 0xf9, 0x0d, 0x4b, 0x37, 0x8b, 0xdf, 0x66, 0x9b, 0xbb, 0xda, 0xd0, 0x9d, 0xfd, 0x76, 0x2d,
+	0x5f, 0xb7, 0x9a, 0x0c, 0x97, 0xd8, 0x7f, 0xd7, 0x91, 0x76, 0x50, 0x70, 0x8e, 0x5b, 0x10, 0x91,
+	0x09, 0x4f, 0xba, 0x62, 0x78, 0x89, 0x93, 0xae, 0x38, 0x4b, 0x77, 0x1a, 0x22, 0x4b, 0xf2, 0x04,
+	0x1d, 0x97, 0xe8, 0xf0, 0x93, 0x18, 0x98, 0xdc, 0x41, 0x8d, 0x6f, 0xe9, 0xce, 0xbe, 0x66, 0xab,
+	0x47, 0xaa, 0xf1, 0xb9, 0xc1, 0xc1, 0x21, 0xc8, 0x32, 0xcb, 0x1c, 0x4b, 0xb1, 0x61, 0xd3, 0x3a,
+	0x84, 0x0c, 0x15, 0xca, 0xc3, 0x1c, 0xdb, 0x33, 0xf1, 0xa4, 0x2b, 0xce, 0x85, 0x36, 0xeb, 0x71,
+	0x24, 0x39, 0x43, 0x49, 0x55, 0x4b, 0x26, 0x84, 0x41, 0xc9, 0x9c, 0x38, 0x3d, 0x99, 0xc7, 0xfd,
+	0x64, 0x5e, 0x93, 0xa2, 0x59, 0x39, 0xcd, 0xb2, 0xd2, 0x3f, 0x45, 0xe9, 0xa3, 0x38, 0x78, 0x21,
+	0x44, 0xe9, 0x9b, 0x53, 0x47, 0x8c, 0x6d, 0xd2, 0xa3, 0x3e, 0x4f, 0x4e, 0x79, 0x53, 0xfb, 0xe4,
+	0x94, 0xc7, 0x0b, 0xe4, 0x94, 0x6b, 0x89, 0x19, 0xca, 0x29, 0xdf, 0x80, 0xd8, 0xf9, 0x0c, 0x58,
+	0x3d, 0xc5, 0x80, 0xd5, 0x7e, 0x06, 0xac, 0xfa, 0x06, 0x04, 0xd2, 0xa1, 0xd6, 0xb6, 0x4d, 0xa8,
+	0xb1, 0x94, 0x7a, 0x36, 0xe9, 0x40, 0x97, 0xe8, 0x49, 0x07, 0x4a, 0xf6, 0xd2, 0xa1, 0x48, 0x87,
+	0x1f, 0x8e, 0x13, 0x1c, 0xdc, 0x35, 0xd4, 0x3a, 0x2c, 0xeb, 0x4d, 0xdd, 0xa9, 0xd8, 0x1a, 0xb4,
+	0x3f, 0x63, 0x4e, 0xcc, 0x83, 0x24, 0x0d, 0x7d, 0xdd, 0x64, 0x49, 0x41, 0x53, 0xa1, 0x64, 0xf2,
+	0x0b, 0x20, 0x45, 0x59, 0x56, 0xdb, 0x61, 0x79, 0x41, 0x65, 0x2b, 0x6d, 0x87, 0xbf, 0x09, 0x66,
+	0xfd, 0x08, 0x55, 0x74, 0x13, 0x07, 0x28, 0x96, 0x1b, 0x5b, 0xe2, 0x96, 0xe3, 0xc5, 0x98, 0xc0,
+	0xc9, 0x59, 0x2f, 0x4c, 0x4b, 0x66, 0xd5, 0xc2, 0x73, 0xbc, 0xfb, 0x0f, 0x2f, 0x36, 0x4e, 0x7c,
+	0x79, 0xd6, 0xfb, 0x4f, 0xd1, 0xcd, 0xe8, 0xfd, 0xa7, 0xe8, 0xa6, 0x77, 0xff, 0x95, 0x4c, 0x7e,
+	0x0d, 0x00, 0x0b, 0x9f, 0x83, 0x82, 0x0f, 0x58, 0x48, 0x2e, 0x71, 0xcb, 0x99, 0xc8, 0x05, 0xe6,
+	0x9f, 0x55, 0xf5, 0xb8, 0x05, 0xe5, 0x94, 0xe5, 0x7e, 0xf2, 0x3b, 0x60, 0x0a, 0x76, 0x5a, 0xba,
+	0xad, 0xe2, 0x1b, 0x4d, 0xc1, 0x65, 0x92, 0x90, 0x5a, 0xe2, 0x08, 0x80, 0xd2, 0x12, 0x29, 0xef,
+	0x96, 0x48, 0xf9, 0xaa, 0x5b, 0x43, 0x15, 0x93, 0x0f, 0xba, 0x22, 0xf7, 0xc1, 0xa7, 0x22, 0x27,
+	0x67, 0xfc, 0xc9, 0x98, 0xcd, 0x9b, 0x20, 0xd3, 0x54, 0x3b, 0x0a, 0x33, 0x13, 0x9f, 0x0a, 0x20,
+	0x9b, 0x7d, 0x13, 0xcf, 0x38, 0x6d, 0xb3, 0x91, 0x69, 0x27, 0x5d, 0xf1, 0x05, 0xba, 0xe3, 0x30,
+	0x5d, 0x92, 0x27, 0x9a, 0x6a, 0x67, 0x9d, 0x8c, 0xf1, 0xb9, 0xfe, 0x94, 0x03, 0x59, 0x03, 0x6f,
+	0x4e, 0x41, 0xd0, 0x30, 0x94, 0x96, 0xad, 0xd7, 0xa1, 0x90, 0x26, 0x4b, 0x1e, 0xb0, 0x25, 0x5f,
+	0x0d, 0xc4, 0x24, 0x3b, 0x93, 0xeb, 0x96, 0xdd, 0x70, 0xbf, 0x0b, 0x87, 0xaf, 0x15, 0xda, 0x8e,
+	0x6e, 0x20, 0x6a, 0xcd, 0xae, 0x0d, 0xeb, 0x9b, 0xb0, 0x8e, 0x51, 0x2c, 0xaa, 0xd7, 0x47, 0xb1,
+	0x28, 0x47, 0x92, 0x33, 0x84, 0xb4, 0x07, 0x0d, 0x63, 0x17, 0x13, 0xf8, 0xdf, 0x73, 0xe0, 0xc5,
+	0xa6, 0x6e, 0x2a, 0xea, 0x21, 0xb4, 0xd5, 0x06, 0x0c, 0x5a, 0x37, 0x41, 0xac, 0x3b, 0x7a, 0x4a,
+	0xeb, 0x06, 0x68, 0x3f, 0xe9, 0x8a, 0x2f, 0xb1, 0x73, 0xeb, 0xcb, 0x97, 0xe4, 0x99, 0xa6, 0x6e,
+	0xae, 0x53, 0xba, 0x6f, 0xee, 0x2b, 0x60, 0xaa, 0x65, 0x21, 0x47, 0xb1, 0x4c, 0xe3, 0x58, 0x41,
+	0x86, 0xae, 0x41, 0x61, 0x92, 0x14, 0xa6, 0x93, 0x98, 0x5c, 0x31, 0x8d, 0xe3, 0x3d, 0x4c, 0x5c,
+	0xbb, 0x1a, 0x85, 0xd6, 0x17, 0x19, 0xb4, 0x46, 0x32, 0x52, 0xfa, 0xf5, 0x28, 0xc8, 0xf5, 0x92,
+	0x3d, 0x90, 0x5d, 0x04, 0xc0, 0xb1, 0x55, 0xb3, 0xbe, 0x0f, 0x6f, 0xc3, 0x63, 0x96, 0xb3, 0x01,
+	0x0a, 0xff, 0x2e, 0x07, 0xc6, 0x71, 0x63, 0x80, 0xb3, 0x25, 0x46, 0xc2, 0xf1, 0x14, 0xf0, 0x29,
+	0x9f, 0x1f, 0x7c, 0x5c, 0xe5, 0x27, 0x5d, 0x31, 0x43, 0x8f, 0x8b, 0x11, 0x24, 0x39, 0x81, 0xbf,
+	0x4a, 0x26, 0xff, 0x0b, 0x0e, 0x64, 0x1c, 0xf5, 0x00, 0xda, 0x0a, 0x61, 0xe1, 0x50, 0x8e, 0x0f,
+	0xb3, 0xe4, 0x9d, 0xf3, 0x5b, 0x12, 0x59, 0xc3, 0x8f, 0xfb, 0x30, 0x5d, 0x92, 0x27, 0x08, 0x01,
+	0xcf, 0xc2, 0x71, 0xff, 0x33, 0x0e, 0x4c, 0x06, 0x24, 0x74, 0x93, 0xa0, 0xd4, 0x85, 0x63, 0x74,
+	0x68, 0x09, 0x1f, 0xa3, 0x43, 0x64, 0x49, 0x4e, 0x7b, 0xa6, 0x95, 0x4c, 0x3e, 0x7f, 0x1a, 0x3a,
+	0xf6, 0x22, 0xa3, 0xf4, 0x3e, 0x07, 0x16, 0x02, 0x37, 0xf1, 0xb6, 0x6e, 0x18, 0x50, 0x3b, 0x13,
+	0xb6, 0x8b, 0x20, 0xcd, 0x42, 0x46, 0x39, 0x80, 0xc7, 0x0c, 0xde, 0x03, 0x51, 0xb4, 0x76, 0x23,
+	0x1a, 0xad, 0x62, 0xa4, 0x10, 0x88, 0x2e, 0x26, 0xfd, 0x3b, 0x06, 0xae, 0x9c, 0xc2, 0xf7, 0xe2,
+	0xb7, 0x4f, 0x70, 0x70, 0xcf, 0x4f, 0x70, 0x60, 0xeb, 0x9a, 0x61, 0xeb, 0x62, 0xcf, 0xc2, 0xba,
+	0xe6, 0x00, 0xeb, 0x9a, 0x51, 0xeb, 0x9a, 0x01, 0xeb, 0xa4, 0xef, 0x83, 0x99, 0x1d, 0xd4, 0xd8,
+	0x50, 0xcd, 0x3a, 0x34, 0x2e, 0xc6, 0xcf, 0xcb, 0x51, 0x3f, 0xcf, 0x31, 0x3f, 0x47, 0x17, 0x91,
+	0xfe, 0x11, 0x23, 0xc1, 0x16, 0xa5, 0x7f, 0xe5, 0xd7, 0x0b, 0xf0, 0xeb, 0x15, 0x30, 0xb9, 0xd3,
+	0x36, 0x1c, 0xfd, 0x4d, 0xab, 0x25, 0x5b, 0x6d, 0x07, 0xe2, 0xda, 0x7c, 0xdf, 0x6a, 0x21, 0xda,
+	0x8f, 0xca, 0xe4, 0x5b, 0xfa, 0x63, 0x1c, 0x4c, 0xed, 0xa0, 0x86, 0x2b, 0xb8, 0x77, 0xa4, 0xb6,
+	0x3e, 0x63, 0xf5, 0x76, 0x13, 0x24, 0x6c, 0xbc, 0x4c, 0xff, 0x86, 0x2f, 0x64, 0x89, 0xcc, 0x24,
+	0xc3, 0x55, 0xd8, 0xe8, 0x05, 0x57, 0x61, 0xb8, 0x14, 0x81, 0x1d, 0xdd, 0x51, 0x68, 0x75, 0x40,
+	0x2f, 0xfb, 0x31, 0xaf, 0x14, 0x19, 0x79, 0x9a, 0x52, 0x24, 0xaa, 0xd7, 0x2f, 0x45, 0xa2, 0x1c,
+	0x09, 0x97, 0x64, 0xba, 0x43, 0x62, 0xdb, 0xbf, 0xdb, 0x31, 0x20, 0xd7, 0x20, 0x72, 0x14, 0x72,
+	0x10, 0x42, 0x82, 0xdd, 0xed, 0x7a, 0xfd, 0xa0, 0x08, 0x91, 0x43, 0x0e, 0x69, 0xed, 0xe5, 0x68,
+	0x16, 0xcd, 0xb0, 0x2c, 0x0a, 0x3a, 0x4b, 0xfa, 0x73, 0x0c, 0xcc, 0x45, 0x68, 0x5e, 0xf6, 0xfc,
+	0x90, 0x03, 0xc9, 0xb3, 0xe7, 0xcd, 0x9d, 0xf3, 0x47, 0x66, 0x32, 0x10, 0x93, 0x53, 0x81, 0x7b,
+	0x9b, 0x44, 0x23, 0xb9, 0xd3, 0x71, 0x9a, 0xdc, 0x00, 0x63, 0x74, 0x9b, 0x31, 0x56, 0xc8, 0x0e,
+	0x0e, 0x0c, 0x2a, 0xc8, 0xb7, 0xc1, 0xa8, 0xd6, 0x46, 0xce, 0xf0, 0x3e, 0x67, 0xfb, 0xfc, 0x36,
+	0x13, 0xcd, 0x27, 0x5d, 0x31, 0x4d, 0xed, 0xc5, 0x23, 0x49, 0x26, 0x44, 0xe9, 0xb7, 0x1c, 0x49,
+	0x86, 0xb7, 0x5b, 0x9a, 0xea, 0xc0, 0x5d, 0xf2, 0xc8, 0xc8, 0xdf, 0x02, 0x29, 0xb5, 0xed, 0xec,
+	0x5b, 0xb6, 0xee, 0xb0, 0xc2, 0xa8, 0x28, 0xfc, 0xf5, 0xa3, 0xeb, 0xb3, 0xcc, 0xa4, 0x75, 0x4d,
+	0xb3, 0x21, 0x42, 0x7b, 0x8e, 0xad, 0x9b, 0x0d, 0xd9, 0x17, 0xe5, 0x6f, 0x81, 0x04, 0x7d, 0xa6,
+	0x64, 0xbb, 0x9e, 0x09, 0xed, 0x9a, 0x2a, 0x2f, 0xa6, 0xb0, 0xf9, 0xbf, 0x79, 0x7c, 0x7f, 0x85,
+	0x93, 0x99, 0xf4, 0xda, 0x2b, 0xd8, 0xeb, 0xbe, 0x9e, 0xa0, 0xdf, 0x83, 0x76, 0x49, 0xf3, 0xc4,
+	0xed, 0x41, 0x92, 0xeb, 0x76, 0xe9, 0x61, 0x02, 0xcc, 0xba, 0xb5, 0x5e, 0xd5, 0xd6, 0x1b, 0x0d,
+	0x68, 0x7f, 0x11, 0x6d, 0xd9, 0xeb, 0x60, 0xc2, 0xa1, 0xab, 0xd3, 0x3e, 0x68, 0x8c, 0xf4, 0x41,
+	0x42, 0xe8, 0x1c, 0x98, 0x79, 0xa4, 0x09, 0x4a, 0x3b, 0xfe, 0x80, 0xff, 0x1a, 0xb8, 0xe4, 0x4d,
+	0xee, 0x57, 0xbd, 0x24, 0x48, 0xf5, 0x32, 0xe7, 0x4e, 0x89, 0xb6, 0x77, 0x83, 0x8a, 0x9e, 0xf1,
+	0xfe, 0x45, 0x4f, 0x18, 0x88, 0x92, 0xcf, 0xb4, 0x1d, 0x4c, 0x3d, 0x6d, 0x3b, 0x08, 0x2e, 0xb4,
+	0x1d, 0x4c, 0x3f, 0xd3, 0x76, 0xf0, 0xcb, 0xd5, 0x76, 0xad, 0x5d, 0x8b, 0x42, 0xae, 0x10, 0x6c,
+	0xa7, 0x82, 0xb9, 0x24, 0xfd, 0x81, 0x03, 0x97, 0xfa, 0x31, 0x3c, 0xf0, 0xcd, 0x80, 0x98, 0xae,
+	0xb1, 0x07, 0xe5, 0x98, 0xae, 0x3d, 0x07, 0x2d, 0x94, 0xd4, 0x24, 0x6f, 0x6c, 0xb4, 0xd8, 0x3a,
+	0x23, 0x30, 0xd0, 0x5d, 0xc4, 0xdc, 0x5d, 0xac, 0xad, 0x44, 0x4f, 0x68, 0x3e, 0x54, 0xda, 0x85,
+	0x8e, 0xe8, 0x43, 0x0e, 0xbc, 0xd4, 0x97, 0xf3, 0x9c, 0x5d, 0x50, 0xd2, 0x9f, 0x12, 0x60, 0xb6,
+	0xa8, 0x3a, 0xf5, 0xfd, 0xe8, 0x3b, 0x56, 0x10, 0xfa, 0xb8, 0x53, 0xa0, 0x2f, 0x16, 0x81, 0xbe,
+	0x41, 0xf0, 0x13, 0x3f, 0x0b, 0xfc, 0x8c, 0x3e, 0x53, 0xf8, 0x19, 0x7b, 0x5a, 0xf8, 0x49, 0x5c,
+	0x28, 0xfc, 0x8c, 0x7f, 0xfe, 0xaf, 0x51, 0xc9, 0xe7, 0xfa, 0x35, 0x2a, 0xf5, 0xe5, 0x78, 0x8d,
+	0x02, 0x7d, 0x5e, 0xa3, 0xa4, 0x4f, 0x38, 0xd2, 0x4a, 0x92, 0x54, 0xf2, 0x63, 0x0a, 0x9d, 0x02,
+	0x2f, 0x79, 0x30, 0x53, 0x27, 0xf0, 0xa0, 0x04, 0x3a, 0x4a, 0x44, 0xff, 0x60, 0x25, 0x4f, 0xd7,
+	0x19, 0x72, 0xb8, 0x8d, 0x25, 0xe2, 0xdf, 0x02, 0x13, 0x2d, 0x9c, 0xa3, 0x0a, 0x09, 0x51, 0xb7,
+	0xd5, 0xb8, 0x1c, 0x8a, 0xe6, 0x7e, 0x99, 0x5c, 0x1c, 0xc5, 0xe9, 0x24, 0xa7, 0xc9, 0x64, 0x6a,
+	0xd5, 0xe0, 0x2e, 0x35, 0x6a, 0xbf, 0xf4, 0x37, 0xda, 0xa5, 0x46, 0xe9, 0x1e, 0x8c, 0x5d, 0xc6,
+	0x95, 0x4e, 0xc0, 0x7c, 0xda, 0x60, 0xa5, 0x9d, 0x80, 0xe1, 0x3f, 0x62, 0x48, 0x87, 0x28, 0xfc,
+	0x0f, 0x29, 0x6b, 0x2b, 0xd8, 0x5a, 0x17, 0xbe, 0x10, 0x4d, 0xf1, 0x00, 0x7c, 0x61, 0x8a, 0xf4,
+	0xbb, 0x4f, 0xc5, 0xe5, 0x33, 0x02, 0x21, 0xa2, 0x50, 0x87, 0x4a, 0x26, 0xff, 0x13, 0x0e, 0xa4,
+	0xa8, 0x0a, 0x8a, 0x47, 0x43, 0x0c, 0xf9, 0x06, 0x33, 0xc4, 0x9f, 0xe3, 0x83, 0x8d, 0x47, 0x3a,
+	0x9f, 0x29, 0x74, 0x4f, 0x18, 0x76, 0xff, 0x4b, 0xff, 0x88, 0xba, 0xde, 0x84, 0xe6, 0xc5, 0x3c,
+	0x30, 0xf5, 0xcf, 0xf7, 0xf8, 0x17, 0x9f, 0xef, 0x03, 0xf0, 0x9d, 0xbb, 0x10, 0x7c, 0xff, 0x31,
+	0x77, 0xca, 0x0b, 0x5f, 0xfa, 0xe6, 0x42, 0x0f, 0x52, 0x97, 0x4c, 0xe7, 0xd6, 0xab, 0xdf, 0x54,
+	0x8d, 0x36, 0x2c, 0xbe, 0xfe, 0xa4, 0x2b, 0xf6, 0x9d, 0x7c, 0xd2, 0x15, 0x17, 0xd8, 0x13, 0x48,
+	0x1f, 0xae, 0xf4, 0x4b, 0x8c, 0xee, 0x3d, 0xf7, 0xd8, 0xe0, 0xe7, 0xe8, 0x88, 0x8f, 0xa5, 0xbf,
+	0xc4, 0xc8, 0x73, 0x74, 0x84, 0xec, 0x25, 0x54, 0xc4, 0xd1, 0xbd, 0xef, 0xd1, 0x5f, 0xa5, 0x53,
+	0x4f, 0x3a, 0xad, 0xfc, 0x8a, 0x03, 0x99, 0xf0, 0x4d, 0xce, 0xbf, 0x08, 0xf8, 0x37, 0x2a, 0x95,
+	0x4d, 0xa5, 0x5a, 0x2a, 0x2b, 0x1b, 0xeb, 0x77, 0x36, 0xb6, 0xca, 0xe5, 0xad, 0xcd, 0xec, 0x08,
+	0x9f, 0x05, 0x13, 0xdb, 0xa5, 0x72, 0x59, 0xa9, 0xc8, 0xca, 0xed, 0x52, 0xb9, 0x9c, 0xe5, 0xf8,
+	0x39, 0x30, 0x53, 0xda, 0xd9, 0xd9, 0xda, 0x2c, 0xad, 0x57, 0xb7, 0x30, 0x99, 0x4a, 0x67, 0x63,
+	0x58, 0xf4, 0xad, 0xb7, 0xf7, 0xaa, 0x4a, 0xe9, 0x8e, 0x52, 0x2d, 0xed, 0x6c, 0x65, 0xe3, 0xfc,
+	0x34, 0x98, 0xf4, 0x94, 0x12, 0xd2, 0x28, 0x3f, 0x09, 0x52, 0xbb, 0x95, 0xbd, 0xaa, 0x52, 0xb9,
+	0x53, 0xfe, 0x4e, 0x76, 0x8c, 0x5f, 0x00, 0x73, 0xde, 0x50, 0x09, 0xcb, 0x26, 0x56, 0xae, 0x83,
+	0x74, 0xa0, 0xeb, 0xc3, 0x53, 0xf7, 0xaa, 0x95, 0x5d, 0xa5, 0x5c, 0xd9, 0xdb, 0xcb, 0x8e, 0xf0,
+	0x53, 0x20, 0x5d, 0x5d, 0xbf, 0xbd, 0xa5, 0xec, 0xca, 0x95, 0xed, 0x52, 0x35, 0xcb, 0xdd, 0x7c,
+	0x2f, 0x09, 0xe2, 0x3b, 0xa8, 0xc1, 0x6f, 0x80, 0x71, 0xf7, 0x37, 0x1b, 0x73, 0xe1, 0x07, 0x04,
+	0xef, 0x67, 0x18, 0x39, 0x71, 0x00, 0xc3, 0x8b, 0xab, 0x32, 0x00, 0x81, 0xbf, 0xdc, 0xe7, 0xa2,
+	0xe2, 0x3e, 0x2f, 0x27, 0x0d, 0xe6, 0x79, 0xda, 0xee, 0x82, 0xa9, 0x68, 0xc1, 0xd8, 0x63, 0x41,
+	0x44, 0x20, 0x77, 0x75, 0x88, 0x80, 0xa7, 0xfc, 0x10, 0x08, 0x03, 0x9f, 0xe0, 0x97, 0x07, 0x19,
+	0x17, 0x95, 0xcc, 0xdd, 0x38, 0xab, 0xa4, 0xb7, 0xee, 0x77, 0x41, 0xb6, 0xe7, 0x29, 0x78, 0x29,
+	0xaa, 0x25, 0x2a, 0x91, 0x5b, 0x1e, 0x26, 0xe1, 0xe9, 0x97, 0xc1, 0x44, 0xe8, 0xb1, 0xf1, 0x52,
+	0x74, 0x66, 0x90, 0x9b, 0x7b, 0xf9, 0x34, 0x6e, 0x50, 0x67, 0xe8, 0xcd, 0xa6, 0x47, 0x67, 0x90,
+	0xdb, 0xab, 0xb3, 0xdf, 0x23, 0x0a, 0xaf, 0x82, 0xe9, 0xde, 0x07, 0x94, 0xcb, 0x7d, 0xbd, 0x17,
+	0x14, 0xc9, 0x5d, 0x1b, 0x2a, 0xe2, 0x2d, 0xa1, 0x01, 0xbe, 0x4f, 0x2f, 0x26, 0xf5, 0x3f, 0xca,
+	0xd0, 0x22, 0x2b, 0xc3, 0x65, 0x82, 0x0e, 0xed, 0x29, 0xc8, 0x7a, 0x1c, 0x1a, 0x95, 0xe8, 0x75,
+	0xe8, 0xc0, 0xe2, 0xe7, 0x2e, 0x98, 0x8a, 0xde, 0xe0, 0x3d, 0x59, 0x10, 0x11, 0xe8, 0xcd, 0x82,
+	0x01, 0x17, 0x41, 0x6e, 0xec, 0xdd, 0xc7, 0xf7, 0x57, 0xb8, 0xe2, 0x1b, 0x0f, 0x1e, 0x2e, 0x72,
+	0x1f, 0x3f, 0x5c, 0xe4, 0xfe, 0xf5, 0x70, 0x91, 0xfb, 0xe0, 0xd1, 0xe2, 0xc8, 0xc7, 0x8f, 0x16,
+	0x47, 0xfe, 0xfe, 0x68, 0x71, 0xe4, 0x9d, 0xeb, 0xc3, 0xaf, 0xf3, 0x0e, 0xfd, 0x51, 0x24, 0xc6,
+	0xcc, 0x5a, 0x82, 0x5c, 0x82, 0xff, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xa8, 0x75,
+	0xda, 0x30, 0x29, 0x00, 0x00,
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	PlaceTriggerOrder(ctx context.Context, in *MsgPlaceTriggerOrder, opts ...grpc.CallOption) (*MsgPlaceTriggerOrderResponse, error)
+	CancelTriggerOrder(ctx context.Context, in *MsgCancelTriggerOrder, opts ...grpc.CallOption) (*MsgCancelTriggerOrderResponse, error)
+	BatchLimitOrders(ctx context.Context, in *MsgBatchLimitOrders, opts ...grpc.CallOption) (*MsgBatchLimitOrdersResponse, error)
+	AmendLimitOrder(ctx context.Context, in *MsgAmendLimitOrder, opts ...grpc.CallOption) (*MsgAmendLimitOrderResponse, error)
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesIssued) > 0 {
-		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesIssued[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.FailedDeposits) > 0 {
-		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Reserve1Deposited) > 0 {
-		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve1Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Reserve0Deposited) > 0 {
-		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve0Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
+	out := new(MsgWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		dAtA6 := make([]byte, len(m.Fees)*10)
-		var j5 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j7 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.SharesToRemove) > 0 {
-		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesToRemove[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	out := new(MsgWithdrawFilledLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesBurned) > 0 {
-		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesBurned[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.Reserve1Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
+	out := new(MsgMultiHopSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Reserve0Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PlaceTriggerOrder(ctx context.Context, in *MsgPlaceTriggerOrder, opts ...grpc.CallOption) (*MsgPlaceTriggerOrderResponse, error) {
+	out := new(MsgPlaceTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceTriggerOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelTriggerOrder(ctx context.Context, in *MsgCancelTriggerOrder, opts ...grpc.CallOption) (*MsgCancelTriggerOrderResponse, error) {
+	out := new(MsgCancelTriggerOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelTriggerOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinAverageSellPrice != nil {
-		{
-			size := m.MinAverageSellPrice.Size()
-			i -= size
-			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.LimitSellPrice != nil {
-		{
-			size := m.LimitSellPrice.Size()
-			i -= size
-			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.MaxAmountOut != nil {
-		{
-			size := m.MaxAmountOut.Size()
-			i -= size
-			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.ExpirationTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintTx(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.OrderType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.TickIndexInToOut != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) BatchLimitOrders(ctx context.Context, in *MsgBatchLimitOrders, opts ...grpc.CallOption) (*MsgBatchLimitOrdersResponse, error) {
+	out := new(MsgBatchLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/BatchLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AmendLimitOrder(ctx context.Context, in *MsgAmendLimitOrder, opts ...grpc.CallOption) (*MsgAmendLimitOrderResponse, error) {
+	out := new(MsgAmendLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/AmendLimitOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	PlaceTriggerOrder(context.Context, *MsgPlaceTriggerOrder) (*MsgPlaceTriggerOrderResponse, error)
+	CancelTriggerOrder(context.Context, *MsgCancelTriggerOrder) (*MsgCancelTriggerOrderResponse, error)
+	BatchLimitOrders(context.Context, *MsgBatchLimitOrders) (*MsgBatchLimitOrdersResponse, error)
+	AmendLimitOrder(context.Context, *MsgAmendLimitOrder) (*MsgAmendLimitOrderResponse, error)
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TakerCoinIn.Size()
-		i -= size
-		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CoinIn.Size()
-		i -= size
-		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) PlaceTriggerOrder(ctx context.Context, req *MsgPlaceTriggerOrder) (*MsgPlaceTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceTriggerOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelTriggerOrder(ctx context.Context, req *MsgCancelTriggerOrder) (*MsgCancelTriggerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTriggerOrder not implemented")
+}
+func (*UnimplementedMsgServer) BatchLimitOrders(ctx context.Context, req *MsgBatchLimitOrders) (*MsgBatchLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchLimitOrders not implemented")
+}
+func (*UnimplementedMsgServer) AmendLimitOrder(ctx context.Context, req *MsgAmendLimitOrder) (*MsgAmendLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendLimitOrder not implemented")
 }
 
-func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawal)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFilledLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiHopSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiHopSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceTriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceTriggerOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceTriggerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PlaceTriggerOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceTriggerOrder(ctx, req.(*MsgPlaceTriggerOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTriggerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTriggerOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTriggerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/CancelTriggerOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTriggerOrder(ctx, req.(*MsgCancelTriggerOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchLimitOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/BatchLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchLimitOrders(ctx, req.(*MsgBatchLimitOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/AmendLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendLimitOrder(ctx, req.(*MsgAmendLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.dex.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Msg_Withdrawal_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "WithdrawFilledLimitOrder",
+			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "MultiHopSwap",
+			Handler:    _Msg_MultiHopSwap_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "PlaceTriggerOrder",
+			Handler:    _Msg_PlaceTriggerOrder_Handler,
+		},
+		{
+			MethodName: "CancelTriggerOrder",
+			Handler:    _Msg_CancelTriggerOrder_Handler,
+		},
+		{
+			MethodName: "BatchLimitOrders",
+			Handler:    _Msg_BatchLimitOrders_Handler,
+		},
+		{
+			MethodName: "AmendLimitOrder",
+			Handler:    _Msg_AmendLimitOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/dex/tx.proto",
+}
+
+func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2195,34 +2347,40 @@ func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+	if m.FailTxOnBel {
 		i--
-		dAtA[i] = 0x12
+		if m.FailTxOnBel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if m.DisableAutoswap {
 		i--
-		dAtA[i] = 0xa
+		if m.DisableAutoswap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2232,40 +2390,127 @@ func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Fees) > 0 {
+		dAtA2 := make([]byte, len(m.Fees)*10)
+		var j1 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j3 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AmountsB) > 0 {
+		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsB[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AmountsA) > 0 {
+		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsA[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
+func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2275,29 +2520,32 @@ func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
+func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hops) > 0 {
-		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hops[iNdEx])
-			copy(dAtA[i:], m.Hops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2307,50 +2555,34 @@ func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PickBestRoute {
-		i--
-		if m.PickBestRoute {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.ExitLimitPrice.Size()
-		i -= size
-		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesIssued[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2361,24 +2593,38 @@ func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Reserve1Deposited) > 0 {
+		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve1Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Reserve0Deposited) > 0 {
+		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve0Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2388,56 +2634,99 @@ func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dust) > 0 {
-		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Dust[iNdEx].Size()
-				i -= size
-				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Fees) > 0 {
+		dAtA6 := make([]byte, len(m.Fees)*10)
+		var j5 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
 			}
-			i--
-			dAtA[i] = 0x1a
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j7 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	{
-		size := m.CoinOut.Size()
-		i -= size
-		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.SharesToRemove) > 0 {
+		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesToRemove[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2447,37 +2736,54 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SharesBurned) > 0 {
+		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesBurned[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Reserve1Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.Reserve0Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2487,440 +2793,3577 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DepositOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DisableAutoswap {
-		n += 2
-	}
-	if m.FailTxOnBel {
-		n += 2
-	}
-	return n
-}
-
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AmountsA) > 0 {
-		for _, e := range m.AmountsA {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.PostOnlySlide {
+		i--
+		if m.PostOnlySlide {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.AmountsB) > 0 {
-		for _, e := range m.AmountsB {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.ExpirationTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err9 != nil {
+			return 0, err9
 		}
+		i -= n9
+		i = encodeVarintTx(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
-}
-
-func (m *FailedDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DepositIdx != 0 {
-		n += 1 + sovTx(uint64(m.DepositIdx))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Reserve0Deposited) > 0 {
-		for _, e := range m.Reserve0Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Reserve1Deposited) > 0 {
-		for _, e := range m.Reserve1Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x3a
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.FailedDeposits) > 0 {
-		for _, e := range m.FailedDeposits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.SharesIssued) > 0 {
-		for _, e := range m.SharesIssued {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.SharesToRemove) > 0 {
-		for _, e := range m.SharesToRemove {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.TakerCoinIn.Size()
+		i -= size
+		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Reserve0Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Reserve1Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.SharesBurned) > 0 {
-		for _, e := range m.SharesBurned {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TickIndexInToOut != 0 {
-		n += 1 + sovTx(uint64(m.TickIndexInToOut))
-	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovTx(uint64(m.OrderType))
-	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxAmountOut != nil {
-		l = m.MaxAmountOut.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LimitSellPrice != nil {
-		l = m.LimitSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MinAverageSellPrice != nil {
-		l = m.MinAverageSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.CoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MultiHopRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Hops) > 0 {
-		for _, s := range m.Hops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hops[iNdEx])
+			copy(dAtA[i:], m.Hops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMultiHopSwap) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ExitLimitPrice.Size()
+		i -= size
+		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExitLimitPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PickBestRoute {
-		n += 2
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *MsgMultiHopSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Dust[iNdEx].Size()
+				i -= size
+				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Dust) > 0 {
-		for _, e := range m.Dust {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceTriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceTriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceTriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpirationTime != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintTx(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TriggerTickIndexInToOut))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TriggerType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TriggerType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTriggerOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTriggerOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTriggerOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostOnlySlide {
+		i--
+		if m.PostOnlySlide {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpirationTime != nil {
+		n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintTx(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchLimitOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchLimitOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchLimitOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlaceOrders) > 0 {
+		for iNdEx := len(m.PlaceOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlaceOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CancelTrancheKeys) > 0 {
+		for iNdEx := len(m.CancelTrancheKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelTrancheKeys[iNdEx])
+			copy(dAtA[i:], m.CancelTrancheKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CancelTrancheKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsOut) > 0 {
+		for iNdEx := len(m.CoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CoinsIn) > 0 {
+		for iNdEx := len(m.CoinsIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TrancheKeys) > 0 {
+		for iNdEx := len(m.TrancheKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrancheKeys[iNdEx])
+			copy(dAtA[i:], m.TrancheKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickIndexInToOut != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdInt64MarshalTo(*m.TickIndexInToOut, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdInt64(*m.TickIndexInToOut):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintTx(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AmountIn != nil {
+		{
+			size := m.AmountIn.Size()
+			i -= size
+			if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoinsOut) > 0 {
+		for iNdEx := len(m.CoinsOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CoinsIn) > 0 {
+		for iNdEx := len(m.CoinsIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinsIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisableAutoswap {
+		n += 2
+	}
+	if m.FailTxOnBel {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AmountsA) > 0 {
+		for _, e := range m.AmountsA {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AmountsB) > 0 {
+		for _, e := range m.AmountsB {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FailedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositIdx != 0 {
+		n += 1 + sovTx(uint64(m.DepositIdx))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserve0Deposited) > 0 {
+		for _, e := range m.Reserve0Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Reserve1Deposited) > 0 {
+		for _, e := range m.Reserve1Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SharesToRemove) > 0 {
+		for _, e := range m.SharesToRemove {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesBurned) > 0 {
+		for _, e := range m.SharesBurned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PostOnlySlide {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MultiHopRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, s := range m.Hops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitLimitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceTriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TriggerType != 0 {
+		n += 1 + sovTx(uint64(m.TriggerType))
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TriggerTickIndexInToOut))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPlaceTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelTriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelTriggerOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *BatchPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PostOnlySlide {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBatchLimitOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CancelTrancheKeys) > 0 {
+		for _, s := range m.CancelTrancheKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PlaceOrders) > 0 {
+		for _, e := range m.PlaceOrders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TrancheKeys) > 0 {
+		for _, s := range m.TrancheKeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CoinsIn) > 0 {
+		for _, e := range m.CoinsIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CoinsOut) > 0 {
+		for _, e := range m.CoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAmendLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountIn != nil {
+		l = m.AmountIn.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TickIndexInToOut != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdInt64(*m.TickIndexInToOut)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoinsIn) > 0 {
+		for _, e := range m.CoinsIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CoinsOut) > 0 {
+		for _, e := range m.CoinsOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoswap = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailTxOnBel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsA = append(m.AmountsA, v)
+			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsB = append(m.AmountsB, v)
+			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &DepositOptions{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
+			}
+			m.DepositIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
+			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
+			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesIssued = append(m.SharesIssued, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.SharesToRemove = append(m.SharesToRemove, v)
+			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesBurned = append(m.SharesBurned, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnlySlide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnlySlide = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DepositOptions) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2943,17 +6386,17 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2963,17 +6406,128 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableAutoswap = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
 			}
-			var v int
+			m.TickIndexInToOut = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2983,12 +6537,11 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FailTxOnBel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3010,7 +6563,7 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3033,10 +6586,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3073,7 +6626,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3101,13 +6654,63 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3117,29 +6720,30 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3149,27 +6753,78 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3197,15 +6852,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsA = append(m.AmountsA, v)
-			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3233,167 +6884,94 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsB = append(m.AmountsB, v)
-			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 8:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3420,8 +6998,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &DepositOptions{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3446,7 +7023,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3469,34 +7046,15 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
-			}
-			m.DepositIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DepositIdx |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3524,7 +7082,7 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3547,7 +7105,7 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,15 +7128,15 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3606,15 +7164,11 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
-			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3642,15 +7196,11 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
-			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3677,16 +7227,16 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
-			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3696,79 +7246,29 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharesIssued = append(m.SharesIssued, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3796,13 +7296,15 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3812,29 +7314,67 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			m.PickBestRoute = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3844,29 +7384,30 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3876,29 +7417,33 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3908,180 +7453,26 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.SharesToRemove = append(m.SharesToRemove, v)
-			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
-			}
-		case 7:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4103,7 +7494,7 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4126,15 +7517,15 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4162,15 +7553,13 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4180,60 +7569,75 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SharesBurned = append(m.SharesBurned, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4255,7 +7659,7 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceTriggerOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4278,10 +7682,10 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceTriggerOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceTriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4413,6 +7817,44 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerType", wireType)
+			}
+			m.TriggerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerType |= TriggerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTickIndexInToOut", wireType)
+			}
+			m.TriggerTickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerTickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
 			}
@@ -4431,7 +7873,7 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
@@ -4465,11 +7907,188 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.OrderType = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4479,14 +8098,14 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4513,16 +8132,63 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4550,17 +8216,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.MaxAmountOut = &v
-			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4570,33 +8232,66 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.LimitSellPrice = &v
-			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTriggerOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTriggerOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTriggerOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4606,25 +8301,22 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.MinAverageSellPrice = &v
-			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4649,7 +8341,7 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +8364,15 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchPlaceLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4708,13 +8400,13 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4724,30 +8416,29 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
 			}
-			var msglen int
+			m.TickIndexInToOut = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4757,30 +8448,16 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4790,80 +8467,50 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4873,27 +8520,31 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4921,63 +8572,17 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4987,30 +8592,33 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5020,25 +8628,48 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnlySlide", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnlySlide = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5060,7 +8691,7 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchLimitOrders) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5083,10 +8714,10 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchLimitOrders: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchLimitOrders: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5123,7 +8754,7 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTrancheKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5151,7 +8782,41 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.CancelTrancheKeys = append(m.CancelTrancheKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlaceOrders = append(m.PlaceOrders, BatchPlaceLimitOrder{})
+			if err := m.PlaceOrders[len(m.PlaceOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5174,7 +8839,7 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5197,17 +8862,17 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5217,28 +8882,27 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TrancheKeys = append(m.TrancheKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5265,65 +8929,16 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoinsIn = append(m.CoinsIn, types.Coin{})
+			if err := m.CoinsIn[len(m.CoinsIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5333,23 +8948,25 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			m.CoinsOut = append(m.CoinsOut, types.Coin{})
+			if err := m.CoinsOut[len(m.CoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5372,7 +8989,7 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5395,10 +9012,10 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5435,7 +9052,7 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5463,13 +9080,13 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5479,23 +9096,25 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &MultiHopRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5529,15 +9148,17 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			var v cosmossdk_io_math.Int
+			m.AmountIn = &v
 			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5547,46 +9168,28 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TickIndexInToOut == nil {
+				m.TickIndexInToOut = new(int64)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdInt64Unmarshal(m.TickIndexInToOut, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PickBestRoute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5608,7 +9211,7 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAmendLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5631,17 +9234,17 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAmendLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAmendLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5651,28 +9254,27 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5699,132 +9301,14 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Route == nil {
-				m.Route = &MultiHopRoute{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoinsIn = append(m.CoinsIn, types.Coin{})
+			if err := m.CoinsIn[len(m.CoinsIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinsOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5851,7 +9335,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoinsOut = append(m.CoinsOut, types.Coin{})
+			if err := m.CoinsOut[len(m.CoinsOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5876,56 +9361,6 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func CmdQueryGMPMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message [channel-id] [gateway] [source-chain] [command-id]",
+		Short: "shows a delivered general message by the gateway that relayed it and its command id",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GMPMessage(cmd.Context(), &types.QueryGMPMessageRequest{
+				ChannelId:   args[0],
+				Gateway:     args[1],
+				SourceChain: args[2],
+				CommandId:   args[3],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGMPMessagesBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "messages-by-sender [source-chain] [source-address]",
+		Short: "list the received general messages of a source address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GMPMessagesBySender(cmd.Context(), &types.QueryGMPMessagesBySenderRequest{
+				SourceChain:   args[0],
+				SourceAddress: args[1],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
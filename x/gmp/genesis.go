@@ -0,0 +1,27 @@
+package gmp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, record := range genState.Messages {
+		k.SetMessageRecord(ctx, record)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.Messages = k.GetAllMessageRecords(ctx)
+
+	return genesis
+}
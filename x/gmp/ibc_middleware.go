@@ -4,21 +4,28 @@ import (
 	"encoding/json"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
+	"github.com/neutron-org/neutron/v5/x/ibc-hooks/utils"
 )
 
 type IBCMiddleware struct {
-	app porttypes.IBCModule
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
 }
 
-func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
-		app: app,
+		app:    app,
+		keeper: k,
 	}
 }
 
@@ -89,7 +96,19 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket implements the IBCMiddleware interface
+// OnRecvPacket implements the IBCMiddleware interface.
+// GMP memos are only accepted from the gateways of the destination channel. For gateways
+// wrapping contract messages, the source chain and source address of a general message
+// are then passed down the stack in the context, for the contract the payload calls to
+// be executed with them, see types.MessageSource.
+// Versioned payloads of EVM senders are decoded into a wasm hook memo calling the
+// receiver of the packet, see types.PayloadMemo. Native dex actions are executed for
+// the receiver without a contract, see types.DexAction.
+// Pure general messages only call a contract, the tokens of their packet are sent to
+// the fee sink. Send token messages are plain transfers to the receiver.
+// Received messages are recorded by packet until pruned, along with their outcome. A
+// delivered message whose command id was already delivered by the same gateway from the
+// same source chain is rejected as a replay.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -100,31 +119,196 @@ func (im IBCMiddleware) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal ICS-20 transfer packet data"))
 	}
 
-	var msg Message
-	var err error
-	err = json.Unmarshal([]byte(data.GetMemo()), &msg)
-	if err != nil || len(msg.Payload) == 0 {
+	var msg gmptypes.Message
+	err := json.Unmarshal([]byte(data.GetMemo()), &msg)
+	if err != nil || !msg.IsEnvelope() {
 		// Not a packet that should be handled by the GMP middleware
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	gateway, found := im.keeper.FindGateway(ctx, packet.GetDestChannel(), data.Sender)
+	if !found {
+		return channeltypes.NewErrorAcknowledgement(
+			gmptypes.ErrUnknownGateway.Wrapf("%s on %s", data.Sender, packet.GetDestChannel()),
+		)
+	}
+	if err := msg.Validate(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if gateway.WrapContractMsg {
+		ctx = gmptypes.ContextWithMessageSource(ctx, gmptypes.MessageSource{
+			SourceChain:   msg.SourceChain,
+			SourceAddress: msg.SourceAddress,
+		})
+	}
+
+	ack, status := im.onRecvMessage(ctx, packet, data, relayer, msg)
+	record := gmptypes.NewMessageRecord(msg, data.Sender, packet.GetDestChannel(), packet.GetSequence(), status, ctx.BlockHeight())
+	if ack == nil || ack.Success() {
+		// asynchronously acknowledged messages are recorded as delivered
+		im.keeper.SetMessageRecord(ctx, record)
+		return ack
+	}
+
+	// the state changes of an error acknowledgement are reverted
+	record.Status = gmptypes.MessageStatus_MESSAGE_STATUS_FAILED
+	if errAck, ok := ack.(channeltypes.Acknowledgement); ok {
+		record.Error = errAck.GetError()
+	}
+	im.keeper.RecordFailedMessage(ctx, packet.GetDestPort(), record)
+	return ack
+}
+
+// onRecvMessage handles a general message relayed by a gateway according to its type.
+// It returns the status of the message along with the acknowledgement.
+func (im IBCMiddleware) onRecvMessage(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	msg gmptypes.Message,
+) (ibcexported.Acknowledgement, gmptypes.MessageStatus) {
+	if msg.CommandID != "" && im.keeper.HasMessageCommand(ctx, packet.GetDestChannel(), data.Sender, msg.SourceChain, msg.CommandID) {
+		return channeltypes.NewErrorAcknowledgement(gmptypes.ErrDuplicateMessage.Wrap(msg.CommandID)), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+
 	switch msg.Type {
-	case TypeGeneralMessage:
-		// let the next layer deal with this
-		// the rest of the data fields should be normal
-		fallthrough
-	case TypeGeneralMessageWithToken:
-		// we throw out the rest of the msg.Payload fields here, for better or worse
-		data.Memo = string(msg.Payload)
-		var dataBytes []byte
-		if dataBytes, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
-			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
+	case gmptypes.TypeGeneralMessage:
+		return im.onRecvPureMessage(ctx, packet, data, relayer, msg), gmptypes.MessageStatus_MESSAGE_STATUS_EXECUTED
+	case gmptypes.TypeGeneralMessageWithToken:
+		return im.onRecvMessageWithToken(ctx, packet, data, relayer, msg)
+	case gmptypes.TypeSendToken:
+		if len(msg.Payload) != 0 {
+			return channeltypes.NewErrorAcknowledgement(gmptypes.ErrInvalidGeneralMessage.Wrap("send token messages carry no payload")), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
 		}
-		packet.Data = dataBytes
-		return im.app.OnRecvPacket(ctx, packet, relayer)
+		// the envelope is not meant for the next layers
+		data.Memo = ""
+		var err error
+		if packet.Data, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
+			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data")), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+		}
+		return im.app.OnRecvPacket(ctx, packet, relayer), gmptypes.MessageStatus_MESSAGE_STATUS_EXECUTED
 	default:
-		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unrecognized mesasge type: %d", msg.Type))
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("unrecognized mesasge type: %d", msg.Type)), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+}
+
+// onRecvMessageWithToken passes the payload of the general message down the stack as
+// the memo of the packet, unless it is a native dex action. It returns the status of
+// the message along with the acknowledgement.
+func (im IBCMiddleware) onRecvMessageWithToken(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	msg gmptypes.Message,
+) (ibcexported.Acknowledgement, gmptypes.MessageStatus) {
+	// versioned payloads of EVM senders call the contract the packet is sent to
+	var err error
+	if data.Memo, err = gmptypes.PayloadMemo(msg.Payload, data.Receiver); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+	action, isAction, err := gmptypes.ParseDexAction(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+	if isAction {
+		// the tokens are credited to the receiver before the action sells them
+		data.Memo = ""
+	}
+
+	if packet.Data, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data")), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+	if !isAction {
+		return im.app.OnRecvPacket(ctx, packet, relayer), gmptypes.MessageStatus_MESSAGE_STATUS_EXECUTED
+	}
+	return im.onRecvDexAction(ctx, packet, data, relayer, action)
+}
+
+// onRecvPureMessage calls the contract of a pure general message. The tokens of the
+// packet are credited to the caller, the intermediate sender wasm hooks would use, and
+// sent to the fee sink: the contract is called without funds.
+func (im IBCMiddleware) onRecvPureMessage(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	msg gmptypes.Message,
+) ibcexported.Acknowledgement {
+	memo, err := gmptypes.PayloadMemo(msg.Payload, data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	contract, contractMsg, isCall, err := gmptypes.ParseContractCall(memo, data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !isCall {
+		return channeltypes.NewErrorAcknowledgement(gmptypes.ErrInvalidGeneralMessage.Wrap("a pure general message must call a contract"))
+	}
+	if source, ok := gmptypes.MessageSourceFromContext(ctx); ok {
+		if contractMsg, err = source.WrapContractMsg(contractMsg); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid token amount"))
+	}
+
+	callerBech32, err := utils.DeriveIntermediateSender(packet.GetDestChannel(), data.Sender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot derive the caller of %s: %w", data.Sender, err))
+	}
+	data.Receiver = callerBech32
+	data.Memo = ""
+	if packet.Data, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	caller := sdk.MustAccAddressFromBech32(callerBech32)
+	dust := sdk.NewCoin(utils.MustExtractDenomFromPacketOnRecv(packet), amount)
+	if _, err := im.keeper.ExecutePureMessage(ctx, caller, dust, contract, contractMsg); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// onRecvDexAction credits the receiver with the tokens of the packet, then executes the
+// native dex action of the general message for them. It returns the status of the
+// message along with the acknowledgement.
+func (im IBCMiddleware) onRecvDexAction(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data types.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+	action gmptypes.DexAction,
+) (ibcexported.Acknowledgement, gmptypes.MessageStatus) {
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(gmptypes.ErrInvalidDexAction.Wrapf("invalid receiver: %s", err)), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid token amount")), gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack, gmptypes.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+
+	tokenIn := sdk.NewCoin(utils.MustExtractDenomFromPacketOnRecv(packet), amount)
+	if !im.keeper.HandleDexAction(ctx, packet.GetDestChannel(), packet.GetSequence(), receiver, tokenIn, action) {
+		return ack, gmptypes.MessageStatus_MESSAGE_STATUS_ACTION_FAILED
 	}
+	return ack, gmptypes.MessageStatus_MESSAGE_STATUS_EXECUTED
 }
 
 // OnAcknowledgementPacket implements the IBCMiddleware interface
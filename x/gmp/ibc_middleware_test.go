@@ -0,0 +1,508 @@
+package gmp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/app/params"
+	"github.com/neutron-org/neutron/v5/testutil"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/gmp"
+	"github.com/neutron-org/neutron/v5/x/gmp/keeper"
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+	"github.com/neutron-org/neutron/v5/x/ibc-hooks/utils"
+)
+
+var receiver = sdk.AccAddress("gmp_receiver________")
+
+const (
+	sourceChain   = "ethereum"
+	sourceAddress = "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"
+)
+
+type MiddlewareTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+// openGatewayChannel opens a transfer channel between chain A and chain B, with the chain B
+// sender as the gateway on chain A
+func (suite *MiddlewareTestSuite) openGatewayChannel() {
+	suite.ConfigureTransferChannel()
+	err := suite.GetNeutronZoneApp(suite.ChainA).GMPKeeper.SetParams(suite.ChainA.GetContext(), types.NewParams([]types.Gateway{{
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+		Sender:    suite.ChainB.SenderAccount.GetAddress().String(),
+	}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit))
+	suite.Require().NoError(err)
+}
+
+// voucherDenom is the denom of the chain B native token on chain A
+func (suite *MiddlewareTestSuite) voucherDenom() string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		params.DefaultDenom,
+	)).IBCDenom()
+}
+
+// sendGeneralMessage relays a general message with the payload from the chain B gateway to
+// the receiver on chain A, returning the events and the acknowledgement of its receipt
+func (suite *MiddlewareTestSuite) sendGeneralMessage(amount int64, payload string) ([]abci.Event, string) {
+	return suite.relayMessage(amount, types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte(payload),
+		Type:          types.TypeGeneralMessageWithToken,
+	})
+}
+
+// relayMessage relays the general message from the chain B gateway to the receiver on chain A
+func (suite *MiddlewareTestSuite) relayMessage(amount int64, gmpMsg types.Message) ([]abci.Event, string) {
+	memo, err := json.Marshal(gmpMsg)
+	suite.Require().NoError(err)
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+		sdk.NewInt64Coin(params.DefaultDenom, amount),
+		suite.ChainB.SenderAccount.GetAddress().String(),
+		receiver.String(),
+		clienttypes.NewHeight(10, 100),
+		uint64(time.Now().Add(time.Hour).UnixNano()),
+		string(memo),
+	)
+	res, err := suite.SendMsgsNoCheck(suite.ChainB, msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	recvRes, err := suite.TransferPath.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(recvRes.GetEvents())
+	suite.Require().NoError(err)
+	return recvRes.GetEvents(), string(ack)
+}
+
+func (suite *MiddlewareTestSuite) hasEvent(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// provideLiquidity deposits untrn to be bought with the voucher at tick 0
+func (suite *MiddlewareTestSuite) provideLiquidity() {
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	lp := suite.ChainA.SenderAccount.GetAddress().String()
+	_, err := dexkeeper.NewMsgServerImpl(appA.DexKeeper).Deposit(suite.ChainA.GetContext(), &dextypes.MsgDeposit{
+		Creator:         lp,
+		Receiver:        lp,
+		TokenA:          params.DefaultDenom,
+		TokenB:          suite.voucherDenom(),
+		AmountsA:        []sdkmath.Int{sdkmath.NewInt(10_000)},
+		AmountsB:        []sdkmath.Int{sdkmath.ZeroInt()},
+		TickIndexesAToB: []int64{0},
+		Fees:            []uint64{1},
+		Options:         []*dextypes.DepositOptions{{}},
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *MiddlewareTestSuite) TestDexSwap() {
+	suite.openGatewayChannel()
+	suite.provideLiquidity()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	events, ack := suite.sendGeneralMessage(1000, `{"dex": {"swap": {"routes": [["untrn"]], "min_out": "900"}}}`)
+	suite.Require().Contains(ack, `"result"`)
+	suite.Require().True(suite.hasEvent(events, "neutron.gmp.EventDexActionExecuted"))
+
+	ctx := suite.ChainA.GetContext()
+	suite.Require().True(appA.BankKeeper.GetBalance(ctx, receiver, suite.voucherDenom()).IsZero())
+	out := appA.BankKeeper.GetBalance(ctx, receiver, params.DefaultDenom).Amount
+	suite.Require().True(out.GTE(sdkmath.NewInt(900)), out.String())
+}
+
+func (suite *MiddlewareTestSuite) TestDexDeposit() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	events, ack := suite.sendGeneralMessage(1000, fmt.Sprintf(
+		`{"dex": {"deposit": {"token_b": "%s", "tick_index_a_to_b": 10, "fee": 1}}}`, params.DefaultDenom,
+	))
+	suite.Require().Contains(ack, `"result"`)
+	suite.Require().True(suite.hasEvent(events, "neutron.gmp.EventDexActionExecuted"))
+
+	balances := appA.BankKeeper.GetAllBalances(suite.ChainA.GetContext(), receiver)
+	suite.Require().True(balances.AmountOf(suite.voucherDenom()).IsZero())
+	suite.Require().Len(balances, 1, "pool shares")
+}
+
+func (suite *MiddlewareTestSuite) TestDexLimitOrder() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	events, ack := suite.sendGeneralMessage(1000, fmt.Sprintf(
+		`{"dex": {"place_limit_order": {"token_out": "%s", "tick_index_in_to_out": 10, "order_type": "GOOD_TIL_CANCELLED"}}}`, params.DefaultDenom,
+	))
+	suite.Require().Contains(ack, `"result"`)
+	suite.Require().True(suite.hasEvent(events, "neutron.gmp.EventDexActionExecuted"))
+
+	ctx := suite.ChainA.GetContext()
+	suite.Require().True(appA.BankKeeper.GetBalance(ctx, receiver, suite.voucherDenom()).IsZero())
+	suite.Require().Len(appA.DexKeeper.GetAllLimitOrderTrancheUser(ctx), 1)
+}
+
+func (suite *MiddlewareTestSuite) TestFailedDexActionCreditsReceiver() {
+	suite.openGatewayChannel()
+	suite.provideLiquidity()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	// the swap returns less than the min out
+	events, ack := suite.sendGeneralMessage(1000, `{"dex": {"swap": {"routes": [["untrn"]], "min_out": "2000"}}}`)
+	suite.Require().Contains(ack, `"result"`)
+	suite.Require().True(suite.hasEvent(events, "neutron.gmp.EventDexActionFailed"))
+	suite.Require().False(suite.hasEvent(events, "neutron.gmp.EventDexActionExecuted"))
+
+	ctx := suite.ChainA.GetContext()
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctx, receiver, suite.voucherDenom()).Amount)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctx, receiver, params.DefaultDenom).IsZero())
+}
+
+func (suite *MiddlewareTestSuite) TestInvalidDexActionIsRejected() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	_, ack := suite.sendGeneralMessage(1000, `{"dex": {}}`)
+	suite.Require().Contains(ack, `"error"`)
+	suite.Require().True(appA.BankKeeper.GetBalance(suite.ChainA.GetContext(), receiver, suite.voucherDenom()).IsZero())
+}
+
+func (suite *MiddlewareTestSuite) TestDuplicateMessageIsRejected() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	gateway := suite.ChainB.SenderAccount.GetAddress().String()
+
+	msg := types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte(`{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`),
+		Type:          types.TypeGeneralMessageWithToken,
+		CommandID:     "0x0f1c",
+	}
+	_, ack := suite.relayMessage(1000, msg)
+	suite.Require().Contains(ack, `"result"`)
+
+	// the same command in another packet is a replay
+	_, ack = suite.relayMessage(1000, msg)
+	suite.Require().Contains(ack, `"error"`)
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(suite.ChainA.GetContext(), receiver, suite.voucherDenom()).Amount)
+
+	// command ids are only unique per source chain
+	otherChain := msg
+	otherChain.SourceChain = "avalanche"
+	_, ack = suite.relayMessage(1000, otherChain)
+	suite.Require().Contains(ack, `"result"`)
+
+	// a message without command id is only protected by the packet receipts
+	_, ack = suite.sendGeneralMessage(1000, `{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`)
+	suite.Require().Contains(ack, `"result"`)
+
+	ctx := suite.ChainA.GetContext()
+	res, err := appA.GMPKeeper.GMPMessage(ctx, &types.QueryGMPMessageRequest{
+		ChannelId:   channelID,
+		Gateway:     gateway,
+		SourceChain: sourceChain,
+		CommandId:   msg.CommandID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MessageStatus_MESSAGE_STATUS_ACTION_FAILED, res.Message.Status)
+	suite.Require().Equal(types.PayloadHash(msg.Payload), res.Message.PayloadHash)
+	suite.Require().Equal(uint64(1), res.Message.Sequence)
+	suite.Require().Equal(gateway, res.Message.Gateway)
+
+	_, err = appA.GMPKeeper.GMPMessage(ctx, &types.QueryGMPMessageRequest{
+		ChannelId:   channelID,
+		Gateway:     gateway,
+		SourceChain: sourceChain,
+		CommandId:   "0x0f1d",
+	})
+	suite.Require().Error(err)
+
+	// the replay is recorded as failed
+	replay, found := appA.GMPKeeper.GetMessageRecord(ctx, channelID, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.MessageStatus_MESSAGE_STATUS_FAILED, replay.Status)
+	suite.Require().Equal(msg.CommandID, replay.CommandId)
+	suite.Require().NotEmpty(replay.Error)
+
+	bySender, err := appA.GMPKeeper.GMPMessagesBySender(ctx, &types.QueryGMPMessagesBySenderRequest{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(bySender.Messages, 3)
+	suite.Require().Equal([]uint64{1, 2, 4}, []uint64{bySender.Messages[0].Sequence, bySender.Messages[1].Sequence, bySender.Messages[2].Sequence})
+	suite.Require().Empty(bySender.Messages[2].CommandId)
+
+	bySender, err = appA.GMPKeeper.GMPMessagesBySender(ctx, &types.QueryGMPMessagesBySenderRequest{
+		SourceChain:   sourceChain,
+		SourceAddress: "0x0000000000000000000000000000000000000000",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(bySender.Messages)
+}
+
+func (suite *MiddlewareTestSuite) TestFailedMessageIsRecorded() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	gateway := suite.ChainB.SenderAccount.GetAddress().String()
+
+	msg := types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte(`{"dex": {}}`),
+		Type:          types.TypeGeneralMessageWithToken,
+		CommandID:     "0x0f1c",
+	}
+	_, ack := suite.relayMessage(1000, msg)
+	suite.Require().Contains(ack, `"error"`)
+
+	ctx := suite.ChainA.GetContext()
+	record, found := appA.GMPKeeper.GetMessageRecord(ctx, channelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.MessageStatus_MESSAGE_STATUS_FAILED, record.Status)
+	suite.Require().Equal(gateway, record.Gateway)
+	suite.Require().Contains(ack, record.Error)
+
+	// a failed command can be delivered again
+	suite.Require().False(appA.GMPKeeper.HasMessageCommand(ctx, channelID, gateway, sourceChain, msg.CommandID))
+	msg.Payload = []byte(`{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`)
+	_, ack = suite.relayMessage(1000, msg)
+	suite.Require().Contains(ack, `"result"`)
+	suite.Require().True(appA.GMPKeeper.HasMessageCommand(suite.ChainA.GetContext(), channelID, gateway, sourceChain, msg.CommandID))
+}
+
+func (suite *MiddlewareTestSuite) TestFailedMessageOfRevertedReceptionIsDropped() {
+	suite.openGatewayChannel()
+	k := suite.GetNeutronZoneApp(suite.ChainA).GMPKeeper
+	ctx := suite.ChainA.GetContext().WithExecMode(sdk.ExecModeFinalize)
+
+	// the packet was never received, e.g. the transaction receiving it failed
+	k.RecordFailedMessage(ctx, transfertypes.PortID, types.MessageRecord{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		ChannelId:     suite.TransferPath.EndpointA.ChannelID,
+		Sequence:      1,
+		Status:        types.MessageStatus_MESSAGE_STATUS_FAILED,
+		Height:        ctx.BlockHeight(),
+		Gateway:       suite.ChainB.SenderAccount.GetAddress().String(),
+	})
+	k.StoreFailedMessages(ctx)
+
+	_, found := k.GetMessageRecord(ctx, suite.TransferPath.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+}
+
+func (suite *MiddlewareTestSuite) TestPruneMessageRecords() {
+	k := suite.GetNeutronZoneApp(suite.ChainA).GMPKeeper
+	ctx := suite.ChainA.GetContext()
+
+	params := types.DefaultParams()
+	params.MessageRetention = 10
+	params.MessagePruneLimit = 2
+	suite.Require().NoError(k.SetParams(ctx, params))
+
+	record := types.MessageRecord{
+		CommandId:     "0x0f1c",
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		ChannelId:     "channel-0",
+		Status:        types.MessageStatus_MESSAGE_STATUS_EXECUTED,
+		Height:        ctx.BlockHeight(),
+		Gateway:       "gateway",
+	}
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		record.Sequence = sequence
+		record.CommandId = fmt.Sprintf("0x0f1%d", sequence)
+		k.SetMessageRecord(ctx, record)
+	}
+
+	k.PruneMessageRecords(ctx.WithBlockHeight(record.Height + 9))
+	suite.Require().Len(k.GetAllMessageRecords(ctx), 3)
+
+	// at most the prune limit is pruned per block
+	k.PruneMessageRecords(ctx.WithBlockHeight(record.Height + 10))
+	suite.Require().Len(k.GetAllMessageRecords(ctx), 1)
+	suite.Require().False(k.HasMessageCommand(ctx, "channel-0", "gateway", sourceChain, "0x0f11"))
+	suite.Require().True(k.HasMessageCommand(ctx, "channel-0", "gateway", sourceChain, "0x0f13"))
+
+	k.PruneMessageRecords(ctx.WithBlockHeight(record.Height + 11))
+	suite.Require().Empty(k.GetAllMessageRecords(ctx))
+	suite.Require().False(k.HasMessageCommand(ctx, "channel-0", "gateway", sourceChain, "0x0f13"))
+	bySender, err := k.GMPMessagesBySender(ctx, &types.QueryGMPMessagesBySenderRequest{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(bySender.Messages)
+}
+
+func (suite *MiddlewareTestSuite) TestSendToken() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	_, ack := suite.relayMessage(1000, types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Type:          types.TypeSendToken,
+	})
+	suite.Require().Contains(ack, `"result"`)
+
+	ctx := suite.ChainA.GetContext()
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctx, receiver, suite.voucherDenom()).Amount)
+	record, found := appA.GMPKeeper.GetMessageRecord(ctx, suite.TransferPath.EndpointA.ChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.MessageStatus_MESSAGE_STATUS_EXECUTED, record.Status)
+
+	// send token messages carry no payload
+	_, ack = suite.relayMessage(1000, types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte(`{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`),
+		Type:          types.TypeSendToken,
+	})
+	suite.Require().Contains(ack, `"error"`)
+}
+
+// contractKeeper records the contract call of a pure general message
+type contractKeeper struct {
+	contract sdk.AccAddress
+	caller   sdk.AccAddress
+	msg      []byte
+	funds    sdk.Coins
+}
+
+func (c *contractKeeper) Execute(_ sdk.Context, contract, caller sdk.AccAddress, msg []byte, funds sdk.Coins) ([]byte, error) {
+	c.contract, c.caller, c.msg, c.funds = contract, caller, msg, funds
+	return nil, nil
+}
+
+func (suite *MiddlewareTestSuite) TestPureMessage() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	contracts := &contractKeeper{}
+	k := keeper.NewKeeper(
+		appA.AppCodec(),
+		appA.GetKey(types.StoreKey),
+		appA.TransferKeeper,
+		appA.IBCKeeper.ChannelKeeper,
+		&appA.DexKeeper,
+		appA.BankKeeper,
+		contracts,
+		appA.GMPKeeper.GetAuthority(),
+	)
+	middleware := gmp.NewIBCMiddleware(appA.TransferStack, k)
+
+	contract := sdk.AccAddress("gmp_contract________")
+	memo, err := json.Marshal(types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       append([]byte{0, 0, 0, 1}, []byte(`{"echo": {}}`)...),
+		Type:          types.TypeGeneralMessage,
+	})
+	suite.Require().NoError(err)
+	sender := suite.ChainB.SenderAccount.GetAddress().String()
+	data := transfertypes.NewFungibleTokenPacketData(params.DefaultDenom, "10", sender, contract.String(), string(memo))
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(
+			data.GetBytes(),
+			sequence,
+			suite.TransferPath.EndpointB.ChannelConfig.PortID,
+			suite.TransferPath.EndpointB.ChannelID,
+			suite.TransferPath.EndpointA.ChannelConfig.PortID,
+			suite.TransferPath.EndpointA.ChannelID,
+			clienttypes.NewHeight(10, 100),
+			0,
+		)
+	}
+
+	ctx := suite.ChainA.GetContext()
+	feeSink := appA.AccountKeeper.GetModuleAddress(types.FeeSinkName)
+	feesBefore := appA.BankKeeper.GetBalance(ctx, feeSink, suite.voucherDenom()).Amount
+
+	ack := middleware.OnRecvPacket(ctx, packet(1), suite.ChainA.SenderAccount.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// the contract is called without funds, with the message of the payload as is
+	caller, err := utils.DeriveIntermediateSender(suite.TransferPath.EndpointA.ChannelID, sender, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	suite.Require().NoError(err)
+	suite.Require().Equal(contract, contracts.contract)
+	suite.Require().Equal(caller, contracts.caller.String())
+	suite.Require().True(contracts.funds.IsZero())
+	suite.Require().JSONEq(`{"echo": {}}`, string(contracts.msg))
+
+	// the tokens are sent to the fee sink
+	suite.Require().Equal(feesBefore.AddRaw(10), appA.BankKeeper.GetBalance(ctx, feeSink, suite.voucherDenom()).Amount)
+	suite.Require().True(appA.BankKeeper.GetAllBalances(ctx, contract).IsZero())
+	suite.Require().True(appA.BankKeeper.GetAllBalances(ctx, contracts.caller).IsZero())
+	_, found := k.GetMessageRecord(ctx, suite.TransferPath.EndpointA.ChannelID, 1)
+	suite.Require().True(found)
+
+	// gateways wrapping contract messages pass the source of the message to the contract
+	err = k.SetParams(ctx, types.NewParams([]types.Gateway{{
+		ChannelId:       suite.TransferPath.EndpointA.ChannelID,
+		Sender:          sender,
+		WrapContractMsg: true,
+	}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit))
+	suite.Require().NoError(err)
+
+	ack = middleware.OnRecvPacket(ctx, packet(2), suite.ChainA.SenderAccount.GetAddress())
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+	suite.Require().JSONEq(`{"gmp_message": {"source_chain": "ethereum", "source_address": "`+sourceAddress+`", "msg": "eyJlY2hvIjp7fX0="}}`, string(contracts.msg))
+}
+
+func (suite *MiddlewareTestSuite) TestPureMessageMustCallContract() {
+	suite.openGatewayChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	// dex actions sell the tokens of the message, pure messages carry none
+	_, ack := suite.relayMessage(1000, types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       []byte(`{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`),
+		Type:          types.TypeGeneralMessage,
+	})
+	suite.Require().Contains(ack, `"error"`)
+
+	// the receiver is not a contract
+	_, ack = suite.relayMessage(1000, types.Message{
+		SourceChain:   sourceChain,
+		SourceAddress: sourceAddress,
+		Payload:       append([]byte{0, 0, 0, 1}, []byte(`{"echo": {}}`)...),
+		Type:          types.TypeGeneralMessage,
+	})
+	suite.Require().Contains(ack, `"error"`)
+	suite.Require().True(appA.BankKeeper.GetAllBalances(suite.ChainA.GetContext(), receiver).IsZero())
+}
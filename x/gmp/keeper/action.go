@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+// HandleDexAction executes the native dex action of a general message for the receiver
+// of its tokens, already credited with tokenIn, and reports whether it succeeded. If
+// the action fails, its changes are discarded and the receiver keeps tokenIn.
+func (k Keeper) HandleDexAction(ctx sdk.Context, channelID string, sequence uint64, receiver sdk.AccAddress, tokenIn sdk.Coin, action types.DexAction) bool {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ExecuteDexAction(cacheCtx, receiver, tokenIn, action); err != nil {
+		k.Logger(ctx).Info("failed to execute dex action", "channel", channelID, "sequence", sequence, "error", err)
+		k.EmitEvent(ctx, &types.EventDexActionFailed{
+			ChannelId: channelID,
+			Sequence:  sequence,
+			Receiver:  receiver.String(),
+			Action:    action.Name(),
+			TokenIn:   tokenIn,
+			Error:     err.Error(),
+		})
+		return false
+	}
+	writeCache()
+
+	k.EmitEvent(ctx, &types.EventDexActionExecuted{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		Receiver:  receiver.String(),
+		Action:    action.Name(),
+		TokenIn:   tokenIn,
+	})
+	return true
+}
+
+// ExecuteDexAction executes the dex action selling tokenIn for the receiver.
+func (k Keeper) ExecuteDexAction(ctx sdk.Context, receiver sdk.AccAddress, tokenIn sdk.Coin, action types.DexAction) error {
+	if k.dexKeeper.GetParams(ctx).Paused {
+		return dextypes.ErrDexPaused
+	}
+
+	switch {
+	case action.Swap != nil:
+		msg := action.Swap.MsgMultiHopSwap(receiver, tokenIn)
+		if err := msg.Validate(); err != nil {
+			return errors.Wrap(err, "failed to validate MsgMultiHopSwap")
+		}
+		coinOut, _, _, err := k.dexKeeper.MultiHopSwapCore(ctx, msg.AmountIn, msg.Routes, msg.ExitLimitPrice, msg.PickBestRoute, receiver, receiver)
+		if err != nil {
+			return err
+		}
+		if coinOut.Amount.LT(action.Swap.MinOut) {
+			return errors.Wrapf(types.ErrInvalidDexAction, "swap returned %s, less than min out %s", coinOut, action.Swap.MinOut)
+		}
+		return nil
+	case action.PlaceLimitOrder != nil:
+		msg, err := action.PlaceLimitOrder.MsgPlaceLimitOrder(receiver, tokenIn)
+		if err != nil {
+			return err
+		}
+		if err := msg.Validate(); err != nil {
+			return errors.Wrap(err, "failed to validate MsgPlaceLimitOrder")
+		}
+		if err := msg.ValidateGoodTilExpiration(ctx.BlockTime()); err != nil {
+			return err
+		}
+		_, _, _, _, _, err = k.dexKeeper.PlaceLimitOrderCore(
+			ctx,
+			msg.TokenIn,
+			msg.TokenOut,
+			msg.AmountIn,
+			msg.TickIndexInToOut,
+			msg.OrderType,
+			msg.ExpirationTime,
+			msg.MaxAmountOut,
+			msg.MinAverageSellPrice,
+			msg.PostOnlySlide,
+			receiver,
+			receiver,
+		)
+		return err
+	case action.Deposit != nil:
+		msg := action.Deposit.MsgDeposit(receiver, tokenIn)
+		if err := msg.Validate(); err != nil {
+			return errors.Wrap(err, "failed to validate MsgDeposit")
+		}
+		pairID, err := dextypes.NewPairID(msg.TokenA, msg.TokenB)
+		if err != nil {
+			return err
+		}
+		amounts0, amounts1 := dexkeeper.SortAmounts(msg.TokenA, pairID.Token0, msg.AmountsA, msg.AmountsB)
+		tickIndexes := dexkeeper.NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, msg.TickIndexesAToB)
+		_, _, _, failedDeposits, err := k.dexKeeper.DepositCore(ctx, pairID, receiver, receiver, amounts0, amounts1, tickIndexes, msg.Fees, msg.Options)
+		if err != nil {
+			return err
+		}
+		if len(failedDeposits) > 0 {
+			return errors.Wrapf(types.ErrInvalidDexAction, "deposit failed: %s", failedDeposits[0].Error)
+		}
+		return nil
+	default:
+		return action.ValidateBasic()
+	}
+}
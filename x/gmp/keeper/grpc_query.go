@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) GMPMessage(c context.Context, req *types.QueryGMPMessageRequest) (*types.QueryGMPMessageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Gateway) > types.MaxMessageFieldLength || len(req.SourceChain) > types.MaxMessageFieldLength {
+		return nil, status.Error(codes.InvalidArgument, "gateway or source chain too long")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetMessageRecordByCommand(ctx, req.ChannelId, req.Gateway, req.SourceChain, req.CommandId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "message %s not found", req.CommandId)
+	}
+
+	return &types.QueryGMPMessageResponse{Message: record}, nil
+}
+
+func (k Keeper) GMPMessagesBySender(c context.Context, req *types.QueryGMPMessagesBySenderRequest) (*types.QueryGMPMessagesBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.SourceChain) > types.MaxMessageFieldLength || len(req.SourceAddress) > types.MaxMessageFieldLength {
+		return nil, status.Error(codes.InvalidArgument, "source chain or source address too long")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.MessageBySenderKeyPrefix),
+		types.GetMessageSenderPrefix(req.SourceChain, req.SourceAddress),
+	)
+	records := make([]types.MessageRecord, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		record, found := k.getMessageRecord(ctx, key)
+		if !found {
+			return fmt.Errorf("message %X is indexed but not found", key)
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGMPMessagesBySenderResponse{Messages: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+type Keeper struct {
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	dexKeeper      types.DexKeeper
+	bankKeeper     types.BankKeeper
+	contractKeeper types.ContractKeeper
+	authority      string
+
+	// failedMessages are the general messages acknowledged with an error in the current
+	// block, shared by the copies of the keeper
+	failedMessages *[]failedMessage
+}
+
+// failedMessage is a general message acknowledged with an error, received on a port.
+type failedMessage struct {
+	portID string
+	record types.MessageRecord
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	dexKeeper types.DexKeeper,
+	bankKeeper types.BankKeeper,
+	contractKeeper types.ContractKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		dexKeeper:      dexKeeper,
+		bankKeeper:     bankKeeper,
+		contractKeeper: contractKeeper,
+		authority:      authority,
+		failedMessages: &[]failedMessage{},
+	}
+}
+
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// FindGateway returns the gateway of sender, if it is allowed to relay general messages
+// over the channel.
+func (k Keeper) FindGateway(ctx sdk.Context, channelID, sender string) (types.Gateway, bool) {
+	return k.GetParams(ctx).FindGateway(channelID, sender)
+}
+
+// GetGateway returns the gateway general messages are sent to over the channel, the
+// first one configured for it.
+func (k Keeper) GetGateway(ctx sdk.Context, channelID string) (types.Gateway, bool) {
+	for _, gateway := range k.GetParams(ctx).Gateways {
+		if gateway.ChannelId == channelID {
+			return gateway, true
+		}
+	}
+	return types.Gateway{}, false
+}
+
+// EmitEvent emits a typed event, logging failures instead of aborting the caller.
+func (k Keeper) EmitEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "event", proto.MessageName(event), "error", err)
+	}
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func (k Keeper) messageStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.MessageKeyPrefix)
+}
+
+// SetMessageRecord stores the record of a received general message and indexes it by
+// source address and height, and by command id if it is replay protected.
+func (k Keeper) SetMessageRecord(ctx sdk.Context, record types.MessageRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := record.Key()
+	k.messageStore(ctx).Set(key, k.cdc.MustMarshal(&record))
+	prefix.NewStore(store, types.MessageBySenderKeyPrefix).Set(types.GetMessageBySenderKey(record.SourceChain, record.SourceAddress, key), []byte{})
+	prefix.NewStore(store, types.MessageByHeightKeyPrefix).Set(types.GetMessageByHeightKey(record.Height, key), []byte{})
+	if record.IsReplayProtected() {
+		prefix.NewStore(store, types.MessageByCommandKeyPrefix).Set(
+			types.GetMessageByCommandKey(record.ChannelId, record.Gateway, record.SourceChain, record.CommandId),
+			key,
+		)
+	}
+}
+
+// GetMessageRecord returns the record of the general message carried by a packet.
+func (k Keeper) GetMessageRecord(ctx sdk.Context, channelID string, sequence uint64) (types.MessageRecord, bool) {
+	return k.getMessageRecord(ctx, types.GetMessageKey(channelID, sequence))
+}
+
+func (k Keeper) getMessageRecord(ctx sdk.Context, key []byte) (types.MessageRecord, bool) {
+	bz := k.messageStore(ctx).Get(key)
+	if bz == nil {
+		return types.MessageRecord{}, false
+	}
+
+	var record types.MessageRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetMessageRecordByCommand returns the record of the delivered general message with the
+// command id, relayed by the gateway on the channel from the source chain.
+func (k Keeper) GetMessageRecordByCommand(ctx sdk.Context, channelID, gateway, sourceChain, commandID string) (types.MessageRecord, bool) {
+	key := prefix.NewStore(ctx.KVStore(k.storeKey), types.MessageByCommandKeyPrefix).Get(
+		types.GetMessageByCommandKey(channelID, gateway, sourceChain, commandID),
+	)
+	if key == nil {
+		return types.MessageRecord{}, false
+	}
+	return k.getMessageRecord(ctx, key)
+}
+
+// HasMessageCommand reports whether a general message with the command id was delivered
+// by the gateway on the channel from the source chain and not pruned yet.
+func (k Keeper) HasMessageCommand(ctx sdk.Context, channelID, gateway, sourceChain, commandID string) bool {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.MessageByCommandKeyPrefix).Has(
+		types.GetMessageByCommandKey(channelID, gateway, sourceChain, commandID),
+	)
+}
+
+func (k Keeper) RemoveMessageRecord(ctx sdk.Context, record types.MessageRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := record.Key()
+	k.messageStore(ctx).Delete(key)
+	prefix.NewStore(store, types.MessageBySenderKeyPrefix).Delete(types.GetMessageBySenderKey(record.SourceChain, record.SourceAddress, key))
+	prefix.NewStore(store, types.MessageByHeightKeyPrefix).Delete(types.GetMessageByHeightKey(record.Height, key))
+	if record.IsReplayProtected() {
+		prefix.NewStore(store, types.MessageByCommandKeyPrefix).Delete(
+			types.GetMessageByCommandKey(record.ChannelId, record.Gateway, record.SourceChain, record.CommandId),
+		)
+	}
+}
+
+// GetAllMessageRecords returns the records of all received general messages.
+func (k Keeper) GetAllMessageRecords(ctx sdk.Context) []types.MessageRecord {
+	iterator := k.messageStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	records := make([]types.MessageRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MessageRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// PruneMessageRecords removes the records of the general messages received more than
+// the message retention ago, at most the message prune limit per block. Their command
+// ids are accepted again afterwards.
+func (k Keeper) PruneMessageRecords(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.MessageRetention == 0 || ctx.BlockHeight() <= int64(params.MessageRetention) {
+		return
+	}
+
+	// the height index is ordered by height, so every key below the cutoff is expired
+	end := types.GetMessageHeightPrefix(ctx.BlockHeight() - int64(params.MessageRetention) + 1)
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.MessageByHeightKeyPrefix).Iterator(nil, end)
+	expired := make([][]byte, 0)
+	for ; iterator.Valid() && uint64(len(expired)) < params.MessagePruneLimit; iterator.Next() {
+		expired = append(expired, iterator.Key()[8:])
+	}
+	iterator.Close()
+
+	for _, key := range expired {
+		if record, found := k.getMessageRecord(ctx, key); found {
+			k.RemoveMessageRecord(ctx, record)
+		}
+	}
+}
+
+// RecordFailedMessage records a general message acknowledged with an error. The state
+// changes of an error acknowledgement are reverted by IBC core, so the record is kept
+// in memory until the end of the block and only stored if the packet was received, see
+// StoreFailedMessages. Only messages of finalized blocks are recorded.
+func (k Keeper) RecordFailedMessage(ctx sdk.Context, portID string, record types.MessageRecord) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	*k.failedMessages = append(*k.failedMessages, failedMessage{portID: portID, record: record})
+}
+
+// StoreFailedMessages stores the records of the general messages acknowledged with an
+// error in the block. The receipt of their packet is only written if the transaction
+// receiving it succeeded, so the records of reverted receptions are dropped.
+func (k Keeper) StoreFailedMessages(ctx sdk.Context) {
+	for _, failed := range *k.failedMessages {
+		record := failed.record
+		if _, received := k.channelKeeper.GetPacketReceipt(ctx, failed.portID, record.ChannelId, record.Sequence); !received {
+			continue
+		}
+		// a reception of the packet reverted earlier in the block may have failed differently
+		if _, found := k.GetMessageRecord(ctx, record.ChannelId, record.Sequence); found {
+			continue
+		}
+		k.SetMessageRecord(ctx, record)
+	}
+	k.ResetFailedMessages()
+}
+
+// ResetFailedMessages drops the failed general messages recorded in memory, e.g. by an
+// aborted optimistic execution of the block.
+func (k Keeper) ResetFailedMessages() {
+	*k.failedMessages = (*k.failedMessages)[:0]
+}
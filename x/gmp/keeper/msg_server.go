@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k Keeper) checkAuthority(authority string) error {
+	if k.authority != authority {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// UpdateParams updates the module parameters
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SendGeneralMessage sends a general message through the gateway of a channel
+func (k msgServer) SendGeneralMessage(goCtx context.Context, req *types.MsgSendGeneralMessage) (*types.MsgSendGeneralMessageResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSendGeneralMessage")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp, err := k.Keeper.SendGeneralMessage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendGeneralMessageResponse{
+		SequenceId: resp.SequenceId,
+		Channel:    resp.Channel,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+// ExecutePureMessage sends the tokens a pure general message credited to the caller to
+// the fee sink, then calls the contract of the message without funds: the tokens only
+// pay for the relay and don't belong to the sender of the message.
+func (k Keeper) ExecutePureMessage(ctx sdk.Context, caller sdk.AccAddress, dust sdk.Coin, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, caller, types.FeeSinkName, sdk.NewCoins(dust)); err != nil {
+		return nil, errors.Wrap(err, "failed to send tokens to the fee sink")
+	}
+	return k.contractKeeper.Execute(ctx, contract, caller, msg, sdk.NewCoins())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+	transfertypes "github.com/neutron-org/neutron/v5/x/transfer/types"
+)
+
+// DefaultPacketTimeout is the timeout of general messages sent without one.
+const DefaultPacketTimeout = 10 * time.Minute
+
+// SendGeneralMessage transfers the token of the message to the gateway of its channel,
+// with the general message as the memo of the ICS20 packet.
+func (k Keeper) SendGeneralMessage(ctx sdk.Context, msg *types.MsgSendGeneralMessage) (*transfertypes.MsgTransferResponse, error) {
+	gateway, found := k.GetGateway(ctx, msg.ChannelId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrUnknownGateway, "no gateway on %s", msg.ChannelId)
+	}
+
+	memo, err := types.NewOutboundMessage(msg).Memo()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal general message")
+	}
+
+	timeoutTimestamp := msg.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(DefaultPacketTimeout).UnixNano())
+	}
+
+	// the gateway sender on the counterparty is the account general messages are sent to
+	resp, err := k.transferKeeper.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       ibctransfertypes.PortID,
+		SourceChannel:    msg.ChannelId,
+		Token:            msg.Token,
+		Sender:           msg.Sender,
+		Receiver:         gateway.Sender,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+		Fee:              msg.IbcFee,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to transfer general message")
+	}
+
+	fee := sdkmath.ZeroInt()
+	if msg.Fee != nil {
+		fee = msg.Fee.Amount
+	}
+	k.EmitEvent(ctx, &types.EventGeneralMessageSent{
+		Sender:             msg.Sender,
+		ChannelId:          msg.ChannelId,
+		Sequence:           resp.SequenceId,
+		Gateway:            gateway.Sender,
+		DestinationChain:   msg.DestinationChain,
+		DestinationAddress: msg.DestinationAddress,
+		MessageType:        msg.MessageType,
+		Token:              msg.Token,
+		Fee:                fee,
+		PayloadHash:        types.PayloadHash(msg.Payload),
+	})
+	return resp, nil
+}
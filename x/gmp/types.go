@@ -1,19 +0,0 @@
-package gmp
-
-// Message is attached in ICS20 packet memo field
-type Message struct {
-	SourceChain   string `json:"source_chain"`
-	SourceAddress string `json:"source_address"`
-	Payload       []byte `json:"payload"`
-	Type          int64  `json:"type"`
-}
-
-type MessageType int
-
-const (
-	// TypeUnrecognized means coin type is unrecognized
-	TypeUnrecognized = iota
-	TypeGeneralMessage
-	// TypeGeneralMessageWithToken is a general message with token
-	TypeGeneralMessageWithToken
-)
@@ -0,0 +1,276 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// abiWordSize is the size of a slot of the ABI encoding.
+const abiWordSize = 32
+
+type abiKind int
+
+const (
+	abiUint abiKind = iota
+	abiInt
+	abiAddress
+	abiBool
+	abiFixedBytes
+	abiBytes
+	abiString
+	abiSlice
+)
+
+// abiType is a parsed solidity type. Only the types common in general messages are
+// supported: integers, address, bool, fixed and dynamic bytes, string and dynamic
+// arrays of those. Nested arrays, fixed arrays and tuples are not.
+type abiType struct {
+	kind abiKind
+	// size is the bit size of integers and the byte size of fixed bytes.
+	size int
+	elem *abiType
+}
+
+func parseABIType(s string) (abiType, error) {
+	if strings.HasSuffix(s, "[]") {
+		elem, err := parseABIType(strings.TrimSuffix(s, "[]"))
+		if err != nil {
+			return abiType{}, err
+		}
+		if elem.kind == abiSlice {
+			return abiType{}, errors.Wrapf(ErrInvalidPayload, "nested arrays are not supported: %q", s)
+		}
+		return abiType{kind: abiSlice, elem: &elem}, nil
+	}
+
+	switch s {
+	case "address":
+		return abiType{kind: abiAddress}, nil
+	case "bool":
+		return abiType{kind: abiBool}, nil
+	case "bytes":
+		return abiType{kind: abiBytes}, nil
+	case "string":
+		return abiType{kind: abiString}, nil
+	case "uint":
+		return abiType{kind: abiUint, size: 256}, nil
+	case "int":
+		return abiType{kind: abiInt, size: 256}, nil
+	}
+
+	for _, sized := range []struct {
+		prefix string
+		kind   abiKind
+	}{{"uint", abiUint}, {"int", abiInt}, {"bytes", abiFixedBytes}} {
+		if !strings.HasPrefix(s, sized.prefix) {
+			continue
+		}
+		size, err := strconv.Atoi(strings.TrimPrefix(s, sized.prefix))
+		if err != nil {
+			break
+		}
+		if sized.kind == abiFixedBytes && size >= 1 && size <= abiWordSize {
+			return abiType{kind: sized.kind, size: size}, nil
+		}
+		if sized.kind != abiFixedBytes && size >= 8 && size <= 256 && size%8 == 0 {
+			return abiType{kind: sized.kind, size: size}, nil
+		}
+		break
+	}
+	return abiType{}, errors.Wrapf(ErrInvalidPayload, "unsupported abi type %q", s)
+}
+
+func (t abiType) isDynamic() bool {
+	return t.kind == abiBytes || t.kind == abiString || t.kind == abiSlice
+}
+
+// decodeABI decodes the values of a tuple of types, as encoded by abi.encode. Values
+// are decoded to their JSON representation for CosmWasm contracts: integers wider than
+// 64 bits and addresses are strings, bytes are base64 encoded.
+func decodeABI(types []abiType, data []byte) ([]interface{}, error) {
+	d := abiDecoder{budget: len(data)}
+	values, _, err := d.decodeTuple(types, data)
+	return values, err
+}
+
+// abiDecoder decodes ABI encoded data within a budget of decoded bytes, the size of the
+// data: the heads, lengths and contents of an encoding without aliased offsets always
+// fit in it. Along with the tails of a tuple being required not to overlap, this keeps
+// the work of decoding linear in the size of the data.
+type abiDecoder struct {
+	budget int
+}
+
+func (d *abiDecoder) consume(n int) error {
+	if n > d.budget {
+		return errors.Wrap(ErrInvalidPayload, "abi data decodes to more than its size")
+	}
+	d.budget -= n
+	return nil
+}
+
+// decodeTuple decodes the values of a tuple of types, returning the end of the data
+// they are encoded in. The tails of dynamic values must follow the heads of the tuple
+// and each other without overlapping.
+func (d *abiDecoder) decodeTuple(types []abiType, data []byte) ([]interface{}, int, error) {
+	values := make([]interface{}, 0, len(types))
+	end := len(types) * abiWordSize
+	for i, t := range types {
+		if err := d.consume(abiWordSize); err != nil {
+			return nil, 0, err
+		}
+		head, err := abiWord(data, i*abiWordSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !t.isDynamic() {
+			value, err := decodeABIStatic(t, head)
+			if err != nil {
+				return nil, 0, err
+			}
+			values = append(values, value)
+			continue
+		}
+
+		offset, err := abiOffset(head, len(data))
+		if err != nil {
+			return nil, 0, err
+		}
+		if offset < end {
+			return nil, 0, errors.Wrap(ErrInvalidPayload, "offset overlaps the heads or a previous value")
+		}
+		value, size, err := d.decodeDynamic(t, data[offset:])
+		if err != nil {
+			return nil, 0, err
+		}
+		values = append(values, value)
+		end = offset + size
+	}
+	return values, end, nil
+}
+
+func decodeABIStatic(t abiType, word []byte) (interface{}, error) {
+	switch t.kind {
+	case abiUint:
+		value := new(big.Int).SetBytes(word)
+		if value.BitLen() > t.size {
+			return nil, errors.Wrapf(ErrInvalidPayload, "value overflows uint%d", t.size)
+		}
+		return abiInteger(value, t.size), nil
+	case abiInt:
+		value := new(big.Int).SetBytes(word)
+		if word[0]&0x80 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), abiWordSize*8))
+		}
+		// the minimal value of intN has N-1 significant bits, as the maximal one
+		if (value.Sign() >= 0 && value.BitLen() >= t.size) ||
+			(value.Sign() < 0 && new(big.Int).Add(value, big.NewInt(1)).BitLen() >= t.size) {
+			return nil, errors.Wrapf(ErrInvalidPayload, "value overflows int%d", t.size)
+		}
+		return abiInteger(value, t.size), nil
+	case abiAddress:
+		if !isZero(word[:abiWordSize-20]) {
+			return nil, errors.Wrap(ErrInvalidPayload, "invalid address")
+		}
+		return "0x" + hex.EncodeToString(word[abiWordSize-20:]), nil
+	case abiBool:
+		if !isZero(word[:abiWordSize-1]) || word[abiWordSize-1] > 1 {
+			return nil, errors.Wrap(ErrInvalidPayload, "invalid bool")
+		}
+		return word[abiWordSize-1] == 1, nil
+	case abiFixedBytes:
+		if !isZero(word[t.size:]) {
+			return nil, errors.Wrapf(ErrInvalidPayload, "invalid bytes%d", t.size)
+		}
+		return word[:t.size], nil
+	default:
+		return nil, errors.Wrap(ErrInvalidPayload, "unexpected dynamic type")
+	}
+}
+
+// decodeDynamic decodes the dynamic value at the start of data, returning the size it
+// is encoded in.
+func (d *abiDecoder) decodeDynamic(t abiType, data []byte) (interface{}, int, error) {
+	if err := d.consume(abiWordSize); err != nil {
+		return nil, 0, err
+	}
+	word, err := abiWord(data, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	length, err := abiOffset(word, len(data))
+	if err != nil {
+		return nil, 0, err
+	}
+	data = data[abiWordSize:]
+
+	switch t.kind {
+	case abiBytes, abiString:
+		if length > len(data) {
+			return nil, 0, errors.Wrap(ErrInvalidPayload, "length out of bounds")
+		}
+		if err := d.consume(length); err != nil {
+			return nil, 0, err
+		}
+		if t.kind == abiString {
+			return string(data[:length]), abiWordSize + length, nil
+		}
+		return data[:length], abiWordSize + length, nil
+	default:
+		if length > len(data)/abiWordSize {
+			return nil, 0, errors.Wrap(ErrInvalidPayload, "length out of bounds")
+		}
+		elems := make([]abiType, length)
+		for i := range elems {
+			elems[i] = *t.elem
+		}
+		values, size, err := d.decodeTuple(elems, data)
+		if err != nil {
+			return nil, 0, err
+		}
+		return values, abiWordSize + size, nil
+	}
+}
+
+// abiInteger returns the JSON representation of an integer of the bit size.
+func abiInteger(value *big.Int, size int) interface{} {
+	if size <= 64 {
+		return json.Number(value.String())
+	}
+	return value.String()
+}
+
+// abiWord returns the slot of data at offset.
+func abiWord(data []byte, offset int) ([]byte, error) {
+	if offset+abiWordSize > len(data) {
+		return nil, errors.Wrap(ErrInvalidPayload, "unexpected end of abi data")
+	}
+	return data[offset : offset+abiWordSize], nil
+}
+
+// abiOffset decodes the offset or length in word, which must be at most limit.
+func abiOffset(word []byte, limit int) (int, error) {
+	if !isZero(word[:abiWordSize-8]) {
+		return 0, errors.Wrap(ErrInvalidPayload, "offset out of bounds")
+	}
+	offset := binary.BigEndian.Uint64(word[abiWordSize-8:])
+	if offset > uint64(limit) {
+		return 0, errors.Wrap(ErrInvalidPayload, "offset out of bounds")
+	}
+	return int(offset), nil
+}
+
+func isZero(bz []byte) bool {
+	for _, b := range bz {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
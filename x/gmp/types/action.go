@@ -0,0 +1,163 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Names of the native dex actions.
+const (
+	ActionSwap            = "swap"
+	ActionPlaceLimitOrder = "place_limit_order"
+	ActionDeposit         = "deposit"
+)
+
+// DexAction is a native dex action a general message executes for the receiver of its
+// tokens, without a contract. Exactly one of the actions must be set. It is the payload
+// of the message:
+//
+//	{"dex": {"swap": {"routes": [["untrn"]], "min_out": "100"}}}
+type DexAction struct {
+	Swap            *SwapAction            `json:"swap,omitempty"`
+	PlaceLimitOrder *PlaceLimitOrderAction `json:"place_limit_order,omitempty"`
+	Deposit         *DepositAction         `json:"deposit,omitempty"`
+}
+
+// SwapAction swaps the received token over the best of the routes.
+type SwapAction struct {
+	// Routes are the hops of the routes following the received token, e.g. [["uatom", "untrn"]].
+	Routes [][]string `json:"routes"`
+	// MinOut is the minimal amount the swap must return.
+	MinOut sdkmath.Int `json:"min_out"`
+}
+
+// PlaceLimitOrderAction places a limit order selling the received token.
+type PlaceLimitOrderAction struct {
+	TokenOut            string              `json:"token_out"`
+	TickIndexInToOut    int64               `json:"tick_index_in_to_out"`
+	OrderType           string              `json:"order_type"`
+	ExpirationTime      *time.Time          `json:"expiration_time,omitempty"`
+	MaxAmountOut        *sdkmath.Int        `json:"max_amount_out,omitempty"`
+	MinAverageSellPrice *math_utils.PrecDec `json:"min_average_sell_price,omitempty"`
+}
+
+// DepositAction deposits the received token as single sided liquidity.
+type DepositAction struct {
+	// TokenB is the other token of the pair.
+	TokenB        string                   `json:"token_b"`
+	TickIndexAToB int64                    `json:"tick_index_a_to_b"`
+	Fee           uint64                   `json:"fee"`
+	Options       *dextypes.DepositOptions `json:"options,omitempty"`
+}
+
+// ParseDexAction returns the native dex action of the memo, if it is one.
+func ParseDexAction(memo string) (DexAction, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return DexAction{}, false, nil
+	}
+	raw, ok := fields["dex"]
+	if !ok {
+		return DexAction{}, false, nil
+	}
+
+	var action DexAction
+	if err := json.Unmarshal(raw, &action); err != nil {
+		return DexAction{}, true, errors.Wrapf(ErrInvalidDexAction, "failed to parse dex action: %s", err)
+	}
+	if err := action.ValidateBasic(); err != nil {
+		return DexAction{}, true, err
+	}
+	return action, true, nil
+}
+
+// Name returns the name of the action set.
+func (a DexAction) Name() string {
+	switch {
+	case a.Swap != nil:
+		return ActionSwap
+	case a.PlaceLimitOrder != nil:
+		return ActionPlaceLimitOrder
+	case a.Deposit != nil:
+		return ActionDeposit
+	default:
+		return ""
+	}
+}
+
+// ValidateBasic checks exactly one action is set.
+func (a DexAction) ValidateBasic() error {
+	set := 0
+	for _, isSet := range []bool{a.Swap != nil, a.PlaceLimitOrder != nil, a.Deposit != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.Wrapf(ErrInvalidDexAction, "exactly one action must be set, got %d", set)
+	}
+	return nil
+}
+
+// MsgMultiHopSwap returns the swap of tokenIn by the receiver.
+func (a SwapAction) MsgMultiHopSwap(receiver sdk.AccAddress, tokenIn sdk.Coin) *dextypes.MsgMultiHopSwap {
+	routes := make([]*dextypes.MultiHopRoute, 0, len(a.Routes))
+	for _, hops := range a.Routes {
+		routes = append(routes, &dextypes.MultiHopRoute{Hops: append([]string{tokenIn.Denom}, hops...)})
+	}
+	return &dextypes.MsgMultiHopSwap{
+		Creator:  receiver.String(),
+		Receiver: receiver.String(),
+		Routes:   routes,
+		AmountIn: tokenIn.Amount,
+		// the min out is checked against the amount returned instead
+		ExitLimitPrice: math_utils.SmallestPrecDec(),
+		PickBestRoute:  true,
+	}
+}
+
+// MsgPlaceLimitOrder returns the limit order selling tokenIn by the receiver.
+func (a PlaceLimitOrderAction) MsgPlaceLimitOrder(receiver sdk.AccAddress, tokenIn sdk.Coin) (*dextypes.MsgPlaceLimitOrder, error) {
+	orderType, ok := dextypes.LimitOrderType_value[a.OrderType]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidDexAction, "unknown order type %q", a.OrderType)
+	}
+	return &dextypes.MsgPlaceLimitOrder{
+		Creator:             receiver.String(),
+		Receiver:            receiver.String(),
+		TokenIn:             tokenIn.Denom,
+		TokenOut:            a.TokenOut,
+		TickIndexInToOut:    a.TickIndexInToOut,
+		AmountIn:            tokenIn.Amount,
+		OrderType:           dextypes.LimitOrderType(orderType),
+		ExpirationTime:      a.ExpirationTime,
+		MaxAmountOut:        a.MaxAmountOut,
+		MinAverageSellPrice: a.MinAverageSellPrice,
+	}, nil
+}
+
+// MsgDeposit returns the single sided deposit of tokenIn by the receiver.
+func (a DepositAction) MsgDeposit(receiver sdk.AccAddress, tokenIn sdk.Coin) *dextypes.MsgDeposit {
+	options := a.Options
+	if options == nil {
+		options = &dextypes.DepositOptions{}
+	}
+	return &dextypes.MsgDeposit{
+		Creator:         receiver.String(),
+		Receiver:        receiver.String(),
+		TokenA:          tokenIn.Denom,
+		TokenB:          a.TokenB,
+		AmountsA:        []sdkmath.Int{tokenIn.Amount},
+		AmountsB:        []sdkmath.Int{sdkmath.ZeroInt()},
+		TickIndexesAToB: []int64{a.TickIndexAToB},
+		Fees:            []uint64{a.Fee},
+		Options:         []*dextypes.DepositOptions{options},
+	}
+}
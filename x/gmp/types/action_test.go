@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func TestParseDexAction(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		memo     string
+		isAction bool
+		name     string
+		valid    bool
+	}{
+		{desc: "wasm memo", memo: `{"wasm": {}}`},
+		{desc: "not json", memo: "memo"},
+		{desc: "swap", memo: `{"dex": {"swap": {"routes": [["untrn"]], "min_out": "1"}}}`, isAction: true, name: types.ActionSwap, valid: true},
+		{desc: "limit order", memo: `{"dex": {"place_limit_order": {"token_out": "untrn", "order_type": "FILL_OR_KILL"}}}`, isAction: true, name: types.ActionPlaceLimitOrder, valid: true},
+		{desc: "deposit", memo: `{"dex": {"deposit": {"token_b": "untrn", "fee": 1}}}`, isAction: true, name: types.ActionDeposit, valid: true},
+		{desc: "no action", memo: `{"dex": {}}`, isAction: true},
+		{desc: "two actions", memo: `{"dex": {"swap": {}, "deposit": {}}}`, isAction: true},
+		{desc: "malformed", memo: `{"dex": {"swap": {"min_out": 1}}}`, isAction: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			action, isAction, err := types.ParseDexAction(tc.memo)
+			require.Equal(t, tc.isAction, isAction)
+			if !tc.isAction {
+				require.NoError(t, err)
+				return
+			}
+			if !tc.valid {
+				require.ErrorIs(t, err, types.ErrInvalidDexAction)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.name, action.Name())
+		})
+	}
+}
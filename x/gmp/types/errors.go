@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/gmp module sentinel errors
+var (
+	ErrInvalidGateway        = errors.Register(ModuleName, 1100, "invalid gateway")
+	ErrUnknownGateway        = errors.Register(ModuleName, 1101, "sender is not a gateway on the channel")
+	ErrInvalidGeneralMessage = errors.Register(ModuleName, 1102, "invalid general message")
+	ErrInvalidPayload        = errors.Register(ModuleName, 1103, "invalid payload")
+	ErrUnknownPayloadVersion = errors.Register(ModuleName, 1104, "unknown payload version")
+	ErrInvalidDexAction      = errors.Register(ModuleName, 1105, "invalid dex action")
+	ErrDuplicateMessage      = errors.Register(ModuleName, 1106, "general message already received")
+)
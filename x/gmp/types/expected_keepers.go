@@ -0,0 +1,74 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+	transfertypes "github.com/neutron-org/neutron/v5/x/transfer/types"
+)
+
+// TransferKeeper defines the expected transfer keeper general messages are sent with.
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper the receptions of failed general
+// messages are confirmed with.
+type ChannelKeeper interface {
+	GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool)
+}
+
+// BankKeeper defines the expected bank keeper the tokens of pure general messages are
+// sent to the fee sink with.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// ContractKeeper defines the expected wasm keeper pure general messages call contracts with.
+type ContractKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
+
+// DexKeeper defines the expected dex keeper native dex actions are executed with.
+type DexKeeper interface {
+	GetParams(ctx sdk.Context) dextypes.Params
+	MultiHopSwapCore(
+		goCtx context.Context,
+		amountIn sdkmath.Int,
+		routes []*dextypes.MultiHopRoute,
+		exitLimitPrice math_utils.PrecDec,
+		pickBestRoute bool,
+		callerAddr sdk.AccAddress,
+		receiverAddr sdk.AccAddress,
+	) (coinOut sdk.Coin, route []string, dust sdk.Coins, err error)
+	PlaceLimitOrderCore(
+		goCtx context.Context,
+		tokenIn string,
+		tokenOut string,
+		amountIn sdkmath.Int,
+		tickIndexInToOut int64,
+		orderType dextypes.LimitOrderType,
+		goodTil *time.Time,
+		maxAmountOut *sdkmath.Int,
+		minAvgSellPriceP *math_utils.PrecDec,
+		postOnlySlide bool,
+		callerAddr sdk.AccAddress,
+		receiverAddr sdk.AccAddress,
+	) (trancheKey string, placedTickIndexInToOut int64, totalInCoin, swapInCoin, swapOutCoin sdk.Coin, err error)
+	DepositCore(
+		goCtx context.Context,
+		pairID *dextypes.PairID,
+		callerAddr sdk.AccAddress,
+		receiverAddr sdk.AccAddress,
+		amounts0 []sdkmath.Int,
+		amounts1 []sdkmath.Int,
+		tickIndices []int64,
+		fees []uint64,
+		options []*dextypes.DepositOptions,
+	) (amounts0Deposit, amounts1Deposit []sdkmath.Int, sharesIssued sdk.Coins, failedDeposits []*dextypes.FailedDeposit, err error)
+}
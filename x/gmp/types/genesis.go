@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:   DefaultParams(),
+		Messages: []MessageRecord{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	keys := make(map[string]struct{}, len(gs.Messages))
+	commands := make(map[string]struct{}, len(gs.Messages))
+	for _, record := range gs.Messages {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := string(record.Key())
+		if _, ok := keys[key]; ok {
+			return fmt.Errorf("duplicate message %s/%d", record.ChannelId, record.Sequence)
+		}
+		keys[key] = struct{}{}
+
+		if !record.IsReplayProtected() {
+			continue
+		}
+		command := string(GetMessageByCommandKey(record.ChannelId, record.Gateway, record.SourceChain, record.CommandId))
+		if _, ok := commands[command]; ok {
+			return fmt.Errorf("duplicate command %s of %s on %s", record.CommandId, record.Gateway, record.ChannelId)
+		}
+		commands[command] = struct{}{}
+	}
+	return nil
+}
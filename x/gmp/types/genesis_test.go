@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	record := types.MessageRecord{
+		CommandId:     "0x0f1c",
+		SourceChain:   "ethereum",
+		SourceAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		ChannelId:     "channel-0",
+		Sequence:      1,
+		Status:        types.MessageStatus_MESSAGE_STATUS_EXECUTED,
+		Height:        10,
+		Gateway:       "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5",
+	}
+	withoutCommand := record
+	withoutCommand.CommandId = ""
+	withoutCommand.Sequence = 2
+	replay := record
+	replay.Sequence = 3
+	failedReplay := replay
+	failedReplay.Status = types.MessageStatus_MESSAGE_STATUS_FAILED
+	otherChain := replay
+	otherChain.SourceChain = "avalanche"
+	otherChain.Sequence = 4
+
+	for _, tc := range []struct {
+		desc     string
+		messages []types.MessageRecord
+		valid    bool
+	}{
+		{
+			desc:  "no messages",
+			valid: true,
+		},
+		{
+			desc:     "valid messages",
+			messages: []types.MessageRecord{record, withoutCommand, failedReplay, otherChain},
+			valid:    true,
+		},
+		{
+			desc:     "duplicate command",
+			messages: []types.MessageRecord{record, replay},
+			valid:    false,
+		},
+		{
+			desc:     "duplicate message",
+			messages: []types.MessageRecord{record, record},
+			valid:    false,
+		},
+		{
+			desc:     "missing status",
+			messages: []types.MessageRecord{{ChannelId: record.ChannelId, Gateway: record.Gateway, SourceChain: record.SourceChain, SourceAddress: record.SourceAddress}},
+			valid:    false,
+		},
+		{
+			desc:     "empty source",
+			messages: []types.MessageRecord{{ChannelId: record.ChannelId, Gateway: record.Gateway, Status: record.Status}},
+			valid:    false,
+		},
+		{
+			desc:     "missing gateway",
+			messages: []types.MessageRecord{{ChannelId: record.ChannelId, SourceChain: record.SourceChain, SourceAddress: record.SourceAddress, Status: record.Status}},
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			genesis := types.DefaultGenesis()
+			genesis.Messages = tc.messages
+			err := genesis.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "gmp"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// FeeSinkName is the module account the tokens of pure general messages are sent to
+	FeeSinkName = authtypes.FeeCollectorName
+)
+
+const (
+	prefixParamsKey = iota + 1
+	prefixMessageKey
+	prefixMessageBySenderKey
+	prefixMessageByHeightKey
+	prefixMessageByCommandKey
+)
+
+var (
+	ParamsKey                 = []byte{prefixParamsKey}
+	MessageKeyPrefix          = []byte{prefixMessageKey}
+	MessageBySenderKeyPrefix  = []byte{prefixMessageBySenderKey}
+	MessageByHeightKeyPrefix  = []byte{prefixMessageByHeightKey}
+	MessageByCommandKeyPrefix = []byte{prefixMessageByCommandKey}
+)
+
+// GetMessageKey returns the store key of the record of the message carried by a packet
+// within MessageKeyPrefix
+func GetMessageKey(channelID string, sequence uint64) []byte {
+	return append(lengthPrefix([]byte(channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetMessageSenderPrefix returns the store prefix indexing the messages of a source
+// address within MessageBySenderKeyPrefix. Both fields are length prefixed, as source
+// chains and addresses may contain any character.
+func GetMessageSenderPrefix(sourceChain, sourceAddress string) []byte {
+	key := lengthPrefix([]byte(sourceChain))
+	return append(key, lengthPrefix([]byte(sourceAddress))...)
+}
+
+// GetMessageBySenderKey returns the index key of a message within MessageBySenderKeyPrefix
+func GetMessageBySenderKey(sourceChain, sourceAddress string, messageKey []byte) []byte {
+	return append(GetMessageSenderPrefix(sourceChain, sourceAddress), messageKey...)
+}
+
+// GetMessageHeightPrefix returns the store prefix indexing the messages received at a
+// height within MessageByHeightKeyPrefix
+func GetMessageHeightPrefix(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+// GetMessageByHeightKey returns the index key of a message within MessageByHeightKeyPrefix
+func GetMessageByHeightKey(height int64, messageKey []byte) []byte {
+	return append(GetMessageHeightPrefix(height), messageKey...)
+}
+
+// GetMessageByCommandKey returns the index key of a delivered message within
+// MessageByCommandKeyPrefix. Command ids are only unique per gateway and source chain,
+// so they are namespaced by both.
+func GetMessageByCommandKey(channelID, gateway, sourceChain, commandID string) []byte {
+	key := lengthPrefix([]byte(channelID))
+	key = append(key, lengthPrefix([]byte(gateway))...)
+	key = append(key, lengthPrefix([]byte(sourceChain))...)
+	return append(key, []byte(commandID)...)
+}
+
+func lengthPrefix(bz []byte) []byte {
+	return append([]byte{byte(len(bz))}, bz...)
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"golang.org/x/crypto/sha3"
+)
+
+// MaxMessageFieldLength bounds the command id, source chain and source address of a
+// general message, which are part of store keys.
+const MaxMessageFieldLength = 255
+
+// Message is attached in ICS20 packet memo field
+type Message struct {
+	SourceChain   string `json:"source_chain"`
+	SourceAddress string `json:"source_address"`
+	Payload       []byte `json:"payload"`
+	Type          int64  `json:"type"`
+	// CommandID is the unique id the gateway assigns to the message, if any
+	CommandID string `json:"command_id,omitempty"`
+}
+
+// Validate performs a stateless validation of a received general message.
+func (m Message) Validate() error {
+	if m.SourceChain == "" || m.SourceAddress == "" {
+		return ErrInvalidGeneralMessage.Wrap("source chain and source address must not be empty")
+	}
+	if len(m.SourceChain) > MaxMessageFieldLength || len(m.SourceAddress) > MaxMessageFieldLength || len(m.CommandID) > MaxMessageFieldLength {
+		return ErrInvalidGeneralMessage.Wrapf("command id, source chain and source address must not exceed %d bytes", MaxMessageFieldLength)
+	}
+	return nil
+}
+
+// NewMessageRecord returns the record of a general message relayed by the gateway in
+// the packet.
+func NewMessageRecord(msg Message, gateway, channelID string, sequence uint64, status MessageStatus, height int64) MessageRecord {
+	return MessageRecord{
+		CommandId:     msg.CommandID,
+		SourceChain:   msg.SourceChain,
+		SourceAddress: msg.SourceAddress,
+		PayloadHash:   PayloadHash(msg.Payload),
+		ChannelId:     channelID,
+		Sequence:      sequence,
+		Status:        status,
+		Height:        height,
+		Gateway:       gateway,
+	}
+}
+
+// Key returns the store key of the record, the channel and sequence of its packet.
+func (r MessageRecord) Key() []byte {
+	return GetMessageKey(r.ChannelId, r.Sequence)
+}
+
+// IsReplayProtected reports whether the command id of the message is rejected as a
+// replay while the record is kept. Messages without a command id are only protected by
+// the packet receipts of their channel, and failed messages can be delivered again.
+func (r MessageRecord) IsReplayProtected() bool {
+	return r.CommandId != "" && r.Status != MessageStatus_MESSAGE_STATUS_FAILED
+}
+
+// Validate performs a stateless validation of the message record.
+func (r MessageRecord) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return ErrInvalidGeneralMessage.Wrapf("invalid channel_id: %s", err)
+	}
+	id := fmt.Sprintf("%s/%d", r.ChannelId, r.Sequence)
+	if r.Gateway == "" || len(r.Gateway) > MaxMessageFieldLength {
+		return ErrInvalidGeneralMessage.Wrapf("message %s has an invalid gateway %q", id, r.Gateway)
+	}
+	if err := (Message{SourceChain: r.SourceChain, SourceAddress: r.SourceAddress, CommandID: r.CommandId}).Validate(); err != nil {
+		return errors.Wrapf(err, "message %s", id)
+	}
+	if r.Status == MessageStatus_MESSAGE_STATUS_UNSPECIFIED {
+		return ErrInvalidGeneralMessage.Wrapf("message %s has no status", id)
+	}
+	if r.Height < 0 {
+		return ErrInvalidGeneralMessage.Wrapf("message %s has a negative height", id)
+	}
+	return nil
+}
+
+// PayloadHash returns the hex encoded keccak256 hash of the payload.
+func PayloadHash(payload []byte) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write(payload)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+type MessageType int
+
+const (
+	// TypeUnrecognized means coin type is unrecognized
+	TypeUnrecognized = iota
+	// TypeGeneralMessage is a pure general message calling a contract. The tokens of its
+	// packet only pay for the relay and are sent to the fee sink.
+	TypeGeneralMessage
+	// TypeGeneralMessageWithToken is a general message with token
+	TypeGeneralMessageWithToken
+	// TypeSendToken is a plain token transfer in a GMP envelope, without payload
+	TypeSendToken
+)
+
+// IsEnvelope reports whether the memo is a GMP envelope for the middleware to handle,
+// as opposed to a memo for the next layers.
+func (m Message) IsEnvelope() bool {
+	return len(m.Payload) != 0 || m.Type == TypeSendToken
+}
+
+// OutboundMessage is attached in the ICS20 packet memo field of general messages sent
+// to a gateway.
+type OutboundMessage struct {
+	DestinationChain   string `json:"destination_chain"`
+	DestinationAddress string `json:"destination_address"`
+	Payload            []byte `json:"payload"`
+	Type               int64  `json:"type"`
+	Fee                *Fee   `json:"fee"`
+}
+
+// NewOutboundMessage returns the memo envelope of the general message to send.
+func NewOutboundMessage(msg *MsgSendGeneralMessage) OutboundMessage {
+	return OutboundMessage{
+		DestinationChain:   msg.DestinationChain,
+		DestinationAddress: msg.DestinationAddress,
+		Payload:            msg.Payload,
+		Type:               msg.MessageType,
+		Fee:                msg.Fee,
+	}
+}
+
+// Memo returns the ICS20 memo carrying the message.
+func (m OutboundMessage) Memo() (string, error) {
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// MessageSource is the origin of a general message relayed by a gateway. The gateway
+// vouches for it, so contracts may use it to authenticate cross-chain callers.
+type MessageSource struct {
+	SourceChain   string `json:"source_chain"`
+	SourceAddress string `json:"source_address"`
+}
+
+type messageSourceKey struct{}
+
+// ContextWithMessageSource returns a context carrying the verified source of the general
+// message being received, for the contract it executes to be called with it.
+func ContextWithMessageSource(ctx sdk.Context, source MessageSource) sdk.Context {
+	return ctx.WithValue(messageSourceKey{}, source)
+}
+
+// MessageSourceFromContext returns the verified source of the general message being
+// received, if its gateway wraps contract messages. Only the gmp middleware sets it, so it
+// can't be forged with a memo.
+func MessageSourceFromContext(ctx sdk.Context) (MessageSource, bool) {
+	source, ok := ctx.Value(messageSourceKey{}).(MessageSource)
+	return source, ok
+}
+
+// contractMsg is the message a contract is executed with by a general message.
+type contractMsg struct {
+	GMPMessage struct {
+		MessageSource
+		// Msg is the message the contract is called with by the payload
+		Msg []byte `json:"msg"`
+	} `json:"gmp_message"`
+}
+
+// WrapContractMsg wraps the message a general message executes a contract with
+// together with its source:
+//
+//	{"gmp_message": {"source_chain": "...", "source_address": "...", "msg": "<base64 msg>"}}
+func (s MessageSource) WrapContractMsg(msg []byte) ([]byte, error) {
+	var wrapped contractMsg
+	wrapped.GMPMessage.MessageSource = s
+	wrapped.GMPMessage.Msg = msg
+	return json.Marshal(wrapped)
+}
@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/gmp/message.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MessageStatus is the outcome of a received general message.
+type MessageStatus int32
+
+const (
+	// MESSAGE_STATUS_UNSPECIFIED is never recorded.
+	MessageStatus_MESSAGE_STATUS_UNSPECIFIED MessageStatus = 0
+	// MESSAGE_STATUS_EXECUTED means the message was delivered, and its dex action
+	// executed if it had one.
+	MessageStatus_MESSAGE_STATUS_EXECUTED MessageStatus = 1
+	// MESSAGE_STATUS_ACTION_FAILED means the dex action of the message failed and the
+	// receiver was credited with the tokens instead.
+	MessageStatus_MESSAGE_STATUS_ACTION_FAILED MessageStatus = 2
+	// MESSAGE_STATUS_FAILED means the message was acknowledged with an error and had no
+	// effect. Its command id can be delivered again.
+	MessageStatus_MESSAGE_STATUS_FAILED MessageStatus = 3
+)
+
+var MessageStatus_name = map[int32]string{
+	0: "MESSAGE_STATUS_UNSPECIFIED",
+	1: "MESSAGE_STATUS_EXECUTED",
+	2: "MESSAGE_STATUS_ACTION_FAILED",
+	3: "MESSAGE_STATUS_FAILED",
+}
+
+var MessageStatus_value = map[string]int32{
+	"MESSAGE_STATUS_UNSPECIFIED":   0,
+	"MESSAGE_STATUS_EXECUTED":      1,
+	"MESSAGE_STATUS_ACTION_FAILED": 2,
+	"MESSAGE_STATUS_FAILED":        3,
+}
+
+func (x MessageStatus) String() string {
+	return proto.EnumName(MessageStatus_name, int32(x))
+}
+
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73962b27cdc2cf36, []int{0}
+}
+
+// MessageRecord is a general message received from a gateway, identified by the channel
+// and sequence of the packet carrying it.
+type MessageRecord struct {
+	// command_id is the unique id the gateway assigned to the message, if any. Delivered
+	// messages are deduplicated by gateway, source chain and command id.
+	CommandId     string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	SourceChain   string `protobuf:"bytes,2,opt,name=source_chain,json=sourceChain,proto3" json:"source_chain,omitempty"`
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// payload_hash is the hex encoded keccak256 hash of the payload.
+	PayloadHash string        `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	ChannelId   string        `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence    uint64        `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status      MessageStatus `protobuf:"varint,7,opt,name=status,proto3,enum=neutron.gmp.MessageStatus" json:"status,omitempty"`
+	// height is the block height the message was received at.
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// gateway is the ICS20 sender of the gateway that relayed the message.
+	Gateway string `protobuf:"bytes,9,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// error is the error the message was acknowledged with, if it failed.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MessageRecord) Reset()         { *m = MessageRecord{} }
+func (m *MessageRecord) String() string { return proto.CompactTextString(m) }
+func (*MessageRecord) ProtoMessage()    {}
+func (*MessageRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73962b27cdc2cf36, []int{0}
+}
+func (m *MessageRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRecord.Merge(m, src)
+}
+func (m *MessageRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageRecord proto.InternalMessageInfo
+
+func (m *MessageRecord) GetCommandId() string {
+	if m != nil {
+		return m.CommandId
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetSourceChain() string {
+	if m != nil {
+		return m.SourceChain
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetSourceAddress() string {
+	if m != nil {
+		return m.SourceAddress
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MessageRecord) GetStatus() MessageStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (m *MessageRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MessageRecord) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *MessageRecord) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("neutron.gmp.MessageStatus", MessageStatus_name, MessageStatus_value)
+	proto.RegisterType((*MessageRecord)(nil), "neutron.gmp.MessageRecord")
+}
+
+func init() { proto.RegisterFile("neutron/gmp/message.proto", fileDescriptor_73962b27cdc2cf36) }
+
+var fileDescriptor_73962b27cdc2cf36 = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xdf, 0x6a, 0xdb, 0x30,
+	0x14, 0xc6, 0xa3, 0xa4, 0x4d, 0x9b, 0xd3, 0xb5, 0x04, 0xb1, 0x3f, 0x6a, 0xb6, 0x19, 0x6f, 0x30,
+	0x08, 0x83, 0xda, 0xd0, 0xb1, 0x07, 0xf0, 0x12, 0xb7, 0x33, 0xac, 0xdd, 0xb0, 0x13, 0x18, 0xbb,
+	0x31, 0xaa, 0x2d, 0xec, 0x40, 0x6d, 0x79, 0x92, 0xbc, 0x2d, 0x8f, 0xb0, 0xbb, 0x3d, 0xd6, 0x2e,
+	0xcb, 0xae, 0x76, 0x39, 0x92, 0x17, 0x19, 0x91, 0xd4, 0x52, 0x72, 0xe7, 0xef, 0xf7, 0x7d, 0xc8,
+	0xdf, 0x39, 0x1c, 0x38, 0xae, 0x59, 0xab, 0x04, 0xaf, 0xfd, 0xa2, 0x6a, 0xfc, 0x8a, 0x49, 0x49,
+	0x0b, 0xe6, 0x35, 0x82, 0x2b, 0x8e, 0x0f, 0xac, 0xe5, 0x15, 0x55, 0xf3, 0xf2, 0x4f, 0x17, 0x0e,
+	0x2f, 0x8c, 0x1d, 0xb3, 0x8c, 0x8b, 0x1c, 0x3f, 0x07, 0xc8, 0x78, 0x55, 0xd1, 0x3a, 0x4f, 0x17,
+	0x39, 0x41, 0x2e, 0x1a, 0x0f, 0xe2, 0x81, 0x25, 0x51, 0x8e, 0x5f, 0xc0, 0x03, 0xc9, 0x5b, 0x91,
+	0xb1, 0x34, 0x2b, 0xe9, 0xa2, 0x26, 0x5d, 0x1d, 0x38, 0x30, 0x6c, 0xb2, 0x41, 0xf8, 0x15, 0x1c,
+	0xd9, 0x08, 0xcd, 0x73, 0xc1, 0xa4, 0x24, 0x3d, 0x1d, 0x3a, 0x34, 0x34, 0x30, 0x70, 0xf3, 0x52,
+	0x43, 0x97, 0xd7, 0x9c, 0xe6, 0x69, 0x49, 0x65, 0x49, 0x76, 0xcc, 0x4b, 0x96, 0xbd, 0xa7, 0xb2,
+	0xd4, 0x5d, 0x4a, 0x5a, 0xd7, 0xec, 0x7a, 0xd3, 0x65, 0xd7, 0x76, 0x31, 0x24, 0xca, 0xf1, 0x08,
+	0xf6, 0x25, 0xfb, 0xda, 0xb2, 0x3a, 0x63, 0xa4, 0xef, 0xa2, 0xf1, 0x4e, 0x7c, 0xa7, 0xf1, 0x29,
+	0xf4, 0xa5, 0xa2, 0xaa, 0x95, 0x64, 0xcf, 0x45, 0xe3, 0xa3, 0xd3, 0x91, 0x77, 0x6f, 0x6c, 0xcf,
+	0x8e, 0x9c, 0xe8, 0x44, 0x6c, 0x93, 0xf8, 0x31, 0xf4, 0x4b, 0xb6, 0x28, 0x4a, 0x45, 0xf6, 0x5d,
+	0x34, 0xee, 0xc5, 0x56, 0x61, 0x02, 0x7b, 0x05, 0x55, 0xec, 0x3b, 0x5d, 0x92, 0x81, 0xee, 0x70,
+	0x2b, 0xf1, 0x43, 0xd8, 0x65, 0x42, 0x70, 0x41, 0x40, 0x73, 0x23, 0x5e, 0xff, 0x44, 0x77, 0x4b,
+	0x35, 0x7f, 0xc0, 0x0e, 0x8c, 0x2e, 0xc2, 0x24, 0x09, 0xce, 0xc3, 0x34, 0x99, 0x05, 0xb3, 0x79,
+	0x92, 0xce, 0x2f, 0x93, 0x4f, 0xe1, 0x24, 0x3a, 0x8b, 0xc2, 0xe9, 0xb0, 0x83, 0x9f, 0xc2, 0x93,
+	0x2d, 0x3f, 0xfc, 0x1c, 0x4e, 0xe6, 0xb3, 0x70, 0x3a, 0x44, 0xd8, 0x85, 0x67, 0x5b, 0x66, 0x30,
+	0x99, 0x45, 0x1f, 0x2f, 0xd3, 0xb3, 0x20, 0xfa, 0x10, 0x4e, 0x87, 0x5d, 0x7c, 0x0c, 0x8f, 0xb6,
+	0x12, 0xd6, 0xea, 0xbd, 0x3b, 0xff, 0xbd, 0x72, 0xd0, 0xcd, 0xca, 0x41, 0xff, 0x56, 0x0e, 0xfa,
+	0xb5, 0x76, 0x3a, 0x37, 0x6b, 0xa7, 0xf3, 0x77, 0xed, 0x74, 0xbe, 0x9c, 0x14, 0x0b, 0x55, 0xb6,
+	0x57, 0x5e, 0xc6, 0x2b, 0xdf, 0xee, 0xe6, 0x84, 0x8b, 0xe2, 0xf6, 0xdb, 0xff, 0xf6, 0xd6, 0xff,
+	0xa1, 0xcf, 0x47, 0x2d, 0x1b, 0x26, 0xaf, 0xfa, 0xfa, 0x7a, 0xde, 0xfc, 0x0f, 0x00, 0x00, 0xff,
+	0xff, 0xef, 0x40, 0x04, 0xa2, 0x5a, 0x02, 0x00, 0x00,
+}
+
+func (m *MessageRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Gateway) > 0 {
+		i -= len(m.Gateway)
+		copy(dAtA[i:], m.Gateway)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Gateway)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Height != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChain) > 0 {
+		i -= len(m.SourceChain)
+		copy(dAtA[i:], m.SourceChain)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.SourceChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CommandId) > 0 {
+		i -= len(m.CommandId)
+		copy(dAtA[i:], m.CommandId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CommandId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MessageRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommandId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SourceChain)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMessage(uint64(m.Sequence))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMessage(uint64(m.Status))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMessage(uint64(m.Height))
+	}
+	l = len(m.Gateway)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MessageRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommandId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MessageStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateway = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMessage(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMessage
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMessage
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMessage
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMessage        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMessage          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMessage = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultMessageRetention keeps received general messages for about two weeks of 1s blocks
+const DefaultMessageRetention uint64 = 1_209_600
+
+// DefaultMessagePruneLimit bounds the work of pruning expired message records in a block
+const DefaultMessagePruneLimit uint64 = 1_000
+
+// NewParams creates a new Params instance
+func NewParams(gateways []Gateway, messageRetention, messagePruneLimit uint64) Params {
+	return Params{
+		Gateways:          gateways,
+		MessageRetention:  messageRetention,
+		MessagePruneLimit: messagePruneLimit,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams([]Gateway{}, DefaultMessageRetention, DefaultMessagePruneLimit)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MessageRetention == 0 {
+		return fmt.Errorf("message retention must be positive")
+	}
+	if p.MessagePruneLimit == 0 {
+		return fmt.Errorf("message prune limit must be positive")
+	}
+	gateways := make(map[[2]string]struct{}, len(p.Gateways))
+	for _, gateway := range p.Gateways {
+		if err := gateway.Validate(); err != nil {
+			return err
+		}
+		key := [2]string{gateway.ChannelId, gateway.Sender}
+		if _, ok := gateways[key]; ok {
+			return fmt.Errorf("duplicate gateway %s on channel %s", gateway.Sender, gateway.ChannelId)
+		}
+		gateways[key] = struct{}{}
+	}
+	return nil
+}
+
+// IsGateway reports whether sender is a gateway on the channel.
+func (p Params) IsGateway(channelID, sender string) bool {
+	_, found := p.FindGateway(channelID, sender)
+	return found
+}
+
+// FindGateway returns the gateway of sender on the channel, if any.
+func (p Params) FindGateway(channelID, sender string) (Gateway, bool) {
+	for _, gateway := range p.Gateways {
+		if gateway.ChannelId == channelID && gateway.Sender == sender {
+			return gateway, true
+		}
+	}
+	return Gateway{}, false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Validate performs a stateless validation of the gateway.
+func (g Gateway) Validate() error {
+	if err := host.ChannelIdentifierValidator(g.ChannelId); err != nil {
+		return ErrInvalidGateway.Wrapf("invalid channel_id: %s", err)
+	}
+	// the sender is an address of the counterparty chain, its format is unknown
+	if strings.TrimSpace(g.Sender) == "" {
+		return ErrInvalidGateway.Wrap("sender must not be empty")
+	}
+	// the sender is part of the store keys of the messages it relays
+	if len(g.Sender) > MaxMessageFieldLength {
+		return ErrInvalidGateway.Wrapf("sender must not exceed %d bytes", MaxMessageFieldLength)
+	}
+	return nil
+}
@@ -0,0 +1,683 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/gmp/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Gateway is an ICS20 sender trusted to relay general messages over a channel, e.g.
+// the Axelar GMP account on the channel to Axelar.
+type Gateway struct {
+	// channel_id is the local transfer channel the gateway sends over.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sender is the ICS20 sender address of the gateway on the counterparty chain.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// wrap_contract_msg wraps the message contracts are executed with by the general
+	// messages of the gateway together with their verified source:
+	// {"gmp_message": {"source_chain": ..., "source_address": ..., "msg": <base64 msg>}}.
+	// Contracts are executed with the message of the payload as is otherwise.
+	WrapContractMsg bool `protobuf:"varint,3,opt,name=wrap_contract_msg,json=wrapContractMsg,proto3" json:"wrap_contract_msg,omitempty"`
+}
+
+func (m *Gateway) Reset()         { *m = Gateway{} }
+func (m *Gateway) String() string { return proto.CompactTextString(m) }
+func (*Gateway) ProtoMessage()    {}
+func (*Gateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b56efa7cd27c1a80, []int{0}
+}
+func (m *Gateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gateway.Merge(m, src)
+}
+func (m *Gateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gateway proto.InternalMessageInfo
+
+func (m *Gateway) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *Gateway) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Gateway) GetWrapContractMsg() bool {
+	if m != nil {
+		return m.WrapContractMsg
+	}
+	return false
+}
+
+// Params defines the parameters for the module.
+type Params struct {
+	// gateways are the senders GMP memos are accepted from. The source chain and
+	// source address of a general message are only trusted when relayed by a gateway.
+	Gateways []Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways"`
+	// message_retention is the number of blocks a received general message is kept
+	// for. Its command id is rejected as a replay until then.
+	MessageRetention uint64 `protobuf:"varint,2,opt,name=message_retention,json=messageRetention,proto3" json:"message_retention,omitempty"`
+	// message_prune_limit is the maximum number of expired message records pruned per
+	// block. The remaining ones are pruned in the next blocks.
+	MessagePruneLimit uint64 `protobuf:"varint,3,opt,name=message_prune_limit,json=messagePruneLimit,proto3" json:"message_prune_limit,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b56efa7cd27c1a80, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGateways() []Gateway {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+func (m *Params) GetMessageRetention() uint64 {
+	if m != nil {
+		return m.MessageRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMessagePruneLimit() uint64 {
+	if m != nil {
+		return m.MessagePruneLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Gateway)(nil), "neutron.gmp.Gateway")
+	proto.RegisterType((*Params)(nil), "neutron.gmp.Params")
+}
+
+func init() { proto.RegisterFile("neutron/gmp/params.proto", fileDescriptor_b56efa7cd27c1a80) }
+
+var fileDescriptor_b56efa7cd27c1a80 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x4b, 0x03, 0x31,
+	0x18, 0xc6, 0x2f, 0xf6, 0xa8, 0x6d, 0x3a, 0x68, 0x63, 0x91, 0x43, 0xf0, 0x5a, 0x3a, 0x15, 0xa5,
+	0x77, 0xa0, 0xe8, 0xe0, 0x58, 0x87, 0x22, 0x28, 0x94, 0x1b, 0x5d, 0x8e, 0xf4, 0x1a, 0xd2, 0x83,
+	0xe6, 0x0f, 0x49, 0x6a, 0xed, 0xb7, 0x70, 0x74, 0x14, 0xfc, 0x32, 0x1d, 0x3b, 0x3a, 0x89, 0xf4,
+	0xbe, 0x88, 0x5c, 0x9a, 0x13, 0xb7, 0x37, 0xcf, 0xef, 0x21, 0xf9, 0x91, 0x17, 0x06, 0x9c, 0x2c,
+	0x8d, 0x12, 0x3c, 0xa6, 0x4c, 0xc6, 0x12, 0x2b, 0xcc, 0x74, 0x24, 0x95, 0x30, 0x02, 0xb5, 0x1c,
+	0x89, 0x28, 0x93, 0x67, 0x1d, 0x2a, 0xa8, 0xb0, 0x79, 0x5c, 0x4e, 0xfb, 0x4a, 0x7f, 0x01, 0x0f,
+	0xc7, 0xd8, 0x90, 0x15, 0x5e, 0xa3, 0x73, 0x08, 0xb3, 0x39, 0xe6, 0x9c, 0x2c, 0xd2, 0x7c, 0x16,
+	0x80, 0x1e, 0x18, 0x34, 0x93, 0xa6, 0x4b, 0x1e, 0x66, 0xe8, 0x14, 0xd6, 0x35, 0xe1, 0x33, 0xa2,
+	0x82, 0x03, 0x8b, 0xdc, 0x09, 0x5d, 0xc0, 0xf6, 0x4a, 0x61, 0x99, 0x66, 0x82, 0x1b, 0x85, 0x33,
+	0x93, 0x32, 0x4d, 0x83, 0x5a, 0x0f, 0x0c, 0x1a, 0xc9, 0x51, 0x09, 0xee, 0x5d, 0xfe, 0xa4, 0x69,
+	0xff, 0x13, 0xc0, 0xfa, 0xc4, 0x1a, 0xa2, 0x5b, 0xd8, 0xa0, 0xfb, 0x87, 0x75, 0x00, 0x7a, 0xb5,
+	0x41, 0xeb, 0xaa, 0x13, 0xfd, 0xd3, 0x8d, 0x9c, 0xd5, 0xc8, 0xdf, 0x7c, 0x77, 0xbd, 0xe4, 0xaf,
+	0x8b, 0x2e, 0x61, 0x9b, 0x11, 0xad, 0x31, 0x25, 0xa9, 0x22, 0x86, 0x70, 0x93, 0x0b, 0x6e, 0x8d,
+	0xfc, 0xe4, 0xd8, 0x81, 0xa4, 0xca, 0x51, 0x04, 0x4f, 0xaa, 0xb2, 0x54, 0x4b, 0x4e, 0xd2, 0x45,
+	0xce, 0x72, 0x63, 0xed, 0xfc, 0xa4, 0xba, 0x67, 0x52, 0x92, 0xc7, 0x12, 0xdc, 0xf9, 0xef, 0x1f,
+	0x5d, 0x6f, 0x34, 0xde, 0xec, 0x42, 0xb0, 0xdd, 0x85, 0xe0, 0x67, 0x17, 0x82, 0xb7, 0x22, 0xf4,
+	0xb6, 0x45, 0xe8, 0x7d, 0x15, 0xa1, 0xf7, 0x3c, 0xa4, 0xb9, 0x99, 0x2f, 0xa7, 0x51, 0x26, 0x58,
+	0xec, 0x64, 0x87, 0x42, 0xd1, 0x6a, 0x8e, 0x5f, 0x6e, 0xe2, 0x57, 0xbb, 0x06, 0xb3, 0x96, 0x44,
+	0x4f, 0xeb, 0xf6, 0x8f, 0xaf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x31, 0xee, 0x5d, 0xa2,
+	0x01, 0x00, 0x00,
+}
+
+func (m *Gateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WrapContractMsg {
+		i--
+		if m.WrapContractMsg {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessagePruneLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MessagePruneLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MessageRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MessageRetention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Gateways) > 0 {
+		for iNdEx := len(m.Gateways) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gateways[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WrapContractMsg {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MessageRetention != 0 {
+		n += 1 + sovParams(uint64(m.MessageRetention))
+	}
+	if m.MessagePruneLimit != 0 {
+		n += 1 + sovParams(uint64(m.MessagePruneLimit))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrapContractMsg", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WrapContractMsg = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateways = append(m.Gateways, Gateway{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRetention", wireType)
+			}
+			m.MessageRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagePruneLimit", wireType)
+			}
+			m.MessagePruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagePruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	gateway := types.Gateway{ChannelId: "channel-0", Sender: "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"}
+
+	for _, tc := range []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			params: types.DefaultParams(),
+			valid:  true,
+		},
+		{
+			desc:   "valid gateways",
+			params: types.NewParams([]types.Gateway{gateway, {ChannelId: "channel-1", Sender: gateway.Sender}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid:  true,
+		},
+		{
+			desc:   "invalid channel id",
+			params: types.NewParams([]types.Gateway{{ChannelId: "chan", Sender: gateway.Sender}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid:  false,
+		},
+		{
+			desc:   "empty sender",
+			params: types.NewParams([]types.Gateway{{ChannelId: "channel-0", Sender: " "}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid:  false,
+		},
+		{
+			desc:   "zero message retention",
+			params: types.NewParams([]types.Gateway{gateway}, 0, types.DefaultMessagePruneLimit),
+			valid:  false,
+		},
+		{
+			desc:   "zero message prune limit",
+			params: types.NewParams([]types.Gateway{gateway}, types.DefaultMessageRetention, 0),
+			valid:  false,
+		},
+		{
+			desc:   "too long sender",
+			params: types.NewParams([]types.Gateway{{ChannelId: "channel-0", Sender: strings.Repeat("a", types.MaxMessageFieldLength+1)}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid:  false,
+		},
+		{
+			desc:   "duplicate gateway",
+			params: types.NewParams([]types.Gateway{gateway, gateway}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid:  false,
+		},
+		{
+			desc: "duplicate gateway wrapping contract messages",
+			params: types.NewParams([]types.Gateway{
+				gateway,
+				{ChannelId: gateway.ChannelId, Sender: gateway.Sender, WrapContractMsg: true},
+			}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_IsGateway(t *testing.T) {
+	params := types.NewParams([]types.Gateway{{ChannelId: "channel-0", Sender: "gateway"}}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit)
+
+	require.True(t, params.IsGateway("channel-0", "gateway"))
+	require.False(t, params.IsGateway("channel-1", "gateway"))
+	require.False(t, params.IsGateway("channel-0", "sender"))
+}
+
+func TestParams_FindGateway(t *testing.T) {
+	gateway := types.Gateway{ChannelId: "channel-0", Sender: "gateway", WrapContractMsg: true}
+	params := types.NewParams([]types.Gateway{gateway}, types.DefaultMessageRetention, types.DefaultMessagePruneLimit)
+
+	found, ok := params.FindGateway("channel-0", "gateway")
+	require.True(t, ok)
+	require.Equal(t, gateway, found)
+	_, ok = params.FindGateway("channel-1", "gateway")
+	require.False(t, ok)
+}
+
+func TestMessageSource_WrapContractMsg(t *testing.T) {
+	source := types.MessageSource{SourceChain: "ethereum", SourceAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8"}
+
+	wrapped, err := source.WrapContractMsg([]byte(`{"echo":{}}`))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"gmp_message":{"source_chain":"ethereum","source_address":"0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8","msg":"eyJlY2hvIjp7fX0="}}`, string(wrapped))
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Versions of the payloads EVM senders prefix with 4 bytes, e.g.
+// abi.encodePacked(bytes4(0x00000000), abi.encode(method, argNames, argTypes, argValues))
+const (
+	// PayloadVersionABI is an ABI encoded contract call:
+	// abi.encode(string method, string[] argNames, string[] argTypes, bytes argValues),
+	// with argValues the abi.encode of the arguments.
+	PayloadVersionABI uint32 = iota
+	// PayloadVersionJSON is the JSON contract message, e.g. bytes('{"method": {}}').
+	PayloadVersionJSON
+)
+
+// payloadVersionSize is the size of the version prefix of a versioned payload.
+const payloadVersionSize = 4
+
+// abiCallTypes are the types of the ABI encoded contract call of a versioned payload.
+var abiCallTypes = []abiType{
+	{kind: abiString},
+	{kind: abiSlice, elem: &abiType{kind: abiString}},
+	{kind: abiSlice, elem: &abiType{kind: abiString}},
+	{kind: abiBytes},
+}
+
+// IsVersionedPayload reports whether the payload is prefixed with a version, as opposed
+// to a raw ICS20 memo, which is a JSON object.
+func IsVersionedPayload(payload []byte) bool {
+	trimmed := bytes.TrimSpace(payload)
+	return len(trimmed) == 0 || trimmed[0] != '{'
+}
+
+// PayloadMemo returns the ICS20 memo the payload of a general message is executed with.
+// A raw memo is used as is. A versioned payload calls the contract the packet is sent
+// to, decoded into a wasm hook memo.
+func PayloadMemo(payload []byte, contract string) (string, error) {
+	if !IsVersionedPayload(payload) {
+		return string(payload), nil
+	}
+
+	msg, err := DecodeVersionedPayload(payload)
+	if err != nil {
+		return "", err
+	}
+	memo, err := json.Marshal(wasmMemo{Wasm: wasmExecute{Contract: contract, Msg: msg}})
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidPayload, err.Error())
+	}
+	return string(memo), nil
+}
+
+// DecodeVersionedPayload decodes a versioned payload into the JSON message the contract
+// is executed with.
+func DecodeVersionedPayload(payload []byte) (json.RawMessage, error) {
+	if len(payload) < payloadVersionSize {
+		return nil, errors.Wrap(ErrInvalidPayload, "payload is too short for a version")
+	}
+	version := binary.BigEndian.Uint32(payload[:payloadVersionSize])
+	body := payload[payloadVersionSize:]
+
+	switch version {
+	case PayloadVersionABI:
+		return decodeABICall(body)
+	case PayloadVersionJSON:
+		var msg map[string]json.RawMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return nil, errors.Wrapf(ErrInvalidPayload, "contract message is not a JSON object: %s", err)
+		}
+		return body, nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownPayloadVersion, "%#08x", version)
+	}
+}
+
+// decodeABICall decodes an ABI encoded contract call into {"<method>": {"<argName>": <arg>}}.
+func decodeABICall(body []byte) (json.RawMessage, error) {
+	values, err := decodeABI(abiCallTypes, body)
+	if err != nil {
+		return nil, err
+	}
+	method := values[0].(string)
+	argNames := values[1].([]interface{})
+	argTypes := values[2].([]interface{})
+	argValues := values[3].([]byte)
+
+	if method == "" {
+		return nil, errors.Wrap(ErrInvalidPayload, "method must not be empty")
+	}
+	if len(argNames) != len(argTypes) {
+		return nil, errors.Wrapf(ErrInvalidPayload, "%d argument names for %d argument types", len(argNames), len(argTypes))
+	}
+
+	parsedTypes := make([]abiType, 0, len(argTypes))
+	for _, argType := range argTypes {
+		t, err := parseABIType(argType.(string))
+		if err != nil {
+			return nil, err
+		}
+		parsedTypes = append(parsedTypes, t)
+	}
+	args, err := decodeABI(parsedTypes, argValues)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode arguments of %s", method)
+	}
+
+	namedArgs := make(map[string]interface{}, len(args))
+	for i, arg := range args {
+		name := argNames[i].(string)
+		if _, ok := namedArgs[name]; ok {
+			return nil, errors.Wrapf(ErrInvalidPayload, "duplicate argument %s", name)
+		}
+		namedArgs[name] = arg
+	}
+
+	msg, err := json.Marshal(map[string]interface{}{method: namedArgs})
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidPayload, err.Error())
+	}
+	return msg, nil
+}
+
+// wasmMemo is the wasm hook memo a versioned payload is executed with.
+type wasmMemo struct {
+	Wasm wasmExecute `json:"wasm"`
+}
+
+type wasmExecute struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// ParseContractCall returns the contract and message of the wasm hook memo of a pure
+// general message, if it is one. As for wasm hooks, the contract must be the receiver
+// of the packet.
+func ParseContractCall(memo, receiver string) (sdk.AccAddress, json.RawMessage, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil, false, nil
+	}
+	raw, ok := fields["wasm"]
+	if !ok {
+		return nil, nil, false, nil
+	}
+
+	var call wasmExecute
+	if err := json.Unmarshal(raw, &call); err != nil {
+		return nil, nil, true, errors.Wrapf(ErrInvalidPayload, "invalid wasm call: %s", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(call.Contract)
+	if err != nil {
+		return nil, nil, true, errors.Wrapf(ErrInvalidPayload, "invalid contract: %s", err)
+	}
+	if call.Contract != receiver {
+		return nil, nil, true, errors.Wrap(ErrInvalidPayload, "contract must be the receiver of the packet")
+	}
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(call.Msg, &msg); err != nil {
+		return nil, nil, true, errors.Wrapf(ErrInvalidPayload, "contract message is not a JSON object: %s", err)
+	}
+	return contract, call.Msg, true, nil
+}
@@ -0,0 +1,277 @@
+package types_test
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+// abiArg is an encoded argument, the word of a static one or the tail of a dynamic one.
+type abiArg struct {
+	word    []byte
+	dynamic []byte
+}
+
+func abiUint(v uint64) abiArg {
+	return abiArg{word: abiWord(new(big.Int).SetUint64(v))}
+}
+
+func abiWord(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, 32))
+}
+
+func abiBytes(bz []byte) abiArg {
+	tail := abiWord(big.NewInt(int64(len(bz))))
+	padded := make([]byte, (len(bz)+31)/32*32)
+	copy(padded, bz)
+	return abiArg{dynamic: append(tail, padded...)}
+}
+
+func abiStrings(ss ...string) abiArg {
+	args := make([]abiArg, 0, len(ss))
+	for _, s := range ss {
+		args = append(args, abiBytes([]byte(s)))
+	}
+	return abiArg{dynamic: append(abiWord(big.NewInt(int64(len(ss)))), abiEncode(args...)...)}
+}
+
+func abiEncode(args ...abiArg) []byte {
+	var head, tail []byte
+	for _, arg := range args {
+		if arg.dynamic == nil {
+			head = append(head, arg.word...)
+			continue
+		}
+		head = append(head, abiWord(big.NewInt(int64(32*len(args)+len(tail))))...)
+		tail = append(tail, arg.dynamic...)
+	}
+	return append(head, tail...)
+}
+
+func concat(words ...[]byte) []byte {
+	var bz []byte
+	for _, word := range words {
+		bz = append(bz, word...)
+	}
+	return bz
+}
+
+func versioned(version uint32, body []byte) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, version), body...)
+}
+
+func abiCall(method string, names, argTypes []string, values []byte) []byte {
+	return versioned(types.PayloadVersionABI, abiEncode(
+		abiBytes([]byte(method)),
+		abiStrings(names...),
+		abiStrings(argTypes...),
+		abiBytes(values),
+	))
+}
+
+func TestPayloadMemo(t *testing.T) {
+	const contract = "neutron14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s5c2epq"
+
+	// -1 as int64
+	minusOne := abiArg{word: []byte(strings.Repeat("\xff", 32))}
+	address := abiArg{word: abiWord(new(big.Int).SetBytes([]byte(strings.Repeat("\x11", 20))))}
+	values := abiEncode(
+		abiUint(1_000_000),
+		abiArg{word: abiWord(new(big.Int).Lsh(big.NewInt(1), 100))},
+		minusOne,
+		address,
+		abiUint(1),
+		abiBytes([]byte("raw")),
+		abiStrings("a", "b"),
+	)
+
+	for _, tc := range []struct {
+		desc    string
+		payload []byte
+		memo    string
+		err     error
+	}{
+		{
+			desc:    "raw memo",
+			payload: []byte(`{"wasm": {"contract": "c", "msg": {}}}`),
+			memo:    `{"wasm": {"contract": "c", "msg": {}}}`,
+		},
+		{
+			desc:    "json",
+			payload: versioned(types.PayloadVersionJSON, []byte(`{"echo": {"msg": "test"}}`)),
+			memo:    `{"wasm": {"contract": "` + contract + `", "msg": {"echo": {"msg": "test"}}}}`,
+		},
+		{
+			desc:    "json not an object",
+			payload: versioned(types.PayloadVersionJSON, []byte(`[1]`)),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc: "abi",
+			payload: abiCall(
+				"swap",
+				[]string{"amount", "limit", "delta", "recipient", "exact", "memo", "routes"},
+				[]string{"uint64", "uint128", "int64", "address", "bool", "bytes", "string[]"},
+				values,
+			),
+			memo: `{"wasm": {"contract": "` + contract + `", "msg": {"swap": {
+				"amount": 1000000,
+				"limit": "1267650600228229401496703205376",
+				"delta": -1,
+				"recipient": "0x1111111111111111111111111111111111111111",
+				"exact": true,
+				"memo": "cmF3",
+				"routes": ["a", "b"]
+			}}}}`,
+		},
+		{
+			desc:    "abi without arguments",
+			payload: abiCall("claim", nil, nil, nil),
+			memo:    `{"wasm": {"contract": "` + contract + `", "msg": {"claim": {}}}}`,
+		},
+		{
+			desc:    "abi overflow",
+			payload: abiCall("swap", []string{"amount"}, []string{"uint8"}, abiEncode(abiUint(256))),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc:    "abi unsupported type",
+			payload: abiCall("swap", []string{"amount"}, []string{"uint256[2]"}, abiEncode(abiUint(1))),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc:    "abi nested arrays",
+			payload: abiCall("swap", []string{"routes"}, []string{"uint8[][]"}, abiEncode(abiUint(32), abiUint(0))),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc: "abi arguments aliasing a tail",
+			payload: abiCall("swap", []string{"a", "b"}, []string{"string", "string"}, concat(
+				abiWord(big.NewInt(64)),
+				abiWord(big.NewInt(64)),
+				abiBytes([]byte("a")).dynamic,
+			)),
+			err: types.ErrInvalidPayload,
+		},
+		{
+			desc: "abi array elements aliasing a tail",
+			payload: abiCall("swap", []string{"routes"}, []string{"string[]"}, concat(
+				abiWord(big.NewInt(32)),
+				abiWord(big.NewInt(2)),
+				abiWord(big.NewInt(64)),
+				abiWord(big.NewInt(64)),
+				abiBytes([]byte("a")).dynamic,
+			)),
+			err: types.ErrInvalidPayload,
+		},
+		{
+			desc: "abi tail in the heads",
+			payload: abiCall("swap", []string{"memo", "amount"}, []string{"bytes", "uint256"}, concat(
+				abiWord(big.NewInt(32)),
+				abiWord(big.NewInt(0)),
+			)),
+			err: types.ErrInvalidPayload,
+		},
+		{
+			desc: "abi array longer than its data",
+			payload: abiCall("swap", []string{"amounts"}, []string{"uint256[]"}, concat(
+				abiWord(big.NewInt(32)),
+				abiWord(big.NewInt(1_000_000)),
+			)),
+			err: types.ErrInvalidPayload,
+		},
+		{
+			desc:    "abi names mismatch types",
+			payload: abiCall("swap", []string{"amount", "limit"}, []string{"uint256"}, abiEncode(abiUint(1))),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc:    "abi truncated",
+			payload: abiCall("swap", []string{"amount", "limit"}, []string{"uint256", "uint256"}, abiEncode(abiUint(1))),
+			err:     types.ErrInvalidPayload,
+		},
+		{
+			desc:    "unknown version",
+			payload: versioned(2, []byte(`{}`)),
+			err:     types.ErrUnknownPayloadVersion,
+		},
+		{
+			desc:    "too short for a version",
+			payload: []byte{0},
+			err:     types.ErrInvalidPayload,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			memo, err := types.PayloadMemo(tc.payload, contract)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tc.memo, memo)
+		})
+	}
+}
+
+func TestParseContractCall(t *testing.T) {
+	contract := sdk.AccAddress("contract").String()
+
+	for _, tc := range []struct {
+		desc   string
+		memo   string
+		isCall bool
+		err    error
+	}{
+		{
+			desc:   "contract call",
+			memo:   `{"wasm": {"contract": "` + contract + `", "msg": {"echo": {}}}}`,
+			isCall: true,
+		},
+		{
+			desc: "dex action",
+			memo: `{"dex": {"swap": {}}}`,
+		},
+		{
+			desc: "not json",
+			memo: "memo",
+		},
+		{
+			desc:   "contract is not the receiver",
+			memo:   `{"wasm": {"contract": "` + sdk.AccAddress("other").String() + `", "msg": {}}}`,
+			isCall: true,
+			err:    types.ErrInvalidPayload,
+		},
+		{
+			desc:   "invalid contract",
+			memo:   `{"wasm": {"contract": "contract", "msg": {}}}`,
+			isCall: true,
+			err:    types.ErrInvalidPayload,
+		},
+		{
+			desc:   "msg not an object",
+			memo:   `{"wasm": {"contract": "` + contract + `", "msg": "echo"}}`,
+			isCall: true,
+			err:    types.ErrInvalidPayload,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			addr, msg, isCall, err := types.ParseContractCall(tc.memo, contract)
+			require.Equal(t, tc.isCall, isCall)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			if isCall {
+				require.Equal(t, contract, addr.String())
+				require.JSONEq(t, `{"echo": {}}`, string(msg))
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.Params.Validate()
+}
+
+var _ sdk.Msg = &MsgSendGeneralMessage{}
+
+func (msg *MsgSendGeneralMessage) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendGeneralMessage) Type() string {
+	return "send-general-message"
+}
+
+func (msg *MsgSendGeneralMessage) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSendGeneralMessage) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSendGeneralMessage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGeneralMessage, "invalid channel_id: %s", err)
+	}
+	if strings.TrimSpace(msg.DestinationChain) == "" {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "destination_chain must not be empty")
+	}
+	if strings.TrimSpace(msg.DestinationAddress) == "" {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "destination_address must not be empty")
+	}
+	switch msg.MessageType {
+	case TypeGeneralMessage, TypeGeneralMessageWithToken:
+		if len(msg.Payload) == 0 {
+			return errorsmod.Wrap(ErrInvalidGeneralMessage, "payload must not be empty")
+		}
+	case TypeSendToken:
+		if len(msg.Payload) != 0 {
+			return errorsmod.Wrap(ErrInvalidGeneralMessage, "send token messages carry no payload")
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidGeneralMessage, "unsupported message type: %d", msg.MessageType)
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token: %s", msg.Token)
+	}
+	if msg.Fee != nil {
+		if err := msg.Fee.Validate(msg.Token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the fee can be paid with the token sent along the message.
+func (f Fee) Validate(token sdk.Coin) error {
+	if f.Amount.IsNil() || !f.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "fee amount must be positive")
+	}
+	if f.Amount.GT(token.Amount) {
+		return errorsmod.Wrapf(ErrInvalidGeneralMessage, "fee %s exceeds token %s", f.Amount, token)
+	}
+	if strings.TrimSpace(f.Recipient) == "" {
+		return errorsmod.Wrap(ErrInvalidGeneralMessage, "fee recipient must not be empty")
+	}
+	return nil
+}
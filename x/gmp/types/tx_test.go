@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/gmp/types"
+)
+
+func validMsgSendGeneralMessage() types.MsgSendGeneralMessage {
+	return types.MsgSendGeneralMessage{
+		Sender:             sdk.AccAddress("sender").String(),
+		ChannelId:          "channel-0",
+		DestinationChain:   "ethereum",
+		DestinationAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		Payload:            []byte("payload"),
+		MessageType:        types.TypeGeneralMessageWithToken,
+		Token:              sdk.NewInt64Coin("untrn", 1000),
+		Fee:                &types.Fee{Amount: sdkmath.NewInt(100), Recipient: "axelar1zl3rxpp70lmte2xr6c4lgske2fyuj3hupcsvcd"},
+	}
+}
+
+func TestMsgSendGeneralMessage_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		malleate func(msg *types.MsgSendGeneralMessage)
+		valid    bool
+	}{
+		{
+			desc:     "valid",
+			malleate: func(_ *types.MsgSendGeneralMessage) {},
+			valid:    true,
+		},
+		{
+			desc:     "without fee",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Fee = nil },
+			valid:    true,
+		},
+		{
+			desc:     "invalid sender",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Sender = "sender" },
+			valid:    false,
+		},
+		{
+			desc:     "invalid channel id",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.ChannelId = "chan" },
+			valid:    false,
+		},
+		{
+			desc:     "empty destination chain",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.DestinationChain = "" },
+			valid:    false,
+		},
+		{
+			desc:     "empty destination address",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.DestinationAddress = "" },
+			valid:    false,
+		},
+		{
+			desc:     "unsupported type",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.MessageType = types.TypeUnrecognized },
+			valid:    false,
+		},
+		{
+			desc:     "empty payload",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Payload = nil },
+			valid:    false,
+		},
+		{
+			desc: "send token",
+			malleate: func(msg *types.MsgSendGeneralMessage) {
+				msg.MessageType = types.TypeSendToken
+				msg.Payload = nil
+			},
+			valid: true,
+		},
+		{
+			desc:     "send token with payload",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.MessageType = types.TypeSendToken },
+			valid:    false,
+		},
+		{
+			desc:     "zero token",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Token = sdk.NewInt64Coin("untrn", 0) },
+			valid:    false,
+		},
+		{
+			desc:     "fee exceeds token",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Fee.Amount = sdkmath.NewInt(1001) },
+			valid:    false,
+		},
+		{
+			desc:     "empty fee recipient",
+			malleate: func(msg *types.MsgSendGeneralMessage) { msg.Fee.Recipient = "" },
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := validMsgSendGeneralMessage()
+			tc.malleate(&msg)
+			err := msg.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOutboundMessage_Memo(t *testing.T) {
+	msg := validMsgSendGeneralMessage()
+
+	memo, err := types.NewOutboundMessage(&msg).Memo()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"destination_chain": "ethereum",
+		"destination_address": "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		"payload": "cGF5bG9hZA==",
+		"type": 2,
+		"fee": {"amount": "100", "recipient": "axelar1zl3rxpp70lmte2xr6c4lgske2fyuj3hupcsvcd"}
+	}`, memo)
+}
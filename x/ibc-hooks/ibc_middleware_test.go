@@ -17,6 +17,7 @@ import (
 
 	"github.com/neutron-org/neutron/v5/app/params"
 	"github.com/neutron-org/neutron/v5/testutil"
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 	"github.com/neutron-org/neutron/v5/x/ibc-hooks/testutils"
 	"github.com/neutron-org/neutron/v5/x/ibc-hooks/utils"
 
@@ -287,6 +288,86 @@ func (suite *HooksTestSuite) TestPacketsThatShouldBeSkipped() {
 	}
 }
 
+// General messages are only executed when relayed by a gateway of the channel
+func (suite *HooksTestSuite) TestGeneralMessagesFromGateways() {
+	suite.ConfigureTransferChannel()
+
+	receiver := suite.ChainB.SenderAccount.GetAddress().String()
+	memo, err := json.Marshal(gmptypes.Message{
+		SourceChain:   "ethereum",
+		SourceAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		Payload:       []byte(`{"something": ""}`),
+		Type:          gmptypes.TypeGeneralMessageWithToken,
+	})
+	suite.Require().NoError(err)
+
+	// the sender is not a gateway yet
+	ackBytes := suite.receivePacketWithSequence(receiver, string(memo), 0)
+	suite.Require().Contains(string(ackBytes), "error")
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	err = app.GMPKeeper.SetParams(suite.ChainA.GetContext(), gmptypes.NewParams([]gmptypes.Gateway{{
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+		Sender:    suite.ChainB.SenderAccount.GetAddress().String(),
+	}}, gmptypes.DefaultMessageRetention, gmptypes.DefaultMessagePruneLimit))
+	suite.Require().NoError(err)
+
+	ackBytes = suite.receivePacketWithSequence(receiver, string(memo), 1)
+	var ack map[string]string // This can't be unmarshalled to Acknowledgement because it's fetched from the events
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack))
+	suite.Require().Equal("AQ==", ack["result"])
+
+	// payloads of unknown versions are rejected
+	memo, err = json.Marshal(gmptypes.Message{
+		SourceChain:   "ethereum",
+		SourceAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		Payload:       []byte{0, 0, 0, 9},
+		Type:          gmptypes.TypeGeneralMessageWithToken,
+	})
+	suite.Require().NoError(err)
+	ackBytes = suite.receivePacketWithSequence(receiver, string(memo), 2)
+	suite.Require().Contains(string(ackBytes), "error")
+}
+
+// Contracts called by general messages are executed with the message of the payload, unless
+// its gateway wraps it with the source of the message
+func (suite *HooksTestSuite) TestGeneralMessageContractMsg() {
+	suite.ConfigureTransferChannel()
+
+	codeID := suite.StoreContractCode(suite.ChainA, sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress), "./bytecode/echo.wasm")
+	addr := suite.InstantiateContract(suite.ChainA, sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress), codeID, "{}")
+	memo, err := json.Marshal(gmptypes.Message{
+		SourceChain:   "ethereum",
+		SourceAddress: "0x68B93045fe7D8794a7cAF327e7f855CD6Cd03BB8",
+		Payload:       []byte(fmt.Sprintf(`{"wasm": {"contract": "%s", "msg": {"echo": {"msg": "test"}}}}`, addr)),
+		Type:          gmptypes.TypeGeneralMessageWithToken,
+	})
+	suite.Require().NoError(err)
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	setGateway := func(wrapContractMsg bool) {
+		err := app.GMPKeeper.SetParams(suite.ChainA.GetContext(), gmptypes.NewParams([]gmptypes.Gateway{{
+			ChannelId:       suite.TransferPath.EndpointA.ChannelID,
+			Sender:          suite.ChainB.SenderAccount.GetAddress().String(),
+			WrapContractMsg: wrapContractMsg,
+		}}, gmptypes.DefaultMessageRetention, gmptypes.DefaultMessagePruneLimit))
+		suite.Require().NoError(err)
+	}
+
+	// the echo contract is executed with {"echo": {"msg": "test"}}
+	setGateway(false)
+	ackBytes := suite.receivePacketWithSequence(addr.String(), string(memo), 0)
+	var ack map[string]string // This can't be unmarshalled to Acknowledgement because it's fetched from the events
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack))
+	suite.Require().NotContains(ack, "error")
+	suite.Require().Equal("eyJjb250cmFjdF9yZXN1bHQiOiJkR2hwY3lCemFHOTFiR1FnWldOb2J3PT0iLCJpYmNfYWNrIjoiZXlKeVpYTjFiSFFpT2lKQlVUMDlJbjA9In0=", ack["result"])
+
+	// the echo contract doesn't handle {"gmp_message": {...}}
+	setGateway(true)
+	ackBytes = suite.receivePacketWithSequence(addr.String(), string(memo), 1)
+	suite.Require().Contains(string(ackBytes), "error")
+}
+
 type Direction int64
 
 const (
@@ -16,6 +16,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	gmptypes "github.com/neutron-org/neutron/v5/x/gmp/types"
 	"github.com/neutron-org/neutron/v5/x/ibc-hooks/types"
 )
 
@@ -62,6 +63,14 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
 
+	// A general message relayed by a GMP gateway wrapping contract messages carries its
+	// verified source chain and sender, contracts are executed with the memo msg otherwise
+	if source, ok := gmptypes.MessageSourceFromContext(ctx); ok {
+		if msgBytes, err = source.WrapContractMsg(msgBytes); err != nil {
+			return utils.NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+		}
+	}
+
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	channel := packet.GetDestChannel()
 	sender := data.GetSender()
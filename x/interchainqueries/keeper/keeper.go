@@ -11,6 +11,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	tendermintLightClientTypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -144,6 +145,52 @@ func (k Keeper) RemoveQuery(ctx sdk.Context, query *types.RegisteredQuery) {
 	}
 }
 
+// RegisterModuleQuery registers a KV query owned by a module account. Unlike queries registered
+// with MsgRegisterInterchainQuery it takes no deposit, and as the owner is not a contract it is
+// not called back with results: the module reads the verified results from the store instead.
+func (k Keeper) RegisterModuleQuery(ctx sdk.Context, owner string, keys []*types.KVKey, connectionID string, updatePeriod uint64) (uint64, error) {
+	if _, found := k.ibcKeeper.ConnectionKeeper.GetConnection(ctx, connectionID); !found {
+		return 0, errors.Wrapf(types.ErrInvalidConnectionID, "failed to get connection with ID '%s'", connectionID)
+	}
+
+	lastID := k.GetLastRegisteredQueryKey(ctx)
+	lastID++
+
+	registeredQuery := &types.RegisteredQuery{
+		Id:                 lastID,
+		Owner:              owner,
+		Keys:               keys,
+		QueryType:          string(types.InterchainQueryTypeKV),
+		UpdatePeriod:       updatePeriod,
+		ConnectionId:       connectionID,
+		SubmitTimeout:      k.GetParams(ctx).QuerySubmitTimeout,
+		RegisteredAtHeight: uint64(ctx.BlockHeader().Height),
+	}
+
+	k.SetLastRegisteredQueryKey(ctx, lastID)
+	if err := k.SaveQuery(ctx, registeredQuery); err != nil {
+		return 0, errors.Wrapf(err, "failed to save query: %v", err)
+	}
+
+	ctx.EventManager().EmitEvents(getEventsQueryUpdated(registeredQuery))
+	return lastID, nil
+}
+
+// RemoveModuleQuery removes a query registered with RegisterModuleQuery by the same owner.
+func (k Keeper) RemoveModuleQuery(ctx sdk.Context, owner string, queryID uint64) error {
+	query, err := k.GetQueryByID(ctx, queryID)
+	if err != nil {
+		return err
+	}
+	if query.Owner != owner {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "query %d is not owned by %s", queryID, owner)
+	}
+
+	k.RemoveQuery(ctx, query)
+	ctx.EventManager().EmitEvents(getEventsQueryRemoved(query))
+	return nil
+}
+
 // TxQueriesCleanup cleans the module store from obsolete registered TX queries and relative
 // stored transaction hashes. Cleans up to params.TxQueryRemovalLimit hashes at a time or all
 // the hashes if params.TxQueryRemovalLimit is 0.
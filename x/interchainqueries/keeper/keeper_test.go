@@ -19,6 +19,7 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types" //nolint:staticcheck
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/neutron-org/neutron/v5/testutil"
@@ -1731,6 +1732,39 @@ func (suite *KeeperTestSuite) TestRemoveFreshlyCreatedICQ() {
 	suite.ErrorContains(err, "only owner can remove a query within its service period")
 }
 
+func (suite *KeeperTestSuite) TestModuleQuery() {
+	suite.SetupTest()
+	var (
+		ctx   = suite.ChainA.GetContext()
+		owner = authtypes.NewModuleAddress("mintburn").String()
+		keys  = []*iqtypes.KVKey{{Key: []byte("key1"), Path: "path1"}}
+	)
+
+	iqkeeper := suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+
+	_, err := iqkeeper.RegisterModuleQuery(ctx, owner, keys, "unknown", 1)
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidConnectionID)
+
+	queryID, err := iqkeeper.RegisterModuleQuery(ctx, owner, keys, suite.Path.EndpointA.ConnectionID, 5)
+	suite.Require().NoError(err)
+
+	registeredQuery, err := iqkeeper.GetQueryByID(ctx, queryID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner, registeredQuery.Owner)
+	suite.Require().Equal(string(iqtypes.InterchainQueryTypeKV), registeredQuery.QueryType)
+	suite.Require().Equal(keys, registeredQuery.Keys)
+	suite.Require().Equal(uint64(5), registeredQuery.UpdatePeriod)
+	suite.Require().Empty(registeredQuery.Deposit)
+	suite.Require().Equal(iqkeeper.GetParams(ctx).QuerySubmitTimeout, registeredQuery.SubmitTimeout)
+
+	err = iqkeeper.RemoveModuleQuery(ctx, authtypes.NewModuleAddress("other").String(), queryID)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.Require().NoError(iqkeeper.RemoveModuleQuery(ctx, owner, queryID))
+	_, err = iqkeeper.GetQueryByID(ctx, queryID)
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryID)
+}
+
 func (suite *KeeperTestSuite) TopUpWallet(ctx sdk.Context, sender, contractAddress sdk.AccAddress) {
 	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(int64(1_000_000))))
 	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
@@ -280,7 +280,8 @@ func (m msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, errors.Wrapf(err, "failed to SaveKVQueryResult: %v", err)
 		}
 
-		if msg.Result.GetAllowKvCallbacks() {
+		// Queries owned by modules are not called back, the modules read the stored result.
+		if msg.Result.GetAllowKvCallbacks() && m.contractManagerKeeper.HasContractInfo(ctx, queryOwner) {
 			// Let the query owner contract process the query result.
 			if _, err := m.contractManagerKeeper.SudoKVQueryResult(ctx, queryOwner, query.Id); err != nil {
 				ctx.Logger().Debug("SubmitQueryResult: failed to SudoKVQueryResult",
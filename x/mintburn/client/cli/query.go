@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	// Group mintburn queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryAllowedChannels())
+	cmd.AddCommand(CmdQueryPendingChannels())
+	cmd.AddCommand(CmdQueryChannelStats())
+	cmd.AddCommand(CmdQuerySupplyDrift())
+	cmd.AddCommand(CmdQueryPendingBurns())
+	cmd.AddCommand(CmdQueryChannelQuotas())
+	cmd.AddCommand(CmdQueryPauseStatus())
+	cmd.AddCommand(CmdQueryReservesStatus())
+	cmd.AddCommand(CmdQueryBridgeRoutes())
+
+	return cmd
+}
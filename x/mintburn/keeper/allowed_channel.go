@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) allowedChannelStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.AllowedChannelKeyPrefix)
+}
+
+func (k Keeper) IsAllowedChannel(ctx sdk.Context, channelID string) bool {
+	return k.allowedChannelStore(ctx).Has([]byte(channelID))
+}
+
+// GetAllowedChannel returns the allow-listed transfer channel with the given id.
+func (k Keeper) GetAllowedChannel(ctx sdk.Context, channelID string) (types.AllowedChannel, bool) {
+	bz := k.allowedChannelStore(ctx).Get([]byte(channelID))
+	if bz == nil {
+		return types.AllowedChannel{}, false
+	}
+
+	var channel types.AllowedChannel
+	k.cdc.MustUnmarshal(bz, &channel)
+	return channel, true
+}
+
+func (k Keeper) SetAllowedChannel(ctx sdk.Context, channel types.AllowedChannel) {
+	k.allowedChannelStore(ctx).Set([]byte(channel.ChannelId), k.cdc.MustMarshal(&channel))
+}
+
+func (k Keeper) RemoveAllowedChannel(ctx sdk.Context, channelID string) {
+	k.allowedChannelStore(ctx).Delete([]byte(channelID))
+}
+
+// GetAllAllowedChannels returns every allow-listed transfer channel.
+func (k Keeper) GetAllAllowedChannels(ctx sdk.Context) []types.AllowedChannel {
+	iterator := k.allowedChannelStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	channels := make([]types.AllowedChannel, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.AllowedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+func (k Keeper) pendingChannelStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingChannelKeyPrefix)
+}
+
+// GetPendingChannel returns the opened channel with the given id waiting for approval.
+func (k Keeper) GetPendingChannel(ctx sdk.Context, channelID string) (types.AllowedChannel, bool) {
+	bz := k.pendingChannelStore(ctx).Get([]byte(channelID))
+	if bz == nil {
+		return types.AllowedChannel{}, false
+	}
+
+	var channel types.AllowedChannel
+	k.cdc.MustUnmarshal(bz, &channel)
+	return channel, true
+}
+
+func (k Keeper) SetPendingChannel(ctx sdk.Context, channel types.AllowedChannel) {
+	k.pendingChannelStore(ctx).Set([]byte(channel.ChannelId), k.cdc.MustMarshal(&channel))
+}
+
+func (k Keeper) RemovePendingChannel(ctx sdk.Context, channelID string) {
+	k.pendingChannelStore(ctx).Delete([]byte(channelID))
+}
+
+// GetAllPendingChannels returns every channel waiting for approval.
+func (k Keeper) GetAllPendingChannels(ctx sdk.Context) []types.AllowedChannel {
+	iterator := k.pendingChannelStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	channels := make([]types.AllowedChannel, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.AllowedChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// ResolveCounterparty walks channel -> connection -> light client and returns the
+// channel description as it would be stored in the allow-list.
+func (k Keeper) ResolveCounterparty(ctx sdk.Context, portID, channelID string) (types.AllowedChannel, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.AllowedChannel{}, fmt.Errorf("channel %s not found", channelID)
+	}
+	if len(channel.ConnectionHops) == 0 {
+		return types.AllowedChannel{}, fmt.Errorf("channel %s has no connection hops", channelID)
+	}
+	connectionID := channel.ConnectionHops[0]
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return types.AllowedChannel{}, fmt.Errorf("connection %s not found", connectionID)
+	}
+	// the local client tracks the counterparty chain
+	clientID := connection.ClientId
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return types.AllowedChannel{}, fmt.Errorf("client state for %s not found", clientID)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return types.AllowedChannel{}, types.ErrInvalidCounterpartyClient.Wrapf("unexpected client state type %T", clientState)
+	}
+
+	return types.AllowedChannel{
+		ChannelId:           channelID,
+		CounterpartyChainId: tmClientState.ChainId,
+		ConnectionId:        connectionID,
+		ClientId:            clientID,
+	}, nil
+}
+
+// AuthenticateCounterparty checks that a resolved channel may mint and burn: it must
+// be an unordered ICS20 channel, and the light client it is opened over must be active,
+// not frozen, and trust headers for no longer than the max trusting period.
+func (k Keeper) AuthenticateCounterparty(ctx sdk.Context, portID string, channel types.AllowedChannel) error {
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, portID, channel.ChannelId)
+	if !found {
+		return fmt.Errorf("channel %s not found", channel.ChannelId)
+	}
+	if channelEnd.Ordering != channeltypes.UNORDERED {
+		return types.ErrInvalidChannel.Wrapf("expected %s channel, got %s", channeltypes.UNORDERED, channelEnd.Ordering)
+	}
+	if channelEnd.Version != ibctransfertypes.Version {
+		return types.ErrInvalidChannel.Wrapf("expected version %s, got %s", ibctransfertypes.Version, channelEnd.Version)
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, channel.ClientId)
+	if !found {
+		return fmt.Errorf("client state for %s not found", channel.ClientId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return types.ErrInvalidCounterpartyClient.Wrapf("unexpected client state type %T", clientState)
+	}
+	if !tmClientState.FrozenHeight.IsZero() {
+		return types.ErrInvalidCounterpartyClient.Wrapf("client %s is frozen", channel.ClientId)
+	}
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, channel.ClientId); status != ibcexported.Active {
+		return types.ErrInvalidCounterpartyClient.Wrapf("client %s is %s", channel.ClientId, status)
+	}
+	if maxTrustingPeriod := k.GetParams(ctx).MaxTrustingPeriod; tmClientState.TrustingPeriod > maxTrustingPeriod {
+		return types.ErrInvalidCounterpartyClient.Wrapf(
+			"client %s trusting period %s exceeds %s", channel.ClientId, tmClientState.TrustingPeriod, maxTrustingPeriod,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v8/modules/light-clients/09-localhost"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestAuthenticateCounterparty(t *testing.T) {
+	channel := types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: "maany-mainnet",
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	}
+	transferChannel := channeltypes.Channel{Ordering: channeltypes.UNORDERED, Version: transfertypes.Version}
+	tmClient := func(trustingPeriod time.Duration, frozen bool) *ibctmtypes.ClientState {
+		clientState := &ibctmtypes.ClientState{ChainId: channel.CounterpartyChainId, TrustingPeriod: trustingPeriod}
+		if frozen {
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		}
+		return clientState
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		channelEnd  channeltypes.Channel
+		clientState ibcexported.ClientState
+		status      ibcexported.Status
+		err         error
+	}{
+		{
+			desc:        "valid",
+			channelEnd:  transferChannel,
+			clientState: tmClient(types.DefaultMaxTrustingPeriod, false),
+			status:      ibcexported.Active,
+		},
+		{
+			desc:        "ordered channel",
+			channelEnd:  channeltypes.Channel{Ordering: channeltypes.ORDERED, Version: transfertypes.Version},
+			clientState: tmClient(time.Hour, false),
+			status:      ibcexported.Active,
+			err:         types.ErrInvalidChannel,
+		},
+		{
+			desc:        "not an ICS20 version",
+			channelEnd:  channeltypes.Channel{Ordering: channeltypes.UNORDERED, Version: "ics27-1"},
+			clientState: tmClient(time.Hour, false),
+			status:      ibcexported.Active,
+			err:         types.ErrInvalidChannel,
+		},
+		{
+			desc:        "not a tendermint client",
+			channelEnd:  transferChannel,
+			clientState: localhost.NewClientState(clienttypes.NewHeight(0, 1)),
+			status:      ibcexported.Active,
+			err:         types.ErrInvalidCounterpartyClient,
+		},
+		{
+			desc:        "frozen client",
+			channelEnd:  transferChannel,
+			clientState: tmClient(time.Hour, true),
+			status:      ibcexported.Frozen,
+			err:         types.ErrInvalidCounterpartyClient,
+		},
+		{
+			desc:        "expired client",
+			channelEnd:  transferChannel,
+			clientState: tmClient(time.Hour, false),
+			status:      ibcexported.Expired,
+			err:         types.ErrInvalidCounterpartyClient,
+		},
+		{
+			desc:        "trusting period too long",
+			channelEnd:  transferChannel,
+			clientState: tmClient(types.DefaultMaxTrustingPeriod+time.Second, false),
+			status:      ibcexported.Active,
+			err:         types.ErrInvalidCounterpartyClient,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+			clientKeeper := mock_types.NewMockClientKeeper(ctrl)
+			k, ctx := keeper.MintBurnKeeperWithIBCDeps(t, nil, nil, channelKeeper, nil, clientKeeper, nil)
+			require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+			channelKeeper.EXPECT().GetChannel(gomock.Any(), transfertypes.PortID, channel.ChannelId).Return(tc.channelEnd, true)
+			clientKeeper.EXPECT().GetClientState(gomock.Any(), channel.ClientId).Return(tc.clientState, true).AnyTimes()
+			clientKeeper.EXPECT().GetClientStatus(gomock.Any(), tc.clientState, channel.ClientId).Return(tc.status).AnyTimes()
+
+			err := k.AuthenticateCounterparty(ctx, transfertypes.PortID, channel)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) bridgeRouteStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.BridgeRouteKeyPrefix)
+}
+
+func (k Keeper) SetBridgeRoute(ctx sdk.Context, route types.BridgeRoute) {
+	k.bridgeRouteStore(ctx).Set(
+		types.GetBridgeRouteKey(route.CounterpartyChainId, route.RemoteDenom),
+		k.cdc.MustMarshal(&route),
+	)
+}
+
+func (k Keeper) GetBridgeRoute(ctx sdk.Context, chainID, remoteDenom string) (types.BridgeRoute, bool) {
+	bz := k.bridgeRouteStore(ctx).Get(types.GetBridgeRouteKey(chainID, remoteDenom))
+	if bz == nil {
+		return types.BridgeRoute{}, false
+	}
+
+	var route types.BridgeRoute
+	k.cdc.MustUnmarshal(bz, &route)
+	return route, true
+}
+
+func (k Keeper) DeleteBridgeRoute(ctx sdk.Context, chainID, remoteDenom string) {
+	k.bridgeRouteStore(ctx).Delete(types.GetBridgeRouteKey(chainID, remoteDenom))
+}
+
+// GetBridgeRoutesByChain returns all routes registered for the counterparty chain.
+func (k Keeper) GetBridgeRoutesByChain(ctx sdk.Context, chainID string) []types.BridgeRoute {
+	store := prefix.NewStore(k.bridgeRouteStore(ctx), types.GetBridgeRouteChainPrefix(chainID))
+	return k.collectBridgeRoutes(store.Iterator(nil, nil))
+}
+
+// GetAllBridgeRoutes returns all registered routes.
+func (k Keeper) GetAllBridgeRoutes(ctx sdk.Context) []types.BridgeRoute {
+	return k.collectBridgeRoutes(k.bridgeRouteStore(ctx).Iterator(nil, nil))
+}
+
+func (k Keeper) collectBridgeRoutes(iterator storetypes.Iterator) []types.BridgeRoute {
+	defer iterator.Close()
+
+	routes := make([]types.BridgeRoute, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var route types.BridgeRoute
+		k.cdc.MustUnmarshal(iterator.Value(), &route)
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// HasRouteForChannel reports whether at least one route may be used over the channel.
+func (k Keeper) HasRouteForChannel(ctx sdk.Context, channel types.AllowedChannel) bool {
+	for _, route := range k.GetBridgeRoutesByChain(ctx, channel.CounterpartyChainId) {
+		if route.Matches(channel) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPinnedRouteForChannel reports whether a route usable over the channel is pinned to
+// its connection or client. Governance registering such a route approves the counterparty
+// the channel is opened over.
+func (k Keeper) HasPinnedRouteForChannel(ctx sdk.Context, channel types.AllowedChannel) bool {
+	for _, route := range k.GetBridgeRoutesByChain(ctx, channel.CounterpartyChainId) {
+		if route.IsPinned() && route.Matches(channel) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetInboundRoute returns the enabled route minting local tokens for remoteDenom
+// received over an allowed channel.
+func (k Keeper) GetInboundRoute(ctx sdk.Context, channelID, remoteDenom string) (types.BridgeRoute, bool) {
+	channel, found := k.GetAllowedChannel(ctx, channelID)
+	if !found {
+		return types.BridgeRoute{}, false
+	}
+
+	route, found := k.GetBridgeRoute(ctx, channel.CounterpartyChainId, remoteDenom)
+	if !found || !route.Enabled || !route.Matches(channel) {
+		return types.BridgeRoute{}, false
+	}
+	return route, true
+}
+
+// GetOutboundRoute returns the enabled route burning localDenom sent over an
+// allowed channel.
+func (k Keeper) GetOutboundRoute(ctx sdk.Context, channelID, localDenom string) (types.BridgeRoute, bool) {
+	channel, found := k.GetAllowedChannel(ctx, channelID)
+	if !found {
+		return types.BridgeRoute{}, false
+	}
+
+	for _, route := range k.GetBridgeRoutesByChain(ctx, channel.CounterpartyChainId) {
+		if route.LocalDenom == localDenom && route.Enabled && route.Matches(channel) {
+			return route, true
+		}
+	}
+	return types.BridgeRoute{}, false
+}
+
+// ResolveInboundRoute resolves the denom trace of a received ICS20 packet and returns the
+// route minting local tokens for it.
+//
+// When the counterparty is the source of the denom the route's remote denom is matched
+// against the full trace, as the counterparty denominates it, or its ibc/ hash, so that
+// assets forwarded over several hops resolve to the route registered for their trace.
+// When this chain is the source the packet returns a voucher of a local denom; the escrow
+// of bridged tokens is burned on the way out, so the route is returned for them to be
+// minted again instead of unescrowed.
+func (k Keeper) ResolveInboundRoute(ctx sdk.Context, packet channeltypes.Packet, denom string) (types.BridgeRoute, bool) {
+	if ibctransfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, denom) {
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		localDenom := ibctransfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+		return k.GetOutboundRoute(ctx, packet.DestinationChannel, localDenom)
+	}
+
+	if route, found := k.GetInboundRoute(ctx, packet.DestinationChannel, denom); found {
+		return route, true
+	}
+
+	trace := ibctransfertypes.ParseDenomTrace(denom)
+	if trace.Path == "" {
+		return types.BridgeRoute{}, false
+	}
+	return k.GetInboundRoute(ctx, packet.DestinationChannel, trace.IBCDenom())
+}
+
+// ResolveOutboundRoute resolves the denom trace of a sent ICS20 packet and returns the
+// route burning the escrowed local tokens. Vouchers returning to their source are burned
+// by the transfer app and never escrowed, so no route is returned for them.
+func (k Keeper) ResolveOutboundRoute(ctx sdk.Context, packet channeltypes.Packet, denom string) (types.BridgeRoute, bool) {
+	if !ibctransfertypes.SenderChainIsSource(packet.SourcePort, packet.SourceChannel, denom) {
+		return types.BridgeRoute{}, false
+	}
+	return k.GetOutboundRoute(ctx, packet.SourceChannel, ibctransfertypes.ParseDenomTrace(denom).IBCDenom())
+}
+
+// validateLocalDenomUnique makes sure the outbound direction stays unambiguous:
+// a local denom may be bridged to a counterparty chain through one route only.
+func (k Keeper) validateLocalDenomUnique(ctx sdk.Context, route types.BridgeRoute) error {
+	for _, other := range k.GetBridgeRoutesByChain(ctx, route.CounterpartyChainId) {
+		if other.RemoteDenom != route.RemoteDenom && other.LocalDenom == route.LocalDenom {
+			return types.ErrLocalDenomAlreadyBridged.Wrapf(
+				"%s is bridged to %s as %s", route.LocalDenom, route.CounterpartyChainId, other.RemoteDenom,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) channelStatsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelStatsKeyPrefix)
+}
+
+// GetChannelStats returns the cumulative minted and burned amounts of the channel.
+func (k Keeper) GetChannelStats(ctx sdk.Context, channelID string) types.ChannelStats {
+	bz := k.channelStatsStore(ctx).Get([]byte(channelID))
+	if bz == nil {
+		return types.NewChannelStats(channelID)
+	}
+
+	var stats types.ChannelStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+func (k Keeper) SetChannelStats(ctx sdk.Context, stats types.ChannelStats) {
+	k.channelStatsStore(ctx).Set([]byte(stats.ChannelId), k.cdc.MustMarshal(&stats))
+}
+
+// GetAllChannelStats returns the stats of every channel that ever minted or burned.
+func (k Keeper) GetAllChannelStats(ctx sdk.Context) []types.ChannelStats {
+	iterator := k.channelStatsStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	stats := make([]types.ChannelStats, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.ChannelStats
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		stats = append(stats, s)
+	}
+	return stats
+}
+
+func (k Keeper) recordMinted(ctx sdk.Context, channelID string, coin sdk.Coin) {
+	stats := k.GetChannelStats(ctx, channelID)
+	stats.Minted = stats.Minted.Add(coin)
+	k.SetChannelStats(ctx, stats)
+}
+
+func (k Keeper) recordBurned(ctx sdk.Context, channelID string, coin sdk.Coin) {
+	stats := k.GetChannelStats(ctx, channelID)
+	stats.Burned = stats.Burned.Add(coin)
+	k.SetChannelStats(ctx, stats)
+}
+
+// GetSupplyAdjustment returns the cumulative amounts minted and burned by the authority without a channel.
+func (k Keeper) GetSupplyAdjustment(ctx sdk.Context) types.SupplyAdjustment {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyAdjustmentKey)
+	if bz == nil {
+		return types.NewSupplyAdjustment()
+	}
+
+	var adjustment types.SupplyAdjustment
+	k.cdc.MustUnmarshal(bz, &adjustment)
+	// empty coins are decoded as nil
+	if adjustment.Minted == nil {
+		adjustment.Minted = sdk.NewCoins()
+	}
+	if adjustment.Burned == nil {
+		adjustment.Burned = sdk.NewCoins()
+	}
+	return adjustment
+}
+
+func (k Keeper) SetSupplyAdjustment(ctx sdk.Context, adjustment types.SupplyAdjustment) {
+	ctx.KVStore(k.storeKey).Set(types.SupplyAdjustmentKey, k.cdc.MustMarshal(&adjustment))
+}
+
+func (k Keeper) recordAdjustmentMinted(ctx sdk.Context, coin sdk.Coin) {
+	adjustment := k.GetSupplyAdjustment(ctx)
+	adjustment.Minted = adjustment.Minted.Add(coin)
+	k.SetSupplyAdjustment(ctx, adjustment)
+}
+
+func (k Keeper) recordAdjustmentBurned(ctx sdk.Context, coin sdk.Coin) {
+	adjustment := k.GetSupplyAdjustment(ctx)
+	adjustment.Burned = adjustment.Burned.Add(coin)
+	k.SetSupplyAdjustment(ctx, adjustment)
+}
+
+// GetInFlight returns the bridged denoms held in the escrow of the channel, i.e.
+// sent but not yet acknowledged by the counterparty.
+func (k Keeper) GetInFlight(ctx sdk.Context, channelID string) sdk.Coins {
+	channel, found := k.GetAllowedChannel(ctx, channelID)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, channelID)
+	inFlight := sdk.NewCoins()
+	for _, route := range k.GetBridgeRoutesByChain(ctx, channel.CounterpartyChainId) {
+		if !route.Matches(channel) {
+			continue
+		}
+		inFlight = inFlight.Add(k.bankKeeper.GetBalance(ctx, escrowAddr, route.LocalDenom))
+	}
+	return inFlight
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) AllowedChannels(c context.Context, req *types.QueryAllowedChannelsRequest) (*types.QueryAllowedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := make([]types.AllowedChannel, 0)
+	pageRes, err := query.Paginate(k.allowedChannelStore(ctx), req.Pagination, func(_, value []byte) error {
+		var channel types.AllowedChannel
+		k.cdc.MustUnmarshal(value, &channel)
+
+		channels = append(channels, channel)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedChannelsResponse{AllowedChannels: channels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PendingChannels(c context.Context, req *types.QueryPendingChannelsRequest) (*types.QueryPendingChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := make([]types.AllowedChannel, 0)
+	pageRes, err := query.Paginate(k.pendingChannelStore(ctx), req.Pagination, func(_, value []byte) error {
+		var channel types.AllowedChannel
+		k.cdc.MustUnmarshal(value, &channel)
+
+		channels = append(channels, channel)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingChannelsResponse{PendingChannels: channels, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChannelStats(c context.Context, req *types.QueryChannelStatsRequest) (*types.QueryChannelStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChannelStatsResponse{
+		Stats:    k.GetChannelStats(ctx, req.ChannelId),
+		InFlight: k.GetInFlight(ctx, req.ChannelId),
+	}, nil
+}
+
+func (k Keeper) SupplyDrift(c context.Context, req *types.QuerySupplyDriftRequest) (*types.QuerySupplyDriftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySupplyDriftResponse{Denoms: k.GetSupplyReport(ctx)}, nil
+}
+
+func (k Keeper) PendingBurns(c context.Context, req *types.QueryPendingBurnsRequest) (*types.QueryPendingBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	burns := make([]types.PendingBurn, 0)
+	pageRes, err := query.Paginate(k.pendingBurnStore(ctx), req.Pagination, func(_, value []byte) error {
+		var burn types.PendingBurn
+		k.cdc.MustUnmarshal(value, &burn)
+
+		burns = append(burns, burn)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingBurnsResponse{PendingBurns: burns, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ChannelQuotas(c context.Context, req *types.QueryChannelQuotasRequest) (*types.QueryChannelQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChannelQuotasResponse{Quotas: k.GetQuotaStatuses(ctx)}, nil
+}
+
+func (k Keeper) PauseStatus(c context.Context, req *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPauseStatusResponse{
+		Paused:         k.IsPausedGlobally(ctx),
+		PausedChannels: k.GetPausedChannels(ctx),
+	}, nil
+}
+
+func (k Keeper) ReservesStatus(c context.Context, req *types.QueryReservesStatusRequest) (*types.QueryReservesStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reserves := make([]types.ReserveStatus, 0)
+	for _, reserve := range k.GetAllReserves(ctx) {
+		reserves = append(reserves, k.GetReserveStatus(ctx, reserve))
+	}
+
+	return &types.QueryReservesStatusResponse{Reserves: reserves}, nil
+}
+
+func (k Keeper) BridgeRoutes(c context.Context, req *types.QueryBridgeRoutesRequest) (*types.QueryBridgeRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	routes := make([]types.BridgeRoute, 0)
+	pageRes, err := query.Paginate(k.bridgeRouteStore(ctx), req.Pagination, func(_, value []byte) error {
+		var route types.BridgeRoute
+		k.cdc.MustUnmarshal(value, &route)
+
+		routes = append(routes, route)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBridgeRoutesResponse{BridgeRoutes: routes, Pagination: pageRes}, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestParamsQuery(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	response, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
+
+	_, err = k.Params(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestAllowedChannelsQuery(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	channels := []types.AllowedChannel{
+		{ChannelId: "channel-0", CounterpartyChainId: "maany-mainnet", ConnectionId: "connection-0", ClientId: "07-tendermint-0"},
+		{ChannelId: "channel-1", CounterpartyChainId: "maany-mainnet", ConnectionId: "connection-0", ClientId: "07-tendermint-0"},
+		{ChannelId: "channel-2", CounterpartyChainId: "maany-testnet", ConnectionId: "connection-1", ClientId: "07-tendermint-1"},
+	}
+	for _, c := range channels {
+		k.SetAllowedChannel(ctx, c)
+	}
+
+	resp, err := k.AllowedChannels(ctx, &types.QueryAllowedChannelsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, channels, resp.AllowedChannels)
+
+	resp, err = k.AllowedChannels(ctx, &types.QueryAllowedChannelsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, channels[:2], resp.AllowedChannels)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	_, err = k.AllowedChannels(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestBridgeRoutesQuery(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	route := validRoute()
+	k.SetBridgeRoute(ctx, route)
+
+	resp, err := k.BridgeRoutes(ctx, &types.QueryBridgeRoutesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BridgeRoute{route}, resp.BridgeRoutes)
+}
+
+func TestChannelStatsQuery(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	stats := types.ChannelStats{
+		ChannelId: "channel-0",
+		Minted:    sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))),
+		Burned:    sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(3))),
+	}
+	k.SetChannelStats(ctx, stats)
+
+	resp, err := k.ChannelStats(ctx, &types.QueryChannelStatsRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, stats, resp.Stats)
+	require.True(t, resp.InFlight.IsZero())
+
+	// a channel without history has empty stats
+	resp, err = k.ChannelStats(ctx, &types.QueryChannelStatsRequest{ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.Equal(t, types.NewChannelStats("channel-1"), resp.Stats)
+
+	_, err = k.ChannelStats(ctx, &types.QueryChannelStatsRequest{ChannelId: "invalid"})
+	require.Error(t, err)
+}
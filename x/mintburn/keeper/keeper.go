@@ -1,96 +1,125 @@
-package mintburn
+package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
-	"cosmossdk.io/store/prefix"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	bankKeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/gogoproto/proto"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
-	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
 )
 
-type ChannelKeeper interface {
-	GetChannel(ctx sdk.Context, srcPort, srcChannel string) (channeltypes.Channel, bool)
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	bankKeeper       types.BankKeeper
+	transferKeeper   types.TransferKeeper
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+	clientKeeper     types.ClientKeeper
+	icqKeeper        types.InterchainQueriesKeeper
+	authority        string
 }
 
-type ConnectionKeeper interface {
-	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
+	icqKeeper types.InterchainQueriesKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
+		bankKeeper:       bankKeeper,
+		transferKeeper:   transferKeeper,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		clientKeeper:     clientKeeper,
+		icqKeeper:        icqKeeper,
+		authority:        authority,
+	}
 }
 
-type ClientKeeper interface {
-	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
-type Keeper struct {
-    ModuleName string
-    StoreKey      storetypes.StoreKey
-    BankKeeper bankKeeper.Keeper
-    ChannelKeeper    ChannelKeeper
-	ConnectionKeeper ConnectionKeeper
-	ClientKeeper     ClientKeeper
-
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func NewKeeper(moduleName string, storeKey storetypes.StoreKey, bankKeeper bankKeeper.Keeper, channelKeeper ChannelKeeper,
-	connectionKeeper ConnectionKeeper,
-	clientKeeper ClientKeeper ) Keeper {
-    return Keeper{
-        ModuleName: moduleName,
-        StoreKey:   storeKey,
-        BankKeeper: bankKeeper,
-        ChannelKeeper:    channelKeeper,
-		ConnectionKeeper: connectionKeeper,
-		ClientKeeper:     clientKeeper,
-    }
+// EmitEvent emits a typed event. A failure can only come from marshaling the event
+// and is logged instead of aborting the bridge flow.
+func (k Keeper) EmitEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "event", proto.MessageName(event), "error", err)
+	}
 }
 
-// Logger returns a module-specific logger.
-func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/-"+"mintburn")
-}
+// MintTokens mints amount received over the channel to the recipient.
+func (k Keeper) MintTokens(ctx sdk.Context, channelID string, recipient sdk.AccAddress, amount sdk.Coin) error {
+	// Mint tokens to the module account
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
 
-func (k Keeper) MintTokens(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
-    // Mint tokens to the module account
-    ctx.Logger().Info("Now in MintTokens in Keeper")
-    err := k.BankKeeper.MintCoins(ctx, k.ModuleName, sdk.NewCoins(amount))
-    if err != nil {
-        return err
-    }
+	// Send minted tokens to the recipient
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
 
-    // Send minted tokens to the recipient
-    return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, k.ModuleName, recipient, sdk.NewCoins(amount))
+	k.recordMinted(ctx, channelID, amount)
+	return nil
 }
 
 func (k Keeper) GetBalances(ctx sdk.Context, address sdk.AccAddress) error {
-    ctx.Logger().Info("Now in Get Balance in Keeper")
-    balances := k.BankKeeper.GetAllBalances(ctx, address)
-    ctx.Logger().Info("The transfer module account balances are: ", "balamces", balances.String())
-    return nil
+	balances := k.bankKeeper.GetAllBalances(ctx, address)
+	k.Logger(ctx).Info("The transfer module account balances are: ", "balances", balances.String())
+	return nil
 }
 
-func (k Keeper) BurnTokens(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
-
-    if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, ibctransfertypes.ModuleName, k.ModuleName, sdk.NewCoins(amount)); err != nil {
-		ctx.Logger().Error("Failed to redirect tokens to module account", "error", err)
-	    return err
-    }
+func (k Keeper) BurnTokens(ctx sdk.Context, _ sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, ibctransfertypes.ModuleName, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		k.Logger(ctx).Error("Failed to redirect tokens to module account", "error", err)
+		return err
+	}
 
-    return nil
+	return nil
 }
 
-func (k Keeper) IsAllowedChannel(ctx sdk.Context, channelID string) bool {
-	store := prefix.NewStore(ctx.KVStore(k.StoreKey), []byte("allowed-channel/"))
-	return store.Has([]byte(channelID))
-}
+// BurnEscrowedTokens burns coin from the transfer escrow account of the channel, lowering
+// the total the transfer module tracks as escrowed for its denom along with it.
+// Either the whole burn is applied or, on error, nothing is.
+func (k Keeper) BurnEscrowedTokens(ctx sdk.Context, channelID string, coin sdk.Coin) error {
+	cacheCtx, writeCache := ctx.CacheContext()
 
+	escrowAddr := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, channelID)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(cacheCtx, escrowAddr, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
 
-func (k Keeper) BurnEscrowedTokens(ctx sdk.Context, escrowAddr sdk.AccAddress, coin sdk.Coin) error {
-    	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, escrowAddr, k.ModuleName, sdk.NewCoins(coin)); err != nil {
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
 		return err
 	}
 
-	return k.BankKeeper.BurnCoins(ctx, k.ModuleName, sdk.NewCoins(coin))
-}
\ No newline at end of file
+	// Tokens sent to an escrow account directly are not tracked, the total can't go below zero
+	totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(cacheCtx, coin.Denom)
+	totalEscrow.Amount = totalEscrow.Amount.Sub(sdkmath.MinInt(totalEscrow.Amount, coin.Amount))
+	k.transferKeeper.SetTotalEscrowForDenom(cacheCtx, totalEscrow)
+
+	k.recordBurned(cacheCtx, channelID, coin)
+	writeCache()
+	return nil
+}
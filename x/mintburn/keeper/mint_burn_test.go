@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	mintburnkeeper "github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestMsgMintValidate(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	valid := types.MsgMint{
+		Authority: authority,
+		Recipient: testutil.TestOwnerAddress,
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		Reason:    "reconcile failed burn",
+	}
+
+	tests := []struct {
+		name        string
+		msg         func(m *types.MsgMint)
+		expectedErr string
+	}{
+		{
+			"wrong authority",
+			func(m *types.MsgMint) { m.Authority = testutil.TestOwnerAddress },
+			"invalid authority",
+		},
+		{
+			"invalid recipient",
+			func(m *types.MsgMint) { m.Recipient = "neutron" },
+			"recipient is invalid",
+		},
+		{
+			"zero amount",
+			func(m *types.MsgMint) { m.Amount = sdk.NewInt64Coin("stake", 0) },
+			"amount must be positive",
+		},
+		{
+			"empty reason",
+			func(m *types.MsgMint) { m.Reason = " " },
+			"reason must not be empty",
+		},
+		{
+			"invalid channel",
+			func(m *types.MsgMint) { m.ChannelId = "c" },
+			"invalid channel_id",
+		},
+		{
+			"channel not allowed",
+			func(m *types.MsgMint) { m.ChannelId = "channel-0" },
+			"channel channel-0 is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := valid
+			tt.msg(&msg)
+			resp, err := k.Mint(ctx, &msg)
+			require.ErrorContains(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMintAndBurn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	transferKeeper := mock_types.NewMockTransferKeeper(ctrl)
+	k, ctx := keeper.MintBurnKeeperWithEscrowDeps(t, bankKeeper, transferKeeper)
+
+	recipient := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	k.SetAllowedChannel(ctx, types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: "maany-mainnet",
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	})
+
+	// a supply correction is not accounted to any channel
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, coins).Return(nil)
+	_, err := k.Mint(ctx, &types.MsgMint{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    coins[0],
+		Reason:    "correction",
+	})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllChannelStats(ctx))
+	require.Equal(t, coins, k.GetSupplyAdjustment(ctx).Minted)
+
+	// a channel mint is
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, coins).Return(nil)
+	_, err = k.Mint(ctx, &types.MsgMint{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    coins[0],
+		Reason:    "replay lost packet",
+		ChannelId: "channel-0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, coins, k.GetChannelStats(ctx, "channel-0").Minted)
+
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	_, err = k.Burn(ctx, &types.MsgBurn{Authority: authority, Amount: coins[0], Reason: "correction"})
+	require.NoError(t, err)
+	require.Equal(t, coins, k.GetSupplyAdjustment(ctx).Burned)
+
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), escrow, types.ModuleName, coins).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil)
+	transferKeeper.EXPECT().GetTotalEscrowForDenom(gomock.Any(), "stake").Return(sdk.NewInt64Coin("stake", 250))
+	transferKeeper.EXPECT().SetTotalEscrowForDenom(gomock.Any(), sdk.NewInt64Coin("stake", 150))
+	_, err = k.Burn(ctx, &types.MsgBurn{Authority: authority, Amount: coins[0], Reason: "stuck escrow", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, coins, k.GetChannelStats(ctx, "channel-0").Burned)
+
+	var mints, burns int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case "neutron.mintburn.EventAuthorityMint":
+			mints++
+		case "neutron.mintburn.EventAuthorityBurn":
+			burns++
+			for _, attr := range event.Attributes {
+				if attr.Key == "source" {
+					require.Contains(t, []string{
+						`"` + authtypes.NewModuleAddress(types.ModuleName).String() + `"`,
+						`"` + escrow.String() + `"`,
+					}, attr.Value)
+				}
+			}
+		}
+	}
+	require.Equal(t, 2, mints)
+	require.Equal(t, 2, burns)
+}
+
+func TestChannelLessMintAndBurnKeepSupplyReconciled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	supply := sdkmath.NewInt(500)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetSupply(gomock.Any(), "stake").DoAndReturn(func(_ any, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, supply)
+	}).AnyTimes()
+	bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(func(_ any, _ string, amt sdk.Coins) error {
+		supply = supply.Add(amt.AmountOf("stake"))
+		return nil
+	}).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(func(_ any, _ string, amt sdk.Coins) error {
+		supply = supply.Sub(amt.AmountOf("stake"))
+		return nil
+	}).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	k, ctx := keeper.MintBurnKeeperWithDeps(t, bankKeeper)
+
+	_, err := k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: validRoute()})
+	require.NoError(t, err)
+
+	assertReconciled := func() {
+		resp, err := k.SupplyDrift(ctx, &types.QuerySupplyDriftRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Denoms, 1)
+		require.True(t, resp.Denoms[0].Drift.IsZero(), "drift %s", resp.Denoms[0].Drift)
+		_, broken := mintburnkeeper.AllInvariants(k)(ctx)
+		require.False(t, broken)
+	}
+
+	// a correction of the bridged denom without a channel
+	_, err = k.Mint(ctx, &types.MsgMint{
+		Authority: authority,
+		Recipient: testutil.TestOwnerAddress,
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		Reason:    "correction",
+	})
+	require.NoError(t, err)
+	assertReconciled()
+
+	_, err = k.Burn(ctx, &types.MsgBurn{Authority: authority, Amount: sdk.NewInt64Coin("stake", 30), Reason: "correction"})
+	require.NoError(t, err)
+	assertReconciled()
+
+	resp, err := k.SupplyDrift(ctx, &types.QuerySupplyDriftRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(100), resp.Denoms[0].AdjustedMinted)
+	require.Equal(t, sdkmath.NewInt(30), resp.Denoms[0].AdjustedBurned)
+	require.Equal(t, sdkmath.NewInt(570), resp.Denoms[0].Supply)
+}
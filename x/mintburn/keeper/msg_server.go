@@ -1,9 +1,311 @@
-package mintburn
+package keeper
 
-type MsgServer struct {
-    Keeper
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k Keeper) checkAuthority(authority string) error {
+	if k.authority != authority {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// RegisterBridgeRoute adds a new bridge route
+func (k Keeper) RegisterBridgeRoute(goCtx context.Context, req *types.MsgRegisterBridgeRoute) (*types.MsgRegisterBridgeRouteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRegisterBridgeRoute")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetBridgeRoute(ctx, req.BridgeRoute.CounterpartyChainId, req.BridgeRoute.RemoteDenom); found {
+		return nil, errors.Wrapf(types.ErrBridgeRouteExists, "%s/%s", req.BridgeRoute.CounterpartyChainId, req.BridgeRoute.RemoteDenom)
+	}
+	if err := k.validateLocalDenomUnique(ctx, req.BridgeRoute); err != nil {
+		return nil, err
+	}
+
+	k.SetBridgeRoute(ctx, req.BridgeRoute)
+	k.EnsureSupplyBaseline(ctx, req.BridgeRoute.LocalDenom)
+
+	return &types.MsgRegisterBridgeRouteResponse{}, nil
+}
+
+// UpdateBridgeRoute replaces an existing bridge route
+func (k Keeper) UpdateBridgeRoute(goCtx context.Context, req *types.MsgUpdateBridgeRoute) (*types.MsgUpdateBridgeRouteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateBridgeRoute")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetBridgeRoute(ctx, req.BridgeRoute.CounterpartyChainId, req.BridgeRoute.RemoteDenom); !found {
+		return nil, errors.Wrapf(types.ErrBridgeRouteNotFound, "%s/%s", req.BridgeRoute.CounterpartyChainId, req.BridgeRoute.RemoteDenom)
+	}
+	if err := k.validateLocalDenomUnique(ctx, req.BridgeRoute); err != nil {
+		return nil, err
+	}
+
+	k.SetBridgeRoute(ctx, req.BridgeRoute)
+	k.EnsureSupplyBaseline(ctx, req.BridgeRoute.LocalDenom)
+
+	return &types.MsgUpdateBridgeRouteResponse{}, nil
+}
+
+// RemoveBridgeRoute deletes a bridge route
+func (k Keeper) RemoveBridgeRoute(goCtx context.Context, req *types.MsgRemoveBridgeRoute) (*types.MsgRemoveBridgeRouteResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveBridgeRoute")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetBridgeRoute(ctx, req.CounterpartyChainId, req.RemoteDenom); !found {
+		return nil, errors.Wrapf(types.ErrBridgeRouteNotFound, "%s/%s", req.CounterpartyChainId, req.RemoteDenom)
+	}
+
+	k.DeleteBridgeRoute(ctx, req.CounterpartyChainId, req.RemoteDenom)
+
+	return &types.MsgRemoveBridgeRouteResponse{}, nil
 }
 
-func NewMsgServer(keeper Keeper) MsgServer {
-    return MsgServer{Keeper: keeper}
+// UpdateParams updates the module parameters
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetChannelQuota creates or replaces a mint quota. The usage of a replaced quota is kept.
+func (k Keeper) SetChannelQuota(goCtx context.Context, req *types.MsgSetChannelQuota) (*types.MsgSetChannelQuotaResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetChannelQuota")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SaveChannelQuota(ctx, req.Quota)
+
+	return &types.MsgSetChannelQuotaResponse{}, nil
+}
+
+// RemoveChannelQuota deletes a mint quota together with its usage
+func (k Keeper) RemoveChannelQuota(goCtx context.Context, req *types.MsgRemoveChannelQuota) (*types.MsgRemoveChannelQuotaResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveChannelQuota")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetChannelQuota(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errors.Wrapf(types.ErrChannelQuotaNotFound, "%s/%s", req.ChannelId, req.Denom)
+	}
+
+	k.DeleteChannelQuota(ctx, req.ChannelId, req.Denom)
+
+	return &types.MsgRemoveChannelQuotaResponse{}, nil
+}
+
+// PauseBridge stops minting over a channel, or over every channel if none is given
+func (k Keeper) PauseBridge(goCtx context.Context, req *types.MsgPauseBridge) (*types.MsgPauseBridgeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPauseBridge")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.ChannelId == "" {
+		k.SetPausedGlobally(ctx, true)
+	} else {
+		k.SetChannelPaused(ctx, req.ChannelId, true)
+	}
+	k.Logger(ctx).Info("Bridge paused", "channel", req.ChannelId)
+
+	return &types.MsgPauseBridgeResponse{}, nil
+}
+
+// ResumeBridge lifts a pause previously set for the same channel, or the global one if none is given
+func (k Keeper) ResumeBridge(goCtx context.Context, req *types.MsgResumeBridge) (*types.MsgResumeBridgeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgResumeBridge")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.ChannelId == "" {
+		k.SetPausedGlobally(ctx, false)
+	} else {
+		k.SetChannelPaused(ctx, req.ChannelId, false)
+	}
+	k.Logger(ctx).Info("Bridge resumed", "channel", req.ChannelId)
+
+	return &types.MsgResumeBridgeResponse{}, nil
+}
+
+// Mint mints tokens to the recipient to reconcile the bridge supply
+func (k Keeper) Mint(goCtx context.Context, req *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgMint")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recipient := sdk.MustAccAddressFromBech32(req.Recipient)
+	if req.ChannelId != "" {
+		if !k.IsAllowedChannel(ctx, req.ChannelId) {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is not allowed", req.ChannelId)
+		}
+		if err := k.MintTokens(ctx, req.ChannelId, recipient, req.Amount); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(req.Amount)); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(req.Amount)); err != nil {
+			return nil, err
+		}
+		// not accounted to any channel, but still part of the reconciled supply
+		k.recordAdjustmentMinted(ctx, req.Amount)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAuthorityMint{
+		Authority: req.Authority,
+		Recipient: req.Recipient,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+		ChannelId: req.ChannelId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns tokens held by the module account or by a channel escrow to reconcile the bridge supply
+func (k Keeper) Burn(goCtx context.Context, req *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBurn")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var source sdk.AccAddress
+	if req.ChannelId != "" {
+		if !k.IsAllowedChannel(ctx, req.ChannelId) {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %s is not allowed", req.ChannelId)
+		}
+		if err := k.BurnEscrowedTokens(ctx, req.ChannelId, req.Amount); err != nil {
+			return nil, err
+		}
+		source = ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, req.ChannelId)
+	} else {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(req.Amount)); err != nil {
+			return nil, err
+		}
+		k.recordAdjustmentBurned(ctx, req.Amount)
+		source = authtypes.NewModuleAddress(types.ModuleName)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAuthorityBurn{
+		Authority: req.Authority,
+		Source:    source.String(),
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+		ChannelId: req.ChannelId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ApproveChannel allow-lists a channel opened over a counterparty no route is pinned to
+func (k Keeper) ApproveChannel(goCtx context.Context, req *types.MsgApproveChannel) (*types.MsgApproveChannelResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgApproveChannel")
+	}
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetPendingChannel(ctx, req.ChannelId); !found {
+		return nil, errors.Wrapf(types.ErrChannelNotPending, "channel %s", req.ChannelId)
+	}
+
+	// the client may have been frozen or expired since the channel was opened
+	channel, err := k.ResolveCounterparty(ctx, ibctransfertypes.PortID, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.AuthenticateCounterparty(ctx, ibctransfertypes.PortID, channel); err != nil {
+		return nil, err
+	}
+	if !k.HasRouteForChannel(ctx, channel) {
+		return nil, errors.Wrapf(types.ErrBridgeRouteNotFound, "no route may be used over channel %s", req.ChannelId)
+	}
+
+	k.RemovePendingChannel(ctx, req.ChannelId)
+	k.SetAllowedChannel(ctx, channel)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventChannelAllowed{
+		ChannelId:           channel.ChannelId,
+		CounterpartyChainId: channel.CounterpartyChainId,
+		ConnectionId:        channel.ConnectionId,
+		ClientId:            channel.ClientId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveChannelResponse{}, nil
 }
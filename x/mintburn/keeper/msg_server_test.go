@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"testing"
+
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+var authority = authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String()
+
+func validRoute() types.BridgeRoute {
+	return types.BridgeRoute{
+		CounterpartyChainId: "maany-mainnet",
+		RemoteDenom:         "stake",
+		LocalDenom:          "stake",
+		Enabled:             true,
+	}
+}
+
+func TestMsgRegisterBridgeRouteValidate(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	tests := []struct {
+		name        string
+		route       func(r *types.BridgeRoute)
+		authority   string
+		expectedErr string
+	}{
+		{
+			"invalid authority",
+			func(_ *types.BridgeRoute) {},
+			"invalid authority",
+			"authority is invalid",
+		},
+		{
+			"wrong authority",
+			func(_ *types.BridgeRoute) {},
+			testutil.TestOwnerAddress,
+			"invalid authority",
+		},
+		{
+			"empty chain id",
+			func(r *types.BridgeRoute) { r.CounterpartyChainId = "" },
+			authority,
+			"counterparty_chain_id must not be empty",
+		},
+		{
+			"chain id with a slash",
+			func(r *types.BridgeRoute) { r.CounterpartyChainId = "maany/mainnet" },
+			authority,
+			"counterparty_chain_id must not contain '/'",
+		},
+		{
+			"invalid connection id",
+			func(r *types.BridgeRoute) { r.ConnectionId = "c" },
+			authority,
+			"invalid connection_id",
+		},
+		{
+			"invalid client id",
+			func(r *types.BridgeRoute) { r.ClientId = "c" },
+			authority,
+			"invalid client_id",
+		},
+		{
+			"invalid remote denom",
+			func(r *types.BridgeRoute) { r.RemoteDenom = "" },
+			authority,
+			"invalid remote_denom",
+		},
+		{
+			"invalid local denom",
+			func(r *types.BridgeRoute) { r.LocalDenom = "1" },
+			authority,
+			"invalid local_denom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := validRoute()
+			tt.route(&route)
+			resp, err := k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{
+				Authority:   tt.authority,
+				BridgeRoute: route,
+			})
+			require.ErrorContains(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestBridgeRouteLifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetSupply(gomock.Any(), gomock.Any()).DoAndReturn(func(_ any, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, 0)
+	}).AnyTimes()
+	k, ctx := keeper.MintBurnKeeperWithDeps(t, bankKeeper)
+
+	route := validRoute()
+	_, err := k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: route})
+	require.NoError(t, err)
+
+	got, found := k.GetBridgeRoute(ctx, route.CounterpartyChainId, route.RemoteDenom)
+	require.True(t, found)
+	require.Equal(t, route, got)
+
+	// the same route can't be registered twice
+	_, err = k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: route})
+	require.ErrorIs(t, err, types.ErrBridgeRouteExists)
+
+	// a second asset to the same chain can't reuse the local denom
+	second := validRoute()
+	second.RemoteDenom = "uatom"
+	_, err = k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: second})
+	require.ErrorIs(t, err, types.ErrLocalDenomAlreadyBridged)
+
+	second.LocalDenom = "uatom"
+	_, err = k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: second})
+	require.NoError(t, err)
+	require.Len(t, k.GetBridgeRoutesByChain(ctx, route.CounterpartyChainId), 2)
+	require.Len(t, k.GetBridgeRoutesByChain(ctx, "maany-testnet"), 0)
+
+	// update
+	route.Enabled = false
+	route.ConnectionId = "connection-1"
+	_, err = k.UpdateBridgeRoute(ctx, &types.MsgUpdateBridgeRoute{Authority: authority, BridgeRoute: route})
+	require.NoError(t, err)
+	got, _ = k.GetBridgeRoute(ctx, route.CounterpartyChainId, route.RemoteDenom)
+	require.Equal(t, route, got)
+
+	missing := validRoute()
+	missing.CounterpartyChainId = "maany-testnet"
+	_, err = k.UpdateBridgeRoute(ctx, &types.MsgUpdateBridgeRoute{Authority: authority, BridgeRoute: missing})
+	require.ErrorIs(t, err, types.ErrBridgeRouteNotFound)
+
+	// remove
+	_, err = k.RemoveBridgeRoute(ctx, &types.MsgRemoveBridgeRoute{
+		Authority:           authority,
+		CounterpartyChainId: route.CounterpartyChainId,
+		RemoteDenom:         route.RemoteDenom,
+	})
+	require.NoError(t, err)
+	_, found = k.GetBridgeRoute(ctx, route.CounterpartyChainId, route.RemoteDenom)
+	require.False(t, found)
+	require.Len(t, k.GetAllBridgeRoutes(ctx), 1)
+
+	_, err = k.RemoveBridgeRoute(ctx, &types.MsgRemoveBridgeRoute{
+		Authority:           authority,
+		CounterpartyChainId: route.CounterpartyChainId,
+		RemoteDenom:         route.RemoteDenom,
+	})
+	require.ErrorIs(t, err, types.ErrBridgeRouteNotFound)
+}
+
+func TestRouteLookup(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	route := validRoute()
+	route.RemoteDenom = "uremote"
+	route.LocalDenom = "ulocal"
+	route.ConnectionId = "connection-0"
+	k.SetBridgeRoute(ctx, route)
+
+	k.SetAllowedChannel(ctx, types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: route.CounterpartyChainId,
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	})
+	k.SetAllowedChannel(ctx, types.AllowedChannel{
+		ChannelId:           "channel-1",
+		CounterpartyChainId: route.CounterpartyChainId,
+		ConnectionId:        "connection-1",
+		ClientId:            "07-tendermint-1",
+	})
+
+	_, found := k.GetInboundRoute(ctx, "channel-0", "uremote")
+	require.True(t, found)
+	_, found = k.GetOutboundRoute(ctx, "channel-0", "ulocal")
+	require.True(t, found)
+	_, found = k.GetInboundRoute(ctx, "channel-0", "ulocal")
+	require.False(t, found)
+
+	// the route is pinned to connection-0
+	_, found = k.GetInboundRoute(ctx, "channel-1", "uremote")
+	require.False(t, found)
+	// unknown channel
+	_, found = k.GetOutboundRoute(ctx, "channel-2", "ulocal")
+	require.False(t, found)
+
+	route.Enabled = false
+	k.SetBridgeRoute(ctx, route)
+	_, found = k.GetInboundRoute(ctx, "channel-0", "uremote")
+	require.False(t, found)
+}
+
+func TestResolveRouteThroughTrace(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	forwarded := validRoute()
+	forwarded.RemoteDenom = "transfer/channel-9/uatom"
+	forwarded.LocalDenom = "uatom"
+	k.SetBridgeRoute(ctx, forwarded)
+
+	hashed := validRoute()
+	hashed.RemoteDenom = transfertypes.ParseDenomTrace("transfer/channel-7/uosmo").IBCDenom()
+	hashed.LocalDenom = "uosmo"
+	k.SetBridgeRoute(ctx, hashed)
+
+	native := validRoute()
+	k.SetBridgeRoute(ctx, native)
+
+	k.SetAllowedChannel(ctx, types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: native.CounterpartyChainId,
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	})
+
+	// received over channel-0 from the counterparty's channel-3
+	recv := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-3",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	for denom, localDenom := range map[string]string{
+		"stake":                    "stake",
+		"transfer/channel-9/uatom": "uatom",
+		"transfer/channel-7/uosmo": "uosmo",
+		// a voucher of the local denom returning from the counterparty
+		"transfer/channel-3/stake": "stake",
+	} {
+		route, found := k.ResolveInboundRoute(ctx, recv, denom)
+		require.True(t, found, denom)
+		require.Equal(t, localDenom, route.LocalDenom, denom)
+	}
+	for _, denom := range []string{"uatom", "transfer/channel-8/uatom", "transfer/channel-3/uother"} {
+		_, found := k.ResolveInboundRoute(ctx, recv, denom)
+		require.False(t, found, denom)
+	}
+
+	// sent over channel-0 to the counterparty's channel-3
+	sent := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-3",
+	}
+	route, found := k.ResolveOutboundRoute(ctx, sent, "uatom")
+	require.True(t, found)
+	require.Equal(t, forwarded, route)
+	// a voucher returning to its source is not escrowed
+	_, found = k.ResolveOutboundRoute(ctx, sent, "transfer/channel-0/stake")
+	require.False(t, found)
+}
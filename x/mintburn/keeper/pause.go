@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) pausedChannelStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedChannelKeyPrefix)
+}
+
+// IsBridgePaused returns true if minting over the channel is paused, either for the
+// channel alone or for the whole bridge.
+func (k Keeper) IsBridgePaused(ctx sdk.Context, channelID string) bool {
+	return k.IsPausedGlobally(ctx) || k.pausedChannelStore(ctx).Has([]byte(channelID))
+}
+
+func (k Keeper) IsPausedGlobally(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BridgePausedKey)
+}
+
+func (k Keeper) SetPausedGlobally(ctx sdk.Context, paused bool) {
+	if paused {
+		ctx.KVStore(k.storeKey).Set(types.BridgePausedKey, []byte{1})
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.BridgePausedKey)
+	}
+}
+
+func (k Keeper) SetChannelPaused(ctx sdk.Context, channelID string, paused bool) {
+	if paused {
+		k.pausedChannelStore(ctx).Set([]byte(channelID), []byte{1})
+	} else {
+		k.pausedChannelStore(ctx).Delete([]byte(channelID))
+	}
+}
+
+// GetPausedChannels returns the channels paused individually.
+func (k Keeper) GetPausedChannels(ctx sdk.Context) []string {
+	iterator := k.pausedChannelStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	channels := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		channels = append(channels, string(iterator.Key()))
+	}
+	return channels
+}
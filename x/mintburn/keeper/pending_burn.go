@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) pendingBurnStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingBurnKeyPrefix)
+}
+
+func (k Keeper) GetPendingBurn(ctx sdk.Context, channelID string, sequence uint64) (types.PendingBurn, bool) {
+	bz := k.pendingBurnStore(ctx).Get(types.GetPendingBurnKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingBurn{}, false
+	}
+
+	var burn types.PendingBurn
+	k.cdc.MustUnmarshal(bz, &burn)
+	return burn, true
+}
+
+func (k Keeper) SetPendingBurn(ctx sdk.Context, burn types.PendingBurn) {
+	k.pendingBurnStore(ctx).Set(types.GetPendingBurnKey(burn.ChannelId, burn.Sequence), k.cdc.MustMarshal(&burn))
+}
+
+func (k Keeper) RemovePendingBurn(ctx sdk.Context, channelID string, sequence uint64) {
+	k.pendingBurnStore(ctx).Delete(types.GetPendingBurnKey(channelID, sequence))
+}
+
+// GetAllPendingBurns returns every acknowledged transfer still waiting for its burn.
+func (k Keeper) GetAllPendingBurns(ctx sdk.Context) []types.PendingBurn {
+	return k.getPendingBurns(ctx, 0)
+}
+
+// getPendingBurns returns up to limit pending burns, or all of them if limit is 0.
+func (k Keeper) getPendingBurns(ctx sdk.Context, limit int) []types.PendingBurn {
+	iterator := storetypes.KVStorePrefixIterator(k.pendingBurnStore(ctx), nil)
+	defer iterator.Close()
+
+	burns := make([]types.PendingBurn, 0)
+	for ; iterator.Valid() && (limit == 0 || len(burns) < limit); iterator.Next() {
+		var burn types.PendingBurn
+		k.cdc.MustUnmarshal(iterator.Value(), &burn)
+		burns = append(burns, burn)
+	}
+	return burns
+}
+
+// BurnOrQueue burns the escrowed amount of an acknowledged packet. If the burn fails
+// the amount stays in escrow and is queued to be retried at the end of every block.
+func (k Keeper) BurnOrQueue(ctx sdk.Context, burn types.PendingBurn) {
+	err := k.BurnEscrowedTokens(ctx, burn.ChannelId, burn.Amount)
+	if err == nil {
+		k.EmitEvent(ctx, &types.EventBridgeBurn{
+			ChannelId: burn.ChannelId,
+			Sequence:  burn.Sequence,
+			Amount:    burn.Amount,
+			Sender:    burn.Sender,
+			Receiver:  burn.Receiver,
+		})
+		return
+	}
+
+	burn.Attempts = 1
+	burn.LastError = err.Error()
+	k.SetPendingBurn(ctx, burn)
+	k.emitBurnFailed(ctx, burn)
+}
+
+// RetryPendingBurns retries up to MaxPendingBurnRetriesPerBlock queued burns. Successful
+// burns leave the queue; failed ones are kept with their attempt counter bumped.
+func (k Keeper) RetryPendingBurns(ctx sdk.Context) {
+	for _, burn := range k.getPendingBurns(ctx, types.MaxPendingBurnRetriesPerBlock) {
+		if err := k.BurnEscrowedTokens(ctx, burn.ChannelId, burn.Amount); err != nil {
+			burn.Attempts++
+			burn.LastError = err.Error()
+			k.SetPendingBurn(ctx, burn)
+			k.emitBurnFailed(ctx, burn)
+			continue
+		}
+
+		k.RemovePendingBurn(ctx, burn.ChannelId, burn.Sequence)
+		k.EmitEvent(ctx, &types.EventBridgeBurn{
+			ChannelId: burn.ChannelId,
+			Sequence:  burn.Sequence,
+			Amount:    burn.Amount,
+			Sender:    burn.Sender,
+			Receiver:  burn.Receiver,
+			Attempts:  burn.Attempts,
+		})
+	}
+}
+
+func (k Keeper) emitBurnFailed(ctx sdk.Context, burn types.PendingBurn) {
+	k.EmitEvent(ctx, &types.EventBridgeBurnFailed{
+		ChannelId: burn.ChannelId,
+		Sequence:  burn.Sequence,
+		Amount:    burn.Amount,
+		Sender:    burn.Sender,
+		Receiver:  burn.Receiver,
+		Attempts:  burn.Attempts,
+		Error:     burn.LastError,
+	})
+}
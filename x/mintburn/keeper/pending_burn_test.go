@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestBurnOrQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	transferKeeper := mock_types.NewMockTransferKeeper(ctrl)
+	k, ctx := keeper.MintBurnKeeperWithEscrowDeps(t, bankKeeper, transferKeeper)
+
+	// the total escrowed tracked by the transfer module, lowered by every burn
+	totalEscrow := sdk.NewInt64Coin("stake", 150)
+	transferKeeper.EXPECT().GetTotalEscrowForDenom(gomock.Any(), "stake").DoAndReturn(func(_ sdk.Context, _ string) sdk.Coin {
+		return totalEscrow
+	}).AnyTimes()
+	transferKeeper.EXPECT().SetTotalEscrowForDenom(gomock.Any(), gomock.Any()).Do(func(_ sdk.Context, coin sdk.Coin) {
+		totalEscrow = coin
+	}).AnyTimes()
+
+	burn := types.PendingBurn{
+		ChannelId: "channel-0",
+		Sequence:  1,
+		Amount:    sdk.NewInt64Coin("stake", 100),
+		Sender:    "neutron1sender",
+		Receiver:  "maany1receiver",
+	}
+	eventTypes := func(ctx sdk.Context) []string {
+		var seen []string
+		for _, event := range ctx.EventManager().Events() {
+			seen = append(seen, event.Type)
+		}
+		return seen
+	}
+
+	// the burn goes through right away
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(nil)
+	okCtx := ctx.WithEventManager(sdk.NewEventManager())
+	k.BurnOrQueue(okCtx, burn)
+	require.Empty(t, k.GetAllPendingBurns(okCtx))
+	require.Equal(t, []string{"neutron.mintburn.EventBridgeBurn"}, eventTypes(okCtx))
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), totalEscrow)
+
+	// the burn fails and is queued
+	burn.Sequence = 2
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(errors.New("insufficient funds"))
+	failCtx := ctx.WithEventManager(sdk.NewEventManager())
+	k.BurnOrQueue(failCtx, burn)
+	pending, found := k.GetPendingBurn(failCtx, "channel-0", 2)
+	require.True(t, found)
+	require.Equal(t, uint64(1), pending.Attempts)
+	require.Equal(t, "insufficient funds", pending.LastError)
+	require.Equal(t, burn.Receiver, pending.Receiver)
+	require.Equal(t, []string{"neutron.mintburn.EventBridgeBurnFailed"}, eventTypes(failCtx))
+	require.Equal(t, sdk.NewInt64Coin("stake", 50), totalEscrow)
+
+	// retried until it succeeds
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(errors.New("insufficient funds"))
+	k.RetryPendingBurns(ctx)
+	pending, _ = k.GetPendingBurn(ctx, "channel-0", 2)
+	require.Equal(t, uint64(2), pending.Attempts)
+
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(burn.Amount)).Return(nil)
+	retryCtx := ctx.WithEventManager(sdk.NewEventManager())
+	k.RetryPendingBurns(retryCtx)
+	require.Empty(t, k.GetAllPendingBurns(retryCtx))
+	require.Equal(t, []string{"neutron.mintburn.EventBridgeBurn"}, eventTypes(retryCtx))
+	// tokens sent to the escrow account directly are not tracked, the total stops at zero
+	require.True(t, totalEscrow.IsZero())
+	require.Equal(t, sdk.NewCoins(burn.Amount.Add(burn.Amount)), k.GetChannelStats(ctx, "channel-0").Burned)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) channelQuotaStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelQuotaKeyPrefix)
+}
+
+func (k Keeper) quotaUsageStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.QuotaUsageKeyPrefix)
+}
+
+func (k Keeper) GetChannelQuota(ctx sdk.Context, channelID, denom string) (types.ChannelQuota, bool) {
+	bz := k.channelQuotaStore(ctx).Get(types.GetChannelQuotaKey(channelID, denom))
+	if bz == nil {
+		return types.ChannelQuota{}, false
+	}
+
+	var quota types.ChannelQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+	return quota, true
+}
+
+// SaveChannelQuota stores the quota, named apart from the SetChannelQuota msg handler.
+func (k Keeper) SaveChannelQuota(ctx sdk.Context, quota types.ChannelQuota) {
+	k.channelQuotaStore(ctx).Set(types.GetChannelQuotaKey(quota.ChannelId, quota.Denom), k.cdc.MustMarshal(&quota))
+}
+
+// DeleteChannelQuota removes the quota together with its usage.
+func (k Keeper) DeleteChannelQuota(ctx sdk.Context, channelID, denom string) {
+	k.channelQuotaStore(ctx).Delete(types.GetChannelQuotaKey(channelID, denom))
+	k.quotaUsageStore(ctx).Delete(types.GetChannelQuotaKey(channelID, denom))
+}
+
+func (k Keeper) GetAllChannelQuotas(ctx sdk.Context) []types.ChannelQuota {
+	iterator := k.channelQuotaStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	quotas := make([]types.ChannelQuota, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.ChannelQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &quota)
+		quotas = append(quotas, quota)
+	}
+	return quotas
+}
+
+func (k Keeper) GetQuotaUsage(ctx sdk.Context, channelID, denom string) (types.QuotaUsage, bool) {
+	bz := k.quotaUsageStore(ctx).Get(types.GetChannelQuotaKey(channelID, denom))
+	if bz == nil {
+		return types.QuotaUsage{}, false
+	}
+
+	var usage types.QuotaUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+func (k Keeper) SetQuotaUsage(ctx sdk.Context, usage types.QuotaUsage) {
+	k.quotaUsageStore(ctx).Set(types.GetChannelQuotaKey(usage.ChannelId, usage.Denom), k.cdc.MustMarshal(&usage))
+}
+
+func (k Keeper) GetAllQuotaUsages(ctx sdk.Context) []types.QuotaUsage {
+	iterator := k.quotaUsageStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	usages := make([]types.QuotaUsage, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.QuotaUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// ConsumeQuota checks coin against the quota of its denom on the channel and, if it fits,
+// adds it to the window usage. Channels and denoms without a quota are not limited.
+func (k Keeper) ConsumeQuota(ctx sdk.Context, channelID string, coin sdk.Coin) error {
+	quota, found := k.GetChannelQuota(ctx, channelID, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	if quota.MaxPerPacket.IsPositive() && coin.Amount.GT(quota.MaxPerPacket) {
+		return errors.Wrapf(types.ErrQuotaExceeded, "%s exceeds the per packet limit of %s on %s", coin, quota.MaxPerPacket, channelID)
+	}
+	if !quota.WindowQuota.IsPositive() {
+		return nil
+	}
+
+	usage := k.currentQuotaUsage(ctx, quota)
+	used := usedInWindow(usage, quota.Window, ctx.BlockTime())
+	if used.Add(coin.Amount).GT(quota.WindowQuota) {
+		return errors.Wrapf(types.ErrQuotaExceeded, "%s would exceed the window quota of %s on %s, %s already minted",
+			coin, quota.WindowQuota, channelID, used)
+	}
+
+	usage.Current = usage.Current.Add(coin.Amount)
+	k.SetQuotaUsage(ctx, usage)
+	return nil
+}
+
+// GetQuotaStatuses returns every quota along with the amount minted in its rolling window.
+func (k Keeper) GetQuotaStatuses(ctx sdk.Context) []types.ChannelQuotaStatus {
+	quotas := k.GetAllChannelQuotas(ctx)
+	statuses := make([]types.ChannelQuotaStatus, 0, len(quotas))
+	for _, quota := range quotas {
+		used := math.ZeroInt()
+		if quota.Window > 0 {
+			used = usedInWindow(k.currentQuotaUsage(ctx, quota), quota.Window, ctx.BlockTime())
+		}
+		statuses = append(statuses, types.ChannelQuotaStatus{Quota: quota, Used: used})
+	}
+	return statuses
+}
+
+// currentQuotaUsage returns the usage of the quota rolled over to the fixed window
+// containing the block time.
+func (k Keeper) currentQuotaUsage(ctx sdk.Context, quota types.ChannelQuota) types.QuotaUsage {
+	now := ctx.BlockTime()
+	usage, found := k.GetQuotaUsage(ctx, quota.ChannelId, quota.Denom)
+	if !found {
+		return types.QuotaUsage{
+			ChannelId:   quota.ChannelId,
+			Denom:       quota.Denom,
+			WindowStart: now,
+			Current:     math.ZeroInt(),
+			Previous:    math.ZeroInt(),
+		}
+	}
+
+	elapsed := now.Sub(usage.WindowStart)
+	if elapsed < quota.Window {
+		return usage
+	}
+
+	windows := elapsed / quota.Window
+	if windows == 1 {
+		usage.Previous = usage.Current
+	} else {
+		usage.Previous = math.ZeroInt()
+	}
+	usage.Current = math.ZeroInt()
+	usage.WindowStart = usage.WindowStart.Add(windows * quota.Window)
+	return usage
+}
+
+// usedInWindow approximates the amount minted over the rolling window ending at now:
+// all of the current fixed window plus the share of the previous one still covered.
+func usedInWindow(usage types.QuotaUsage, window time.Duration, now time.Time) math.Int {
+	remaining := window - now.Sub(usage.WindowStart)
+	if remaining <= 0 || usage.Previous.IsZero() {
+		return usage.Current
+	}
+
+	previous := math.LegacyNewDecFromInt(usage.Previous).
+		MulInt64(int64(remaining)).
+		QuoInt64(int64(window)).
+		Ceil().
+		TruncateInt()
+	return usage.Current.Add(previous)
+}
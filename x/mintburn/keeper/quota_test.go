@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestMsgSetChannelQuotaValidate(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	valid := types.ChannelQuota{
+		ChannelId:    "channel-0",
+		Denom:        "stake",
+		MaxPerPacket: sdkmath.NewInt(100),
+		WindowQuota:  sdkmath.NewInt(1000),
+		Window:       24 * time.Hour,
+	}
+
+	tests := []struct {
+		name        string
+		quota       func(q *types.ChannelQuota)
+		authority   string
+		expectedErr string
+	}{
+		{
+			"wrong authority",
+			func(_ *types.ChannelQuota) {},
+			testutil.TestOwnerAddress,
+			"invalid authority",
+		},
+		{
+			"invalid channel",
+			func(q *types.ChannelQuota) { q.ChannelId = "c" },
+			authority,
+			"invalid channel_id",
+		},
+		{
+			"invalid denom",
+			func(q *types.ChannelQuota) { q.Denom = "1" },
+			authority,
+			"invalid denom",
+		},
+		{
+			"negative max per packet",
+			func(q *types.ChannelQuota) { q.MaxPerPacket = sdkmath.NewInt(-1) },
+			authority,
+			"max_per_packet must not be negative",
+		},
+		{
+			"window quota without a window",
+			func(q *types.ChannelQuota) { q.Window = 0 },
+			authority,
+			"window must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quota := valid
+			tt.quota(&quota)
+			resp, err := k.SetChannelQuota(ctx, &types.MsgSetChannelQuota{Authority: tt.authority, Quota: quota})
+			require.ErrorContains(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+
+	_, err := k.SetChannelQuota(ctx, &types.MsgSetChannelQuota{Authority: authority, Quota: valid})
+	require.NoError(t, err)
+	got, found := k.GetChannelQuota(ctx, "channel-0", "stake")
+	require.True(t, found)
+	require.Equal(t, valid, got)
+
+	_, err = k.RemoveChannelQuota(ctx, &types.MsgRemoveChannelQuota{Authority: authority, ChannelId: "channel-0", Denom: "stake"})
+	require.NoError(t, err)
+	_, err = k.RemoveChannelQuota(ctx, &types.MsgRemoveChannelQuota{Authority: authority, ChannelId: "channel-0", Denom: "stake"})
+	require.ErrorIs(t, err, types.ErrChannelQuotaNotFound)
+}
+
+func TestConsumeQuota(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	coin := func(amount int64) sdk.Coin { return sdk.NewInt64Coin("stake", amount) }
+
+	// no quota, no limit
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", coin(1_000_000)))
+
+	k.SaveChannelQuota(ctx, types.ChannelQuota{
+		ChannelId:    "channel-0",
+		Denom:        "stake",
+		MaxPerPacket: sdkmath.NewInt(600),
+		WindowQuota:  sdkmath.NewInt(1000),
+		Window:       10 * time.Hour,
+	})
+
+	require.ErrorIs(t, k.ConsumeQuota(ctx, "channel-0", coin(601)), types.ErrQuotaExceeded)
+	// other channels and denoms are not limited
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-1", coin(601)))
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", sdk.NewInt64Coin("uatom", 601)))
+
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", coin(600)))
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", coin(400)))
+	require.ErrorIs(t, k.ConsumeQuota(ctx, "channel-0", coin(1)), types.ErrQuotaExceeded)
+
+	// a rejected mint does not count against the quota
+	usage, found := k.GetQuotaUsage(ctx, "channel-0", "stake")
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1000), usage.Current)
+
+	// halfway through the next window half of the previous one still counts
+	ctx = ctx.WithBlockTime(start.Add(15 * time.Hour))
+	statuses := k.GetQuotaStatuses(ctx)
+	require.Len(t, statuses, 1)
+	require.Equal(t, sdkmath.NewInt(500), statuses[0].Used)
+	require.ErrorIs(t, k.ConsumeQuota(ctx, "channel-0", coin(501)), types.ErrQuotaExceeded)
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", coin(500)))
+
+	// two windows later everything has rolled out
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Hour))
+	require.Equal(t, sdkmath.ZeroInt(), k.GetQuotaStatuses(ctx)[0].Used)
+	require.NoError(t, k.ConsumeQuota(ctx, "channel-0", coin(600)))
+}
+
+func TestPauseBridge(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+
+	_, err := k.PauseBridge(ctx, &types.MsgPauseBridge{Authority: authority, ChannelId: "c"})
+	require.ErrorContains(t, err, "invalid channel_id")
+
+	_, err = k.PauseBridge(ctx, &types.MsgPauseBridge{Authority: authority, ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.False(t, k.IsBridgePaused(ctx, "channel-0"))
+	require.True(t, k.IsBridgePaused(ctx, "channel-1"))
+
+	_, err = k.PauseBridge(ctx, &types.MsgPauseBridge{Authority: authority})
+	require.NoError(t, err)
+	require.True(t, k.IsBridgePaused(ctx, "channel-0"))
+
+	resp, err := k.PauseStatus(ctx, &types.QueryPauseStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Paused)
+	require.Equal(t, []string{"channel-1"}, resp.PausedChannels)
+
+	// lifting the global pause keeps the channel one
+	_, err = k.ResumeBridge(ctx, &types.MsgResumeBridge{Authority: authority})
+	require.NoError(t, err)
+	require.False(t, k.IsBridgePaused(ctx, "channel-0"))
+	require.True(t, k.IsBridgePaused(ctx, "channel-1"))
+
+	_, err = k.ResumeBridge(ctx, &types.MsgResumeBridge{Authority: authority, ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.False(t, k.IsBridgePaused(ctx, "channel-1"))
+}
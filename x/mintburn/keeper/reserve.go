@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) reserveStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ReserveKeyPrefix)
+}
+
+func (k Keeper) SetReserve(ctx sdk.Context, reserve types.Reserve) {
+	k.reserveStore(ctx).Set(types.GetReserveKey(reserve.ChannelId, reserve.LocalDenom), k.cdc.MustMarshal(&reserve))
+}
+
+func (k Keeper) GetReserve(ctx sdk.Context, channelID, localDenom string) (types.Reserve, bool) {
+	bz := k.reserveStore(ctx).Get(types.GetReserveKey(channelID, localDenom))
+	if bz == nil {
+		return types.Reserve{}, false
+	}
+
+	var reserve types.Reserve
+	k.cdc.MustUnmarshal(bz, &reserve)
+	return reserve, true
+}
+
+func (k Keeper) RemoveReserve(ctx sdk.Context, channelID, localDenom string) {
+	k.reserveStore(ctx).Delete(types.GetReserveKey(channelID, localDenom))
+}
+
+// GetReservesByChannel returns the reserves proven for the channel.
+func (k Keeper) GetReservesByChannel(ctx sdk.Context, channelID string) []types.Reserve {
+	return k.collectReserves(prefix.NewStore(k.reserveStore(ctx), types.GetReserveChannelPrefix(channelID)))
+}
+
+// GetAllReserves returns the reserves proven for every channel.
+func (k Keeper) GetAllReserves(ctx sdk.Context) []types.Reserve {
+	return k.collectReserves(k.reserveStore(ctx))
+}
+
+func (k Keeper) collectReserves(store prefix.Store) []types.Reserve {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	reserves := make([]types.Reserve, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var reserve types.Reserve
+		k.cdc.MustUnmarshal(iterator.Value(), &reserve)
+		reserves = append(reserves, reserve)
+	}
+	return reserves
+}
+
+// reservesQueryOwner is the owner of the interchain queries registered by the module.
+func reservesQueryOwner() string {
+	return authtypes.NewModuleAddress(types.ModuleName).String()
+}
+
+// GetOutstanding returns the amount of localDenom minted over the channel and not
+// burned yet, excluding the tokens in flight back to the counterparty: those may
+// already be released from the counterparty escrow.
+func (k Keeper) GetOutstanding(ctx sdk.Context, channelID, localDenom string) sdkmath.Int {
+	stats := k.GetChannelStats(ctx, channelID)
+	outstanding := stats.Minted.AmountOf(localDenom).
+		Sub(stats.Burned.AmountOf(localDenom)).
+		Sub(k.GetInFlight(ctx, channelID).AmountOf(localDenom))
+	if outstanding.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return outstanding
+}
+
+// GetReserveStatus compares the proven reserve to the outstanding supply it backs.
+func (k Keeper) GetReserveStatus(ctx sdk.Context, reserve types.Reserve) types.ReserveStatus {
+	outstanding := k.GetOutstanding(ctx, reserve.ChannelId, reserve.LocalDenom)
+	return types.ReserveStatus{
+		Reserve:     reserve,
+		Outstanding: outstanding,
+		Gap:         outstanding.Sub(reserve.Escrowed),
+	}
+}
+
+// SyncReserves makes sure the counterparty escrow of every route used over an allowed
+// channel is proven by an interchain query. Queries are registered for new routes and
+// channels, removed for revoked ones, and registered again if removed from
+// x/interchainqueries, e.g. by anyone once their submit timeout expired.
+func (k Keeper) SyncReserves(ctx sdk.Context) {
+	for _, reserve := range k.GetAllReserves(ctx) {
+		if !k.IsAllowedChannel(ctx, reserve.ChannelId) {
+			k.removeReserve(ctx, reserve)
+		}
+	}
+
+	for _, channel := range k.GetAllAllowedChannels(ctx) {
+		if err := k.syncChannelReserves(ctx, channel); err != nil {
+			k.Logger(ctx).Error("failed to sync reserve queries", "channel", channel.ChannelId, "error", err)
+		}
+	}
+}
+
+func (k Keeper) syncChannelReserves(ctx sdk.Context, channel types.AllowedChannel) error {
+	channelEnd, found := k.channelKeeper.GetChannel(ctx, ibctransfertypes.PortID, channel.ChannelId)
+	if !found {
+		return errors.Wrapf(types.ErrInvalidChannel, "channel %s not found", channel.ChannelId)
+	}
+	escrowAddr := ibctransfertypes.GetEscrowAddress(channelEnd.Counterparty.PortId, channelEnd.Counterparty.ChannelId)
+	updatePeriod := k.GetParams(ctx).ReservesQueryPeriod
+
+	routes := make([]types.BridgeRoute, 0)
+	escrowDenoms := make(map[string]string)
+	for _, route := range k.GetBridgeRoutesByChain(ctx, channel.CounterpartyChainId) {
+		if route.Matches(channel) {
+			routes = append(routes, route)
+			// the counterparty escrows the ibc/ hash of vouchers it forwards
+			escrowDenoms[route.LocalDenom] = ibctransfertypes.ParseDenomTrace(route.RemoteDenom).IBCDenom()
+		}
+	}
+
+	for _, reserve := range k.GetReservesByChannel(ctx, channel.ChannelId) {
+		if escrowDenoms[reserve.LocalDenom] != reserve.EscrowDenom || !k.isReserveQueryLive(ctx, reserve, channel, updatePeriod) {
+			k.removeReserve(ctx, reserve)
+		}
+	}
+
+	for _, route := range routes {
+		if _, found := k.GetReserve(ctx, channel.ChannelId, route.LocalDenom); found {
+			continue
+		}
+
+		escrowDenom := escrowDenoms[route.LocalDenom]
+		queryID, err := k.icqKeeper.RegisterModuleQuery(
+			ctx,
+			reservesQueryOwner(),
+			[]*icqtypes.KVKey{types.GetEscrowBalanceKVKey(escrowAddr, escrowDenom)},
+			channel.ConnectionId,
+			updatePeriod,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to register reserve query for %s", route.LocalDenom)
+		}
+		k.SetReserve(ctx, types.NewReserve(channel.ChannelId, route.LocalDenom, escrowDenom, queryID))
+	}
+	return nil
+}
+
+// isReserveQueryLive reports whether the query of the reserve is still registered as
+// the module would register it now.
+func (k Keeper) isReserveQueryLive(ctx sdk.Context, reserve types.Reserve, channel types.AllowedChannel, updatePeriod uint64) bool {
+	query, err := k.icqKeeper.GetQueryByID(ctx, reserve.QueryId)
+	if err != nil {
+		return false
+	}
+	return query.Owner == reservesQueryOwner() &&
+		query.ConnectionId == channel.ConnectionId &&
+		query.UpdatePeriod == updatePeriod
+}
+
+func (k Keeper) removeReserve(ctx sdk.Context, reserve types.Reserve) {
+	if query, err := k.icqKeeper.GetQueryByID(ctx, reserve.QueryId); err == nil && query.Owner == reservesQueryOwner() {
+		if err := k.icqKeeper.RemoveModuleQuery(ctx, reservesQueryOwner(), reserve.QueryId); err != nil {
+			k.Logger(ctx).Error("failed to remove reserve query", "query_id", reserve.QueryId, "error", err)
+		}
+	}
+	k.RemoveReserve(ctx, reserve.ChannelId, reserve.LocalDenom)
+}
+
+// UpdateReserves stores the results submitted for the reserve queries since the last
+// update. Minting over a channel is paused if its proven escrow falls short of the
+// outstanding supply by more than the max reserves gap.
+func (k Keeper) UpdateReserves(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, reserve := range k.GetAllReserves(ctx) {
+		query, err := k.icqKeeper.GetQueryByID(ctx, reserve.QueryId)
+		if err != nil || query.LastSubmittedResultLocalHeight <= reserve.LocalHeight {
+			continue
+		}
+
+		result, err := k.icqKeeper.GetQueryResultByID(ctx, reserve.QueryId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get reserve query result", "query_id", reserve.QueryId, "error", err)
+			continue
+		}
+		escrowed, err := types.DecodeEscrowBalance(result)
+		if err != nil {
+			k.Logger(ctx).Error("failed to decode reserve query result", "query_id", reserve.QueryId, "error", err)
+			continue
+		}
+
+		reserve.Escrowed = escrowed
+		reserve.RemoteHeight = result.Height
+		reserve.LocalHeight = query.LastSubmittedResultLocalHeight
+		k.SetReserve(ctx, reserve)
+
+		if params.IsReservesGapEnforced() {
+			k.enforceReservesGap(ctx, reserve, params.MaxReservesGap)
+		}
+	}
+}
+
+func (k Keeper) enforceReservesGap(ctx sdk.Context, reserve types.Reserve, maxGap sdkmath.LegacyDec) {
+	status := k.GetReserveStatus(ctx, reserve)
+	if !status.Gap.IsPositive() {
+		return
+	}
+	if sdkmath.LegacyNewDecFromInt(status.Gap).LTE(maxGap.MulInt(status.Outstanding)) {
+		return
+	}
+	if k.IsBridgePaused(ctx, reserve.ChannelId) {
+		return
+	}
+
+	k.SetChannelPaused(ctx, reserve.ChannelId, true)
+	k.EmitEvent(ctx, &types.EventReservesShortfall{
+		ChannelId:    reserve.ChannelId,
+		LocalDenom:   reserve.LocalDenom,
+		Outstanding:  status.Outstanding,
+		Escrowed:     reserve.Escrowed,
+		RemoteHeight: reserve.RemoteHeight,
+	})
+}
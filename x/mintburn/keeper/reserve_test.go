@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestSyncReserves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	icqKeeper := mock_types.NewMockInterchainQueriesKeeper(ctrl)
+	k, ctx := keeper.MintBurnKeeperWithIBCDeps(t, nil, nil, channelKeeper, nil, nil, icqKeeper)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	owner := authtypes.NewModuleAddress(types.ModuleName).String()
+	route := validRoute()
+	route.RemoteDenom = "transfer/channel-9/uatom"
+	k.SetBridgeRoute(ctx, route)
+	channel := types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: route.CounterpartyChainId,
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	}
+	k.SetAllowedChannel(ctx, channel)
+
+	channelKeeper.EXPECT().GetChannel(gomock.Any(), transfertypes.PortID, channel.ChannelId).Return(channeltypes.Channel{
+		Counterparty: channeltypes.NewCounterparty(transfertypes.PortID, "channel-3"),
+	}, true).AnyTimes()
+
+	// the counterparty escrows the forwarded vouchers under their ibc/ hash
+	escrowDenom := transfertypes.ParseDenomTrace(route.RemoteDenom).IBCDenom()
+	escrowKey := types.GetEscrowBalanceKVKey(transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-3"), escrowDenom)
+	icqKeeper.EXPECT().RegisterModuleQuery(gomock.Any(), owner, []*icqtypes.KVKey{escrowKey}, channel.ConnectionId, types.DefaultReservesQueryPeriod).Return(uint64(1), nil)
+	k.SyncReserves(ctx)
+
+	reserve, found := k.GetReserve(ctx, channel.ChannelId, route.LocalDenom)
+	require.True(t, found)
+	require.Equal(t, types.NewReserve(channel.ChannelId, route.LocalDenom, escrowDenom, 1), reserve)
+
+	// a live query is kept
+	query := &icqtypes.RegisteredQuery{Id: 1, Owner: owner, ConnectionId: channel.ConnectionId, UpdatePeriod: types.DefaultReservesQueryPeriod}
+	icqKeeper.EXPECT().GetQueryByID(gomock.Any(), uint64(1)).Return(query, nil)
+	k.SyncReserves(ctx)
+
+	// a query removed from x/interchainqueries is registered again
+	icqKeeper.EXPECT().GetQueryByID(gomock.Any(), uint64(1)).Return(nil, icqtypes.ErrInvalidQueryID).Times(2)
+	icqKeeper.EXPECT().RegisterModuleQuery(gomock.Any(), owner, []*icqtypes.KVKey{escrowKey}, channel.ConnectionId, types.DefaultReservesQueryPeriod).Return(uint64(2), nil)
+	k.SyncReserves(ctx)
+
+	reserve, found = k.GetReserve(ctx, channel.ChannelId, route.LocalDenom)
+	require.True(t, found)
+	require.Equal(t, uint64(2), reserve.QueryId)
+
+	// the query of a removed route is removed
+	k.DeleteBridgeRoute(ctx, route.CounterpartyChainId, route.RemoteDenom)
+	query.Id = 2
+	icqKeeper.EXPECT().GetQueryByID(gomock.Any(), uint64(2)).Return(query, nil)
+	icqKeeper.EXPECT().RemoveModuleQuery(gomock.Any(), owner, uint64(2)).Return(nil)
+	k.SyncReserves(ctx)
+
+	_, found = k.GetReserve(ctx, channel.ChannelId, route.LocalDenom)
+	require.False(t, found)
+}
+
+func TestUpdateReserves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	icqKeeper := mock_types.NewMockInterchainQueriesKeeper(ctrl)
+	k, ctx := keeper.MintBurnKeeperWithIBCDeps(t, nil, nil, nil, nil, nil, icqKeeper)
+
+	params := types.DefaultParams()
+	params.MaxReservesGap = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	route := validRoute()
+	stats := types.NewChannelStats("channel-0")
+	stats.Minted = stats.Minted.Add(sdk.NewInt64Coin(route.LocalDenom, 1000))
+	k.SetChannelStats(ctx, stats)
+	k.SetReserve(ctx, types.NewReserve("channel-0", route.LocalDenom, route.RemoteDenom, 1))
+
+	submit := func(localHeight, remoteHeight uint64, escrowed int64) {
+		value, err := banktypes.BalanceValueCodec.Encode(sdkmath.NewInt(escrowed))
+		require.NoError(t, err)
+		icqKeeper.EXPECT().GetQueryByID(gomock.Any(), uint64(1)).Return(&icqtypes.RegisteredQuery{
+			Id:                             1,
+			LastSubmittedResultLocalHeight: localHeight,
+		}, nil)
+		icqKeeper.EXPECT().GetQueryResultByID(gomock.Any(), uint64(1)).Return(&icqtypes.QueryResult{
+			KvResults: []*icqtypes.StorageValue{{Value: value}},
+			Height:    remoteHeight,
+		}, nil)
+		k.UpdateReserves(ctx)
+	}
+
+	// a gap within the threshold does not pause minting
+	submit(10, 100, 950)
+	reserve, found := k.GetReserve(ctx, "channel-0", route.LocalDenom)
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(950), reserve.Escrowed)
+	require.Equal(t, uint64(100), reserve.RemoteHeight)
+	require.Equal(t, uint64(10), reserve.LocalHeight)
+	require.False(t, k.IsBridgePaused(ctx, "channel-0"))
+
+	// no new result
+	icqKeeper.EXPECT().GetQueryByID(gomock.Any(), uint64(1)).Return(&icqtypes.RegisteredQuery{Id: 1, LastSubmittedResultLocalHeight: 10}, nil)
+	k.UpdateReserves(ctx)
+
+	submit(20, 110, 800)
+	reserve, _ = k.GetReserve(ctx, "channel-0", route.LocalDenom)
+	require.Equal(t, sdkmath.NewInt(200), k.GetReserveStatus(ctx, reserve).Gap)
+	require.True(t, k.IsBridgePaused(ctx, "channel-0"))
+}
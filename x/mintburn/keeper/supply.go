@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func (k Keeper) supplyBaselineStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyBaselineKeyPrefix)
+}
+
+// GetSupplyBaseline returns the supply the local denom had when it was first bridged.
+func (k Keeper) GetSupplyBaseline(ctx sdk.Context, denom string) (sdk.Coin, bool) {
+	bz := k.supplyBaselineStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+
+	var baseline sdk.Coin
+	k.cdc.MustUnmarshal(bz, &baseline)
+	return baseline, true
+}
+
+func (k Keeper) SetSupplyBaseline(ctx sdk.Context, baseline sdk.Coin) {
+	k.supplyBaselineStore(ctx).Set([]byte(baseline.Denom), k.cdc.MustMarshal(&baseline))
+}
+
+// GetAllSupplyBaselines returns the baselines of every denom ever bridged.
+func (k Keeper) GetAllSupplyBaselines(ctx sdk.Context) []sdk.Coin {
+	iterator := k.supplyBaselineStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	baselines := make([]sdk.Coin, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var baseline sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &baseline)
+		baselines = append(baselines, baseline)
+	}
+	return baselines
+}
+
+// EnsureSupplyBaseline snapshots the supply of a local denom the first time a route bridges it.
+// Amounts the bridge or the authority already minted or burned are excluded, so the baseline stays
+// correct for denoms imported from genesis with existing stats.
+func (k Keeper) EnsureSupplyBaseline(ctx sdk.Context, denom string) {
+	if _, found := k.GetSupplyBaseline(ctx, denom); found {
+		return
+	}
+
+	minted, burned := k.getBridgedTotals(ctx)
+	adjustment := k.GetSupplyAdjustment(ctx)
+	baseline := k.bankKeeper.GetSupply(ctx, denom).Amount.
+		Sub(minted.AmountOf(denom)).
+		Add(burned.AmountOf(denom)).
+		Sub(adjustment.Minted.AmountOf(denom)).
+		Add(adjustment.Burned.AmountOf(denom))
+	if baseline.IsNegative() {
+		baseline = math.ZeroInt()
+	}
+	k.SetSupplyBaseline(ctx, sdk.NewCoin(denom, baseline))
+}
+
+// GetSupplyReport reconciles the bank supply of every bridged local denom against
+// its baseline, the amounts minted and burned over all channels and the amounts
+// minted and burned by the authority without a channel.
+func (k Keeper) GetSupplyReport(ctx sdk.Context) []types.DenomSupply {
+	minted, burned := k.getBridgedTotals(ctx)
+	adjustment := k.GetSupplyAdjustment(ctx)
+
+	escrowed := sdk.NewCoins()
+	for _, channel := range k.GetAllAllowedChannels(ctx) {
+		escrowed = escrowed.Add(k.GetInFlight(ctx, channel.ChannelId)...)
+	}
+
+	baselines := k.GetAllSupplyBaselines(ctx)
+	report := make([]types.DenomSupply, 0, len(baselines))
+	for _, baseline := range baselines {
+		denom := baseline.Denom
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		expected := baseline.Amount.
+			Add(minted.AmountOf(denom)).
+			Sub(burned.AmountOf(denom)).
+			Add(adjustment.Minted.AmountOf(denom)).
+			Sub(adjustment.Burned.AmountOf(denom))
+
+		report = append(report, types.DenomSupply{
+			Denom:          denom,
+			Supply:         supply,
+			Baseline:       baseline.Amount,
+			Minted:         minted.AmountOf(denom),
+			Burned:         burned.AmountOf(denom),
+			Escrowed:       escrowed.AmountOf(denom),
+			Drift:          supply.Sub(expected),
+			AdjustedMinted: adjustment.Minted.AmountOf(denom),
+			AdjustedBurned: adjustment.Burned.AmountOf(denom),
+		})
+	}
+	return report
+}
+
+func (k Keeper) getBridgedTotals(ctx sdk.Context) (minted, burned sdk.Coins) {
+	minted, burned = sdk.NewCoins(), sdk.NewCoins()
+	for _, stats := range k.GetAllChannelStats(ctx) {
+		minted = minted.Add(stats.Minted...)
+		burned = burned.Add(stats.Burned...)
+	}
+	return minted, burned
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mock_types "github.com/neutron-org/neutron/v5/testutil/mocks/mintburn/types"
+	mintburnkeeper "github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestSupplyDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	supply := sdkmath.NewInt(500)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	bankKeeper.EXPECT().GetSupply(gomock.Any(), "stake").DoAndReturn(func(_ any, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, supply)
+	}).AnyTimes()
+	k, ctx := keeper.MintBurnKeeperWithDeps(t, bankKeeper)
+
+	_, err := k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: validRoute()})
+	require.NoError(t, err)
+
+	baseline, found := k.GetSupplyBaseline(ctx, "stake")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin("stake", 500), baseline)
+
+	// a second route for the same local denom keeps the original baseline
+	supply = sdkmath.NewInt(600)
+	second := validRoute()
+	second.CounterpartyChainId = "maany-testnet"
+	_, err = k.RegisterBridgeRoute(ctx, &types.MsgRegisterBridgeRoute{Authority: authority, BridgeRoute: second})
+	require.NoError(t, err)
+	baseline, _ = k.GetSupplyBaseline(ctx, "stake")
+	require.Equal(t, sdk.NewInt64Coin("stake", 500), baseline)
+
+	k.SetChannelStats(ctx, types.ChannelStats{
+		ChannelId: "channel-0",
+		Minted:    sdk.NewCoins(sdk.NewInt64Coin("stake", 150)),
+		Burned:    sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	})
+
+	resp, err := k.SupplyDrift(ctx, &types.QuerySupplyDriftRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Denoms, 1)
+	require.Equal(t, "stake", resp.Denoms[0].Denom)
+	require.True(t, resp.Denoms[0].Drift.IsZero())
+	_, broken := mintburnkeeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	// tokens minted outside of the bridge
+	supply = sdkmath.NewInt(610)
+	resp, err = k.SupplyDrift(ctx, &types.QuerySupplyDriftRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(10), resp.Denoms[0].Drift)
+	_, broken = mintburnkeeper.AllInvariants(k)(ctx)
+	require.True(t, broken)
+
+	// burned outside of the bridge, e.g. as fees, is only reported
+	supply = sdkmath.NewInt(590)
+	resp, err = k.SupplyDrift(ctx, &types.QuerySupplyDriftRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(-10), resp.Denoms[0].Drift)
+	_, broken = mintburnkeeper.AllInvariants(k)(ctx)
+	require.False(t, broken)
+
+	_, err = k.SupplyDrift(ctx, nil)
+	require.Error(t, err)
+}
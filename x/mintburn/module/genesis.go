@@ -0,0 +1,71 @@
+package mintburn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, route := range genState.BridgeRoutes {
+		k.SetBridgeRoute(ctx, route)
+	}
+	for _, channel := range genState.AllowedChannels {
+		k.SetAllowedChannel(ctx, channel)
+	}
+	for _, stats := range genState.ChannelStats {
+		k.SetChannelStats(ctx, stats)
+	}
+	for _, baseline := range genState.SupplyBaselines {
+		k.SetSupplyBaseline(ctx, baseline)
+	}
+	k.SetSupplyAdjustment(ctx, genState.SupplyAdjustment)
+	for _, burn := range genState.PendingBurns {
+		k.SetPendingBurn(ctx, burn)
+	}
+	for _, quota := range genState.ChannelQuotas {
+		k.SaveChannelQuota(ctx, quota)
+	}
+	for _, usage := range genState.QuotaUsages {
+		k.SetQuotaUsage(ctx, usage)
+	}
+	for _, channel := range genState.PendingChannels {
+		k.SetPendingChannel(ctx, channel)
+	}
+	for _, reserve := range genState.Reserves {
+		k.SetReserve(ctx, reserve)
+	}
+	k.SetPausedGlobally(ctx, genState.Paused)
+	for _, channelID := range genState.PausedChannels {
+		k.SetChannelPaused(ctx, channelID, true)
+	}
+	// routes added to genesis by hand may come without a baseline
+	for _, route := range genState.BridgeRoutes {
+		k.EnsureSupplyBaseline(ctx, route.LocalDenom)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.BridgeRoutes = k.GetAllBridgeRoutes(ctx)
+	genesis.AllowedChannels = k.GetAllAllowedChannels(ctx)
+	genesis.ChannelStats = k.GetAllChannelStats(ctx)
+	genesis.SupplyBaselines = k.GetAllSupplyBaselines(ctx)
+	genesis.SupplyAdjustment = k.GetSupplyAdjustment(ctx)
+	genesis.PendingBurns = k.GetAllPendingBurns(ctx)
+	genesis.ChannelQuotas = k.GetAllChannelQuotas(ctx)
+	genesis.QuotaUsages = k.GetAllQuotaUsages(ctx)
+	genesis.Paused = k.IsPausedGlobally(ctx)
+	genesis.PausedChannels = k.GetPausedChannels(ctx)
+	genesis.PendingChannels = k.GetAllPendingChannels(ctx)
+	genesis.Reserves = k.GetAllReserves(ctx)
+
+	return genesis
+}
@@ -0,0 +1,123 @@
+package mintburn_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/mintburn/keeper"
+	mintburn "github.com/neutron-org/neutron/v5/x/mintburn/module"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		BridgeRoutes: []types.BridgeRoute{
+			{
+				CounterpartyChainId: "maany-mainnet",
+				RemoteDenom:         "stake",
+				LocalDenom:          "stake",
+				Enabled:             true,
+			},
+			{
+				CounterpartyChainId: "maany-testnet",
+				ConnectionId:        "connection-3",
+				ClientId:            "07-tendermint-4",
+				RemoteDenom:         "utest",
+				LocalDenom:          "stake",
+			},
+		},
+		AllowedChannels: []types.AllowedChannel{
+			{
+				ChannelId:           "channel-0",
+				CounterpartyChainId: "maany-mainnet",
+				ConnectionId:        "connection-0",
+				ClientId:            "07-tendermint-0",
+			},
+		},
+		ChannelStats: []types.ChannelStats{
+			{
+				ChannelId: "channel-0",
+				Minted:    sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+				Burned:    sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(40))),
+			},
+		},
+		SupplyBaselines: []sdk.Coin{sdk.NewCoin("stake", sdkmath.NewInt(1000))},
+		SupplyAdjustment: types.SupplyAdjustment{
+			Minted: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(5))),
+			Burned: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(2))),
+		},
+		PendingBurns: []types.PendingBurn{
+			{
+				ChannelId: "channel-0",
+				Sequence:  7,
+				Amount:    sdk.NewCoin("stake", sdkmath.NewInt(10)),
+				Attempts:  3,
+				LastError: "insufficient funds",
+			},
+		},
+		ChannelQuotas: []types.ChannelQuota{
+			{
+				ChannelId:    "channel-0",
+				Denom:        "stake",
+				MaxPerPacket: sdkmath.NewInt(100),
+				WindowQuota:  sdkmath.NewInt(1000),
+				Window:       24 * time.Hour,
+			},
+		},
+		QuotaUsages: []types.QuotaUsage{
+			{
+				ChannelId:   "channel-0",
+				Denom:       "stake",
+				WindowStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Current:     sdkmath.NewInt(50),
+				Previous:    sdkmath.NewInt(20),
+			},
+		},
+		Paused:         true,
+		PausedChannels: []string{"channel-1"},
+		PendingChannels: []types.AllowedChannel{
+			{
+				ChannelId:           "channel-2",
+				CounterpartyChainId: "maany-mainnet",
+				ConnectionId:        "connection-2",
+				ClientId:            "07-tendermint-2",
+			},
+		},
+		Params: types.DefaultParams(),
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keeper.MintBurnKeeper(t)
+	mintburn.InitGenesis(ctx, k, genesisState)
+
+	require.True(t, k.IsAllowedChannel(ctx, "channel-0"))
+	_, found := k.GetInboundRoute(ctx, "channel-0", "stake")
+	require.True(t, found)
+
+	got := mintburn.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+	require.ElementsMatch(t, genesisState.BridgeRoutes, got.BridgeRoutes)
+	require.Equal(t, genesisState.AllowedChannels, got.AllowedChannels)
+	require.Equal(t, genesisState.ChannelStats, got.ChannelStats)
+	require.Equal(t, genesisState.SupplyBaselines, got.SupplyBaselines)
+	require.Equal(t, genesisState.SupplyAdjustment, got.SupplyAdjustment)
+	require.Equal(t, genesisState.PendingBurns, got.PendingBurns)
+	require.Equal(t, genesisState.ChannelQuotas, got.ChannelQuotas)
+	require.Equal(t, genesisState.QuotaUsages, got.QuotaUsages)
+	require.True(t, got.Paused)
+	require.Equal(t, genesisState.PausedChannels, got.PausedChannels)
+	require.Equal(t, genesisState.PendingChannels, got.PendingChannels)
+	require.Equal(t, genesisState.Params, got.Params)
+}
+
+func TestDefaultGenesisRoundTrip(t *testing.T) {
+	k, ctx := keeper.MintBurnKeeper(t)
+	mintburn.InitGenesis(ctx, k, *types.DefaultGenesis())
+
+	got := mintburn.ExportGenesis(ctx, k)
+	require.Equal(t, types.DefaultGenesis(), got)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
-	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -13,17 +12,17 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
-	mintburn "github.com/neutron-org/neutron/v5/x/mintburn/keeper"
 
-	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
 )
 
 type IBCMiddleware struct {
 	app    porttypes.IBCModule
-	keeper mintburn.Keeper
+	keeper keeper.Keeper
 }
 
-func NewIBCMiddleware(app porttypes.IBCModule, k mintburn.Keeper) IBCMiddleware {
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
 	return IBCMiddleware{
 		app:    app,
 		keeper: k,
@@ -59,48 +58,71 @@ func (im IBCMiddleware) OnChanOpenTry(
 	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
 }
 
+// HandleChannelIdStorage maintains the set of transfer channels allowed to mint and
+// burn bridged assets. On opening, a channel some bridge route may be used over is
+// authenticated, then allow-listed if a route is pinned to its connection or client.
+// Anyone can create a client reporting an arbitrary chain-id, so a channel over any
+// other client or connection is kept pending until governance approves it.
 func (im IBCMiddleware) HandleChannelIdStorage(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 	isOpening bool,
 ) error {
-	if portID != "transfer" {
+	if portID != ibctransfertypes.PortID {
 		return nil
 	}
 
-	channel, found := im.keeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
-	if !found {
-		return fmt.Errorf("channel not found")
+	if !isOpening {
+		if channel, found := im.keeper.GetAllowedChannel(ctx, channelID); found {
+			im.keeper.RemoveAllowedChannel(ctx, channelID)
+			im.keeper.EmitEvent(ctx, &types.EventChannelRevoked{
+				ChannelId:           channelID,
+				CounterpartyChainId: channel.CounterpartyChainId,
+			})
+		}
+		im.keeper.RemovePendingChannel(ctx, channelID)
+		return nil
 	}
-	connectionID := channel.ConnectionHops[0]
-	connection, found := im.keeper.ConnectionKeeper.GetConnection(ctx, connectionID)
-	if !found {
-		return fmt.Errorf("connection %s not found", connectionID)
+
+	// a channel already handled on OnChanOpenAck is left as is on OnChanOpenConfirm
+	if _, found := im.keeper.GetPendingChannel(ctx, channelID); found || im.keeper.IsAllowedChannel(ctx, channelID) {
+		return nil
 	}
-	clientID := connection.Counterparty.ClientId
-	clientState, found := im.keeper.ClientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return fmt.Errorf("client state for %s not found", clientID)
+
+	channel, err := im.keeper.ResolveCounterparty(ctx, portID, channelID)
+	if err != nil {
+		return err
 	}
-	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
-	if !ok {
-		return fmt.Errorf("unexpected client state type")
+	if !im.keeper.HasRouteForChannel(ctx, channel) {
+		return nil
 	}
-	if tmClientState.ChainId == "maany-mainnet" {
-
-		if isOpening {
-			store := prefix.NewStore(ctx.KVStore(im.keeper.StoreKey), []byte("allowed-channel/"))
-			store.Set([]byte(channelID), []byte{1})
-			ctx.Logger().Info("Successfully set channel-id", "ID", channelID)
-		} else {
-			store := prefix.NewStore(ctx.KVStore(im.keeper.StoreKey), []byte("allowed-channel/"))
-			store.Delete([]byte(channelID))
-			ctx.Logger().Info("Channel deleted in OnChanCloseConfirm", "ID", channelID)
-		}
-		
+
+	// the channel still opens as a regular transfer channel
+	if err := im.keeper.AuthenticateCounterparty(ctx, portID, channel); err != nil {
+		im.keeper.Logger(ctx).Info("channel is not allow-listed", "channel", channelID, "error", err)
+		return nil
+	}
+
+	if !im.keeper.HasPinnedRouteForChannel(ctx, channel) {
+		im.keeper.SetPendingChannel(ctx, channel)
+		im.keeper.EmitEvent(ctx, &types.EventChannelPending{
+			ChannelId:           channel.ChannelId,
+			CounterpartyChainId: channel.CounterpartyChainId,
+			ConnectionId:        channel.ConnectionId,
+			ClientId:            channel.ClientId,
+		})
+		return nil
 	}
 
+	im.keeper.SetAllowedChannel(ctx, channel)
+	im.keeper.EmitEvent(ctx, &types.EventChannelAllowed{
+		ChannelId:           channel.ChannelId,
+		CounterpartyChainId: channel.CounterpartyChainId,
+		ConnectionId:        channel.ConnectionId,
+		ClientId:            channel.ClientId,
+	})
+
 	return nil
 }
 
@@ -113,13 +135,13 @@ func (im IBCMiddleware) OnChanOpenAck(
 ) error {
 	// Run middleware logic first
 	if err := im.HandleChannelIdStorage(ctx, portID, channelID, true); err != nil {
-		//On any error concerning establishing a transfer channel, return the error and aboart the channel creation 
+		//On any error concerning establishing a transfer channel, return the error and aboart the channel creation
 		return err
 	}
 	// Then forward to the underlying IBC app
 	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
 }
- 
+
 // OnChanOpenConfirm implements the IBCMiddleware interface
 func (im IBCMiddleware) OnChanOpenConfirm(
 	ctx sdk.Context,
@@ -129,7 +151,7 @@ func (im IBCMiddleware) OnChanOpenConfirm(
 	//Note: since a channel can be opened from both ways, need to handle same logic here as for OnChanOpenAck
 	// Run middleware logic first
 	if err := im.HandleChannelIdStorage(ctx, portID, channelID, true); err != nil {
-		//On any error concerning establishing a transfer channel, return the error and aboart the channel creation 
+		//On any error concerning establishing a transfer channel, return the error and aboart the channel creation
 		return err
 	}
 	// Then forward to the underlying IBC app
@@ -144,7 +166,7 @@ func (im IBCMiddleware) OnChanCloseInit(
 ) error {
 	// Run middleware logic first
 	if err := im.HandleChannelIdStorage(ctx, portID, channelID, false); err != nil {
-	//On any error concerning establishing a transfer channel, return the error and aboart the channel closure 
+		//On any error concerning establishing a transfer channel, return the error and aboart the channel closure
 		return err
 	}
 	return im.app.OnChanCloseInit(ctx, portID, channelID)
@@ -157,20 +179,20 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 ) error {
 	// Run middleware logic first
 	if err := im.HandleChannelIdStorage(ctx, portID, channelID, false); err != nil {
-	//On any error concerning establishing a transfer channel, return the error and aboart the channel closure 
+		//On any error concerning establishing a transfer channel, return the error and aboart the channel closure
 		return err
 	}
 	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnTimeoutPacket implements the IBCMiddleware interface
-// If fees are not enabled, this callback will default to the ibc-core packet callback
+// OnTimeoutPacket implements the IBCMiddleware interface.
+// Bridged tokens of a timed out transfer are still in the channel escrow, so the
+// transfer app refunding the sender from the escrow is all that needs to happen.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// call underlying app's OnTimeoutPacket callback.
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
@@ -179,120 +201,119 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
-	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
-
 	var data ibctransfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		ctx.Logger().Error("failed to unmarshal packet data", "error", err)
-		return ack
+		// not an ICS20 packet we can mint for, let the transfer app reject it
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if packet.DestinationPort != ibctransfertypes.PortID {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	route, found := im.keeper.ResolveInboundRoute(ctx, packet, data.Denom)
+	if !found {
+		// This is a regular IBC transfer, pass it forward to the transfer app
+		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
-		ctx.Logger().Error("invalid receiver address", "receiver", data.Receiver)
-		ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid receiver address"))
-		return ack
+		im.keeper.Logger(ctx).Error("invalid receiver address", "receiver", data.Receiver)
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid receiver address"))
 	}
 
-	coinDenom := data.Denom
 	coinAmt, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
-		ctx.Logger().Error("invalid token amount", "amount", data.Amount)
-		ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid token amount"))
-		return ack
+		im.keeper.Logger(ctx).Error("invalid token amount", "amount", data.Amount)
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("invalid token amount"))
 	}
 
-	if packet.SourcePort == "transfer" &&
-		im.keeper.IsAllowedChannel(ctx, packet.SourceChannel) &&
-		data.Denom == "stake" {
-
-		ctx.Logger().Info("The denom for exchange is correct")
-		nativeToken := sdk.NewCoin("stake", coinAmt)
+	if im.keeper.IsBridgePaused(ctx, packet.DestinationChannel) {
+		im.keeper.Logger(ctx).Info("bridge is paused, rejecting transfer", "channel", packet.DestinationChannel)
+		return channeltypes.NewErrorAcknowledgement(types.ErrBridgePaused)
+	}
 
-		if err := im.keeper.MintTokens(ctx, receiver, nativeToken); err != nil {
-			ctx.Logger().Error("failed to mint tokens", "error", err)
-			ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to mint tokens"))
-			return ack
-		}
+	nativeToken := sdk.NewCoin(route.LocalDenom, coinAmt)
+	if err := im.keeper.ConsumeQuota(ctx, packet.DestinationChannel, nativeToken); err != nil {
+		im.keeper.Logger(ctx).Info("mint quota exceeded, rejecting transfer", "error", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
 
-		ctx.Logger().Info("Successfully minted native tokens", "amount", nativeToken)
-	} else {
-		ctx.Logger().Info("This is a regular IBC transfer", "denom", coinDenom)
-		ctx.Logger().Info("Passing forward to core IBC module")
-		ack := im.app.OnRecvPacket(ctx, packet, relayer)
-		return ack
+	if err := im.keeper.MintTokens(ctx, packet.DestinationChannel, receiver, nativeToken); err != nil {
+		im.keeper.Logger(ctx).Error("failed to mint tokens", "error", err)
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed to mint tokens"))
 	}
 
-	return ack
+	im.keeper.EmitEvent(ctx, &types.EventBridgeMint{
+		ChannelId:   packet.DestinationChannel,
+		Sequence:    packet.Sequence,
+		RemoteDenom: data.Denom,
+		Amount:      nativeToken,
+		Sender:      data.Sender,
+		Receiver:    data.Receiver,
+	})
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 }
 
+// OnAcknowledgementPacket burns the escrow of bridged transfers delivered to the counterparty.
+// Outbound bridged tokens stay in the channel escrow until the packet lifecycle completes:
+// on a success ack they are burned (or queued for a retried burn), on an error ack the
+// transfer app refunds the sender from the escrow.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
 	var ack channeltypes.Acknowledgement
-	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
-		ctx.Logger().Error("Cant unmarshal ack", "err", err)
-		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-	} else {
-
-		if ack.Response == nil {
-			ctx.Logger().Error("Acknowledgement response is nil")
-			return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-		}
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		// already refunded by the transfer app
+		return nil
+	}
 
-		switch resp := ack.Response.(type) {
-			case *channeltypes.Acknowledgement_Error:
-				// This was an error, handle refund logic or logging
-				ctx.Logger().Info("Acknowledgement contains error", "error", resp.Error)
-				return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-
-			case *channeltypes.Acknowledgement_Result:
-				ctx.Logger().Info("In case successful ack, initialte burning")
-				var data ibctransfertypes.FungibleTokenPacketData
-				if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-					ctx.Logger().Error("Cant unmarshal data", "err", err)
-					return err
-				}
-				ctx.Logger().Info("unpacked data with: ","data",data)
-				// Only burn if the denom is expected and the channel is whitelisted
-				if packet.SourcePort == "transfer" &&
-					im.keeper.IsAllowedChannel(ctx, packet.SourceChannel) &&
-					data.Denom == "stake" {
-
-					ctx.Logger().Info("is valid source port and channel-id")
-
-					amount, ok := sdkmath.NewIntFromString(data.Amount)
-					if !ok {
-						ctx.Logger().Error("invalid token amount", "err", "")
-						return fmt.Errorf("invalid token amount")
-					}
-
-					coin := sdk.NewCoin(data.Denom, amount)
-
-					ctx.Logger().Info("In here with coin and amount", "amount", amount, "coin", coin)
-
-					// Build escrow address and burn the amount
-					escrowAddr := ibctransfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
-					if err := im.keeper.BurnEscrowedTokens(ctx, escrowAddr, coin); err != nil {
-						ctx.Logger().Error("Err burning tokens", "err", err)
-						return nil
-					}
-
-					sdk.UnwrapSDKContext(ctx).Logger().Info("Successfully burned escrowed tokens after ACK",
-						"coin", coin.String(), "escrow", escrowAddr.String())
-				}
-				
-			default:
-				ctx.Logger().Error("Unexpected acknowledgement type")
-				return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
-		}
+	data, coin, found := im.bridgedCoin(ctx, packet)
+	if !found {
+		return nil
+	}
 
+	// A failed burn must not fail the acknowledgement, otherwise the packet can never be
+	// acknowledged. The escrow is kept and the burn is retried in EndBlock instead.
+	im.keeper.BurnOrQueue(ctx, types.PendingBurn{
+		ChannelId: packet.SourceChannel,
+		Sequence:  packet.Sequence,
+		Amount:    coin,
+		Sender:    data.Sender,
+		Receiver:  data.Receiver,
+	})
 
+	return nil
+}
+
+// bridgedCoin returns the local coin escrowed by an outbound transfer over a bridge route.
+func (im IBCMiddleware) bridgedCoin(ctx sdk.Context, packet channeltypes.Packet) (ibctransfertypes.FungibleTokenPacketData, sdk.Coin, bool) {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if packet.SourcePort != ibctransfertypes.PortID {
+		return data, sdk.Coin{}, false
 	}
 
-	return nil
-}
\ No newline at end of file
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return data, sdk.Coin{}, false
+	}
+
+	route, found := im.keeper.ResolveOutboundRoute(ctx, packet, data.Denom)
+	if !found {
+		return data, sdk.Coin{}, false
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return data, sdk.Coin{}, false
+	}
+
+	return data, sdk.NewCoin(route.LocalDenom, amount), true
+}
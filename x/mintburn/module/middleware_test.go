@@ -0,0 +1,635 @@
+package mintburn_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/app/params"
+	"github.com/neutron-org/neutron/v5/testutil"
+	icqkeeper "github.com/neutron-org/neutron/v5/x/interchainqueries/keeper"
+	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+	mintburnkeeper "github.com/neutron-org/neutron/v5/x/mintburn/keeper"
+	mintburn "github.com/neutron-org/neutron/v5/x/mintburn/module"
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+const bridgedDenom = "ubridged"
+
+var authority = authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String()
+
+type MiddlewareTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+// openBridgeChannel opens a transfer channel between chain A and chain B and approves it on chain A
+func (suite *MiddlewareTestSuite) openBridgeChannel() {
+	suite.ConfigureTransferChannel()
+	_, err := suite.GetNeutronZoneApp(suite.ChainA).MintBurnKeeper.ApproveChannel(suite.ChainA.GetContext(), &types.MsgApproveChannel{
+		Authority: authority,
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+	})
+	suite.Require().NoError(err)
+}
+
+// registerRoute bridges the chain B native denom to bridgedDenom on chain A
+func (suite *MiddlewareTestSuite) registerRoute() types.BridgeRoute {
+	return suite.registerRouteFor(params.DefaultDenom)
+}
+
+// registerRouteFor bridges remoteDenom held on chain B to bridgedDenom on chain A
+func (suite *MiddlewareTestSuite) registerRouteFor(remoteDenom string) types.BridgeRoute {
+	route := types.BridgeRoute{
+		CounterpartyChainId: suite.ChainB.ChainID,
+		RemoteDenom:         remoteDenom,
+		LocalDenom:          bridgedDenom,
+		Enabled:             true,
+	}
+	_, err := suite.GetNeutronZoneApp(suite.ChainA).MintBurnKeeper.RegisterBridgeRoute(suite.ChainA.GetContext(), &types.MsgRegisterBridgeRoute{
+		Authority:   authority,
+		BridgeRoute: route,
+	})
+	suite.Require().NoError(err)
+	return route
+}
+
+func (suite *MiddlewareTestSuite) transfer(endpoint *ibctesting.Endpoint, from, to *ibctesting.TestChain, coin sdk.Coin) {
+	packet := suite.sendTransfer(endpoint, from, to.SenderAccount.GetAddress().String(), coin, uint64(time.Now().UnixNano()))
+	suite.Require().NoError(suite.TransferPath.RelayPacket(packet))
+}
+
+// sendTransfer sends coin over the endpoint without relaying the packet
+func (suite *MiddlewareTestSuite) sendTransfer(endpoint *ibctesting.Endpoint, from *ibctesting.TestChain, receiver string, coin sdk.Coin, timeoutTimestamp uint64) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelID,
+		coin,
+		from.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.NewHeight(10, 100),
+		timeoutTimestamp,
+		"",
+	)
+	res, err := suite.SendMsgsNoCheck(from, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+func (suite *MiddlewareTestSuite) TestChannelAllowListing() {
+	suite.ConfigureTransferChannel()
+	keeper := suite.GetNeutronZoneApp(suite.ChainA).MintBurnKeeper
+	suite.Require().False(keeper.IsAllowedChannel(suite.ChainA.GetContext(), suite.TransferPath.EndpointA.ChannelID))
+
+	// the route isn't pinned to the client, so the channel waits for approval
+	route := suite.registerRoute()
+	suite.ConfigureTransferChannel()
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	suite.Require().False(keeper.IsAllowedChannel(suite.ChainA.GetContext(), channelID))
+	pending, found := keeper.GetPendingChannel(suite.ChainA.GetContext(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ChainB.ChainID, pending.CounterpartyChainId)
+	suite.Require().Equal(suite.TransferPath.EndpointA.ConnectionID, pending.ConnectionId)
+	suite.Require().Equal(suite.TransferPath.EndpointA.ClientID, pending.ClientId)
+
+	approve := &types.MsgApproveChannel{Authority: authority, ChannelId: channelID}
+	_, err := keeper.ApproveChannel(suite.ChainA.GetContext(), approve)
+	suite.Require().NoError(err)
+	channel, found := keeper.GetAllowedChannel(suite.ChainA.GetContext(), channelID)
+	suite.Require().True(found)
+	suite.Require().Equal(pending, channel)
+	_, found = keeper.GetPendingChannel(suite.ChainA.GetContext(), channelID)
+	suite.Require().False(found)
+
+	_, err = keeper.ApproveChannel(suite.ChainA.GetContext(), approve)
+	suite.Require().ErrorIs(err, types.ErrChannelNotPending)
+
+	// once the route is pinned to the connection, channels opened over it are allowed right away
+	route.ConnectionId = suite.TransferPath.EndpointA.ConnectionID
+	_, err = keeper.UpdateBridgeRoute(suite.ChainA.GetContext(), &types.MsgUpdateBridgeRoute{
+		Authority:   authority,
+		BridgeRoute: route,
+	})
+	suite.Require().NoError(err)
+
+	path := testutil.NewTransferPath(suite.ChainA, suite.ChainB, suite.ChainProvider)
+	path.EndpointA.ClientID = suite.TransferPath.EndpointA.ClientID
+	path.EndpointA.ConnectionID = suite.TransferPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID = suite.TransferPath.EndpointB.ClientID
+	path.EndpointB.ConnectionID = suite.TransferPath.EndpointB.ConnectionID
+	suite.Require().NoError(testutil.SetupTransferPath(path))
+	suite.Require().True(keeper.IsAllowedChannel(suite.ChainA.GetContext(), path.EndpointA.ChannelID))
+
+	// chain B has no routes for chain A
+	suite.Require().False(suite.GetNeutronZoneApp(suite.ChainB).MintBurnKeeper.IsAllowedChannel(suite.ChainB.GetContext(), suite.TransferPath.EndpointB.ChannelID))
+}
+
+func (suite *MiddlewareTestSuite) TestCounterpartyAuthentication() {
+	suite.registerRoute()
+	keeper := suite.GetNeutronZoneApp(suite.ChainA).MintBurnKeeper
+
+	// a client trusting headers for longer than allowed is neither allowed nor pending
+	params := keeper.GetParams(suite.ChainA.GetContext())
+	suite.Require().NoError(keeper.SetParams(suite.ChainA.GetContext(), types.NewParams(time.Hour, types.DefaultReservesQueryPeriod, types.DefaultMaxReservesGap)))
+	suite.ConfigureTransferChannel()
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	suite.Require().False(keeper.IsAllowedChannel(suite.ChainA.GetContext(), channelID))
+	_, found := keeper.GetPendingChannel(suite.ChainA.GetContext(), channelID)
+	suite.Require().False(found)
+
+	// the counterparty is checked again on approval
+	suite.Require().NoError(keeper.SetParams(suite.ChainA.GetContext(), params))
+	suite.ConfigureTransferChannel()
+	channelID = suite.TransferPath.EndpointA.ChannelID
+	_, found = keeper.GetPendingChannel(suite.ChainA.GetContext(), channelID)
+	suite.Require().True(found)
+
+	suite.Require().NoError(keeper.SetParams(suite.ChainA.GetContext(), types.NewParams(time.Hour, types.DefaultReservesQueryPeriod, types.DefaultMaxReservesGap)))
+	_, err := keeper.ApproveChannel(suite.ChainA.GetContext(), &types.MsgApproveChannel{Authority: authority, ChannelId: channelID})
+	suite.Require().ErrorIs(err, types.ErrInvalidCounterpartyClient)
+	suite.Require().False(keeper.IsAllowedChannel(suite.ChainA.GetContext(), channelID))
+}
+
+func (suite *MiddlewareTestSuite) TestMintOnRecvBurnOnAck() {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	receiver := suite.ChainA.SenderAccount.GetAddress()
+	amount := sdkmath.NewInt(1000)
+
+	// B -> A mints the local denom instead of an IBC voucher
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, amount))
+
+	ctxA := suite.ChainA.GetContext()
+	suite.Require().Equal(amount, appA.BankKeeper.GetBalance(ctxA, receiver, bridgedDenom).Amount)
+	suite.Require().Equal(amount, appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, params.DefaultDenom,
+	)).IBCDenom()
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, receiver, voucher).IsZero())
+
+	// A -> B escrows and burns the local denom once acknowledged
+	suite.transfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB, sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400)))
+
+	ctxA = suite.ChainA.GetContext()
+	escrow := transfertypes.GetEscrowAddress(suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, escrow, bridgedDenom).IsZero())
+	suite.Require().True(appA.TransferKeeper.GetTotalEscrowForDenom(ctxA, bridgedDenom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(600), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+
+	stats := appA.MintBurnKeeper.GetChannelStats(ctxA, suite.TransferPath.EndpointA.ChannelID)
+	suite.Require().Equal(amount, stats.Minted.AmountOf(bridgedDenom))
+	suite.Require().Equal(sdkmath.NewInt(400), stats.Burned.AmountOf(bridgedDenom))
+
+	// the bridged supply is fully accounted for
+	suite.assertNoSupplyDrift()
+	suite.assertTransferEscrowInvariant()
+}
+
+// assertNoSupplyDrift checks that the supply of the bridged denom on chain A matches the bridge accounting
+func (suite *MiddlewareTestSuite) assertNoSupplyDrift() {
+	appA, ctxA := suite.GetNeutronZoneApp(suite.ChainA), suite.ChainA.GetContext()
+	resp, err := appA.MintBurnKeeper.SupplyDrift(ctxA, &types.QuerySupplyDriftRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Denoms, 1)
+	suite.Require().Equal(bridgedDenom, resp.Denoms[0].Denom)
+	suite.Require().True(resp.Denoms[0].Drift.IsZero(), "drift %s", resp.Denoms[0].Drift)
+	msg, broken := mintburnkeeper.AllInvariants(appA.MintBurnKeeper)(ctxA)
+	suite.Require().False(broken, msg)
+}
+
+// assertTransferEscrowInvariant checks that the escrow accounts of chain A hold at least the total the transfer
+// module tracks as escrowed, which burning escrowed tokens must lower
+func (suite *MiddlewareTestSuite) assertTransferEscrowInvariant() {
+	appA, ctxA := suite.GetNeutronZoneApp(suite.ChainA), suite.ChainA.GetContext()
+	msg, broken := transferkeeper.AllInvariants(&appA.TransferKeeper.Keeper)(ctxA)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *MiddlewareTestSuite) TestInFlight() {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(1000)))
+
+	// send without relaying, the tokens stay escrowed until acknowledged
+	suite.sendTransfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB.SenderAccount.GetAddress().String(),
+		sdk.NewCoin(bridgedDenom, sdkmath.NewInt(300)), uint64(time.Now().UnixNano()))
+
+	resp, err := appA.MintBurnKeeper.ChannelStats(suite.ChainA.GetContext(), &types.QueryChannelStatsRequest{
+		ChannelId: suite.TransferPath.EndpointA.ChannelID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bridgedDenom, sdkmath.NewInt(300))), resp.InFlight)
+	suite.Require().Equal(sdkmath.NewInt(1000), resp.Stats.Minted.AmountOf(bridgedDenom))
+	suite.Require().True(resp.Stats.Burned.IsZero())
+}
+
+func (suite *MiddlewareTestSuite) TestDisabledRoutePassesThrough() {
+	route := suite.registerRoute()
+	suite.openBridgeChannel()
+
+	route.Enabled = false
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	_, err := appA.MintBurnKeeper.UpdateBridgeRoute(suite.ChainA.GetContext(), &types.MsgUpdateBridgeRoute{
+		Authority:   authority,
+		BridgeRoute: route,
+	})
+	suite.Require().NoError(err)
+
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(1000)))
+
+	// a regular ICS20 voucher is received
+	ctxA := suite.ChainA.GetContext()
+	suite.Require().True(appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount.IsZero())
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, params.DefaultDenom,
+	)).IBCDenom()
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctxA, suite.ChainA.SenderAccount.GetAddress(), voucher).Amount)
+}
+
+// bridgeIn mints amount of the bridged denom to the chain A sender
+func (suite *MiddlewareTestSuite) bridgeIn(amount sdkmath.Int) {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, amount))
+}
+
+func (suite *MiddlewareTestSuite) TestTimeoutRefundsEscrow() {
+	suite.bridgeIn(sdkmath.NewInt(1000))
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	sender := suite.ChainA.SenderAccount.GetAddress()
+
+	// the packet times out as soon as chain B moves on
+	timeout := uint64(suite.ChainB.CurrentHeader.Time.UnixNano())
+	packet := suite.sendTransfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB.SenderAccount.GetAddress().String(),
+		sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400)), timeout)
+	suite.Require().Equal(sdkmath.NewInt(600), appA.BankKeeper.GetBalance(suite.ChainA.GetContext(), sender, bridgedDenom).Amount)
+
+	suite.Coordinator.CommitBlock(suite.ChainB)
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.TransferPath.EndpointA.TimeoutPacket(packet))
+
+	// refunded from the escrow, nothing burned
+	ctxA := suite.ChainA.GetContext()
+	escrow := transfertypes.GetEscrowAddress(suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID)
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctxA, sender, bridgedDenom).Amount)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, escrow, bridgedDenom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+	suite.Require().True(appA.MintBurnKeeper.GetChannelStats(ctxA, suite.TransferPath.EndpointA.ChannelID).Burned.IsZero())
+	suite.Require().Empty(appA.MintBurnKeeper.GetAllPendingBurns(ctxA))
+	suite.assertNoSupplyDrift()
+	suite.assertTransferEscrowInvariant()
+}
+
+func (suite *MiddlewareTestSuite) TestErrorAckRefundsEscrow() {
+	suite.bridgeIn(sdkmath.NewInt(1000))
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	sender := suite.ChainA.SenderAccount.GetAddress()
+
+	// chain B can't credit an invalid receiver and acknowledges with an error
+	packet := suite.sendTransfer(suite.TransferPath.EndpointA, suite.ChainA, "invalid-receiver",
+		sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400)), uint64(time.Now().UnixNano()))
+	suite.Require().NoError(suite.TransferPath.RelayPacket(packet))
+
+	ctxA := suite.ChainA.GetContext()
+	escrow := transfertypes.GetEscrowAddress(suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID)
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctxA, sender, bridgedDenom).Amount)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, escrow, bridgedDenom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+	suite.Require().True(appA.MintBurnKeeper.GetChannelStats(ctxA, suite.TransferPath.EndpointA.ChannelID).Burned.IsZero())
+	suite.Require().Empty(appA.MintBurnKeeper.GetAllPendingBurns(ctxA))
+	suite.assertNoSupplyDrift()
+	suite.assertTransferEscrowInvariant()
+}
+
+func (suite *MiddlewareTestSuite) TestFailedBurnIsQueuedAndRetried() {
+	suite.bridgeIn(sdkmath.NewInt(1000))
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	escrow := transfertypes.GetEscrowAddress(suite.TransferPath.EndpointA.ChannelConfig.PortID, channelID)
+	burned := sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400))
+
+	packet := suite.sendTransfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB.SenderAccount.GetAddress().String(),
+		burned, uint64(time.Now().UnixNano()))
+
+	// take the tokens out of the escrow so that the burn on ack fails
+	holder := suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+	suite.Require().NoError(appA.BankKeeper.SendCoins(suite.ChainA.GetContext(), escrow, holder, sdk.NewCoins(burned)))
+
+	// the ack is still processed
+	suite.Require().NoError(suite.TransferPath.RelayPacket(packet))
+
+	ctxA := suite.ChainA.GetContext()
+	pending, found := appA.MintBurnKeeper.GetPendingBurn(ctxA, channelID, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(burned, pending.Amount)
+	suite.Require().NotZero(pending.Attempts)
+	suite.Require().NotEmpty(pending.LastError)
+	suite.Require().Equal(suite.ChainA.SenderAccount.GetAddress().String(), pending.Sender)
+	suite.Require().Equal(suite.ChainB.SenderAccount.GetAddress().String(), pending.Receiver)
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+	suite.Require().True(appA.MintBurnKeeper.GetChannelStats(ctxA, channelID).Burned.IsZero())
+	suite.assertNoSupplyDrift()
+
+	// retried every block while the escrow is short
+	attempts := pending.Attempts
+	suite.Coordinator.CommitBlock(suite.ChainA)
+	pending, found = appA.MintBurnKeeper.GetPendingBurn(suite.ChainA.GetContext(), channelID, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(attempts+1, pending.Attempts)
+
+	// once the escrow is funded again the burn goes through
+	suite.Require().NoError(appA.BankKeeper.SendCoins(suite.ChainA.GetContext(), holder, escrow, sdk.NewCoins(burned)))
+	suite.Coordinator.CommitBlock(suite.ChainA)
+
+	ctxA = suite.ChainA.GetContext()
+	_, found = appA.MintBurnKeeper.GetPendingBurn(ctxA, channelID, packet.Sequence)
+	suite.Require().False(found)
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, escrow, bridgedDenom).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(600), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+	suite.Require().Equal(burned.Amount, appA.MintBurnKeeper.GetChannelStats(ctxA, channelID).Burned.AmountOf(bridgedDenom))
+	suite.assertNoSupplyDrift()
+	suite.assertTransferEscrowInvariant()
+}
+
+func (suite *MiddlewareTestSuite) TestQuotaExceededRejectsTransfer() {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	appB := suite.GetNeutronZoneApp(suite.ChainB)
+
+	_, err := appA.MintBurnKeeper.SetChannelQuota(suite.ChainA.GetContext(), &types.MsgSetChannelQuota{
+		Authority: authority,
+		Quota: types.ChannelQuota{
+			ChannelId:    suite.TransferPath.EndpointA.ChannelID,
+			Denom:        bridgedDenom,
+			MaxPerPacket: sdkmath.NewInt(500),
+			WindowQuota:  sdkmath.NewInt(800),
+			Window:       24 * time.Hour,
+		},
+	})
+	suite.Require().NoError(err)
+
+	senderB := suite.ChainB.SenderAccount.GetAddress()
+	balanceB := appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, params.DefaultDenom)
+
+	// over the per packet limit, chain B gets an error ack and refunds the sender
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(501)))
+	suite.Require().True(appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount.IsZero())
+	suite.Require().Equal(balanceB, appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, params.DefaultDenom))
+
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(500)))
+	suite.Require().Equal(sdkmath.NewInt(500), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+
+	// over the window quota
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(301)))
+	suite.Require().Equal(sdkmath.NewInt(500), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(300)))
+	suite.Require().Equal(sdkmath.NewInt(800), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+}
+
+func (suite *MiddlewareTestSuite) TestPausedBridgeRejectsTransfer() {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	appB := suite.GetNeutronZoneApp(suite.ChainB)
+
+	_, err := appA.MintBurnKeeper.PauseBridge(suite.ChainA.GetContext(), &types.MsgPauseBridge{Authority: authority})
+	suite.Require().NoError(err)
+
+	senderB := suite.ChainB.SenderAccount.GetAddress()
+	balanceB := appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, params.DefaultDenom)
+
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(1000)))
+	suite.Require().True(appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount.IsZero())
+	suite.Require().Equal(balanceB, appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, params.DefaultDenom))
+
+	_, err = appA.MintBurnKeeper.ResumeBridge(suite.ChainA.GetContext(), &types.MsgResumeBridge{Authority: authority})
+	suite.Require().NoError(err)
+
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(1000)))
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+}
+
+func (suite *MiddlewareTestSuite) TestBridgeEvents() {
+	suite.registerRoute()
+	suite.openBridgeChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	channelID := suite.TransferPath.EndpointA.ChannelID
+
+	packet := suite.sendTransfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA.SenderAccount.GetAddress().String(),
+		sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(1000)), uint64(time.Now().UnixNano()))
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	res, err := suite.TransferPath.EndpointA.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	event := suite.findEvent(res.GetEvents(), "neutron.mintburn.EventBridgeMint")
+	suite.Require().Equal(`"`+channelID+`"`, event["channel_id"])
+	suite.Require().Equal(fmt.Sprintf(`"%d"`, packet.Sequence), event["sequence"])
+	suite.Require().Equal(`"`+params.DefaultDenom+`"`, event["remote_denom"])
+	suite.Require().Equal(`{"denom":"ubridged","amount":"1000"}`, event["amount"])
+	suite.Require().Equal(`"`+suite.ChainA.SenderAccount.GetAddress().String()+`"`, event["receiver"])
+
+	// allow-listing is reported as well
+	middleware := mintburn.NewIBCMiddleware(appA.TransferStack, appA.MintBurnKeeper)
+	ctx := suite.ChainA.GetContext().WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(middleware.HandleChannelIdStorage(ctx, transfertypes.PortID, channelID, false))
+	event = suite.findEvent(ctx.EventManager().ABCIEvents(), "neutron.mintburn.EventChannelRevoked")
+	suite.Require().Equal(`"`+suite.ChainB.ChainID+`"`, event["counterparty_chain_id"])
+
+	suite.Require().NoError(middleware.HandleChannelIdStorage(ctx, transfertypes.PortID, channelID, true))
+	event = suite.findEvent(ctx.EventManager().ABCIEvents(), "neutron.mintburn.EventChannelPending")
+	suite.Require().Equal(`"`+suite.TransferPath.EndpointA.ConnectionID+`"`, event["connection_id"])
+	suite.Require().Equal(`"`+suite.TransferPath.EndpointA.ClientID+`"`, event["client_id"])
+
+	_, err = appA.MintBurnKeeper.ApproveChannel(ctx, &types.MsgApproveChannel{Authority: authority, ChannelId: channelID})
+	suite.Require().NoError(err)
+	event = suite.findEvent(ctx.EventManager().ABCIEvents(), "neutron.mintburn.EventChannelAllowed")
+	suite.Require().Equal(`"`+suite.TransferPath.EndpointA.ConnectionID+`"`, event["connection_id"])
+	suite.Require().Equal(`"`+suite.TransferPath.EndpointA.ClientID+`"`, event["client_id"])
+}
+
+func (suite *MiddlewareTestSuite) TestForwardedTraceIsMinted() {
+	// chain B holds a voucher of the chain C native denom
+	pathBC := testutil.NewTransferPath(suite.ChainB, suite.ChainC, suite.ChainProvider)
+	suite.Coordinator.SetupConnections(pathBC)
+	suite.Require().NoError(testutil.SetupTransferPath(pathBC))
+
+	amount := sdkmath.NewInt(1000)
+	packet := suite.sendTransfer(pathBC.EndpointB, suite.ChainC, suite.ChainB.SenderAccount.GetAddress().String(),
+		sdk.NewCoin(params.DefaultDenom, amount), uint64(time.Now().UnixNano()))
+	suite.Require().NoError(pathBC.RelayPacket(packet))
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		pathBC.EndpointA.ChannelConfig.PortID, pathBC.EndpointA.ChannelID, params.DefaultDenom,
+	))
+
+	// the route is registered for the full trace on chain B
+	suite.registerRouteFor(trace.GetFullDenomPath())
+	suite.openBridgeChannel()
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+
+	// B -> A with the prefixed trace mints the local denom
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(trace.IBCDenom(), amount))
+
+	ctxA := suite.ChainA.GetContext()
+	receiver := suite.ChainA.SenderAccount.GetAddress()
+	suite.Require().Equal(amount, appA.BankKeeper.GetBalance(ctxA, receiver, bridgedDenom).Amount)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, trace.GetFullDenomPath(),
+	)).IBCDenom()
+	suite.Require().True(appA.BankKeeper.GetBalance(ctxA, receiver, voucher).IsZero())
+
+	// A -> B is burned once acknowledged
+	suite.transfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB, sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400)))
+	suite.Require().Equal(sdkmath.NewInt(600), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+}
+
+func (suite *MiddlewareTestSuite) TestReturningVoucherIsMinted() {
+	suite.bridgeIn(sdkmath.NewInt(1000))
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	appB := suite.GetNeutronZoneApp(suite.ChainB)
+
+	// chain B has no route of its own and receives a voucher of the bridged denom
+	suite.transfer(suite.TransferPath.EndpointA, suite.ChainA, suite.ChainB, sdk.NewCoin(bridgedDenom, sdkmath.NewInt(400)))
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.TransferPath.EndpointB.ChannelConfig.PortID, suite.TransferPath.EndpointB.ChannelID, bridgedDenom,
+	)).IBCDenom()
+	senderB := suite.ChainB.SenderAccount.GetAddress()
+	suite.Require().Equal(sdkmath.NewInt(400), appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, voucher).Amount)
+	suite.Require().Equal(sdkmath.NewInt(600), appA.BankKeeper.GetSupply(suite.ChainA.GetContext(), bridgedDenom).Amount)
+
+	// the escrow was burned, so the returning voucher is minted again
+	suite.transfer(suite.TransferPath.EndpointB, suite.ChainB, suite.ChainA, sdk.NewCoin(voucher, sdkmath.NewInt(400)))
+
+	ctxA := suite.ChainA.GetContext()
+	suite.Require().True(appB.BankKeeper.GetBalance(suite.ChainB.GetContext(), senderB, voucher).IsZero())
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetBalance(ctxA, suite.ChainA.SenderAccount.GetAddress(), bridgedDenom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(1000), appA.BankKeeper.GetSupply(ctxA, bridgedDenom).Amount)
+
+	resp, err := appA.MintBurnKeeper.SupplyDrift(ctxA, &types.QuerySupplyDriftRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Denoms[0].Drift.IsZero())
+}
+
+// findEvent returns the attributes of the first event of the given type
+func (suite *MiddlewareTestSuite) findEvent(events []abci.Event, eventType string) map[string]string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		return attributes
+	}
+	suite.FailNow("event not found", eventType)
+	return nil
+}
+
+// proveReserve submits a proof of the chain B escrow balance backing the reserve
+func (suite *MiddlewareTestSuite) proveReserve(reserve types.Reserve) {
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	query, err := appA.InterchainQueriesKeeper.GetQueryByID(suite.ChainA.GetContext(), reserve.QueryId)
+	suite.Require().NoError(err)
+
+	// the proof of a state committed at height h is verified against the app hash of h+1
+	suite.Coordinator.CommitBlock(suite.ChainB)
+	suite.Require().NoError(suite.TransferPath.EndpointA.UpdateClient())
+	resp, err := suite.ChainB.App.Query(suite.ChainB.GetContext(), &abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", query.Keys[0].Path),
+		Height: suite.ChainB.LastHeader.Header.Height - 1,
+		Data:   query.Keys[0].Key,
+		Prove:  true,
+	})
+	suite.Require().NoError(err)
+
+	_, err = icqkeeper.NewMsgServerImpl(appA.InterchainQueriesKeeper).SubmitQueryResult(suite.ChainA.GetContext(), &icqtypes.MsgSubmitQueryResult{
+		QueryId: reserve.QueryId,
+		Sender:  suite.ChainA.SenderAccount.GetAddress().String(),
+		Result: &icqtypes.QueryResult{
+			KvResults: []*icqtypes.StorageValue{{
+				Key:           resp.Key,
+				Proof:         resp.ProofOps,
+				Value:         resp.Value,
+				StoragePrefix: query.Keys[0].Path,
+			}},
+			Height:   uint64(resp.Height),
+			Revision: suite.ChainA.LastHeader.GetHeight().GetRevisionNumber(),
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Coordinator.CommitBlock(suite.ChainA)
+}
+
+func (suite *MiddlewareTestSuite) TestReservesProof() {
+	suite.bridgeIn(sdkmath.NewInt(1000))
+	appA := suite.GetNeutronZoneApp(suite.ChainA)
+	channelID := suite.TransferPath.EndpointA.ChannelID
+
+	mintburnParams := appA.MintBurnKeeper.GetParams(suite.ChainA.GetContext())
+	mintburnParams.MaxReservesGap = sdkmath.LegacyNewDecWithPrec(1, 1)
+	suite.Require().NoError(appA.MintBurnKeeper.SetParams(suite.ChainA.GetContext(), mintburnParams))
+
+	// the escrow of the allowed channel is queried as soon as the channel is allowed
+	reserve, found := appA.MintBurnKeeper.GetReserve(suite.ChainA.GetContext(), channelID, bridgedDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(params.DefaultDenom, reserve.EscrowDenom)
+	suite.Require().False(reserve.IsProven())
+
+	suite.proveReserve(reserve)
+	resp, err := appA.MintBurnKeeper.ReservesStatus(suite.ChainA.GetContext(), &types.QueryReservesStatusRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Reserves, 1)
+	status := resp.Reserves[0]
+	suite.Require().True(status.Reserve.IsProven())
+	suite.Require().Equal(sdkmath.NewInt(1000), status.Reserve.Escrowed)
+	suite.Require().Equal(sdkmath.NewInt(1000), status.Outstanding)
+	suite.Require().True(status.Gap.IsZero())
+	suite.Require().False(appA.MintBurnKeeper.IsBridgePaused(suite.ChainA.GetContext(), channelID))
+
+	// the escrow drained on chain B pauses minting over the channel
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, suite.TransferPath.EndpointB.ChannelID)
+	appB := suite.GetNeutronZoneApp(suite.ChainB)
+	drained := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, sdkmath.NewInt(500)))
+	suite.Require().NoError(appB.BankKeeper.SendCoins(suite.ChainB.GetContext(), escrow, suite.ChainB.SenderAccount.GetAddress(), drained))
+	suite.Coordinator.CommitBlock(suite.ChainB)
+
+	suite.proveReserve(reserve)
+	ctxA := suite.ChainA.GetContext()
+	reserve, found = appA.MintBurnKeeper.GetReserve(ctxA, channelID, bridgedDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(500), reserve.Escrowed)
+	suite.Require().Equal(sdkmath.NewInt(500), appA.MintBurnKeeper.GetReserveStatus(ctxA, reserve).Gap)
+	suite.Require().True(appA.MintBurnKeeper.IsBridgePaused(ctxA, channelID))
+
+	// revoking the channel removes its reserve query
+	appA.MintBurnKeeper.RemoveAllowedChannel(ctxA, channelID)
+	suite.Coordinator.CommitBlock(suite.ChainA)
+	_, found = appA.MintBurnKeeper.GetReserve(suite.ChainA.GetContext(), channelID, bridgedDenom)
+	suite.Require().False(found)
+	_, err = appA.InterchainQueriesKeeper.GetQueryByID(suite.ChainA.GetContext(), reserve.QueryId)
+	suite.Require().Error(err)
+}
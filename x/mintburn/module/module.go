@@ -1,11 +1,15 @@
 package mintburn
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,15 +17,18 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/client/cli"
 	keeper "github.com/neutron-org/neutron/v5/x/mintburn/keeper"
 	mintburntypes "github.com/neutron-org/neutron/v5/x/mintburn/types"
 )
 
 var (
-    _ module.AppModule      = (*AppModule)(nil)
-    _ module.AppModuleBasic = (*AppModuleBasic)(nil)
-    _ module.HasABCIGenesis = (*AppModule)(nil)
+	_ module.AppModule      = (*AppModule)(nil)
+	_ module.AppModuleBasic = (*AppModuleBasic)(nil)
+	_ module.HasABCIGenesis = (*AppModule)(nil)
+
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the mintburn module.
@@ -34,65 +41,101 @@ func (am AppModule) IsAppModule() {}
 
 // Name returns the mintburn module's name.
 func (AppModuleBasic) Name() string {
-    return mintburntypes.ModuleName
+	return mintburntypes.ModuleName
 }
 
 // RegisterLegacyAminoCodec registers the mintburn module's types for Amino.
-func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	mintburntypes.RegisterCodec(cdc)
+}
 
 // RegisterInterfaces registers the mintburn module's interface types.
-func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	mintburntypes.RegisterInterfaces(registry)
+}
 
 // DefaultGenesis returns the mintburn module's default genesis state as raw bytes.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-    return nil // Provide default genesis state if needed
+	return cdc.MustMarshalJSON(mintburntypes.DefaultGenesis())
 }
 
 // ValidateGenesis validates the mintburn module's genesis state.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
-    return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState mintburntypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", mintburntypes.ModuleName, err)
+	}
+	return genState.Validate()
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the mintburn module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// err := providertypes.RegisterQueryHandlerClient(context.Background(), mux, providertypes.NewQueryClient(clientCtx))
-	// if err != nil {
-	// 	// same behavior as in cosmos-sdk
-	// 	panic(err)
-	// }
+	if err := mintburntypes.RegisterQueryHandlerClient(context.Background(), mux, mintburntypes.NewQueryClient(clientCtx)); err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the root Tx command for the mintburn module.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the root query command for the mintburn module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
 }
 
 // AppModule implements the AppModule interface for the mintburn module.
 type AppModule struct {
 	cdc codec.Codec
-    AppModuleBasic
-    keeper keeper.Keeper
+	AppModuleBasic
+	keeper keeper.Keeper
 }
 
 // NewAppModule creates a new AppModule object.
 func NewAppModule(cdc codec.Codec, k keeper.Keeper, appLogger log.Logger) AppModule {
 	// moduleName := mintburntypes.ModuleName
-    // moduleAccount := authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
-    return AppModule{
-		cdc: cdc,
+	// moduleAccount := authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
+	return AppModule{
+		cdc:            cdc,
 		AppModuleBasic: AppModuleBasic{},
-        keeper: k,
-    }
+		keeper:         k,
+	}
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	mintburntypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	mintburntypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
-func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	moduleName := mintburntypes.ModuleName
-    moduleAccount := authtypes.NewEmptyModuleAccount(moduleName, authtypes.Minter, authtypes.Burner)
-	ctx.Logger().Info("the module account is", "mod", moduleAccount.String())
+// RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// EndBlock retries the burns that failed when their transfers were acknowledged,
+// keeps the reserve queries in sync with the allowed channels and stores their results
+func (am AppModule) EndBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.RetryPendingBurns(sdkCtx)
+	am.keeper.SyncReserves(sdkCtx)
+	am.keeper.UpdateReserves(sdkCtx)
+	return nil
+}
+
+// InitGenesis performs the module's genesis initialization. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState mintburntypes.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	InitGenesis(ctx, am.keeper, genState)
+
 	return []abci.ValidatorUpdate{}
 }
 
-// ExportGenesis exports the genesis state for the mintburn module.
-// module.
+// ExportGenesis returns the module's exported genesis state as raw JSON bytes.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	ctx.Logger().Info("in export gen")
-	return nil
-}
\ No newline at end of file
+	return cdc.MustMarshalJSON(ExportGenesis(ctx, am.keeper))
+}
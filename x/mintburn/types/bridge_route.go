@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs a stateless validation of the route.
+func (r BridgeRoute) Validate() error {
+	if strings.TrimSpace(r.CounterpartyChainId) == "" {
+		return errorsmod.Wrap(ErrInvalidBridgeRoute, "counterparty_chain_id must not be empty")
+	}
+	if strings.Contains(r.CounterpartyChainId, "/") {
+		return errorsmod.Wrap(ErrInvalidBridgeRoute, "counterparty_chain_id must not contain '/'")
+	}
+	if r.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(r.ConnectionId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidBridgeRoute, "invalid connection_id: %s", err)
+		}
+	}
+	if r.ClientId != "" {
+		if err := host.ClientIdentifierValidator(r.ClientId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidBridgeRoute, "invalid client_id: %s", err)
+		}
+	}
+	if err := sdk.ValidateDenom(r.RemoteDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBridgeRoute, "invalid remote_denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(r.LocalDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBridgeRoute, "invalid local_denom: %s", err)
+	}
+	return nil
+}
+
+// IsPinned reports whether the route is pinned to a connection or a light client.
+func (r BridgeRoute) IsPinned() bool {
+	return r.ConnectionId != "" || r.ClientId != ""
+}
+
+// Matches reports whether the route may be used over the given allowed channel,
+// taking the optional connection and client pinning into account.
+func (r BridgeRoute) Matches(channel AllowedChannel) bool {
+	if r.CounterpartyChainId != channel.CounterpartyChainId {
+		return false
+	}
+	if r.ConnectionId != "" && r.ConnectionId != channel.ConnectionId {
+		return false
+	}
+	if r.ClientId != "" && r.ClientId != channel.ClientId {
+		return false
+	}
+	return true
+}
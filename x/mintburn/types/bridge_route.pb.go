@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/bridge_route.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BridgeRoute describes an asset that is minted on receive and burned after a
+// successful send over transfer channels to a given counterparty chain.
+type BridgeRoute struct {
+	// counterparty_chain_id is the chain-id reported by the counterparty light client.
+	CounterpartyChainId string `protobuf:"bytes,1,opt,name=counterparty_chain_id,json=counterpartyChainId,proto3" json:"counterparty_chain_id,omitempty"`
+	// connection_id optionally pins the route to a single IBC connection.
+	// Channels opened over a connection or client the route is pinned to are
+	// allow-listed right away, others wait for MsgApproveChannel.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// client_id optionally pins the route to a single light client.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// remote_denom is the denom of the bridged asset on the counterparty chain. An asset
+	// the counterparty holds as an IBC voucher is denominated by its full trace, e.g.
+	// transfer/channel-1/uatom, or by its ibc/ hash.
+	RemoteDenom string `protobuf:"bytes,4,opt,name=remote_denom,json=remoteDenom,proto3" json:"remote_denom,omitempty"`
+	// local_denom is the denom minted and burned on this chain.
+	LocalDenom string `protobuf:"bytes,5,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty"`
+	// enabled allows to switch a route off without removing it.
+	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *BridgeRoute) Reset()         { *m = BridgeRoute{} }
+func (m *BridgeRoute) String() string { return proto.CompactTextString(m) }
+func (*BridgeRoute) ProtoMessage()    {}
+func (*BridgeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fd024b2befb6aa7, []int{0}
+}
+func (m *BridgeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeRoute.Merge(m, src)
+}
+func (m *BridgeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeRoute proto.InternalMessageInfo
+
+func (m *BridgeRoute) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetRemoteDenom() string {
+	if m != nil {
+		return m.RemoteDenom
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetLocalDenom() string {
+	if m != nil {
+		return m.LocalDenom
+	}
+	return ""
+}
+
+func (m *BridgeRoute) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// AllowedChannel is a transfer channel that may mint and burn bridged assets.
+type AllowedChannel struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// counterparty_chain_id is the chain-id of the client the channel was opened over.
+	CounterpartyChainId string `protobuf:"bytes,2,opt,name=counterparty_chain_id,json=counterpartyChainId,proto3" json:"counterparty_chain_id,omitempty"`
+	ConnectionId        string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ClientId            string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *AllowedChannel) Reset()         { *m = AllowedChannel{} }
+func (m *AllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*AllowedChannel) ProtoMessage()    {}
+func (*AllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fd024b2befb6aa7, []int{1}
+}
+func (m *AllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedChannel.Merge(m, src)
+}
+func (m *AllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedChannel proto.InternalMessageInfo
+
+func (m *AllowedChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AllowedChannel) GetCounterpartyChainId() string {
+	if m != nil {
+		return m.CounterpartyChainId
+	}
+	return ""
+}
+
+func (m *AllowedChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AllowedChannel) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*BridgeRoute)(nil), "neutron.mintburn.BridgeRoute")
+	proto.RegisterType((*AllowedChannel)(nil), "neutron.mintburn.AllowedChannel")
+}
+
+func init() {
+	proto.RegisterFile("neutron/mintburn/bridge_route.proto", fileDescriptor_2fd024b2befb6aa7)
+}
+
+var fileDescriptor_2fd024b2befb6aa7 = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4e, 0x02, 0x31,
+	0x14, 0x86, 0x29, 0x20, 0x42, 0x41, 0x63, 0x6a, 0x4c, 0x26, 0x31, 0x8e, 0x08, 0x1b, 0x36, 0x32,
+	0x09, 0xc6, 0x03, 0x08, 0x6e, 0x26, 0x71, 0xc5, 0xd2, 0xcd, 0x64, 0xa6, 0x7d, 0x81, 0x26, 0x9d,
+	0x3e, 0x52, 0x3b, 0x2a, 0xb7, 0xf0, 0x12, 0xde, 0xc5, 0x25, 0x4b, 0x97, 0x0a, 0x17, 0x31, 0xd3,
+	0x19, 0x82, 0x2e, 0x8c, 0x71, 0xf7, 0xfa, 0xfd, 0xdf, 0xa6, 0xff, 0x4f, 0xfb, 0x1a, 0x32, 0x6b,
+	0x50, 0x07, 0xa9, 0xd4, 0x36, 0xc9, 0x8c, 0x0e, 0x12, 0x23, 0xc5, 0x0c, 0x22, 0x83, 0x99, 0x85,
+	0xe1, 0xc2, 0xa0, 0x45, 0x76, 0x54, 0x4a, 0xc3, 0xad, 0xd4, 0xfb, 0x24, 0xb4, 0x3d, 0x76, 0xe2,
+	0x34, 0xf7, 0xd8, 0x88, 0x9e, 0x70, 0xcc, 0xb4, 0x05, 0xb3, 0x88, 0x8d, 0x5d, 0x46, 0x7c, 0x1e,
+	0x4b, 0x1d, 0x49, 0xe1, 0x91, 0x2e, 0x19, 0xb4, 0xa6, 0xc7, 0xdf, 0xc3, 0x49, 0x9e, 0x85, 0x82,
+	0xf5, 0xe9, 0x01, 0x47, 0xad, 0x81, 0x5b, 0x89, 0xce, 0xad, 0x3a, 0xb7, 0xb3, 0x83, 0xa1, 0x60,
+	0xa7, 0xb4, 0xc5, 0x95, 0x04, 0x6d, 0x73, 0xa1, 0xe6, 0x84, 0x66, 0x01, 0x42, 0xc1, 0x2e, 0x68,
+	0xc7, 0x40, 0x8a, 0x16, 0x22, 0x01, 0x1a, 0x53, 0xaf, 0xee, 0xf2, 0x76, 0xc1, 0x6e, 0x73, 0xc4,
+	0xce, 0x69, 0x5b, 0x21, 0x8f, 0x55, 0x69, 0xec, 0x39, 0x83, 0x3a, 0x54, 0x08, 0x1e, 0xdd, 0x07,
+	0x1d, 0x27, 0x0a, 0x84, 0xd7, 0xe8, 0x92, 0x41, 0x73, 0xba, 0x7d, 0xf6, 0x5e, 0x09, 0x3d, 0xbc,
+	0x51, 0x0a, 0x9f, 0x40, 0x4c, 0xe6, 0xb1, 0xd6, 0xa0, 0xd8, 0x19, 0xa5, 0xbc, 0x38, 0x77, 0x7f,
+	0x6b, 0x95, 0x24, 0x14, 0xbf, 0xb7, 0x50, 0xfd, 0x47, 0x0b, 0xb5, 0xbf, 0x5a, 0xa8, 0xff, 0x6c,
+	0x61, 0x7c, 0xf7, 0xb6, 0xf6, 0xc9, 0x6a, 0xed, 0x93, 0x8f, 0xb5, 0x4f, 0x5e, 0x36, 0x7e, 0x65,
+	0xb5, 0xf1, 0x2b, 0xef, 0x1b, 0xbf, 0x72, 0x3f, 0x9a, 0x49, 0x3b, 0xcf, 0x92, 0x21, 0xc7, 0x34,
+	0x28, 0x27, 0xbc, 0x44, 0x33, 0xdb, 0xde, 0xc1, 0xe3, 0x75, 0xf0, 0xbc, 0x1b, 0xde, 0x2e, 0x17,
+	0xf0, 0x90, 0x34, 0xdc, 0xe4, 0x57, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x88, 0x8b, 0x8a,
+	0x19, 0x02, 0x00, 0x00,
+}
+
+func (m *BridgeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.LocalDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RemoteDenom) > 0 {
+		i -= len(m.RemoteDenom)
+		copy(dAtA[i:], m.RemoteDenom)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.RemoteDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyChainId) > 0 {
+		i -= len(m.CounterpartyChainId)
+		copy(dAtA[i:], m.CounterpartyChainId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.CounterpartyChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintBridgeRoute(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBridgeRoute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBridgeRoute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.RemoteDenom)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *AllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.CounterpartyChainId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovBridgeRoute(uint64(l))
+	}
+	return n
+}
+
+func sovBridgeRoute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBridgeRoute(x uint64) (n int) {
+	return sovBridgeRoute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBridgeRoute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBridgeRoute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBridgeRoute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBridgeRoute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBridgeRoute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBridgeRoute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBridgeRoute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBridgeRoute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBridgeRoute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBridgeRoute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBridgeRoute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBridgeRoute = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewChannelStats returns empty stats for the channel.
+func NewChannelStats(channelID string) ChannelStats {
+	return ChannelStats{
+		ChannelId: channelID,
+		Minted:    sdk.NewCoins(),
+		Burned:    sdk.NewCoins(),
+	}
+}
+
+// Validate performs a stateless validation of the channel stats.
+func (s ChannelStats) Validate() error {
+	if err := host.ChannelIdentifierValidator(s.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel stats channel id: %w", err)
+	}
+	if err := s.Minted.Validate(); err != nil {
+		return fmt.Errorf("invalid minted amount for channel %s: %w", s.ChannelId, err)
+	}
+	if err := s.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned amount for channel %s: %w", s.ChannelId, err)
+	}
+	return nil
+}
+
+// NewSupplyAdjustment returns an empty supply adjustment.
+func NewSupplyAdjustment() SupplyAdjustment {
+	return SupplyAdjustment{
+		Minted: sdk.NewCoins(),
+		Burned: sdk.NewCoins(),
+	}
+}
+
+// Validate performs a stateless validation of the supply adjustment.
+func (a SupplyAdjustment) Validate() error {
+	if err := a.Minted.Validate(); err != nil {
+		return fmt.Errorf("invalid adjusted minted amount: %w", err)
+	}
+	if err := a.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid adjusted burned amount: %w", err)
+	}
+	return nil
+}
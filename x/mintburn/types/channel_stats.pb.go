@@ -0,0 +1,1264 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/channel_stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelStats holds the cumulative amounts minted and burned over a channel.
+type ChannelStats struct {
+	ChannelId string                                   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Minted    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	Burned    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *ChannelStats) Reset()         { *m = ChannelStats{} }
+func (m *ChannelStats) String() string { return proto.CompactTextString(m) }
+func (*ChannelStats) ProtoMessage()    {}
+func (*ChannelStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e8c2f83d51cadd, []int{0}
+}
+func (m *ChannelStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelStats.Merge(m, src)
+}
+func (m *ChannelStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelStats proto.InternalMessageInfo
+
+func (m *ChannelStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelStats) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *ChannelStats) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// DenomSupply reconciles the bank supply of a bridged local denom against the
+// amounts minted and burned by the bridge.
+type DenomSupply struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply is the bank supply of the denom.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// baseline is the supply the denom had when it was first bridged.
+	Baseline cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=baseline,proto3,customtype=cosmossdk.io/math.Int" json:"baseline"`
+	Minted   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=minted,proto3,customtype=cosmossdk.io/math.Int" json:"minted"`
+	Burned   cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=burned,proto3,customtype=cosmossdk.io/math.Int" json:"burned"`
+	// escrowed is the part of the supply held in escrow of allowed channels.
+	Escrowed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=escrowed,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed"`
+	// drift is supply - (baseline + minted - burned + adjusted_minted - adjusted_burned).
+	// It is zero unless the supply changed outside of the bridge.
+	Drift cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=drift,proto3,customtype=cosmossdk.io/math.Int" json:"drift"`
+	// adjusted_minted is the amount minted by MsgMint without a channel.
+	AdjustedMinted cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=adjusted_minted,json=adjustedMinted,proto3,customtype=cosmossdk.io/math.Int" json:"adjusted_minted"`
+	// adjusted_burned is the amount burned by MsgBurn without a channel.
+	AdjustedBurned cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=adjusted_burned,json=adjustedBurned,proto3,customtype=cosmossdk.io/math.Int" json:"adjusted_burned"`
+}
+
+func (m *DenomSupply) Reset()         { *m = DenomSupply{} }
+func (m *DenomSupply) String() string { return proto.CompactTextString(m) }
+func (*DenomSupply) ProtoMessage()    {}
+func (*DenomSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e8c2f83d51cadd, []int{1}
+}
+func (m *DenomSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSupply.Merge(m, src)
+}
+func (m *DenomSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomSupply proto.InternalMessageInfo
+
+func (m *DenomSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// SupplyAdjustment holds the cumulative amounts minted and burned by MsgMint and
+// MsgBurn without a channel.
+type SupplyAdjustment struct {
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *SupplyAdjustment) Reset()         { *m = SupplyAdjustment{} }
+func (m *SupplyAdjustment) String() string { return proto.CompactTextString(m) }
+func (*SupplyAdjustment) ProtoMessage()    {}
+func (*SupplyAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e8c2f83d51cadd, []int{2}
+}
+func (m *SupplyAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyAdjustment.Merge(m, src)
+}
+func (m *SupplyAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyAdjustment proto.InternalMessageInfo
+
+func (m *SupplyAdjustment) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *SupplyAdjustment) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChannelStats)(nil), "neutron.mintburn.ChannelStats")
+	proto.RegisterType((*DenomSupply)(nil), "neutron.mintburn.DenomSupply")
+	proto.RegisterType((*SupplyAdjustment)(nil), "neutron.mintburn.SupplyAdjustment")
+}
+
+func init() {
+	proto.RegisterFile("neutron/mintburn/channel_stats.proto", fileDescriptor_71e8c2f83d51cadd)
+}
+
+var fileDescriptor_71e8c2f83d51cadd = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x8f, 0xdb, 0x35, 0xac, 0x1e, 0x82, 0xc9, 0x1a, 0x52, 0x98, 0xb4, 0x74, 0x9a, 0x38, 0xf4,
+	0x32, 0x9b, 0x6d, 0xea, 0x81, 0x23, 0x19, 0x42, 0x9a, 0x04, 0x97, 0xee, 0xc6, 0x65, 0x4a, 0x62,
+	0x93, 0x9a, 0x35, 0x76, 0x14, 0x3b, 0x83, 0x9d, 0xf9, 0x02, 0x7c, 0x0e, 0x3e, 0x49, 0x8f, 0x3d,
+	0x22, 0x84, 0x0a, 0x6a, 0x3f, 0x04, 0x57, 0xe4, 0xd8, 0x29, 0x3d, 0x86, 0x43, 0x39, 0xe5, 0xe5,
+	0xf9, 0xf7, 0xe7, 0xbd, 0xa7, 0xa7, 0x07, 0x9f, 0x09, 0x56, 0xe9, 0x52, 0x0a, 0x92, 0x73, 0xa1,
+	0x93, 0xaa, 0x14, 0x24, 0x9d, 0xc4, 0x42, 0xb0, 0xe9, 0x8d, 0xd2, 0xb1, 0x56, 0xb8, 0x28, 0xa5,
+	0x96, 0x68, 0xdf, 0xa1, 0x70, 0x83, 0x3a, 0x0c, 0x53, 0xa9, 0x72, 0xa9, 0x48, 0x12, 0x2b, 0x46,
+	0xee, 0xce, 0x12, 0xa6, 0xe3, 0x33, 0x92, 0x4a, 0x2e, 0x2c, 0xe3, 0xf0, 0x20, 0x93, 0x99, 0xac,
+	0x43, 0x62, 0x22, 0x9b, 0x3d, 0xf9, 0x0d, 0xe0, 0xc3, 0x4b, 0xab, 0x7f, 0x6d, 0xe4, 0xd1, 0x11,
+	0x84, 0x8d, 0x1f, 0xa7, 0x01, 0x38, 0x06, 0xc3, 0xfe, 0xb8, 0xef, 0x32, 0x57, 0x14, 0xa5, 0xd0,
+	0x37, 0x8e, 0x8c, 0x06, 0x9d, 0xe3, 0xee, 0x70, 0xef, 0xfc, 0x29, 0xb6, 0xb6, 0xd8, 0xd8, 0x62,
+	0x67, 0x8b, 0x2f, 0x25, 0x17, 0xd1, 0xf3, 0xd9, 0x62, 0xe0, 0x7d, 0xfd, 0x39, 0x18, 0x66, 0x5c,
+	0x4f, 0xaa, 0x04, 0xa7, 0x32, 0x27, 0xae, 0x46, 0xfb, 0x39, 0x55, 0xf4, 0x96, 0xe8, 0xfb, 0x82,
+	0xa9, 0x9a, 0xa0, 0xc6, 0x4e, 0xda, 0x98, 0x98, 0x96, 0x18, 0x0d, 0xba, 0x5b, 0x30, 0xb1, 0xd2,
+	0x27, 0x9f, 0x77, 0xe0, 0xde, 0x2b, 0x26, 0x64, 0x7e, 0x5d, 0x15, 0xc5, 0xf4, 0x1e, 0x1d, 0xc0,
+	0x1e, 0x35, 0xbf, 0xae, 0x67, 0xfb, 0x83, 0x46, 0xd0, 0x57, 0xf5, 0x7b, 0xd0, 0x31, 0xe9, 0xe8,
+	0xc8, 0xf8, 0x7d, 0x5f, 0x0c, 0x9e, 0x58, 0x75, 0x45, 0x6f, 0x31, 0x97, 0x24, 0x8f, 0xf5, 0x04,
+	0x5f, 0x09, 0x3d, 0x76, 0x60, 0xf4, 0x02, 0xee, 0x9a, 0x5a, 0xa7, 0x5c, 0xb0, 0xa0, 0xdb, 0x86,
+	0xb8, 0x86, 0x1b, 0x47, 0x37, 0xe1, 0x9d, 0x56, 0x8e, 0x6e, 0x66, 0xa3, 0xf5, 0xcc, 0x7a, 0xad,
+	0x68, 0x16, 0x6c, 0x0a, 0x65, 0x2a, 0x2d, 0xe5, 0x47, 0x46, 0x03, 0xbf, 0x55, 0xa1, 0x0d, 0x1c,
+	0x5d, 0xc0, 0x1e, 0x2d, 0xf9, 0x7b, 0x1d, 0x3c, 0x68, 0xc3, 0xb3, 0x58, 0xf4, 0x1a, 0x3e, 0x8e,
+	0xe9, 0x87, 0x4a, 0x69, 0x46, 0x6f, 0x5c, 0x9b, 0xbb, 0x6d, 0xe8, 0x8f, 0x1a, 0xd6, 0x5b, 0xdb,
+	0xee, 0xa6, 0x8e, 0xeb, 0xbb, 0xff, 0x4f, 0x3a, 0x91, 0xdd, 0x82, 0x1f, 0x00, 0xee, 0xdb, 0x05,
+	0x78, 0x59, 0x3f, 0xe4, 0x4c, 0xe8, 0x8d, 0x25, 0x07, 0xff, 0x63, 0xc9, 0x3b, 0x5b, 0x5b, 0xf2,
+	0xe8, 0xcd, 0x6c, 0x19, 0x82, 0xf9, 0x32, 0x04, 0xbf, 0x96, 0x21, 0xf8, 0xb2, 0x0a, 0xbd, 0xf9,
+	0x2a, 0xf4, 0xbe, 0xad, 0x42, 0xef, 0xdd, 0xf9, 0x86, 0x96, 0xbb, 0x25, 0xa7, 0xb2, 0xcc, 0x9a,
+	0x98, 0xdc, 0x8d, 0xc8, 0xa7, 0xbf, 0x27, 0xa8, 0xd6, 0x4e, 0xfc, 0xfa, 0x66, 0x5c, 0xfc, 0x09,
+	0x00, 0x00, 0xff, 0xff, 0xdd, 0x82, 0x12, 0xeb, 0xa3, 0x04, 0x00, 0x00,
+}
+
+func (m *ChannelStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannelStats(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AdjustedBurned.Size()
+		i -= size
+		if _, err := m.AdjustedBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AdjustedMinted.Size()
+		i -= size
+		if _, err := m.AdjustedMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Drift.Size()
+		i -= size
+		if _, err := m.Drift.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Escrowed.Size()
+		i -= size
+		if _, err := m.Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Baseline.Size()
+		i -= size
+		if _, err := m.Baseline.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintChannelStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintChannelStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChannelStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannelStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannelStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannelStats(uint64(l))
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovChannelStats(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovChannelStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovChannelStats(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.Baseline.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.Burned.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.Escrowed.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.Drift.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.AdjustedMinted.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	l = m.AdjustedBurned.Size()
+	n += 1 + l + sovChannelStats(uint64(l))
+	return n
+}
+
+func (m *SupplyAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovChannelStats(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovChannelStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovChannelStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChannelStats(x uint64) (n int) {
+	return sovChannelStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drift", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Drift.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustedMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustedBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustedBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannelStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannelStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannelStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChannelStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChannelStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChannelStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChannelStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChannelStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChannelStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChannelStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChannelStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChannelStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterBridgeRoute{}, "neutron.mintburn.MsgRegisterBridgeRoute", nil)
+	cdc.RegisterConcrete(&MsgUpdateBridgeRoute{}, "neutron.mintburn.MsgUpdateBridgeRoute", nil)
+	cdc.RegisterConcrete(&MsgRemoveBridgeRoute{}, "neutron.mintburn.MsgRemoveBridgeRoute", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron.mintburn.MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetChannelQuota{}, "neutron.mintburn.MsgSetChannelQuota", nil)
+	cdc.RegisterConcrete(&MsgRemoveChannelQuota{}, "neutron.mintburn.MsgRemoveChannelQuota", nil)
+	cdc.RegisterConcrete(&MsgPauseBridge{}, "neutron.mintburn.MsgPauseBridge", nil)
+	cdc.RegisterConcrete(&MsgResumeBridge{}, "neutron.mintburn.MsgResumeBridge", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "neutron.mintburn.MsgMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "neutron.mintburn.MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgApproveChannel{}, "neutron.mintburn.MsgApproveChannel", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterBridgeRoute{},
+		&MsgUpdateBridgeRoute{},
+		&MsgRemoveBridgeRoute{},
+		&MsgUpdateParams{},
+		&MsgSetChannelQuota{},
+		&MsgRemoveChannelQuota{},
+		&MsgPauseBridge{},
+		&MsgResumeBridge{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgApproveChannel{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
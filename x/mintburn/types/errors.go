@@ -0,0 +1,22 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/mintburn module sentinel errors
+var (
+	ErrInvalidBridgeRoute        = errors.Register(ModuleName, 1100, "invalid bridge route")
+	ErrBridgeRouteNotFound       = errors.Register(ModuleName, 1101, "bridge route not found")
+	ErrBridgeRouteExists         = errors.Register(ModuleName, 1102, "bridge route already exists")
+	ErrLocalDenomAlreadyBridged  = errors.Register(ModuleName, 1103, "local denom is already bridged to the counterparty chain")
+	ErrInvalidCounterpartyClient = errors.Register(ModuleName, 1104, "invalid counterparty client")
+	ErrInvalidChannelQuota       = errors.Register(ModuleName, 1105, "invalid channel quota")
+	ErrChannelQuotaNotFound      = errors.Register(ModuleName, 1106, "channel quota not found")
+	ErrQuotaExceeded             = errors.Register(ModuleName, 1107, "mint quota exceeded")
+	ErrBridgePaused              = errors.Register(ModuleName, 1108, "bridge is paused")
+	ErrInvalidChannel            = errors.Register(ModuleName, 1109, "channel can't be allow-listed")
+	ErrChannelNotPending         = errors.Register(ModuleName, 1110, "channel is not pending approval")
+)
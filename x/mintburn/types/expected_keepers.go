@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+)
+
+// BankKeeper defines the expected interface needed to mint and burn bridged assets.
+type BankKeeper interface {
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
+
+// TransferKeeper defines the expected ICS-20 transfer keeper, which tracks the total escrowed per denom.
+type TransferKeeper interface {
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
+}
+
+// ChannelKeeper defines the expected IBC channel keeper.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChannel string) (channeltypes.Channel, bool)
+}
+
+// ConnectionKeeper defines the expected IBC connection keeper.
+type ConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
+
+// ClientKeeper defines the expected IBC client keeper.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientStatus(ctx sdk.Context, clientState ibcexported.ClientState, clientID string) ibcexported.Status
+}
+
+// InterchainQueriesKeeper defines the expected interface needed to prove the counterparty escrow.
+type InterchainQueriesKeeper interface {
+	RegisterModuleQuery(ctx sdk.Context, owner string, keys []*icqtypes.KVKey, connectionID string, updatePeriod uint64) (uint64, error)
+	RemoveModuleQuery(ctx sdk.Context, owner string, queryID uint64) error
+	GetQueryByID(ctx sdk.Context, id uint64) (*icqtypes.RegisteredQuery, error)
+	GetQueryResultByID(ctx sdk.Context, id uint64) (*icqtypes.QueryResult, error)
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		BridgeRoutes:     []BridgeRoute{},
+		AllowedChannels:  []AllowedChannel{},
+		ChannelStats:     []ChannelStats{},
+		Params:           DefaultParams(),
+		SupplyBaselines:  []sdk.Coin{},
+		PendingBurns:     []PendingBurn{},
+		ChannelQuotas:    []ChannelQuota{},
+		QuotaUsages:      []QuotaUsage{},
+		PausedChannels:   []string{},
+		PendingChannels:  []AllowedChannel{},
+		Reserves:         []Reserve{},
+		SupplyAdjustment: NewSupplyAdjustment(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	routes := make(map[string]struct{}, len(gs.BridgeRoutes))
+	localDenoms := make(map[string]struct{}, len(gs.BridgeRoutes))
+	for _, route := range gs.BridgeRoutes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetBridgeRouteKey(route.CounterpartyChainId, route.RemoteDenom))
+		if _, ok := routes[key]; ok {
+			return fmt.Errorf("duplicate bridge route %s/%s", route.CounterpartyChainId, route.RemoteDenom)
+		}
+		routes[key] = struct{}{}
+
+		localKey := string(GetBridgeRouteKey(route.CounterpartyChainId, route.LocalDenom))
+		if _, ok := localDenoms[localKey]; ok {
+			return fmt.Errorf("local denom %s is bridged to %s more than once", route.LocalDenom, route.CounterpartyChainId)
+		}
+		localDenoms[localKey] = struct{}{}
+	}
+
+	channels := make(map[string]struct{}, len(gs.AllowedChannels))
+	for _, channel := range gs.AllowedChannels {
+		if err := channel.Validate(); err != nil {
+			return err
+		}
+		if _, ok := channels[channel.ChannelId]; ok {
+			return fmt.Errorf("duplicate allowed channel %s", channel.ChannelId)
+		}
+		channels[channel.ChannelId] = struct{}{}
+	}
+
+	pendingChannels := make(map[string]struct{}, len(gs.PendingChannels))
+	for _, channel := range gs.PendingChannels {
+		if err := channel.Validate(); err != nil {
+			return err
+		}
+		if _, ok := pendingChannels[channel.ChannelId]; ok {
+			return fmt.Errorf("duplicate pending channel %s", channel.ChannelId)
+		}
+		if _, ok := channels[channel.ChannelId]; ok {
+			return fmt.Errorf("channel %s is both allowed and pending", channel.ChannelId)
+		}
+		pendingChannels[channel.ChannelId] = struct{}{}
+	}
+
+	reserves := make(map[string]struct{}, len(gs.Reserves))
+	for _, reserve := range gs.Reserves {
+		if err := reserve.Validate(); err != nil {
+			return err
+		}
+		key := string(GetReserveKey(reserve.ChannelId, reserve.LocalDenom))
+		if _, ok := reserves[key]; ok {
+			return fmt.Errorf("duplicate reserve %s/%s", reserve.ChannelId, reserve.LocalDenom)
+		}
+		reserves[key] = struct{}{}
+	}
+
+	stats := make(map[string]struct{}, len(gs.ChannelStats))
+	for _, s := range gs.ChannelStats {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if _, ok := stats[s.ChannelId]; ok {
+			return fmt.Errorf("duplicate stats for channel %s", s.ChannelId)
+		}
+		stats[s.ChannelId] = struct{}{}
+	}
+
+	if err := gs.SupplyAdjustment.Validate(); err != nil {
+		return err
+	}
+
+	// a freshly bridged denom has a zero baseline, so these are not validated as sdk.Coins
+	baselines := make(map[string]struct{}, len(gs.SupplyBaselines))
+	for _, baseline := range gs.SupplyBaselines {
+		if err := baseline.Validate(); err != nil {
+			return fmt.Errorf("invalid supply baseline: %w", err)
+		}
+		if _, ok := baselines[baseline.Denom]; ok {
+			return fmt.Errorf("duplicate supply baseline for %s", baseline.Denom)
+		}
+		baselines[baseline.Denom] = struct{}{}
+	}
+
+	pendingBurns := make(map[string]struct{}, len(gs.PendingBurns))
+	for _, burn := range gs.PendingBurns {
+		if err := burn.Validate(); err != nil {
+			return err
+		}
+		key := string(GetPendingBurnKey(burn.ChannelId, burn.Sequence))
+		if _, ok := pendingBurns[key]; ok {
+			return fmt.Errorf("duplicate pending burn %s/%d", burn.ChannelId, burn.Sequence)
+		}
+		pendingBurns[key] = struct{}{}
+	}
+
+	quotas := make(map[string]struct{}, len(gs.ChannelQuotas))
+	for _, quota := range gs.ChannelQuotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		key := string(GetChannelQuotaKey(quota.ChannelId, quota.Denom))
+		if _, ok := quotas[key]; ok {
+			return fmt.Errorf("duplicate channel quota %s/%s", quota.ChannelId, quota.Denom)
+		}
+		quotas[key] = struct{}{}
+	}
+
+	usages := make(map[string]struct{}, len(gs.QuotaUsages))
+	for _, usage := range gs.QuotaUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+		key := string(GetChannelQuotaKey(usage.ChannelId, usage.Denom))
+		if _, ok := usages[key]; ok {
+			return fmt.Errorf("duplicate quota usage %s/%s", usage.ChannelId, usage.Denom)
+		}
+		usages[key] = struct{}{}
+	}
+
+	pausedChannels := make(map[string]struct{}, len(gs.PausedChannels))
+	for _, channelID := range gs.PausedChannels {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid paused channel id: %w", err)
+		}
+		if _, ok := pausedChannels[channelID]; ok {
+			return fmt.Errorf("duplicate paused channel %s", channelID)
+		}
+		pausedChannels[channelID] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the allowed channel.
+func (c AllowedChannel) Validate() error {
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return fmt.Errorf("invalid allowed channel id: %w", err)
+	}
+	if c.CounterpartyChainId == "" {
+		return fmt.Errorf("empty counterparty chain id for allowed channel %s", c.ChannelId)
+	}
+	if err := host.ConnectionIdentifierValidator(c.ConnectionId); err != nil {
+		return fmt.Errorf("invalid connection id for allowed channel %s: %w", c.ChannelId, err)
+	}
+	if err := host.ClientIdentifierValidator(c.ClientId); err != nil {
+		return fmt.Errorf("invalid client id for allowed channel %s: %w", c.ChannelId, err)
+	}
+	return nil
+}
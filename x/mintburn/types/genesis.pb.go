@@ -0,0 +1,1057 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the mintburn module's genesis state.
+type GenesisState struct {
+	BridgeRoutes    []BridgeRoute    `protobuf:"bytes,1,rep,name=bridge_routes,json=bridgeRoutes,proto3" json:"bridge_routes"`
+	AllowedChannels []AllowedChannel `protobuf:"bytes,2,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	ChannelStats    []ChannelStats   `protobuf:"bytes,3,rep,name=channel_stats,json=channelStats,proto3" json:"channel_stats"`
+	Params          Params           `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// supply_baselines holds the supply of every bridged local denom at the time
+	// it was first bridged.
+	SupplyBaselines []types.Coin   `protobuf:"bytes,5,rep,name=supply_baselines,json=supplyBaselines,proto3" json:"supply_baselines"`
+	PendingBurns    []PendingBurn  `protobuf:"bytes,6,rep,name=pending_burns,json=pendingBurns,proto3" json:"pending_burns"`
+	ChannelQuotas   []ChannelQuota `protobuf:"bytes,7,rep,name=channel_quotas,json=channelQuotas,proto3" json:"channel_quotas"`
+	QuotaUsages     []QuotaUsage   `protobuf:"bytes,8,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages"`
+	// paused is set when minting is paused over every channel.
+	Paused         bool     `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedChannels []string `protobuf:"bytes,10,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels,omitempty"`
+	// pending_channels are opened channels waiting for MsgApproveChannel.
+	PendingChannels []AllowedChannel `protobuf:"bytes,11,rep,name=pending_channels,json=pendingChannels,proto3" json:"pending_channels"`
+	Reserves        []Reserve        `protobuf:"bytes,12,rep,name=reserves,proto3" json:"reserves"`
+	// supply_adjustment holds the amounts minted and burned by the authority without a channel.
+	SupplyAdjustment SupplyAdjustment `protobuf:"bytes,13,opt,name=supply_adjustment,json=supplyAdjustment,proto3" json:"supply_adjustment"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f076ac710367a5b0, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetBridgeRoutes() []BridgeRoute {
+	if m != nil {
+		return m.BridgeRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelStats() []ChannelStats {
+	if m != nil {
+		return m.ChannelStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSupplyBaselines() []types.Coin {
+	if m != nil {
+		return m.SupplyBaselines
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingBurns() []PendingBurn {
+	if m != nil {
+		return m.PendingBurns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelQuotas() []ChannelQuota {
+	if m != nil {
+		return m.ChannelQuotas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQuotaUsages() []QuotaUsage {
+	if m != nil {
+		return m.QuotaUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedChannels() []string {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingChannels() []AllowedChannel {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReserves() []Reserve {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyAdjustment() SupplyAdjustment {
+	if m != nil {
+		return m.SupplyAdjustment
+	}
+	return SupplyAdjustment{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "neutron.mintburn.GenesisState")
+}
+
+func init() { proto.RegisterFile("neutron/mintburn/genesis.proto", fileDescriptor_f076ac710367a5b0) }
+
+var fileDescriptor_f076ac710367a5b0 = []byte{
+	// 575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xaf, 0xfd, 0xda, 0x74, 0x92, 0xb4, 0xc1, 0x42, 0x68, 0x88, 0x5a, 0x13, 0x15,
+	0x24, 0xb2, 0xc1, 0x56, 0x83, 0x60, 0xc3, 0xaa, 0xa9, 0x10, 0x7f, 0x17, 0x4d, 0xaa, 0x6e, 0xd8,
+	0x58, 0xe3, 0x64, 0xe4, 0x1a, 0x25, 0x33, 0xae, 0xef, 0x38, 0xd0, 0x1d, 0x8f, 0xc0, 0x63, 0x75,
+	0xd9, 0x25, 0x2b, 0x84, 0x92, 0x17, 0x41, 0x9e, 0xb9, 0x76, 0x52, 0x46, 0x15, 0x62, 0x37, 0x3e,
+	0x73, 0xee, 0xcf, 0x33, 0xe7, 0xde, 0x21, 0x9e, 0xe0, 0xb9, 0xca, 0xa4, 0x08, 0x66, 0x89, 0x50,
+	0x51, 0x9e, 0x89, 0x20, 0xe6, 0x82, 0x43, 0x02, 0x7e, 0x9a, 0x49, 0x25, 0xdd, 0x36, 0xee, 0xfb,
+	0xe5, 0x7e, 0xc7, 0x1b, 0x4b, 0x98, 0x49, 0x08, 0x22, 0x06, 0x3c, 0x98, 0x1f, 0x45, 0x5c, 0xb1,
+	0xa3, 0x60, 0x2c, 0x13, 0x61, 0x2a, 0x3a, 0xf7, 0x63, 0x19, 0x4b, 0xbd, 0x0c, 0x8a, 0x15, 0xaa,
+	0x8f, 0xad, 0xff, 0x44, 0x59, 0x32, 0x89, 0x79, 0x98, 0xc9, 0x5c, 0x71, 0x34, 0x3d, 0xb1, 0x4c,
+	0xe3, 0x0b, 0x26, 0x04, 0x9f, 0x86, 0xa0, 0x98, 0xc2, 0x23, 0x75, 0x0e, 0x2c, 0x57, 0xca, 0x32,
+	0x36, 0x83, 0x3b, 0xff, 0x94, 0x72, 0x31, 0x49, 0x44, 0x1c, 0x16, 0x1f, 0x68, 0xda, 0xb7, 0x4c,
+	0x97, 0xb9, 0x54, 0x0c, 0x77, 0xed, 0x50, 0x32, 0x0e, 0x3c, 0x9b, 0xe3, 0x39, 0x0f, 0xbf, 0x6d,
+	0x93, 0xe6, 0x1b, 0x13, 0xd3, 0x99, 0x62, 0x8a, 0xbb, 0x6f, 0x49, 0x6b, 0xfd, 0x3a, 0x40, 0x9d,
+	0xee, 0x46, 0xaf, 0xd1, 0x3f, 0xf0, 0xff, 0x4c, 0xcf, 0x1f, 0x68, 0xdb, 0xa8, 0x70, 0x0d, 0x36,
+	0xaf, 0x7f, 0x3e, 0xaa, 0x8d, 0x9a, 0xd1, 0x4a, 0x02, 0x77, 0x48, 0xda, 0x6c, 0x3a, 0x95, 0x5f,
+	0xf8, 0x24, 0xc4, 0xbb, 0x03, 0xfd, 0x4f, 0xc3, 0xba, 0x36, 0xec, 0xd8, 0x38, 0x4f, 0x8c, 0x11,
+	0x79, 0x7b, 0xec, 0x96, 0x0a, 0xee, 0x3b, 0xd2, 0xba, 0x15, 0x23, 0xdd, 0xd0, 0x3c, 0xcf, 0xe6,
+	0x61, 0x49, 0x71, 0x27, 0x28, 0x4f, 0x37, 0x5e, 0xd3, 0xdc, 0x97, 0x64, 0xcb, 0x64, 0x4d, 0x37,
+	0xbb, 0x4e, 0xaf, 0xd1, 0xa7, 0x36, 0xe3, 0x54, 0xef, 0x63, 0x35, 0xba, 0xdd, 0xf7, 0xa4, 0x0d,
+	0x79, 0x9a, 0x4e, 0xaf, 0xc2, 0x62, 0x6a, 0xa6, 0x89, 0xe0, 0x40, 0xff, 0xd7, 0xa7, 0x78, 0xe8,
+	0x9b, 0x71, 0xf2, 0x8b, 0x0d, 0x1f, 0xc7, 0xc9, 0x3f, 0x91, 0x89, 0x28, 0xaf, 0x63, 0x0a, 0x07,
+	0x65, 0x5d, 0x91, 0xf5, 0x7a, 0x43, 0x81, 0x6e, 0xdd, 0x95, 0xf5, 0xa9, 0xb1, 0x0d, 0xf2, 0xac,
+	0x84, 0x35, 0xd3, 0x95, 0x04, 0xee, 0x07, 0xb2, 0x5b, 0x06, 0xa3, 0xbb, 0x0f, 0x74, 0xfb, 0x2f,
+	0xc9, 0x0c, 0x0b, 0x1b, 0xb2, 0xca, 0x50, 0xb5, 0x06, 0xee, 0x6b, 0xd2, 0xd4, 0x90, 0x30, 0x07,
+	0x16, 0x73, 0xa0, 0x75, 0x8d, 0xda, 0xb7, 0x51, 0xda, 0x7f, 0x5e, 0x98, 0x10, 0xd4, 0xb8, 0xac,
+	0x14, 0x70, 0x1f, 0x14, 0x09, 0xe7, 0xc0, 0x27, 0x74, 0xa7, 0xeb, 0xf4, 0xea, 0x23, 0xfc, 0x72,
+	0x9f, 0x92, 0x3d, 0xb3, 0x5a, 0x8d, 0x05, 0xe9, 0x6e, 0xf4, 0x76, 0x46, 0xbb, 0x46, 0xae, 0xba,
+	0x3d, 0x24, 0xed, 0x32, 0x9e, 0xca, 0xd9, 0xf8, 0xb7, 0x01, 0xc2, 0xfa, 0x0a, 0xf9, 0x8a, 0xd4,
+	0x71, 0xfe, 0x81, 0x36, 0xb1, 0x6b, 0x16, 0x6a, 0x64, 0x1c, 0xc8, 0xa8, 0x0a, 0xdc, 0x73, 0x72,
+	0x0f, 0x5b, 0xcf, 0x26, 0x9f, 0x73, 0x50, 0x33, 0x2e, 0x14, 0x6d, 0xe9, 0xe9, 0x39, 0xb4, 0x29,
+	0x67, 0xda, 0x7a, 0x5c, 0x39, 0x11, 0x87, 0xd3, 0xb3, 0xa6, 0x7f, 0xbc, 0x5e, 0x78, 0xce, 0xcd,
+	0xc2, 0x73, 0x7e, 0x2d, 0x3c, 0xe7, 0xfb, 0xd2, 0xab, 0xdd, 0x2c, 0xbd, 0xda, 0x8f, 0xa5, 0x57,
+	0xfb, 0xd4, 0x8f, 0x13, 0x75, 0x91, 0x47, 0xfe, 0x58, 0xce, 0x02, 0xe4, 0x3f, 0x93, 0x59, 0x5c,
+	0xae, 0x83, 0xf9, 0x8b, 0xe0, 0xeb, 0xea, 0x61, 0xab, 0xab, 0x94, 0x43, 0xb4, 0xa5, 0xdf, 0xf5,
+	0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xad, 0xa3, 0x53, 0xd0, 0x0e, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SupplyAdjustment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PendingChannels) > 0 {
+		for iNdEx := len(m.PendingChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedChannels[iNdEx])
+			copy(dAtA[i:], m.PausedChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.QuotaUsages) > 0 {
+		for iNdEx := len(m.QuotaUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuotaUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelQuotas) > 0 {
+		for iNdEx := len(m.ChannelQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingBurns) > 0 {
+		for iNdEx := len(m.PendingBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SupplyBaselines) > 0 {
+		for iNdEx := len(m.SupplyBaselines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyBaselines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelStats) > 0 {
+		for iNdEx := len(m.ChannelStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BridgeRoutes) > 0 {
+		for iNdEx := len(m.BridgeRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeRoutes) > 0 {
+		for _, e := range m.BridgeRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelStats) > 0 {
+		for _, e := range m.ChannelStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SupplyBaselines) > 0 {
+		for _, e := range m.SupplyBaselines {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingBurns) > 0 {
+		for _, e := range m.PendingBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelQuotas) > 0 {
+		for _, e := range m.ChannelQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QuotaUsages) > 0 {
+		for _, e := range m.QuotaUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedChannels) > 0 {
+		for _, s := range m.PausedChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingChannels) > 0 {
+		for _, e := range m.PendingChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SupplyAdjustment.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeRoutes = append(m.BridgeRoutes, BridgeRoute{})
+			if err := m.BridgeRoutes[len(m.BridgeRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelStats = append(m.ChannelStats, ChannelStats{})
+			if err := m.ChannelStats[len(m.ChannelStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyBaselines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyBaselines = append(m.SupplyBaselines, types.Coin{})
+			if err := m.SupplyBaselines[len(m.SupplyBaselines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBurns = append(m.PendingBurns, PendingBurn{})
+			if err := m.PendingBurns[len(m.PendingBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelQuotas = append(m.ChannelQuotas, ChannelQuota{})
+			if err := m.ChannelQuotas[len(m.ChannelQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaUsages = append(m.QuotaUsages, QuotaUsage{})
+			if err := m.QuotaUsages[len(m.QuotaUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChannels = append(m.PendingChannels, AllowedChannel{})
+			if err := m.PendingChannels[len(m.PendingChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, Reserve{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
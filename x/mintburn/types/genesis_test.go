@@ -0,0 +1,229 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/mintburn/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	route := types.BridgeRoute{
+		CounterpartyChainId: "maany-mainnet",
+		RemoteDenom:         "stake",
+		LocalDenom:          "stake",
+		Enabled:             true,
+	}
+	channel := types.AllowedChannel{
+		ChannelId:           "channel-0",
+		CounterpartyChainId: "maany-mainnet",
+		ConnectionId:        "connection-0",
+		ClientId:            "07-tendermint-0",
+	}
+	stats := types.NewChannelStats("channel-0")
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				BridgeRoutes:    []types.BridgeRoute{route},
+				AllowedChannels: []types.AllowedChannel{channel},
+				ChannelStats:    []types.ChannelStats{stats},
+				Params:          types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "zero max trusting period",
+			genState: &types.GenesisState{
+				Params: types.NewParams(0, types.DefaultReservesQueryPeriod, types.DefaultMaxReservesGap),
+			},
+			valid: false,
+		},
+		{
+			desc: "max reserves gap above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultMaxTrustingPeriod, types.DefaultReservesQueryPeriod, sdkmath.LegacyNewDec(2)),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero reserves query period",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.DefaultMaxTrustingPeriod, 0, types.DefaultMaxReservesGap),
+			},
+			valid: false,
+		},
+		{
+			desc: "reserve",
+			genState: &types.GenesisState{
+				Reserves: []types.Reserve{types.NewReserve("channel-0", "stake", "uatom", 1)},
+				Params:   types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate reserve",
+			genState: &types.GenesisState{
+				Reserves: []types.Reserve{types.NewReserve("channel-0", "stake", "uatom", 1), types.NewReserve("channel-0", "stake", "uatom", 2)},
+				Params:   types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "pending channel",
+			genState: &types.GenesisState{
+				PendingChannels: []types.AllowedChannel{channel},
+				Params:          types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "channel both allowed and pending",
+			genState: &types.GenesisState{
+				AllowedChannels: []types.AllowedChannel{channel},
+				PendingChannels: []types.AllowedChannel{channel},
+				Params:          types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid route",
+			genState: &types.GenesisState{
+				BridgeRoutes: []types.BridgeRoute{{CounterpartyChainId: "maany-mainnet"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate route",
+			genState: &types.GenesisState{
+				BridgeRoutes: []types.BridgeRoute{route, route},
+			},
+			valid: false,
+		},
+		{
+			desc: "local denom bridged twice to the same chain",
+			genState: &types.GenesisState{
+				BridgeRoutes: []types.BridgeRoute{route, {
+					CounterpartyChainId: route.CounterpartyChainId,
+					RemoteDenom:         "uother",
+					LocalDenom:          route.LocalDenom,
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed channel",
+			genState: &types.GenesisState{
+				AllowedChannels: []types.AllowedChannel{{ChannelId: "channel-0"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate allowed channel",
+			genState: &types.GenesisState{
+				AllowedChannels: []types.AllowedChannel{channel, channel},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid channel stats",
+			genState: &types.GenesisState{
+				ChannelStats: []types.ChannelStats{{
+					ChannelId: "channel-0",
+					Minted:    sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate channel stats",
+			genState: &types.GenesisState{
+				ChannelStats: []types.ChannelStats{stats, stats},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid supply adjustment",
+			genState: &types.GenesisState{
+				SupplyAdjustment: types.SupplyAdjustment{
+					Burned: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero supply baseline",
+			genState: &types.GenesisState{
+				SupplyBaselines: []sdk.Coin{sdk.NewInt64Coin("stake", 0)},
+				Params:          types.DefaultParams(),
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid pending burn",
+			genState: &types.GenesisState{
+				PendingBurns: []types.PendingBurn{{ChannelId: "channel-0", Sequence: 1, Amount: sdk.NewInt64Coin("stake", 0)}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate pending burn",
+			genState: &types.GenesisState{
+				PendingBurns: []types.PendingBurn{
+					{ChannelId: "channel-0", Sequence: 1, Amount: sdk.NewInt64Coin("stake", 10)},
+					{ChannelId: "channel-0", Sequence: 1, Amount: sdk.NewInt64Coin("stake", 20)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid channel quota",
+			genState: &types.GenesisState{
+				ChannelQuotas: []types.ChannelQuota{{
+					ChannelId:    "channel-0",
+					Denom:        "stake",
+					MaxPerPacket: sdkmath.NewInt(-1),
+					WindowQuota:  sdkmath.ZeroInt(),
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid paused channel",
+			genState: &types.GenesisState{
+				PausedChannels: []string{"c"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate supply baseline",
+			genState: &types.GenesisState{
+				SupplyBaselines: []sdk.Coin{sdk.NewInt64Coin("stake", 0), sdk.NewInt64Coin("stake", 1)},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
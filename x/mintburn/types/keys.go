@@ -1,7 +1,89 @@
-package mintburn
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
+	// ModuleName defines the module name
 	ModuleName = "mintburn"
+
+	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// MintBurnModuleAccount is the module account minting and burning bridged assets
 	MintBurnModuleAccount = ModuleName
+
+	// MaxPendingBurnRetriesPerBlock bounds the pending burns retried in a single EndBlock
+	MaxPendingBurnRetriesPerBlock = 50
+
+	// MaxReasonLength bounds the reason attached to MsgMint and MsgBurn
+	MaxReasonLength = 512
+)
+
+const (
+	prefixBridgeRouteKey = iota + 1
+	prefixChannelStatsKey
+	prefixParamsKey
+	prefixSupplyBaselineKey
+	prefixPendingBurnKey
+	prefixChannelQuotaKey
+	prefixQuotaUsageKey
+	prefixPausedChannelKey
+	prefixBridgePausedKey
+	prefixPendingChannelKey
+	prefixReserveKey
+	prefixSupplyAdjustmentKey
 )
+
+var (
+	// AllowedChannelKeyPrefix predates the byte prefixes below and is kept for state compatibility
+	AllowedChannelKeyPrefix = []byte("allowed-channel/")
+
+	BridgeRouteKeyPrefix    = []byte{prefixBridgeRouteKey}
+	ChannelStatsKeyPrefix   = []byte{prefixChannelStatsKey}
+	ParamsKey               = []byte{prefixParamsKey}
+	SupplyBaselineKeyPrefix = []byte{prefixSupplyBaselineKey}
+	PendingBurnKeyPrefix    = []byte{prefixPendingBurnKey}
+	ChannelQuotaKeyPrefix   = []byte{prefixChannelQuotaKey}
+	QuotaUsageKeyPrefix     = []byte{prefixQuotaUsageKey}
+	PausedChannelKeyPrefix  = []byte{prefixPausedChannelKey}
+	BridgePausedKey         = []byte{prefixBridgePausedKey}
+	PendingChannelKeyPrefix = []byte{prefixPendingChannelKey}
+	ReserveKeyPrefix        = []byte{prefixReserveKey}
+	SupplyAdjustmentKey     = []byte{prefixSupplyAdjustmentKey}
+)
+
+// GetBridgeRouteChainPrefix returns the store prefix holding all routes of a counterparty chain
+func GetBridgeRouteChainPrefix(chainID string) []byte {
+	return []byte(chainID + "/")
+}
+
+// GetBridgeRouteKey returns the store key of a route within BridgeRouteKeyPrefix.
+// Chain-ids never contain "/", so prefix iteration by chain is unambiguous.
+func GetBridgeRouteKey(chainID, remoteDenom string) []byte {
+	return append(GetBridgeRouteChainPrefix(chainID), []byte(remoteDenom)...)
+}
+
+// GetPendingBurnKey returns the store key of a pending burn within PendingBurnKeyPrefix
+func GetPendingBurnKey(channelID string, sequence uint64) []byte {
+	return append([]byte(channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetChannelQuotaKey returns the store key of a quota within ChannelQuotaKeyPrefix or QuotaUsageKeyPrefix
+func GetChannelQuotaKey(channelID, denom string) []byte {
+	return []byte(channelID + "/" + denom)
+}
+
+// GetReserveChannelPrefix returns the store prefix holding all reserves of a channel within ReserveKeyPrefix
+func GetReserveChannelPrefix(channelID string) []byte {
+	return []byte(channelID + "/")
+}
+
+// GetReserveKey returns the store key of a reserve within ReserveKeyPrefix
+func GetReserveKey(channelID, localDenom string) []byte {
+	return append(GetReserveChannelPrefix(channelID), []byte(localDenom)...)
+}
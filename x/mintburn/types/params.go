@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// DefaultMaxTrustingPeriod is two thirds of a 21 days unbonding period
+	DefaultMaxTrustingPeriod = 14 * 24 * time.Hour
+
+	// DefaultReservesQueryPeriod proves the counterparty escrow every 100 blocks
+	DefaultReservesQueryPeriod uint64 = 100
+)
+
+// DefaultMaxReservesGap disables the automatic pause
+var DefaultMaxReservesGap = sdkmath.LegacyZeroDec()
+
+// NewParams creates a new Params instance
+func NewParams(maxTrustingPeriod time.Duration, reservesQueryPeriod uint64, maxReservesGap sdkmath.LegacyDec) Params {
+	return Params{
+		MaxTrustingPeriod:   maxTrustingPeriod,
+		ReservesQueryPeriod: reservesQueryPeriod,
+		MaxReservesGap:      maxReservesGap,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxTrustingPeriod, DefaultReservesQueryPeriod, DefaultMaxReservesGap)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MaxTrustingPeriod <= 0 {
+		return fmt.Errorf("max trusting period must be positive: %s", p.MaxTrustingPeriod)
+	}
+	if p.ReservesQueryPeriod == 0 {
+		return fmt.Errorf("reserves query period must be positive")
+	}
+	if p.MaxReservesGap.IsNil() {
+		return fmt.Errorf("max reserves gap must not be nil")
+	}
+	if p.MaxReservesGap.IsNegative() || p.MaxReservesGap.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max reserves gap must be between 0 and 1: %s", p.MaxReservesGap)
+	}
+	return nil
+}
+
+// IsReservesGapEnforced reports whether minting is paused over channels whose proven
+// reserves fall short of their outstanding supply.
+func (p Params) IsReservesGapEnforced() bool {
+	return !p.MaxReservesGap.IsNil() && p.MaxReservesGap.IsPositive()
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
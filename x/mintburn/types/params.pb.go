@@ -0,0 +1,421 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// max_trusting_period bounds the trusting period of the light client a channel
+	// is opened over for the channel to be allow-listed. A longer trusting period
+	// leaves more room for an attack with an old validator set.
+	MaxTrustingPeriod time.Duration `protobuf:"bytes,1,opt,name=max_trusting_period,json=maxTrustingPeriod,proto3,stdduration" json:"max_trusting_period"`
+	// reserves_query_period is the update period, in blocks, of the interchain queries
+	// proving the counterparty escrow of every allowed channel.
+	ReservesQueryPeriod uint64 `protobuf:"varint,2,opt,name=reserves_query_period,json=reservesQueryPeriod,proto3" json:"reserves_query_period,omitempty"`
+	// max_reserves_gap is the share of the outstanding supply of a channel the proven
+	// counterparty escrow may fall short of before minting over the channel is paused.
+	// Zero disables the automatic pause.
+	MaxReservesGap cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_reserves_gap,json=maxReservesGap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_reserves_gap"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b9a3ee3c326242, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.MaxTrustingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetReservesQueryPeriod() uint64 {
+	if m != nil {
+		return m.ReservesQueryPeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "neutron.mintburn.Params")
+}
+
+func init() { proto.RegisterFile("neutron/mintburn/params.proto", fileDescriptor_96b9a3ee3c326242) }
+
+var fileDescriptor_96b9a3ee3c326242 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xcd, 0x4a, 0xeb, 0x40,
+	0x14, 0x80, 0x33, 0xf7, 0x96, 0x72, 0x6f, 0x04, 0xa9, 0xad, 0x42, 0xad, 0x38, 0x29, 0xba, 0xe9,
+	0xc6, 0x19, 0xa8, 0xb8, 0x71, 0x59, 0x0a, 0x6e, 0x2a, 0xd4, 0xe8, 0xca, 0x4d, 0x99, 0xa4, 0xe3,
+	0x34, 0xe8, 0xe4, 0xc4, 0xf9, 0x29, 0xe9, 0x5b, 0xb8, 0xec, 0xd2, 0xc7, 0xe9, 0xb2, 0x4b, 0x71,
+	0x51, 0xa5, 0x05, 0x9f, 0x43, 0x92, 0x26, 0xba, 0x3b, 0xe1, 0xcb, 0xf7, 0xcd, 0x70, 0xc6, 0x3d,
+	0x8e, 0xb9, 0x35, 0x0a, 0x62, 0x2a, 0xa3, 0xd8, 0x04, 0x56, 0xc5, 0x34, 0x61, 0x8a, 0x49, 0x4d,
+	0x12, 0x05, 0x06, 0xea, 0xb5, 0x02, 0x93, 0x12, 0xb7, 0xf6, 0x05, 0x08, 0xc8, 0x21, 0xcd, 0xa6,
+	0xed, 0x7f, 0x2d, 0x2c, 0x00, 0xc4, 0x13, 0xa7, 0xf9, 0x57, 0x60, 0x1f, 0xe8, 0xd8, 0x2a, 0x66,
+	0x22, 0x88, 0xb7, 0xfc, 0xe4, 0x0b, 0xb9, 0xd5, 0x61, 0x1e, 0xae, 0xdf, 0xba, 0x0d, 0xc9, 0xd2,
+	0x91, 0x51, 0x56, 0x9b, 0x28, 0x16, 0xa3, 0x84, 0xab, 0x08, 0xc6, 0x4d, 0xd4, 0x46, 0x9d, 0x9d,
+	0xee, 0x21, 0xd9, 0x86, 0x48, 0x19, 0x22, 0xfd, 0x22, 0xd4, 0xfb, 0xb7, 0x58, 0x79, 0xce, 0xfc,
+	0xc3, 0x43, 0xfe, 0x9e, 0x64, 0xe9, 0x5d, 0xa1, 0x0f, 0x73, 0xbb, 0xde, 0x75, 0x0f, 0x14, 0xd7,
+	0x5c, 0x4d, 0xb9, 0x1e, 0x3d, 0x5b, 0xae, 0x66, 0x65, 0xf6, 0x4f, 0x1b, 0x75, 0x2a, 0x7e, 0xa3,
+	0x84, 0x37, 0x19, 0x2b, 0x9c, 0x6b, 0xb7, 0x96, 0x5d, 0xe4, 0xc7, 0x13, 0x2c, 0x69, 0xfe, 0x6d,
+	0xa3, 0xce, 0xff, 0xde, 0x69, 0x76, 0xd4, 0xfb, 0xca, 0x3b, 0x0a, 0x41, 0x4b, 0xd0, 0x7a, 0xfc,
+	0x48, 0x22, 0xa0, 0x92, 0x99, 0x09, 0x19, 0x70, 0xc1, 0xc2, 0x59, 0x9f, 0x87, 0xfe, 0xae, 0x64,
+	0xa9, 0x5f, 0xb8, 0x57, 0x2c, 0xb9, 0xac, 0xcc, 0x5f, 0x3d, 0xa7, 0x37, 0x58, 0xac, 0x31, 0x5a,
+	0xae, 0x31, 0xfa, 0x5c, 0x63, 0xf4, 0xb2, 0xc1, 0xce, 0x72, 0x83, 0x9d, 0xb7, 0x0d, 0x76, 0xee,
+	0xbb, 0x22, 0x32, 0x13, 0x1b, 0x90, 0x10, 0x24, 0x2d, 0xb6, 0x7a, 0x06, 0x4a, 0x94, 0x33, 0x9d,
+	0x5e, 0xd0, 0xf4, 0xf7, 0x15, 0xcc, 0x2c, 0xe1, 0x3a, 0xa8, 0xe6, 0x6b, 0x38, 0xff, 0x0e, 0x00,
+	0x00, 0xff, 0xff, 0xa1, 0xcf, 0x50, 0xa9, 0xa6, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxReservesGap.Size()
+		i -= size
+		if _, err := m.MaxReservesGap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ReservesQueryPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReservesQueryPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxTrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTrustingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxTrustingPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReservesQueryPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ReservesQueryPeriod))
+	}
+	l = m.MaxReservesGap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxTrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesQueryPeriod", wireType)
+			}
+			m.ReservesQueryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservesQueryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReservesGap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReservesGap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs a stateless validation of the pending burn.
+func (b PendingBurn) Validate() error {
+	if err := host.ChannelIdentifierValidator(b.ChannelId); err != nil {
+		return fmt.Errorf("invalid pending burn channel id: %w", err)
+	}
+	if b.Sequence == 0 {
+		return fmt.Errorf("pending burn on channel %s has a zero sequence", b.ChannelId)
+	}
+	if err := b.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid pending burn amount for %s/%d: %w", b.ChannelId, b.Sequence, err)
+	}
+	if !b.Amount.IsPositive() {
+		return fmt.Errorf("pending burn amount for %s/%d must be positive", b.ChannelId, b.Sequence)
+	}
+	return nil
+}
@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/pending_burn.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingBurn is an acknowledged outbound transfer whose escrowed tokens could
+// not be burned. The tokens stay in the channel escrow until a retry succeeds.
+type PendingBurn struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the acknowledged packet
+	Sequence uint64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// attempts is the number of failed burn attempts, including the first one.
+	Attempts  uint64 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// sender and receiver of the transfer
+	Sender   string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *PendingBurn) Reset()         { *m = PendingBurn{} }
+func (m *PendingBurn) String() string { return proto.CompactTextString(m) }
+func (*PendingBurn) ProtoMessage()    {}
+func (*PendingBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cabeffafc907b180, []int{0}
+}
+func (m *PendingBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBurn.Merge(m, src)
+}
+func (m *PendingBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBurn proto.InternalMessageInfo
+
+func (m *PendingBurn) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingBurn) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *PendingBurn) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *PendingBurn) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingBurn) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PendingBurn)(nil), "neutron.mintburn.PendingBurn")
+}
+
+func init() {
+	proto.RegisterFile("neutron/mintburn/pending_burn.proto", fileDescriptor_cabeffafc907b180)
+}
+
+var fileDescriptor_cabeffafc907b180 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x27, 0xdf, 0x57, 0x47, 0x3b, 0xdd, 0xc8, 0x20, 0x12, 0x0b, 0xc6, 0xa2, 0x9b, 0x6e,
+	0x4c, 0x68, 0x45, 0xdc, 0x57, 0x5c, 0x08, 0x2e, 0xa4, 0x4b, 0x37, 0x65, 0xfe, 0x5c, 0xa6, 0x81,
+	0x4e, 0xee, 0x98, 0x64, 0x8a, 0xbe, 0x85, 0x8f, 0xd5, 0x65, 0x97, 0xae, 0x44, 0xda, 0x17, 0xf0,
+	0x11, 0x24, 0x33, 0x69, 0xdd, 0xdd, 0x73, 0xee, 0xc9, 0xe1, 0xc7, 0x4d, 0x74, 0xa5, 0xa0, 0xb6,
+	0x1a, 0x95, 0x28, 0xa5, 0xb2, 0x69, 0xad, 0x95, 0xa8, 0x40, 0xe5, 0x52, 0x15, 0x33, 0x27, 0x78,
+	0xa5, 0xd1, 0x62, 0x7c, 0xec, 0x43, 0x7c, 0x17, 0xea, 0xb3, 0x0c, 0x4d, 0x89, 0x46, 0xa4, 0x89,
+	0x01, 0xb1, 0x1c, 0xa5, 0x60, 0x93, 0x91, 0xc8, 0x50, 0xfa, 0x17, 0xfd, 0x93, 0x02, 0x0b, 0x6c,
+	0x46, 0xe1, 0xa6, 0xd6, 0xbd, 0xfc, 0x21, 0x51, 0xef, 0xb9, 0xad, 0x9f, 0xd4, 0x5a, 0xc5, 0xe7,
+	0x51, 0x94, 0xcd, 0x13, 0xa5, 0x60, 0x31, 0x93, 0x39, 0x25, 0x03, 0x32, 0xec, 0x4e, 0xbb, 0xde,
+	0x79, 0xcc, 0xe3, 0x7e, 0x74, 0x64, 0xe0, 0xb5, 0x06, 0x95, 0x01, 0xfd, 0x37, 0x20, 0xc3, 0xce,
+	0x74, 0xaf, 0xe3, 0xbb, 0x28, 0x4c, 0x4a, 0xac, 0x95, 0xa5, 0xff, 0x07, 0x64, 0xd8, 0x1b, 0x9f,
+	0xf1, 0x96, 0x88, 0x3b, 0x22, 0xee, 0x89, 0xf8, 0x3d, 0x4a, 0x35, 0xe9, 0xac, 0xbe, 0x2e, 0x82,
+	0xa9, 0x8f, 0xbb, 0xd2, 0xc4, 0x5a, 0x28, 0x2b, 0x6b, 0x68, 0xa7, 0x2d, 0xdd, 0x69, 0xc7, 0xb3,
+	0x48, 0x8c, 0x9d, 0x81, 0xd6, 0xa8, 0xe9, 0x41, 0xcb, 0xe3, 0x9c, 0x07, 0x67, 0xc4, 0xa7, 0x51,
+	0x68, 0x40, 0xe5, 0xa0, 0x69, 0xd8, 0xac, 0xbc, 0x72, 0x95, 0x1a, 0x32, 0x90, 0x4b, 0xd0, 0xf4,
+	0xb0, 0xd9, 0xec, 0xf5, 0xe4, 0x69, 0xb5, 0x61, 0x64, 0xbd, 0x61, 0xe4, 0x7b, 0xc3, 0xc8, 0xc7,
+	0x96, 0x05, 0xeb, 0x2d, 0x0b, 0x3e, 0xb7, 0x2c, 0x78, 0x19, 0x17, 0xd2, 0xce, 0xeb, 0x94, 0x67,
+	0x58, 0x0a, 0x7f, 0xdf, 0x6b, 0xd4, 0xc5, 0x6e, 0x16, 0xcb, 0x5b, 0xf1, 0xf6, 0xf7, 0x2b, 0xf6,
+	0xbd, 0x02, 0x93, 0x86, 0xcd, 0x1d, 0x6f, 0x7e, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xb4, 0xba,
+	0x4b, 0xb6, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPendingBurn(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPendingBurn(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintPendingBurn(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintPendingBurn(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPendingBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintPendingBurn(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPendingBurn(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPendingBurn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPendingBurn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPendingBurn(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPendingBurn(uint64(m.Sequence))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPendingBurn(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovPendingBurn(uint64(m.Attempts))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovPendingBurn(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPendingBurn(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPendingBurn(uint64(l))
+	}
+	return n
+}
+
+func sovPendingBurn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPendingBurn(x uint64) (n int) {
+	return sovPendingBurn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPendingBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPendingBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPendingBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPendingBurn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPendingBurn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPendingBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPendingBurn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPendingBurn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPendingBurn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPendingBurn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPendingBurn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPendingBurn = fmt.Errorf("proto: unexpected end of group")
+)
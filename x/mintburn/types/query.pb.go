@@ -0,0 +1,4779 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QueryAllowedChannelsRequest is request type for the Query/AllowedChannels RPC method.
+type QueryAllowedChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedChannelsRequest) Reset()         { *m = QueryAllowedChannelsRequest{} }
+func (m *QueryAllowedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsRequest) ProtoMessage()    {}
+func (*QueryAllowedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{2}
+}
+func (m *QueryAllowedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsRequest.Merge(m, src)
+}
+func (m *QueryAllowedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedChannelsResponse is response type for the Query/AllowedChannels RPC method.
+type QueryAllowedChannelsResponse struct {
+	AllowedChannels []AllowedChannel    `protobuf:"bytes,1,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedChannelsResponse) Reset()         { *m = QueryAllowedChannelsResponse{} }
+func (m *QueryAllowedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedChannelsResponse) ProtoMessage()    {}
+func (*QueryAllowedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{3}
+}
+func (m *QueryAllowedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedChannelsResponse.Merge(m, src)
+}
+func (m *QueryAllowedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedChannelsResponse) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *QueryAllowedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingChannelsRequest is request type for the Query/PendingChannels RPC method.
+type QueryPendingChannelsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingChannelsRequest) Reset()         { *m = QueryPendingChannelsRequest{} }
+func (m *QueryPendingChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChannelsRequest) ProtoMessage()    {}
+func (*QueryPendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{4}
+}
+func (m *QueryPendingChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChannelsRequest.Merge(m, src)
+}
+func (m *QueryPendingChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChannelsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingChannelsResponse is response type for the Query/PendingChannels RPC method.
+type QueryPendingChannelsResponse struct {
+	PendingChannels []AllowedChannel    `protobuf:"bytes,1,rep,name=pending_channels,json=pendingChannels,proto3" json:"pending_channels"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingChannelsResponse) Reset()         { *m = QueryPendingChannelsResponse{} }
+func (m *QueryPendingChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChannelsResponse) ProtoMessage()    {}
+func (*QueryPendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{5}
+}
+func (m *QueryPendingChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChannelsResponse.Merge(m, src)
+}
+func (m *QueryPendingChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingChannelsResponse) GetPendingChannels() []AllowedChannel {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
+func (m *QueryPendingChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelStatsRequest is request type for the Query/ChannelStats RPC method.
+type QueryChannelStatsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryChannelStatsRequest) Reset()         { *m = QueryChannelStatsRequest{} }
+func (m *QueryChannelStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelStatsRequest) ProtoMessage()    {}
+func (*QueryChannelStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{6}
+}
+func (m *QueryChannelStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelStatsRequest.Merge(m, src)
+}
+func (m *QueryChannelStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelStatsRequest proto.InternalMessageInfo
+
+func (m *QueryChannelStatsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryChannelStatsResponse is response type for the Query/ChannelStats RPC method.
+type QueryChannelStatsResponse struct {
+	Stats ChannelStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+	// in_flight is the amount of bridged denoms escrowed by sent packets that
+	// are not acknowledged yet.
+	InFlight github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=in_flight,json=inFlight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"in_flight"`
+}
+
+func (m *QueryChannelStatsResponse) Reset()         { *m = QueryChannelStatsResponse{} }
+func (m *QueryChannelStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelStatsResponse) ProtoMessage()    {}
+func (*QueryChannelStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{7}
+}
+func (m *QueryChannelStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelStatsResponse.Merge(m, src)
+}
+func (m *QueryChannelStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelStatsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelStatsResponse) GetStats() ChannelStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ChannelStats{}
+}
+
+func (m *QueryChannelStatsResponse) GetInFlight() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InFlight
+	}
+	return nil
+}
+
+// QueryBridgeRoutesRequest is request type for the Query/BridgeRoutes RPC method.
+type QueryBridgeRoutesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBridgeRoutesRequest) Reset()         { *m = QueryBridgeRoutesRequest{} }
+func (m *QueryBridgeRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRoutesRequest) ProtoMessage()    {}
+func (*QueryBridgeRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{8}
+}
+func (m *QueryBridgeRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRoutesRequest.Merge(m, src)
+}
+func (m *QueryBridgeRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryBridgeRoutesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBridgeRoutesResponse is response type for the Query/BridgeRoutes RPC method.
+type QueryBridgeRoutesResponse struct {
+	BridgeRoutes []BridgeRoute       `protobuf:"bytes,1,rep,name=bridge_routes,json=bridgeRoutes,proto3" json:"bridge_routes"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBridgeRoutesResponse) Reset()         { *m = QueryBridgeRoutesResponse{} }
+func (m *QueryBridgeRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgeRoutesResponse) ProtoMessage()    {}
+func (*QueryBridgeRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{9}
+}
+func (m *QueryBridgeRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgeRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgeRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBridgeRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgeRoutesResponse.Merge(m, src)
+}
+func (m *QueryBridgeRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgeRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgeRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgeRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryBridgeRoutesResponse) GetBridgeRoutes() []BridgeRoute {
+	if m != nil {
+		return m.BridgeRoutes
+	}
+	return nil
+}
+
+func (m *QueryBridgeRoutesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySupplyDriftRequest is request type for the Query/SupplyDrift RPC method.
+type QuerySupplyDriftRequest struct {
+}
+
+func (m *QuerySupplyDriftRequest) Reset()         { *m = QuerySupplyDriftRequest{} }
+func (m *QuerySupplyDriftRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDriftRequest) ProtoMessage()    {}
+func (*QuerySupplyDriftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{10}
+}
+func (m *QuerySupplyDriftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDriftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDriftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyDriftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDriftRequest.Merge(m, src)
+}
+func (m *QuerySupplyDriftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDriftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDriftRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyDriftRequest proto.InternalMessageInfo
+
+// QuerySupplyDriftResponse is response type for the Query/SupplyDrift RPC method.
+type QuerySupplyDriftResponse struct {
+	Denoms []DenomSupply `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms"`
+}
+
+func (m *QuerySupplyDriftResponse) Reset()         { *m = QuerySupplyDriftResponse{} }
+func (m *QuerySupplyDriftResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyDriftResponse) ProtoMessage()    {}
+func (*QuerySupplyDriftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{11}
+}
+func (m *QuerySupplyDriftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyDriftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyDriftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyDriftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyDriftResponse.Merge(m, src)
+}
+func (m *QuerySupplyDriftResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyDriftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyDriftResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyDriftResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyDriftResponse) GetDenoms() []DenomSupply {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryPendingBurnsRequest is request type for the Query/PendingBurns RPC method.
+type QueryPendingBurnsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingBurnsRequest) Reset()         { *m = QueryPendingBurnsRequest{} }
+func (m *QueryPendingBurnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBurnsRequest) ProtoMessage()    {}
+func (*QueryPendingBurnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{12}
+}
+func (m *QueryPendingBurnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBurnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBurnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBurnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBurnsRequest.Merge(m, src)
+}
+func (m *QueryPendingBurnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBurnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBurnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBurnsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingBurnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingBurnsResponse is response type for the Query/PendingBurns RPC method.
+type QueryPendingBurnsResponse struct {
+	PendingBurns []PendingBurn       `protobuf:"bytes,1,rep,name=pending_burns,json=pendingBurns,proto3" json:"pending_burns"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingBurnsResponse) Reset()         { *m = QueryPendingBurnsResponse{} }
+func (m *QueryPendingBurnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBurnsResponse) ProtoMessage()    {}
+func (*QueryPendingBurnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{13}
+}
+func (m *QueryPendingBurnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBurnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBurnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBurnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBurnsResponse.Merge(m, src)
+}
+func (m *QueryPendingBurnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBurnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBurnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBurnsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingBurnsResponse) GetPendingBurns() []PendingBurn {
+	if m != nil {
+		return m.PendingBurns
+	}
+	return nil
+}
+
+func (m *QueryPendingBurnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChannelQuotasRequest is request type for the Query/ChannelQuotas RPC method.
+type QueryChannelQuotasRequest struct {
+}
+
+func (m *QueryChannelQuotasRequest) Reset()         { *m = QueryChannelQuotasRequest{} }
+func (m *QueryChannelQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelQuotasRequest) ProtoMessage()    {}
+func (*QueryChannelQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{14}
+}
+func (m *QueryChannelQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelQuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelQuotasRequest.Merge(m, src)
+}
+func (m *QueryChannelQuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelQuotasRequest proto.InternalMessageInfo
+
+// ChannelQuotaStatus is a quota together with the amount minted in its rolling window.
+type ChannelQuotaStatus struct {
+	Quota ChannelQuota          `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+	Used  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+}
+
+func (m *ChannelQuotaStatus) Reset()         { *m = ChannelQuotaStatus{} }
+func (m *ChannelQuotaStatus) String() string { return proto.CompactTextString(m) }
+func (*ChannelQuotaStatus) ProtoMessage()    {}
+func (*ChannelQuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{15}
+}
+func (m *ChannelQuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelQuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelQuotaStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelQuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelQuotaStatus.Merge(m, src)
+}
+func (m *ChannelQuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelQuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelQuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelQuotaStatus proto.InternalMessageInfo
+
+func (m *ChannelQuotaStatus) GetQuota() ChannelQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return ChannelQuota{}
+}
+
+// QueryChannelQuotasResponse is response type for the Query/ChannelQuotas RPC method.
+type QueryChannelQuotasResponse struct {
+	Quotas []ChannelQuotaStatus `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas"`
+}
+
+func (m *QueryChannelQuotasResponse) Reset()         { *m = QueryChannelQuotasResponse{} }
+func (m *QueryChannelQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelQuotasResponse) ProtoMessage()    {}
+func (*QueryChannelQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{16}
+}
+func (m *QueryChannelQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelQuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelQuotasResponse.Merge(m, src)
+}
+func (m *QueryChannelQuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelQuotasResponse proto.InternalMessageInfo
+
+func (m *QueryChannelQuotasResponse) GetQuotas() []ChannelQuotaStatus {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// QueryPauseStatusRequest is request type for the Query/PauseStatus RPC method.
+type QueryPauseStatusRequest struct {
+}
+
+func (m *QueryPauseStatusRequest) Reset()         { *m = QueryPauseStatusRequest{} }
+func (m *QueryPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{17}
+}
+func (m *QueryPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusRequest proto.InternalMessageInfo
+
+// QueryPauseStatusResponse is response type for the Query/PauseStatus RPC method.
+type QueryPauseStatusResponse struct {
+	// paused is set when minting is paused over every channel.
+	Paused         bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	PausedChannels []string `protobuf:"bytes,2,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels,omitempty"`
+}
+
+func (m *QueryPauseStatusResponse) Reset()         { *m = QueryPauseStatusResponse{} }
+func (m *QueryPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{18}
+}
+func (m *QueryPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStatusResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryPauseStatusResponse) GetPausedChannels() []string {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+// QueryReservesStatusRequest is request type for the Query/ReservesStatus RPC method.
+type QueryReservesStatusRequest struct {
+}
+
+func (m *QueryReservesStatusRequest) Reset()         { *m = QueryReservesStatusRequest{} }
+func (m *QueryReservesStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesStatusRequest) ProtoMessage()    {}
+func (*QueryReservesStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{19}
+}
+func (m *QueryReservesStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesStatusRequest.Merge(m, src)
+}
+func (m *QueryReservesStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservesStatusRequest proto.InternalMessageInfo
+
+// ReserveStatus is a reserve together with the outstanding supply it backs.
+type ReserveStatus struct {
+	Reserve Reserve `protobuf:"bytes,1,opt,name=reserve,proto3" json:"reserve"`
+	// outstanding is the amount minted minus the amount burned over the channel,
+	// excluding the tokens escrowed by transfers in flight.
+	Outstanding cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=outstanding,proto3,customtype=cosmossdk.io/math.Int" json:"outstanding"`
+	// gap is the outstanding supply minus the proven escrow, negative if the escrow
+	// exceeds the outstanding supply.
+	Gap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=gap,proto3,customtype=cosmossdk.io/math.Int" json:"gap"`
+}
+
+func (m *ReserveStatus) Reset()         { *m = ReserveStatus{} }
+func (m *ReserveStatus) String() string { return proto.CompactTextString(m) }
+func (*ReserveStatus) ProtoMessage()    {}
+func (*ReserveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{20}
+}
+func (m *ReserveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStatus.Merge(m, src)
+}
+func (m *ReserveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveStatus proto.InternalMessageInfo
+
+func (m *ReserveStatus) GetReserve() Reserve {
+	if m != nil {
+		return m.Reserve
+	}
+	return Reserve{}
+}
+
+// QueryReservesStatusResponse is response type for the Query/ReservesStatus RPC method.
+type QueryReservesStatusResponse struct {
+	Reserves []ReserveStatus `protobuf:"bytes,1,rep,name=reserves,proto3" json:"reserves"`
+}
+
+func (m *QueryReservesStatusResponse) Reset()         { *m = QueryReservesStatusResponse{} }
+func (m *QueryReservesStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReservesStatusResponse) ProtoMessage()    {}
+func (*QueryReservesStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6a75dbd8523627, []int{21}
+}
+func (m *QueryReservesStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReservesStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReservesStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReservesStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReservesStatusResponse.Merge(m, src)
+}
+func (m *QueryReservesStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReservesStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReservesStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReservesStatusResponse proto.InternalMessageInfo
+
+func (m *QueryReservesStatusResponse) GetReserves() []ReserveStatus {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.mintburn.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.mintburn.QueryParamsResponse")
+	proto.RegisterType((*QueryAllowedChannelsRequest)(nil), "neutron.mintburn.QueryAllowedChannelsRequest")
+	proto.RegisterType((*QueryAllowedChannelsResponse)(nil), "neutron.mintburn.QueryAllowedChannelsResponse")
+	proto.RegisterType((*QueryPendingChannelsRequest)(nil), "neutron.mintburn.QueryPendingChannelsRequest")
+	proto.RegisterType((*QueryPendingChannelsResponse)(nil), "neutron.mintburn.QueryPendingChannelsResponse")
+	proto.RegisterType((*QueryChannelStatsRequest)(nil), "neutron.mintburn.QueryChannelStatsRequest")
+	proto.RegisterType((*QueryChannelStatsResponse)(nil), "neutron.mintburn.QueryChannelStatsResponse")
+	proto.RegisterType((*QueryBridgeRoutesRequest)(nil), "neutron.mintburn.QueryBridgeRoutesRequest")
+	proto.RegisterType((*QueryBridgeRoutesResponse)(nil), "neutron.mintburn.QueryBridgeRoutesResponse")
+	proto.RegisterType((*QuerySupplyDriftRequest)(nil), "neutron.mintburn.QuerySupplyDriftRequest")
+	proto.RegisterType((*QuerySupplyDriftResponse)(nil), "neutron.mintburn.QuerySupplyDriftResponse")
+	proto.RegisterType((*QueryPendingBurnsRequest)(nil), "neutron.mintburn.QueryPendingBurnsRequest")
+	proto.RegisterType((*QueryPendingBurnsResponse)(nil), "neutron.mintburn.QueryPendingBurnsResponse")
+	proto.RegisterType((*QueryChannelQuotasRequest)(nil), "neutron.mintburn.QueryChannelQuotasRequest")
+	proto.RegisterType((*ChannelQuotaStatus)(nil), "neutron.mintburn.ChannelQuotaStatus")
+	proto.RegisterType((*QueryChannelQuotasResponse)(nil), "neutron.mintburn.QueryChannelQuotasResponse")
+	proto.RegisterType((*QueryPauseStatusRequest)(nil), "neutron.mintburn.QueryPauseStatusRequest")
+	proto.RegisterType((*QueryPauseStatusResponse)(nil), "neutron.mintburn.QueryPauseStatusResponse")
+	proto.RegisterType((*QueryReservesStatusRequest)(nil), "neutron.mintburn.QueryReservesStatusRequest")
+	proto.RegisterType((*ReserveStatus)(nil), "neutron.mintburn.ReserveStatus")
+	proto.RegisterType((*QueryReservesStatusResponse)(nil), "neutron.mintburn.QueryReservesStatusResponse")
+}
+
+func init() { proto.RegisterFile("neutron/mintburn/query.proto", fileDescriptor_3d6a75dbd8523627) }
+
+var fileDescriptor_3d6a75dbd8523627 = []byte{
+	// 1189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x93, 0x76, 0xc9, 0xce, 0x26, 0x4d, 0x35, 0x14, 0xd8, 0xb8, 0xc9, 0xee, 0xd6, 0x0d,
+	0x4d, 0x1a, 0x1a, 0x9b, 0x84, 0x3f, 0x52, 0xe1, 0x80, 0xba, 0xad, 0x0a, 0x95, 0x40, 0x4a, 0xdc,
+	0x03, 0x12, 0x1c, 0xb6, 0xde, 0x78, 0xea, 0xb5, 0xba, 0xeb, 0x71, 0x3d, 0xe3, 0x94, 0x08, 0x71,
+	0x81, 0x2b, 0x87, 0x4a, 0x70, 0xe0, 0x00, 0x5f, 0x80, 0x03, 0x1f, 0x00, 0x71, 0xe1, 0xd6, 0x63,
+	0x25, 0x38, 0x20, 0x0e, 0x05, 0x25, 0x7c, 0x10, 0xe4, 0x99, 0x37, 0xbb, 0xf6, 0xda, 0xde, 0xac,
+	0xaa, 0xf4, 0xb4, 0x5e, 0xcf, 0xfb, 0xf3, 0x7b, 0xbf, 0xf7, 0xe6, 0xbd, 0x67, 0xb4, 0x12, 0x90,
+	0x98, 0x47, 0x34, 0xb0, 0x06, 0x7e, 0xc0, 0xbb, 0x71, 0x14, 0x58, 0x0f, 0x63, 0x12, 0x1d, 0x9a,
+	0x61, 0x44, 0x39, 0xc5, 0xe7, 0xe1, 0xd4, 0x54, 0xa7, 0xfa, 0xe6, 0x3e, 0x65, 0x03, 0xca, 0xac,
+	0xae, 0xc3, 0x88, 0x14, 0xb5, 0x0e, 0xb6, 0xbb, 0x84, 0x3b, 0xdb, 0x56, 0xe8, 0x78, 0x7e, 0xe0,
+	0x70, 0x9f, 0x06, 0x52, 0x5b, 0x6f, 0xa4, 0x65, 0x95, 0xd4, 0x3e, 0xf5, 0xd5, 0xf9, 0x05, 0x8f,
+	0x7a, 0x54, 0x3c, 0x5a, 0xc9, 0x13, 0xbc, 0x5d, 0xf1, 0x28, 0xf5, 0xfa, 0xc4, 0x72, 0x42, 0xdf,
+	0x72, 0x82, 0x80, 0x72, 0x61, 0x92, 0xc1, 0xe9, 0xe5, 0x1c, 0xde, 0x6e, 0xe4, 0xbb, 0x1e, 0xe9,
+	0x44, 0x34, 0xe6, 0x04, 0x84, 0xd6, 0x72, 0x42, 0xfb, 0x3d, 0x27, 0x08, 0x48, 0xbf, 0xc3, 0xb8,
+	0xc3, 0x95, 0xa9, 0xd5, 0x9c, 0x54, 0xe8, 0x44, 0xce, 0xa0, 0xdc, 0x53, 0x48, 0x02, 0xd7, 0x0f,
+	0xbc, 0x4e, 0xf2, 0x47, 0x81, 0x2d, 0xa0, 0x8f, 0x72, 0x47, 0x11, 0x90, 0x3b, 0x8d, 0x08, 0x23,
+	0xd1, 0x01, 0xe0, 0x34, 0x2e, 0x20, 0xbc, 0x97, 0x50, 0xb8, 0x2b, 0xfc, 0xda, 0xe4, 0x61, 0x4c,
+	0x18, 0x37, 0x3e, 0x41, 0x2f, 0x67, 0xde, 0xb2, 0x90, 0x06, 0x8c, 0xe0, 0x77, 0x51, 0x45, 0xe2,
+	0xab, 0x6b, 0x2d, 0x6d, 0xa3, 0xb6, 0x53, 0x37, 0xc7, 0x93, 0x63, 0x4a, 0x8d, 0xf6, 0x99, 0x27,
+	0xcf, 0x9a, 0x33, 0x36, 0x48, 0x1b, 0x04, 0x5d, 0x14, 0xe6, 0x6e, 0xf4, 0xfb, 0xf4, 0x11, 0x71,
+	0x6f, 0x4a, 0x26, 0x94, 0x37, 0x7c, 0x1b, 0xa1, 0x51, 0xe2, 0xc0, 0xf4, 0x15, 0x53, 0x66, 0xce,
+	0x4c, 0x32, 0x67, 0xca, 0x82, 0x80, 0xfc, 0x99, 0xbb, 0x8e, 0x47, 0x40, 0xd7, 0x4e, 0x69, 0x1a,
+	0xbf, 0x6a, 0x68, 0xa5, 0xd8, 0x0f, 0xe0, 0xdf, 0x43, 0xe7, 0x1d, 0x79, 0xd4, 0x81, 0x6c, 0x24,
+	0x91, 0xcc, 0x6d, 0xd4, 0x76, 0x5a, 0xf9, 0x48, 0xb2, 0x46, 0x20, 0xa2, 0x25, 0x27, 0x6b, 0x1a,
+	0x7f, 0x98, 0xc1, 0x3e, 0x2b, 0xb0, 0xaf, 0x9f, 0x88, 0x5d, 0xe2, 0xc9, 0x80, 0x57, 0x1c, 0xed,
+	0xca, 0x0c, 0xbf, 0x70, 0x8e, 0x72, 0x7e, 0x46, 0x1c, 0xa9, 0x22, 0x7b, 0x5e, 0x8e, 0xc2, 0xac,
+	0xe9, 0xd3, 0xe3, 0xe8, 0x3a, 0xaa, 0x0b, 0xec, 0x60, 0xf9, 0x6e, 0x72, 0x93, 0x14, 0x41, 0xab,
+	0x08, 0xa9, 0x1b, 0xe6, 0xbb, 0x82, 0xa0, 0xaa, 0x5d, 0x85, 0x37, 0x77, 0x5c, 0xe3, 0x77, 0x0d,
+	0x2d, 0x17, 0xe8, 0x42, 0xd0, 0xef, 0xa1, 0xb3, 0xe2, 0x5a, 0x02, 0xb1, 0x8d, 0x7c, 0xa4, 0x69,
+	0x35, 0x88, 0x53, 0xaa, 0xe0, 0x1e, 0xaa, 0xfa, 0x41, 0xe7, 0x7e, 0xdf, 0xf7, 0x7a, 0xbc, 0x3e,
+	0x2b, 0x98, 0x5a, 0xce, 0x04, 0xa7, 0xc2, 0xba, 0x49, 0xfd, 0xa0, 0xfd, 0x66, 0xa2, 0xfa, 0xf3,
+	0x3f, 0xcd, 0x0d, 0xcf, 0xe7, 0xbd, 0xb8, 0x6b, 0xee, 0xd3, 0x81, 0x05, 0x3d, 0x4a, 0xfe, 0x6c,
+	0x31, 0xf7, 0x81, 0xc5, 0x0f, 0x43, 0xc2, 0x84, 0x02, 0xb3, 0xe7, 0xfd, 0xe0, 0xb6, 0x30, 0x6e,
+	0x74, 0x21, 0xfc, 0xb6, 0x68, 0x37, 0x76, 0xd2, 0x6d, 0x4e, 0xbd, 0x3e, 0x7e, 0x51, 0x3c, 0x65,
+	0x9d, 0x00, 0x4f, 0x1f, 0xa1, 0xc5, 0x74, 0xaf, 0x53, 0x95, 0xb1, 0x9a, 0xe7, 0x2b, 0xa5, 0x0e,
+	0x74, 0x2d, 0x74, 0x53, 0x16, 0x4f, 0xaf, 0x26, 0x96, 0xd1, 0x6b, 0x02, 0xef, 0xdd, 0x38, 0x0c,
+	0xfb, 0x87, 0xb7, 0x22, 0xff, 0x3e, 0x57, 0x5d, 0xec, 0x53, 0xe0, 0x2b, 0x73, 0x04, 0x91, 0xbc,
+	0x8f, 0x2a, 0x2e, 0x09, 0xe8, 0x60, 0x42, 0x08, 0xb7, 0x92, 0x73, 0xa9, 0xab, 0xfa, 0x99, 0x54,
+	0x19, 0x26, 0x02, 0xee, 0x50, 0x3b, 0x8e, 0x82, 0x17, 0x97, 0x88, 0xac, 0x93, 0x51, 0x22, 0xd2,
+	0xa3, 0x60, 0x42, 0x14, 0x29, 0x75, 0x95, 0x88, 0x30, 0x65, 0xf1, 0xf4, 0x12, 0x71, 0x31, 0x7b,
+	0xc1, 0xf6, 0x92, 0x21, 0x34, 0x1c, 0x28, 0xdf, 0x68, 0x08, 0xa7, 0x0f, 0x92, 0x7b, 0x14, 0xb3,
+	0xe4, 0xde, 0x89, 0x61, 0x75, 0xe2, 0xbd, 0x13, 0x4a, 0xea, 0xde, 0x09, 0x15, 0xbc, 0x8d, 0xce,
+	0xc4, 0x8c, 0xb8, 0x02, 0x72, 0xb5, 0xbd, 0x9a, 0x1c, 0xfd, 0xfd, 0xac, 0xf9, 0x8a, 0x44, 0xce,
+	0xdc, 0x07, 0xa6, 0x4f, 0xad, 0x81, 0xc3, 0x7b, 0xe6, 0x9d, 0x80, 0xdb, 0x42, 0xd4, 0xb8, 0x87,
+	0xf4, 0x22, 0x88, 0xc0, 0x69, 0x1b, 0x55, 0x84, 0x65, 0x45, 0xe6, 0xda, 0x64, 0x34, 0x32, 0x04,
+	0x55, 0x19, 0x52, 0x73, 0x58, 0x8d, 0xbb, 0x4e, 0xcc, 0x88, 0x94, 0x50, 0x14, 0x7c, 0xae, 0x8a,
+	0x26, 0x7d, 0x04, 0xae, 0x5f, 0x4d, 0x06, 0xab, 0x88, 0x26, 0x21, 0x62, 0xde, 0x86, 0x7f, 0x78,
+	0x1d, 0x2d, 0xc9, 0xa7, 0x51, 0x2f, 0x4e, 0x3a, 0x4c, 0xd5, 0x3e, 0x27, 0x5f, 0xab, 0x16, 0x6b,
+	0xac, 0x40, 0x64, 0xb6, 0x1c, 0xee, 0x2c, 0xeb, 0xfa, 0x37, 0x0d, 0x2d, 0xc2, 0x09, 0x10, 0x7f,
+	0x1d, 0xbd, 0x04, 0x7b, 0x00, 0x50, 0xbf, 0x9c, 0x0f, 0x16, 0x34, 0x20, 0x42, 0x25, 0x8f, 0x3f,
+	0x40, 0x35, 0x1a, 0x73, 0xc6, 0x1d, 0x51, 0x44, 0xd3, 0xd1, 0x9f, 0xd6, 0xc0, 0x16, 0x9a, 0xf3,
+	0x9c, 0xb0, 0x3e, 0x37, 0x8d, 0x62, 0x22, 0x69, 0xdc, 0x83, 0xd1, 0x38, 0x1e, 0x1c, 0x90, 0x77,
+	0x03, 0xcd, 0x03, 0x36, 0x95, 0xb9, 0x66, 0x69, 0x30, 0x99, 0xa4, 0x0d, 0xd5, 0x76, 0xfe, 0xac,
+	0xa1, 0xb3, 0xc2, 0x05, 0x7e, 0x84, 0x2a, 0x72, 0x85, 0xc1, 0x05, 0xe9, 0xcf, 0x6f, 0x4a, 0xfa,
+	0xeb, 0x27, 0x48, 0x49, 0x8c, 0x46, 0xeb, 0xeb, 0x3f, 0xfe, 0xfb, 0x6e, 0x56, 0xc7, 0x75, 0xab,
+	0x64, 0xe3, 0xc3, 0x3f, 0x6a, 0x68, 0x69, 0x6c, 0x6f, 0xc1, 0x5b, 0x25, 0xc6, 0x8b, 0xf7, 0x28,
+	0xdd, 0x9c, 0x56, 0x1c, 0x40, 0x6d, 0x0a, 0x50, 0x6b, 0xd8, 0xc8, 0x83, 0x1a, 0x5f, 0x93, 0x04,
+	0xbc, 0xb1, 0x95, 0xa1, 0x14, 0x5e, 0xf1, 0x0a, 0x53, 0x0a, 0xaf, 0x64, 0x13, 0x99, 0x04, 0x6f,
+	0x7c, 0x43, 0xc1, 0x3f, 0x69, 0x68, 0x21, 0x3d, 0xa2, 0xf1, 0x66, 0x89, 0xb3, 0x82, 0xd5, 0x41,
+	0x7f, 0x63, 0x2a, 0x59, 0x40, 0xf5, 0xb6, 0x40, 0x65, 0xe2, 0x6b, 0xd6, 0xe4, 0x0d, 0xdf, 0xfa,
+	0x72, 0xb4, 0x8e, 0x7c, 0x85, 0xbf, 0xd5, 0x50, 0x2d, 0x35, 0x86, 0xf0, 0xd5, 0x12, 0x97, 0xf9,
+	0x29, 0xa6, 0x6f, 0x4e, 0x23, 0x0a, 0xe0, 0xae, 0x08, 0x70, 0x2d, 0xdc, 0xc8, 0x83, 0x63, 0x42,
+	0xbc, 0xe3, 0x0a, 0xf7, 0x8f, 0x35, 0xb4, 0x90, 0x9e, 0x2b, 0xa5, 0x74, 0x15, 0x4c, 0xb8, 0x52,
+	0xba, 0x8a, 0x06, 0x95, 0xb1, 0x2e, 0x10, 0x5d, 0xc2, 0x4d, 0x6b, 0xe2, 0xb7, 0x0c, 0xc3, 0xdf,
+	0x6b, 0x68, 0x31, 0xd3, 0x97, 0xf1, 0x09, 0x69, 0xc9, 0x0c, 0x18, 0xfd, 0xda, 0x74, 0xc2, 0x80,
+	0x6a, 0x43, 0xa0, 0x32, 0x70, 0xab, 0x3c, 0x89, 0xb2, 0xa1, 0x8b, 0xc4, 0xa5, 0x3a, 0x76, 0x69,
+	0xe2, 0xf2, 0x0d, 0xbf, 0x34, 0x71, 0x05, 0x03, 0x60, 0x52, 0xe2, 0x44, 0xa7, 0x17, 0x35, 0x15,
+	0x33, 0xfc, 0x83, 0x86, 0xce, 0x65, 0xdb, 0x20, 0x2e, 0x8b, 0xbc, 0x70, 0x14, 0xe8, 0x5b, 0x53,
+	0x4a, 0x03, 0xae, 0xab, 0x02, 0xd7, 0x65, 0x7c, 0xc9, 0x2a, 0xfb, 0x8e, 0x64, 0x0a, 0x5a, 0x52,
+	0x53, 0xe9, 0xa5, 0xb1, 0xb4, 0xa6, 0x0a, 0xd6, 0xd7, 0xd2, 0x9a, 0x2a, 0xda, 0x42, 0x27, 0xd5,
+	0x54, 0x66, 0x3b, 0x6d, 0x7f, 0xfc, 0xe4, 0xa8, 0xa1, 0x3d, 0x3d, 0x6a, 0x68, 0xff, 0x1e, 0x35,
+	0xb4, 0xc7, 0xc7, 0x8d, 0x99, 0xa7, 0xc7, 0x8d, 0x99, 0xbf, 0x8e, 0x1b, 0x33, 0x9f, 0xed, 0xa4,
+	0xd6, 0x6f, 0x30, 0xb2, 0x45, 0x23, 0x6f, 0x68, 0xf0, 0xe0, 0x1d, 0xeb, 0x8b, 0x91, 0x55, 0xb1,
+	0x8e, 0x77, 0x2b, 0xe2, 0x8b, 0xf9, 0xad, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x72, 0xb1,
+	0x19, 0xb0, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllowedChannels queries the transfer channels allowed to mint and burn.
+	AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error)
+	// PendingChannels queries the opened channels waiting to be approved.
+	PendingChannels(ctx context.Context, in *QueryPendingChannelsRequest, opts ...grpc.CallOption) (*QueryPendingChannelsResponse, error)
+	// ChannelStats queries the minted, burned and in-flight amounts of a channel.
+	ChannelStats(ctx context.Context, in *QueryChannelStatsRequest, opts ...grpc.CallOption) (*QueryChannelStatsResponse, error)
+	// SupplyDrift reconciles the supply of every bridged local denom against the
+	// amounts minted and burned by the bridge. A nonzero drift is either an
+	// accounting bug or supply changed outside of the bridge, e.g. by another
+	// module burning the denom. A positive drift, supply the bridge does not
+	// account for, also breaks the bridged supply invariant.
+	SupplyDrift(ctx context.Context, in *QuerySupplyDriftRequest, opts ...grpc.CallOption) (*QuerySupplyDriftResponse, error)
+	// PendingBurns queries the acknowledged transfers waiting for their escrow to be burned.
+	PendingBurns(ctx context.Context, in *QueryPendingBurnsRequest, opts ...grpc.CallOption) (*QueryPendingBurnsResponse, error)
+	// ChannelQuotas queries the mint quotas together with their usage.
+	ChannelQuotas(ctx context.Context, in *QueryChannelQuotasRequest, opts ...grpc.CallOption) (*QueryChannelQuotasResponse, error)
+	// PauseStatus queries whether minting is paused.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// ReservesStatus compares the outstanding supply minted over every allowed channel
+	// to the counterparty escrow proven by interchain queries.
+	ReservesStatus(ctx context.Context, in *QueryReservesStatusRequest, opts ...grpc.CallOption) (*QueryReservesStatusResponse, error)
+	// BridgeRoutes queries the registered bridge routes.
+	BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedChannels(ctx context.Context, in *QueryAllowedChannelsRequest, opts ...grpc.CallOption) (*QueryAllowedChannelsResponse, error) {
+	out := new(QueryAllowedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/AllowedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingChannels(ctx context.Context, in *QueryPendingChannelsRequest, opts ...grpc.CallOption) (*QueryPendingChannelsResponse, error) {
+	out := new(QueryPendingChannelsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/PendingChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelStats(ctx context.Context, in *QueryChannelStatsRequest, opts ...grpc.CallOption) (*QueryChannelStatsResponse, error) {
+	out := new(QueryChannelStatsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/ChannelStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyDrift(ctx context.Context, in *QuerySupplyDriftRequest, opts ...grpc.CallOption) (*QuerySupplyDriftResponse, error) {
+	out := new(QuerySupplyDriftResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/SupplyDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBurns(ctx context.Context, in *QueryPendingBurnsRequest, opts ...grpc.CallOption) (*QueryPendingBurnsResponse, error) {
+	out := new(QueryPendingBurnsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/PendingBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelQuotas(ctx context.Context, in *QueryChannelQuotasRequest, opts ...grpc.CallOption) (*QueryChannelQuotasResponse, error) {
+	out := new(QueryChannelQuotasResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/ChannelQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/PauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReservesStatus(ctx context.Context, in *QueryReservesStatusRequest, opts ...grpc.CallOption) (*QueryReservesStatusResponse, error) {
+	out := new(QueryReservesStatusResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/ReservesStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRoutes(ctx context.Context, in *QueryBridgeRoutesRequest, opts ...grpc.CallOption) (*QueryBridgeRoutesResponse, error) {
+	out := new(QueryBridgeRoutesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.mintburn.Query/BridgeRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllowedChannels queries the transfer channels allowed to mint and burn.
+	AllowedChannels(context.Context, *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error)
+	// PendingChannels queries the opened channels waiting to be approved.
+	PendingChannels(context.Context, *QueryPendingChannelsRequest) (*QueryPendingChannelsResponse, error)
+	// ChannelStats queries the minted, burned and in-flight amounts of a channel.
+	ChannelStats(context.Context, *QueryChannelStatsRequest) (*QueryChannelStatsResponse, error)
+	// SupplyDrift reconciles the supply of every bridged local denom against the
+	// amounts minted and burned by the bridge. A nonzero drift is either an
+	// accounting bug or supply changed outside of the bridge, e.g. by another
+	// module burning the denom. A positive drift, supply the bridge does not
+	// account for, also breaks the bridged supply invariant.
+	SupplyDrift(context.Context, *QuerySupplyDriftRequest) (*QuerySupplyDriftResponse, error)
+	// PendingBurns queries the acknowledged transfers waiting for their escrow to be burned.
+	PendingBurns(context.Context, *QueryPendingBurnsRequest) (*QueryPendingBurnsResponse, error)
+	// ChannelQuotas queries the mint quotas together with their usage.
+	ChannelQuotas(context.Context, *QueryChannelQuotasRequest) (*QueryChannelQuotasResponse, error)
+	// PauseStatus queries whether minting is paused.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// ReservesStatus compares the outstanding supply minted over every allowed channel
+	// to the counterparty escrow proven by interchain queries.
+	ReservesStatus(context.Context, *QueryReservesStatusRequest) (*QueryReservesStatusResponse, error)
+	// BridgeRoutes queries the registered bridge routes.
+	BridgeRoutes(context.Context, *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllowedChannels(ctx context.Context, req *QueryAllowedChannelsRequest) (*QueryAllowedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedChannels not implemented")
+}
+func (*UnimplementedQueryServer) PendingChannels(ctx context.Context, req *QueryPendingChannelsRequest) (*QueryPendingChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChannels not implemented")
+}
+func (*UnimplementedQueryServer) ChannelStats(ctx context.Context, req *QueryChannelStatsRequest) (*QueryChannelStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelStats not implemented")
+}
+func (*UnimplementedQueryServer) SupplyDrift(ctx context.Context, req *QuerySupplyDriftRequest) (*QuerySupplyDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyDrift not implemented")
+}
+func (*UnimplementedQueryServer) PendingBurns(ctx context.Context, req *QueryPendingBurnsRequest) (*QueryPendingBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBurns not implemented")
+}
+func (*UnimplementedQueryServer) ChannelQuotas(ctx context.Context, req *QueryChannelQuotasRequest) (*QueryChannelQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelQuotas not implemented")
+}
+func (*UnimplementedQueryServer) PauseStatus(ctx context.Context, req *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (*UnimplementedQueryServer) ReservesStatus(ctx context.Context, req *QueryReservesStatusRequest) (*QueryReservesStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReservesStatus not implemented")
+}
+func (*UnimplementedQueryServer) BridgeRoutes(ctx context.Context, req *QueryBridgeRoutesRequest) (*QueryBridgeRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRoutes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/AllowedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedChannels(ctx, req.(*QueryAllowedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/PendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingChannels(ctx, req.(*QueryPendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/ChannelStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelStats(ctx, req.(*QueryChannelStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/SupplyDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyDrift(ctx, req.(*QuerySupplyDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/PendingBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBurns(ctx, req.(*QueryPendingBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/ChannelQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelQuotas(ctx, req.(*QueryChannelQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/PauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReservesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReservesStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReservesStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/ReservesStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReservesStatus(ctx, req.(*QueryReservesStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BridgeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BridgeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.mintburn.Query/BridgeRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BridgeRoutes(ctx, req.(*QueryBridgeRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.mintburn.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllowedChannels",
+			Handler:    _Query_AllowedChannels_Handler,
+		},
+		{
+			MethodName: "PendingChannels",
+			Handler:    _Query_PendingChannels_Handler,
+		},
+		{
+			MethodName: "ChannelStats",
+			Handler:    _Query_ChannelStats_Handler,
+		},
+		{
+			MethodName: "SupplyDrift",
+			Handler:    _Query_SupplyDrift_Handler,
+		},
+		{
+			MethodName: "PendingBurns",
+			Handler:    _Query_PendingBurns_Handler,
+		},
+		{
+			MethodName: "ChannelQuotas",
+			Handler:    _Query_ChannelQuotas_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "ReservesStatus",
+			Handler:    _Query_ReservesStatus_Handler,
+		},
+		{
+			MethodName: "BridgeRoutes",
+			Handler:    _Query_BridgeRoutes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/mintburn/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingChannels) > 0 {
+		for iNdEx := len(m.PendingChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InFlight) > 0 {
+		for iNdEx := len(m.InFlight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeRoutes) > 0 {
+		for iNdEx := len(m.BridgeRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDriftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDriftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDriftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyDriftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyDriftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyDriftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingBurns) > 0 {
+		for iNdEx := len(m.PendingBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelQuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelQuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelQuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelQuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelQuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelQuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelQuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelQuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelQuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedChannels[iNdEx])
+			copy(dAtA[i:], m.PausedChannels[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PausedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Gap.Size()
+		i -= size
+		if _, err := m.Gap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Outstanding.Size()
+		i -= size
+		if _, err := m.Outstanding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Reserve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReservesStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReservesStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReservesStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingChannels) > 0 {
+		for _, e := range m.PendingChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.InFlight) > 0 {
+		for _, e := range m.InFlight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBridgeRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeRoutes) > 0 {
+		for _, e := range m.BridgeRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyDriftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyDriftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingBurns) > 0 {
+		for _, e := range m.PendingBurns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelQuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChannelQuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Used.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelQuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedChannels) > 0 {
+		for _, s := range m.PausedChannels {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryReservesStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReserveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outstanding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Gap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReservesStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChannels = append(m.PendingChannels, AllowedChannel{})
+			if err := m.PendingChannels[len(m.PendingChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlight = append(m.InFlight, types.Coin{})
+			if err := m.InFlight[len(m.InFlight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgeRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgeRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgeRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeRoutes = append(m.BridgeRoutes, BridgeRoute{})
+			if err := m.BridgeRoutes[len(m.BridgeRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyDriftRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyDriftRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyDriftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyDriftResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyDriftResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyDriftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomSupply{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBurnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBurnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBurnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBurnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingBurns = append(m.PendingBurns, PendingBurn{})
+			if err := m.PendingBurns[len(m.PendingBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelQuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelQuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelQuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelQuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelQuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelQuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelQuotasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelQuotasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelQuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, ChannelQuotaStatus{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outstanding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReservesStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReservesStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReservesStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, ReserveStatus{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate performs a stateless validation of the channel quota.
+func (q ChannelQuota) Validate() error {
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "invalid channel_id: %s", err)
+	}
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "invalid denom: %s", err)
+	}
+	if q.MaxPerPacket.IsNil() || q.MaxPerPacket.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidChannelQuota, "max_per_packet must not be negative")
+	}
+	if q.WindowQuota.IsNil() || q.WindowQuota.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidChannelQuota, "window_quota must not be negative")
+	}
+	if q.WindowQuota.IsPositive() && q.Window <= 0 {
+		return errorsmod.Wrap(ErrInvalidChannelQuota, "window must be positive when window_quota is set")
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the quota usage.
+func (u QuotaUsage) Validate() error {
+	if err := host.ChannelIdentifierValidator(u.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "invalid usage channel_id: %s", err)
+	}
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "invalid usage denom: %s", err)
+	}
+	if u.Current.IsNil() || u.Current.IsNegative() || u.Previous.IsNil() || u.Previous.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "usage of %s/%s must not be negative", u.ChannelId, u.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/quota.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelQuota limits how much of a local denom may be minted over a channel.
+type ChannelQuota struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the local denom the quota applies to.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_per_packet is the largest amount a single packet may mint. Zero disables the limit.
+	MaxPerPacket cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_per_packet,json=maxPerPacket,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_packet"`
+	// window_quota is the amount that may be minted within any window. Zero disables the limit.
+	WindowQuota cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=window_quota,json=windowQuota,proto3,customtype=cosmossdk.io/math.Int" json:"window_quota"`
+	// window is the length of the rolling window, e.g. 24h.
+	Window time.Duration `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *ChannelQuota) Reset()         { *m = ChannelQuota{} }
+func (m *ChannelQuota) String() string { return proto.CompactTextString(m) }
+func (*ChannelQuota) ProtoMessage()    {}
+func (*ChannelQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f55117addf9fc667, []int{0}
+}
+func (m *ChannelQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelQuota.Merge(m, src)
+}
+func (m *ChannelQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelQuota proto.InternalMessageInfo
+
+func (m *ChannelQuota) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelQuota) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChannelQuota) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QuotaUsage tracks the amount minted against a quota. The rolling window is
+// approximated from the current and the previous fixed window, weighting the
+// previous one by how much of it still overlaps the rolling window.
+type QuotaUsage struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// window_start is the start of the current fixed window.
+	WindowStart time.Time             `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	Current     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current,proto3,customtype=cosmossdk.io/math.Int" json:"current"`
+	Previous    cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=previous,proto3,customtype=cosmossdk.io/math.Int" json:"previous"`
+}
+
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f55117addf9fc667, []int{1}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsage) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuotaUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ChannelQuota)(nil), "neutron.mintburn.ChannelQuota")
+	proto.RegisterType((*QuotaUsage)(nil), "neutron.mintburn.QuotaUsage")
+}
+
+func init() { proto.RegisterFile("neutron/mintburn/quota.proto", fileDescriptor_f55117addf9fc667) }
+
+var fileDescriptor_f55117addf9fc667 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x6b, 0xd4, 0x40,
+	0x1c, 0xcd, 0x54, 0x5b, 0xdb, 0xd9, 0x45, 0x24, 0x54, 0x88, 0x8b, 0x4d, 0x4a, 0x4f, 0xbd, 0x38,
+	0x03, 0x2b, 0x22, 0xe2, 0x45, 0xb6, 0x82, 0x14, 0x3c, 0xd4, 0x55, 0x2f, 0x5e, 0xc2, 0x24, 0x19,
+	0xb3, 0x43, 0x3b, 0xf3, 0x8b, 0xf3, 0xa7, 0x5d, 0x3f, 0x81, 0xd7, 0x1e, 0xfd, 0x48, 0x3d, 0xf6,
+	0x28, 0x1e, 0xaa, 0xec, 0x7e, 0x0f, 0x91, 0xcc, 0x24, 0x15, 0xf4, 0xe0, 0xd2, 0xdb, 0xfc, 0xf2,
+	0xde, 0xfb, 0xe5, 0xbd, 0x37, 0x83, 0x1f, 0x2a, 0xee, 0xac, 0x06, 0x45, 0xa5, 0x50, 0xb6, 0x70,
+	0x5a, 0xd1, 0x4f, 0x0e, 0x2c, 0x23, 0x8d, 0x06, 0x0b, 0xf1, 0xbd, 0x0e, 0x25, 0x3d, 0x3a, 0xda,
+	0xae, 0xa1, 0x06, 0x0f, 0xd2, 0xf6, 0x14, 0x78, 0xa3, 0xb4, 0x06, 0xa8, 0x4f, 0x38, 0xf5, 0x53,
+	0xe1, 0x3e, 0xd2, 0xca, 0x69, 0x66, 0x05, 0xa8, 0x0e, 0xcf, 0xfe, 0xc6, 0xad, 0x90, 0xdc, 0x58,
+	0x26, 0x9b, 0x40, 0xd8, 0xfb, 0xb2, 0x86, 0x87, 0x07, 0x33, 0xa6, 0x14, 0x3f, 0x79, 0xd3, 0xfe,
+	0x3f, 0xde, 0xc1, 0xb8, 0x0c, 0x73, 0x2e, 0xaa, 0x04, 0xed, 0xa2, 0xfd, 0xad, 0xe9, 0x56, 0xf7,
+	0xe5, 0xb0, 0x8a, 0xb7, 0xf1, 0x7a, 0xc5, 0x15, 0xc8, 0x64, 0xcd, 0x23, 0x61, 0x88, 0x0f, 0xf0,
+	0x5d, 0xc9, 0xe6, 0x79, 0xc3, 0x75, 0xde, 0xb0, 0xf2, 0x98, 0xdb, 0xe4, 0x56, 0x0b, 0x4f, 0x76,
+	0x2e, 0xae, 0xb2, 0xe8, 0xfb, 0x55, 0x76, 0xbf, 0x04, 0x23, 0xc1, 0x98, 0xea, 0x98, 0x08, 0xa0,
+	0x92, 0xd9, 0x19, 0x39, 0x54, 0x76, 0x3a, 0x94, 0x6c, 0x7e, 0xc4, 0xf5, 0x91, 0x97, 0xc4, 0x2f,
+	0xf0, 0xf0, 0x4c, 0xa8, 0x0a, 0xce, 0x72, 0xdf, 0x44, 0x72, 0x7b, 0x95, 0x15, 0x83, 0x20, 0x09,
+	0xde, 0x9f, 0xe3, 0x8d, 0x30, 0x26, 0xeb, 0xbb, 0x68, 0x7f, 0x30, 0x7e, 0x40, 0x42, 0x7c, 0xd2,
+	0xc7, 0x27, 0x2f, 0xbb, 0x7a, 0x26, 0x9b, 0xed, 0xda, 0xaf, 0x3f, 0x32, 0x34, 0xed, 0x24, 0x7b,
+	0xbf, 0x10, 0xc6, 0x7e, 0xcd, 0x7b, 0xc3, 0x6a, 0x7e, 0xb3, 0x1e, 0x5e, 0x5d, 0x47, 0x30, 0x96,
+	0xe9, 0xd0, 0xc2, 0x60, 0x3c, 0xfa, 0xc7, 0xc6, 0xbb, 0xfe, 0x16, 0x82, 0x8f, 0xf3, 0xd6, 0x47,
+	0x97, 0xe4, 0x6d, 0x2b, 0x8c, 0x9f, 0xe2, 0x3b, 0xa5, 0xd3, 0x9a, 0x2b, 0xbb, 0x5a, 0x0d, 0x3d,
+	0x3b, 0x7e, 0x86, 0x37, 0x1b, 0xcd, 0x4f, 0x05, 0x38, 0xe3, 0x4b, 0xf8, 0xaf, 0xf2, 0x9a, 0x3e,
+	0x79, 0x7d, 0xb1, 0x48, 0xd1, 0xe5, 0x22, 0x45, 0x3f, 0x17, 0x29, 0x3a, 0x5f, 0xa6, 0xd1, 0xe5,
+	0x32, 0x8d, 0xbe, 0x2d, 0xd3, 0xe8, 0xc3, 0xb8, 0x16, 0x76, 0xe6, 0x0a, 0x52, 0x82, 0xa4, 0xdd,
+	0xc3, 0x7c, 0x04, 0xba, 0xee, 0xcf, 0xf4, 0xf4, 0x09, 0x9d, 0xff, 0x79, 0xc7, 0xf6, 0x73, 0xc3,
+	0x4d, 0xb1, 0xe1, 0xc3, 0x3e, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x29, 0xe2, 0xcf, 0xe8,
+	0x02, 0x00, 0x00,
+}
+
+func (m *ChannelQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuota(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.WindowQuota.Size()
+		i -= size
+		if _, err := m.WindowQuota.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxPerPacket.Size()
+		i -= size
+		if _, err := m.MaxPerPacket.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Previous.Size()
+		i -= size
+		if _, err := m.Previous.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Current.Size()
+		i -= size
+		if _, err := m.Current.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuota(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuota(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = m.MaxPerPacket.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.WindowQuota.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuota(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.Current.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	l = m.Previous.Size()
+	n += 1 + l + sovQuota(uint64(l))
+	return n
+}
+
+func sovQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuota(x uint64) (n int) {
+	return sovQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerPacket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowQuota", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuota = fmt.Errorf("proto: unexpected end of group")
+)
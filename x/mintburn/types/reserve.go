@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	icqtypes "github.com/neutron-org/neutron/v5/x/interchainqueries/types"
+)
+
+// NewReserve returns a reserve not proven yet.
+func NewReserve(channelID, localDenom, escrowDenom string, queryID uint64) Reserve {
+	return Reserve{
+		ChannelId:   channelID,
+		LocalDenom:  localDenom,
+		EscrowDenom: escrowDenom,
+		QueryId:     queryID,
+		Escrowed:    sdkmath.ZeroInt(),
+	}
+}
+
+// IsProven reports whether a result was submitted for the reserve query.
+func (r Reserve) IsProven() bool {
+	return r.RemoteHeight > 0
+}
+
+// Validate performs a stateless validation of the reserve.
+func (r Reserve) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid reserve channel id: %w", err)
+	}
+	if err := sdk.ValidateDenom(r.LocalDenom); err != nil {
+		return fmt.Errorf("invalid reserve local denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(r.EscrowDenom); err != nil {
+		return fmt.Errorf("invalid reserve escrow denom: %w", err)
+	}
+	if r.Escrowed.IsNil() || r.Escrowed.IsNegative() {
+		return fmt.Errorf("invalid escrowed amount for reserve %s/%s", r.ChannelId, r.LocalDenom)
+	}
+	return nil
+}
+
+// GetEscrowBalanceKVKey returns the key of the bank store holding the balance of the
+// escrow account in denom.
+func GetEscrowBalanceKVKey(escrowAddr sdk.AccAddress, denom string) *icqtypes.KVKey {
+	key := append(address.MustLengthPrefix(escrowAddr), []byte(denom)...)
+	return &icqtypes.KVKey{
+		Path: banktypes.StoreKey,
+		Key:  append(banktypes.BalancesPrefix.Bytes(), key...),
+	}
+}
+
+// DecodeEscrowBalance decodes the escrow balance proven by a KV query result. A missing
+// value is a zero balance.
+func DecodeEscrowBalance(result *icqtypes.QueryResult) (sdkmath.Int, error) {
+	if len(result.KvResults) != 1 {
+		return sdkmath.Int{}, fmt.Errorf("expected a single KV result, got %d", len(result.KvResults))
+	}
+
+	value := result.KvResults[0].Value
+	if len(value) == 0 {
+		return sdkmath.ZeroInt(), nil
+	}
+	return banktypes.BalanceValueCodec.Decode(value)
+}
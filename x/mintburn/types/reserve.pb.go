@@ -0,0 +1,589 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/mintburn/reserve.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reserve tracks the interchain query proving the counterparty escrow that backs
+// a local denom minted over an allowed channel, together with its last verified result.
+type Reserve struct {
+	ChannelId  string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LocalDenom string `protobuf:"bytes,2,opt,name=local_denom,json=localDenom,proto3" json:"local_denom,omitempty"`
+	// escrow_denom is the denom escrowed by the counterparty, the remote denom of the
+	// route or the ibc/ hash of its trace.
+	EscrowDenom string `protobuf:"bytes,3,opt,name=escrow_denom,json=escrowDenom,proto3" json:"escrow_denom,omitempty"`
+	// query_id is the id of the KV query registered in x/interchainqueries.
+	QueryId uint64 `protobuf:"varint,4,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// escrowed is the proven balance of the counterparty channel escrow.
+	Escrowed cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=escrowed,proto3,customtype=cosmossdk.io/math.Int" json:"escrowed"`
+	// remote_height is the counterparty height the balance was proven at, zero until
+	// a result is submitted.
+	RemoteHeight uint64 `protobuf:"varint,6,opt,name=remote_height,json=remoteHeight,proto3" json:"remote_height,omitempty"`
+	// local_height is the height the result was submitted at.
+	LocalHeight uint64 `protobuf:"varint,7,opt,name=local_height,json=localHeight,proto3" json:"local_height,omitempty"`
+}
+
+func (m *Reserve) Reset()         { *m = Reserve{} }
+func (m *Reserve) String() string { return proto.CompactTextString(m) }
+func (*Reserve) ProtoMessage()    {}
+func (*Reserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04e364bf6d1272cf, []int{0}
+}
+func (m *Reserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reserve.Merge(m, src)
+}
+func (m *Reserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reserve proto.InternalMessageInfo
+
+func (m *Reserve) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *Reserve) GetLocalDenom() string {
+	if m != nil {
+		return m.LocalDenom
+	}
+	return ""
+}
+
+func (m *Reserve) GetEscrowDenom() string {
+	if m != nil {
+		return m.EscrowDenom
+	}
+	return ""
+}
+
+func (m *Reserve) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *Reserve) GetRemoteHeight() uint64 {
+	if m != nil {
+		return m.RemoteHeight
+	}
+	return 0
+}
+
+func (m *Reserve) GetLocalHeight() uint64 {
+	if m != nil {
+		return m.LocalHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Reserve)(nil), "neutron.mintburn.Reserve")
+}
+
+func init() { proto.RegisterFile("neutron/mintburn/reserve.proto", fileDescriptor_04e364bf6d1272cf) }
+
+var fileDescriptor_04e364bf6d1272cf = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0x87, 0x93, 0x52, 0xfa, 0xc7, 0x2d, 0x12, 0x8a, 0x40, 0x0a, 0x48, 0x75, 0x0b, 0x2c, 0x5d,
+	0x88, 0x25, 0x10, 0x03, 0x6b, 0xc5, 0x40, 0x25, 0xa6, 0x8c, 0x2c, 0x55, 0x9b, 0x9c, 0x92, 0x88,
+	0xda, 0x57, 0x6c, 0xa7, 0xd0, 0x57, 0x60, 0xe2, 0xb1, 0x3a, 0x76, 0x44, 0x0c, 0x15, 0x6a, 0x5e,
+	0x04, 0xc5, 0x4e, 0x61, 0xbb, 0x7c, 0xbf, 0xef, 0xe2, 0xf3, 0x99, 0x50, 0x01, 0xb9, 0x96, 0x28,
+	0x18, 0xcf, 0x84, 0x9e, 0xe5, 0x52, 0x30, 0x09, 0x0a, 0xe4, 0x12, 0x82, 0x85, 0x44, 0x8d, 0xde,
+	0x71, 0x95, 0x07, 0xfb, 0xfc, 0xfc, 0x24, 0xc1, 0x04, 0x4d, 0xc8, 0xca, 0xca, 0x7a, 0x97, 0x1f,
+	0x35, 0xd2, 0x0c, 0x6d, 0xa7, 0xd7, 0x23, 0x24, 0x4a, 0xa7, 0x42, 0xc0, 0x7c, 0x92, 0xc5, 0xbe,
+	0x3b, 0x70, 0x87, 0xed, 0xb0, 0x5d, 0x91, 0x71, 0xec, 0xf5, 0x49, 0x67, 0x8e, 0xd1, 0x74, 0x3e,
+	0x89, 0x41, 0x20, 0xf7, 0x6b, 0x26, 0x27, 0x06, 0x3d, 0x94, 0xc4, 0xbb, 0x20, 0x5d, 0x50, 0x91,
+	0xc4, 0xb7, 0xca, 0x38, 0x30, 0x46, 0xc7, 0x32, 0xab, 0x9c, 0x91, 0xd6, 0x6b, 0x0e, 0x72, 0x55,
+	0x1e, 0x50, 0x1f, 0xb8, 0xc3, 0x7a, 0xd8, 0x34, 0xdf, 0xe3, 0xd8, 0xbb, 0x27, 0x2d, 0x6b, 0x42,
+	0xec, 0x1f, 0x96, 0x9d, 0xa3, 0xde, 0x7a, 0xdb, 0x77, 0xbe, 0xb7, 0xfd, 0xd3, 0x08, 0x15, 0x47,
+	0xa5, 0xe2, 0x97, 0x20, 0x43, 0xc6, 0xa7, 0x3a, 0x0d, 0xc6, 0x42, 0x87, 0x7f, 0xba, 0x77, 0x45,
+	0x8e, 0x24, 0x70, 0xd4, 0x30, 0x49, 0x21, 0x4b, 0x52, 0xed, 0x37, 0xcc, 0xaf, 0xbb, 0x16, 0x3e,
+	0x1a, 0x56, 0x4e, 0x67, 0xc7, 0xaf, 0x9c, 0xa6, 0x71, 0xec, 0x95, 0xac, 0x32, 0x7a, 0x5a, 0xef,
+	0xa8, 0xbb, 0xd9, 0x51, 0xf7, 0x67, 0x47, 0xdd, 0xcf, 0x82, 0x3a, 0x9b, 0x82, 0x3a, 0x5f, 0x05,
+	0x75, 0x9e, 0x6f, 0x92, 0x4c, 0xa7, 0xf9, 0x2c, 0x88, 0x90, 0xb3, 0x6a, 0xb3, 0xd7, 0x28, 0x93,
+	0x7d, 0xcd, 0x96, 0x77, 0xec, 0xfd, 0xff, 0x29, 0xf4, 0x6a, 0x01, 0x6a, 0xd6, 0x30, 0x1b, 0xbe,
+	0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xda, 0x7f, 0x90, 0xd7, 0xab, 0x01, 0x00, 0x00,
+}
+
+func (m *Reserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LocalHeight != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.LocalHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemoteHeight != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.RemoteHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Escrowed.Size()
+		i -= size
+		if _, err := m.Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReserve(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.QueryId != 0 {
+		i = encodeVarintReserve(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EscrowDenom) > 0 {
+		i -= len(m.EscrowDenom)
+		copy(dAtA[i:], m.EscrowDenom)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.EscrowDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LocalDenom) > 0 {
+		i -= len(m.LocalDenom)
+		copy(dAtA[i:], m.LocalDenom)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.LocalDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintReserve(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReserve(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReserve(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	l = len(m.LocalDenom)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	l = len(m.EscrowDenom)
+	if l > 0 {
+		n += 1 + l + sovReserve(uint64(l))
+	}
+	if m.QueryId != 0 {
+		n += 1 + sovReserve(uint64(m.QueryId))
+	}
+	l = m.Escrowed.Size()
+	n += 1 + l + sovReserve(uint64(l))
+	if m.RemoteHeight != 0 {
+		n += 1 + sovReserve(uint64(m.RemoteHeight))
+	}
+	if m.LocalHeight != 0 {
+		n += 1 + sovReserve(uint64(m.LocalHeight))
+	}
+	return n
+}
+
+func sovReserve(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReserve(x uint64) (n int) {
+	return sovReserve(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReserve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+			}
+			m.RemoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalHeight", wireType)
+			}
+			m.LocalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReserve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReserve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReserve(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReserve
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReserve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReserve
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReserve
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReserve
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReserve        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReserve          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReserve = fmt.Errorf("proto: unexpected end of group")
+)
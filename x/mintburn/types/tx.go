@@ -0,0 +1,366 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterBridgeRoute{}
+	_ sdk.Msg = &MsgUpdateBridgeRoute{}
+	_ sdk.Msg = &MsgRemoveBridgeRoute{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetChannelQuota{}
+	_ sdk.Msg = &MsgRemoveChannelQuota{}
+	_ sdk.Msg = &MsgPauseBridge{}
+	_ sdk.Msg = &MsgResumeBridge{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgApproveChannel{}
+)
+
+func (msg *MsgRegisterBridgeRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterBridgeRoute) Type() string {
+	return "register-bridge-route"
+}
+
+func (msg *MsgRegisterBridgeRoute) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterBridgeRoute) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRegisterBridgeRoute) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.BridgeRoute.Validate()
+}
+
+func (msg *MsgUpdateBridgeRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateBridgeRoute) Type() string {
+	return "update-bridge-route"
+}
+
+func (msg *MsgUpdateBridgeRoute) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateBridgeRoute) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateBridgeRoute) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.BridgeRoute.Validate()
+}
+
+func (msg *MsgRemoveBridgeRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveBridgeRoute) Type() string {
+	return "remove-bridge-route"
+}
+
+func (msg *MsgRemoveBridgeRoute) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveBridgeRoute) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveBridgeRoute) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if msg.CounterpartyChainId == "" {
+		return errorsmod.Wrap(ErrInvalidBridgeRoute, "counterparty_chain_id must not be empty")
+	}
+	if msg.RemoteDenom == "" {
+		return errorsmod.Wrap(ErrInvalidBridgeRoute, "remote_denom must not be empty")
+	}
+	return nil
+}
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return "update-params"
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateParams) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.Params.Validate()
+}
+
+func (msg *MsgSetChannelQuota) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetChannelQuota) Type() string {
+	return "set-channel-quota"
+}
+
+func (msg *MsgSetChannelQuota) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetChannelQuota) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetChannelQuota) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return msg.Quota.Validate()
+}
+
+func (msg *MsgRemoveChannelQuota) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveChannelQuota) Type() string {
+	return "remove-channel-quota"
+}
+
+func (msg *MsgRemoveChannelQuota) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveChannelQuota) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveChannelQuota) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelQuota, "invalid channel_id: %s", err)
+	}
+	if msg.Denom == "" {
+		return errorsmod.Wrap(ErrInvalidChannelQuota, "denom must not be empty")
+	}
+	return nil
+}
+
+func (msg *MsgPauseBridge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseBridge) Type() string {
+	return "pause-bridge"
+}
+
+func (msg *MsgPauseBridge) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgPauseBridge) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgPauseBridge) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return validateOptionalChannelID(msg.ChannelId)
+}
+
+func (msg *MsgResumeBridge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeBridge) Type() string {
+	return "resume-bridge"
+}
+
+func (msg *MsgResumeBridge) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResumeBridge) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgResumeBridge) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return validateOptionalChannelID(msg.ChannelId)
+}
+
+func (msg *MsgMint) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMint) Type() string {
+	return "mint"
+}
+
+func (msg *MsgMint) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgMint) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgMint) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrap(err, "recipient is invalid")
+	}
+	return validateSupplyCorrection(msg.Amount, msg.Reason, msg.ChannelId)
+}
+
+func (msg *MsgBurn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurn) Type() string {
+	return "burn"
+}
+
+func (msg *MsgBurn) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgBurn) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgBurn) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	return validateSupplyCorrection(msg.Amount, msg.Reason, msg.ChannelId)
+}
+
+func (msg *MsgApproveChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveChannel) Type() string {
+	return "approve-channel"
+}
+
+func (msg *MsgApproveChannel) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgApproveChannel) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgApproveChannel) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel_id: %s", err)
+	}
+	return nil
+}
+
+// validateOptionalChannelID accepts an empty channel id, which stands for every channel
+func validateOptionalChannelID(channelID string) error {
+	if channelID == "" {
+		return nil
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel_id: %s", err)
+	}
+	return nil
+}
+
+func validateSupplyCorrection(amount sdk.Coin, reason, channelID string) error {
+	if err := amount.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", err)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+	if strings.TrimSpace(reason) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "reason must not be empty")
+	}
+	if len(reason) > MaxReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason must not be longer than %d characters", MaxReasonLength)
+	}
+	return validateOptionalChannelID(channelID)
+}